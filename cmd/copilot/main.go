@@ -86,6 +86,7 @@ func buildRootCmd() *cobra.Command {
 	// "Extend" command group
 	cmd.AddCommand(cli.BuildStorageCmd())
 	cmd.AddCommand(cli.BuildSecretCmd())
+	cmd.AddCommand(cli.BuildConfigCmd())
 
 	// "Settings" command group.
 	cmd.AddCommand(cli.BuildVersionCmd())
@@ -11,6 +11,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/override"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/dustin/go-humanize/english"
 	"gopkg.in/yaml.v3"
 )
@@ -18,6 +20,8 @@ import (
 const (
 	// StackName is the name of the addons nested stack resource.
 	StackName = "AddonsStack"
+
+	cdkAppFileName = "cdk.json"
 )
 
 var (
@@ -35,6 +39,13 @@ var (
 		}
 		return fnames
 	}()
+
+	// synthCDKApp synthesizes the CDK application under dir into a CloudFormation template.
+	synthCDKApp = func(dir string) ([]byte, error) {
+		return override.WithCDK(dir, override.CDKOpts{
+			ExecWriter: log.DiagnosticWriter,
+		}).Synth()
+	}
 )
 
 // WorkspaceAddonsReader finds and reads addons from a workspace.
@@ -158,7 +169,7 @@ func (p *parser) stack() (*stack, error) {
 			ParentErr: err,
 		})
 	}
-	template, err := p.parseTemplate(fNames)
+	template, err := p.parseTemplate(path, fNames)
 	if err != nil {
 		return nil, err
 	}
@@ -177,17 +188,32 @@ func (p *parser) stack() (*stack, error) {
 
 // parseTemplate merges CloudFormation templates under the "addons/" directory  into a single CloudFormation
 // template and returns it.
+// If the addons directory contains a CDK application, the template synthesized from it is merged as well.
 //
-// If the addons directory doesn't exist or no yaml files are found in
+// If the addons directory doesn't exist or no yaml files nor CDK application are found in
 // the addons directory, it returns the empty string and
 // ErrAddonsNotFound.
-func (p *parser) parseTemplate(fNames []string) (*cfnTemplate, error) {
+func (p *parser) parseTemplate(dir string, fNames []string) (*cfnTemplate, error) {
 	templateFiles := filterFiles(fNames, yamlMatcher, nonParamsMatcher)
-	if len(templateFiles) == 0 {
+	hasCDKApp := slices.Contains(fNames, cdkAppFileName)
+	if len(templateFiles) == 0 && !hasCDKApp {
 		return nil, &ErrAddonsNotFound{}
 	}
 
 	mergedTemplate := newCFNTemplate("merged")
+	if hasCDKApp {
+		out, err := synthCDKApp(dir)
+		if err != nil {
+			return nil, fmt.Errorf("synthesize CDK app under path %s: %w", dir, err)
+		}
+		tpl := newCFNTemplate(cdkAppFileName)
+		if err := yaml.Unmarshal(out, tpl); err != nil {
+			return nil, fmt.Errorf("unmarshal template synthesized from CDK app under path %s: %w", dir, err)
+		}
+		if err := mergedTemplate.merge(tpl); err != nil {
+			return nil, err
+		}
+	}
 	for _, fname := range templateFiles {
 		path := p.addonsFilePath(fname)
 		out, err := p.ws.ReadFile(path)
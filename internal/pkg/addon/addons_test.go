@@ -226,6 +226,135 @@ func TestWorkload_Template(t *testing.T) {
 	}
 }
 
+func TestWorkload_TemplateWithCDKApp(t *testing.T) {
+	const testSvcName = "mysvc"
+	cdkTemplate := `Parameters:
+  App:
+    Type: String
+  Env:
+    Type: String
+  Name:
+    Type: String
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+Outputs:
+  QueueURL:
+    Value: !Ref Queue
+`
+	testCases := map[string]struct {
+		synthTemplate string
+		synthErr      error
+		setupMocks    func(m addonMocks)
+
+		wantedSynthDir string
+		wantedTemplate string
+		wantedErr      error
+	}{
+		"return wrapped error if the CDK app fails to synthesize": {
+			synthErr: errors.New("some error"),
+			setupMocks: func(m addonMocks) {
+				m.ws.EXPECT().WorkloadAddonsAbsPath(testSvcName).Return("mockPath")
+				m.ws.EXPECT().ListFiles("mockPath").Return([]string{"cdk.json", "package.json"}, nil)
+			},
+			wantedErr: errors.New("synthesize CDK app under path mockPath: some error"),
+		},
+		"return error if the synthesized template is missing reserved parameters": {
+			synthTemplate: `Parameters:
+  App:
+    Type: String
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+`,
+			setupMocks: func(m addonMocks) {
+				m.ws.EXPECT().WorkloadAddonsAbsPath(testSvcName).Return("mockPath")
+				m.ws.EXPECT().ListFiles("mockPath").Return([]string{"cdk.json", "package.json"}, nil)
+			},
+			wantedErr: errors.New(`required parameter "Env" is missing from the template`),
+		},
+		"use the synthesized template of the CDK app": {
+			synthTemplate: cdkTemplate,
+			setupMocks: func(m addonMocks) {
+				m.ws.EXPECT().WorkloadAddonsAbsPath(testSvcName).Return("mockPath")
+				m.ws.EXPECT().ListFiles("mockPath").Return([]string{"cdk.json", "package.json", "tsconfig.json"}, nil)
+			},
+			wantedSynthDir: "mockPath",
+			wantedTemplate: cdkTemplate,
+		},
+		"merge the synthesized template with CloudFormation addons": {
+			synthTemplate: cdkTemplate,
+			setupMocks: func(m addonMocks) {
+				m.ws.EXPECT().WorkloadAddonsAbsPath(testSvcName).Return("mockPath")
+				m.ws.EXPECT().ListFiles("mockPath").Return([]string{"cdk.json", "bucket.yml"}, nil)
+				m.ws.EXPECT().WorkloadAddonFileAbsPath(testSvcName, "bucket.yml").Return("mockPath/bucket.yml")
+				m.ws.EXPECT().ReadFile("mockPath/bucket.yml").Return([]byte(`Parameters:
+  App:
+    Type: String
+  Env:
+    Type: String
+  Name:
+    Type: String
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+`), nil)
+			},
+			wantedSynthDir: "mockPath",
+			wantedTemplate: `Parameters:
+  App:
+    Type: String
+  Env:
+    Type: String
+  Name:
+    Type: String
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+  Bucket:
+    Type: AWS::S3::Bucket
+Outputs:
+  QueueURL:
+    Value: !Ref Queue
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := addonMocks{
+				ws: mocks.NewMockWorkspaceAddonsReader(ctrl),
+			}
+			tc.setupMocks(mocks)
+			defer func(original func(dir string) ([]byte, error)) {
+				synthCDKApp = original
+			}(synthCDKApp)
+			var gotSynthDir string
+			synthCDKApp = func(dir string) ([]byte, error) {
+				gotSynthDir = dir
+				return []byte(tc.synthTemplate), tc.synthErr
+			}
+
+			// WHEN
+			stack, err := ParseFromWorkload(testSvcName, mocks.ws)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSynthDir, gotSynthDir)
+
+			template, err := stack.Template()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTemplate, template)
+		})
+	}
+}
+
 func TestWorkload_Parameters(t *testing.T) {
 	mockTemplate := `Parameters:
   App:
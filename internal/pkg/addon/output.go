@@ -6,6 +6,7 @@ package addon
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -16,8 +17,13 @@ const (
 	secretManagerSecretType = "AWS::SecretsManager::Secret"
 	iamManagedPolicyType    = "AWS::IAM::ManagedPolicy"
 	securityGroupType       = "AWS::EC2::SecurityGroup"
+	s3BucketType            = "AWS::S3::Bucket"
+	dynamoDBTableType       = "AWS::DynamoDB::Table"
 )
 
+// fmtTableStreamOutputName is the name of the output of the stream ARN of a DynamoDB table generated by "storage init".
+const fmtTableStreamOutputName = "%sStreamArn"
+
 // Output represents an output from a CloudFormation template.
 type Output struct {
 	// Name is the Logical ID of the output.
@@ -28,6 +34,9 @@ type Output struct {
 	IsManagedPolicy bool
 	// SecurityGroup is true if the output value refers a SecurityGroup ARN. Otherwise, false.
 	IsSecurityGroup bool
+	// IsTableStream is true if the output is the ARN of the stream of a DynamoDB table generated by "storage init",
+	// which is only used to subscribe to the table. Otherwise, false.
+	IsTableStream bool
 }
 
 // Outputs parses the Outputs section of a CloudFormation template to extract logical IDs and returns them.
@@ -65,11 +74,58 @@ func Outputs(template string) ([]Output, error) {
 			output.IsManagedPolicy = typeFor[ref] == iamManagedPolicyType
 			output.IsSecurityGroup = typeFor[ref] == securityGroupType
 		}
+		if logicalID, attr, ok := outputNode.getAtt(); ok {
+			output.IsTableStream = typeFor[logicalID] == dynamoDBTableType && attr == "StreamArn" &&
+				output.Name == fmt.Sprintf(fmtTableStreamOutputName, logicalID)
+		}
 		outputs = append(outputs, output)
 	}
 	return outputs, nil
 }
 
+// EventBridgeBuckets parses the Resources section of a CloudFormation template and returns whether each S3 bucket,
+// by logical ID, sends its notifications to Amazon EventBridge.
+// Buckets whose setting depends on intrinsic functions, such as "!If", are left out.
+func EventBridgeBuckets(template string) (map[string]bool, error) {
+	var tpl struct {
+		Resources map[string]struct {
+			Type       string    `yaml:"Type"`
+			Properties yaml.Node `yaml:"Properties"`
+		} `yaml:"Resources"`
+	}
+	if err := yaml.Unmarshal([]byte(template), &tpl); err != nil {
+		return nil, fmt.Errorf("unmarshal addon cloudformation template: %w", err)
+	}
+	buckets := make(map[string]bool)
+	for logicalID, resource := range tpl.Resources {
+		if resource.Type != s3BucketType {
+			continue
+		}
+		var props struct {
+			NotificationConfiguration struct {
+				EventBridgeConfiguration struct {
+					EventBridgeEnabled yaml.Node `yaml:"EventBridgeEnabled"`
+				} `yaml:"EventBridgeConfiguration"`
+			} `yaml:"NotificationConfiguration"`
+		}
+		if err := resource.Properties.Decode(&props); err != nil {
+			continue
+		}
+		enabled := props.NotificationConfiguration.EventBridgeConfiguration.EventBridgeEnabled
+		if enabled.IsZero() {
+			buckets[logicalID] = false
+			continue
+		}
+		if enabled.Tag != "!!bool" && enabled.Tag != "!!str" {
+			continue
+		}
+		if value, err := strconv.ParseBool(enabled.Value); err == nil {
+			buckets[logicalID] = value
+		}
+	}
+	return buckets, nil
+}
+
 // parseTypeByLogicalID returns a map where the key is the resource's logical ID and the value is the CloudFormation Type
 // of the resource such as "AWS::IAM::Role".
 func parseTypeByLogicalID(resourcesNode *yaml.Node) (typeFor map[string]string, err error) {
@@ -130,6 +186,28 @@ func (n *outputNode) name() string {
 	return n.nameNode.Value
 }
 
+// getAtt returns the logical ID and the attribute of the resource if the value is like "!GetAtt MyDynamoDBTable.StreamArn".
+func (n *outputNode) getAtt() (logicalID, attr string, ok bool) {
+	var args []string
+	switch {
+	case n.valueNode.Tag == "!GetAtt" && n.valueNode.Kind == yaml.ScalarNode:
+		args = strings.SplitN(strings.TrimSpace(n.valueNode.Value), ".", 2)
+	case n.valueNode.Tag == "!GetAtt" && n.valueNode.Kind == yaml.SequenceNode:
+		_ = n.valueNode.Decode(&args)
+	case n.valueNode.Kind == yaml.MappingNode:
+		// Check if it's a map like "Fn::GetAtt: [MyDynamoDBTable, StreamArn]"
+		fields := struct {
+			GetAtt []string `yaml:"Fn::GetAtt"`
+		}{}
+		_ = n.valueNode.Decode(&fields)
+		args = fields.GetAtt
+	}
+	if len(args) != 2 {
+		return "", "", false
+	}
+	return args[0], args[1], true
+}
+
 func (n *outputNode) ref() (string, bool) {
 	switch n.valueNode.Kind {
 	case yaml.ScalarNode:
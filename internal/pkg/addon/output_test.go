@@ -96,6 +96,40 @@ Outputs:
 				},
 			},
 		},
+		"marks the stream ARN of a table generated by storage init": {
+			template: `
+Resources:
+  usersTable:
+    Type: AWS::DynamoDB::Table
+  ordersTable:
+    Type: AWS::DynamoDB::Table
+Outputs:
+  usersTableStreamArn:
+    Value: !GetAtt usersTable.StreamArn
+  ordersTableStreamArn:
+    Value:
+      Fn::GetAtt: [ordersTable, StreamArn]
+  OrdersStream:
+    Value: !GetAtt [ordersTable, StreamArn]
+  usersTableArn:
+    Value: !GetAtt usersTable.Arn`,
+			wantedOut: []Output{
+				{
+					Name:          "usersTableStreamArn",
+					IsTableStream: true,
+				},
+				{
+					Name:          "ordersTableStreamArn",
+					IsTableStream: true,
+				},
+				{
+					Name: "OrdersStream",
+				},
+				{
+					Name: "usersTableArn",
+				},
+			},
+		},
 		"parses CFN template with an IAM managed policy and secret": {
 			testdataFileName: "template.yml",
 
@@ -145,3 +179,61 @@ Outputs:
 		})
 	}
 }
+
+func TestEventBridgeBuckets(t *testing.T) {
+	testCases := map[string]struct {
+		template string
+
+		wanted    map[string]bool
+		wantedErr error
+	}{
+		"returns an error if the template is not valid YAML": {
+			template:  "Resources: hello",
+			wantedErr: errors.New("unmarshal addon cloudformation template"),
+		},
+		"returns whether each bucket sends notifications to EventBridge": {
+			template: `
+Resources:
+  uploadsBucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      NotificationConfiguration:
+        EventBridgeConfiguration:
+          EventBridgeEnabled: true
+  legacyBucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      VersioningConfiguration:
+        Status: Enabled
+  conditionalBucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      NotificationConfiguration: !If [IsProd, {EventBridgeConfiguration: {EventBridgeEnabled: true}}, !Ref AWS::NoValue]
+  referencedBucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      NotificationConfiguration:
+        EventBridgeConfiguration:
+          EventBridgeEnabled: !Ref EnableEvents
+  usersTable:
+    Type: AWS::DynamoDB::Table
+`,
+			wanted: map[string]bool{
+				"uploadsBucket": true,
+				"legacyBucket":  false,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := EventBridgeBuckets(tc.template)
+
+			if tc.wantedErr != nil {
+				require.ErrorContains(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package appconfig provides a client to make API requests to AWS AppConfig.
+package appconfig
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+)
+
+// Deployment states.
+const (
+	DeploymentStateComplete   = appconfig.DeploymentStateComplete
+	DeploymentStateRolledBack = appconfig.DeploymentStateRolledBack
+)
+
+type api interface {
+	CreateHostedConfigurationVersion(input *appconfig.CreateHostedConfigurationVersionInput) (*appconfig.CreateHostedConfigurationVersionOutput, error)
+	StartDeployment(input *appconfig.StartDeploymentInput) (*appconfig.StartDeploymentOutput, error)
+	GetDeployment(input *appconfig.GetDeploymentInput) (*appconfig.GetDeploymentOutput, error)
+}
+
+// AppConfig wraps an AWS AppConfig client.
+type AppConfig struct {
+	client api
+}
+
+// New returns an AppConfig client configured against the input session.
+func New(s *session.Session) *AppConfig {
+	return &AppConfig{
+		client: appconfig.New(s),
+	}
+}
+
+// DeployConfigInput holds the configuration profile to deploy and the content of its new version.
+type DeployConfigInput struct {
+	ApplicationID string
+	EnvironmentID string
+	ProfileID     string
+	StrategyID    string
+	Content       []byte
+	ContentType   string
+	Description   string
+}
+
+// Deployment represents a deployment of a configuration version to an AppConfig environment.
+type Deployment struct {
+	ApplicationID      string
+	EnvironmentID      string
+	Number             int64
+	ConfigVersion      string
+	State              string
+	PercentageComplete float64
+}
+
+// IsDone returns true if the deployment completed or was rolled back.
+func (d *Deployment) IsDone() bool {
+	switch d.State {
+	case DeploymentStateComplete, DeploymentStateRolledBack:
+		return true
+	}
+	return false
+}
+
+// DeployConfig creates a new hosted version of a configuration profile and starts deploying it
+// to the environment with the deployment strategy.
+func (a *AppConfig) DeployConfig(in *DeployConfigInput) (*Deployment, error) {
+	version, err := a.client.CreateHostedConfigurationVersion(&appconfig.CreateHostedConfigurationVersionInput{
+		ApplicationId:          aws.String(in.ApplicationID),
+		ConfigurationProfileId: aws.String(in.ProfileID),
+		Content:                in.Content,
+		ContentType:            aws.String(in.ContentType),
+		Description:            aws.String(in.Description),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create hosted configuration version for profile %s: %w", in.ProfileID, err)
+	}
+	versionNumber := strconv.FormatInt(aws.Int64Value(version.VersionNumber), 10)
+	out, err := a.client.StartDeployment(&appconfig.StartDeploymentInput{
+		ApplicationId:          aws.String(in.ApplicationID),
+		EnvironmentId:          aws.String(in.EnvironmentID),
+		ConfigurationProfileId: aws.String(in.ProfileID),
+		ConfigurationVersion:   aws.String(versionNumber),
+		DeploymentStrategyId:   aws.String(in.StrategyID),
+		Description:            aws.String(in.Description),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("start deployment of version %s of profile %s: %w", versionNumber, in.ProfileID, err)
+	}
+	return &Deployment{
+		ApplicationID:      aws.StringValue(out.ApplicationId),
+		EnvironmentID:      aws.StringValue(out.EnvironmentId),
+		Number:             aws.Int64Value(out.DeploymentNumber),
+		ConfigVersion:      aws.StringValue(out.ConfigurationVersion),
+		State:              aws.StringValue(out.State),
+		PercentageComplete: aws.Float64Value(out.PercentageComplete),
+	}, nil
+}
+
+// Deployment returns the current state of a deployment.
+func (a *AppConfig) Deployment(appID, envID string, number int64) (*Deployment, error) {
+	out, err := a.client.GetDeployment(&appconfig.GetDeploymentInput{
+		ApplicationId:    aws.String(appID),
+		EnvironmentId:    aws.String(envID),
+		DeploymentNumber: aws.Int64(number),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get deployment %d: %w", number, err)
+	}
+	return &Deployment{
+		ApplicationID:      aws.StringValue(out.ApplicationId),
+		EnvironmentID:      aws.StringValue(out.EnvironmentId),
+		Number:             aws.Int64Value(out.DeploymentNumber),
+		ConfigVersion:      aws.StringValue(out.ConfigurationVersion),
+		State:              aws.StringValue(out.State),
+		PercentageComplete: aws.Float64Value(out.PercentageComplete),
+	}, nil
+}
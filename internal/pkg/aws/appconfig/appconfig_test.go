@@ -0,0 +1,160 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package appconfig
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/aws/copilot-cli/internal/pkg/aws/appconfig/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppConfig_DeployConfig(t *testing.T) {
+	in := &DeployConfigInput{
+		ApplicationID: "app1",
+		EnvironmentID: "env1",
+		ProfileID:     "prof1",
+		StrategyID:    "strat1",
+		Content:       []byte(`{"enabled": true}`),
+		ContentType:   "application/json",
+		Description:   "Deployed by Copilot",
+	}
+	testCases := map[string]struct {
+		mockAPI func(m *mocks.Mockapi)
+
+		wanted    *Deployment
+		wantedErr string
+	}{
+		"error if the version cannot be created": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateHostedConfigurationVersion(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "create hosted configuration version for profile prof1: some error",
+		},
+		"error if the deployment cannot be started": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateHostedConfigurationVersion(gomock.Any()).Return(&appconfig.CreateHostedConfigurationVersionOutput{
+					VersionNumber: aws.Int64(3),
+				}, nil)
+				m.EXPECT().StartDeployment(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "start deployment of version 3 of profile prof1: some error",
+		},
+		"deploy a new version of the profile": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateHostedConfigurationVersion(&appconfig.CreateHostedConfigurationVersionInput{
+					ApplicationId:          aws.String("app1"),
+					ConfigurationProfileId: aws.String("prof1"),
+					Content:                []byte(`{"enabled": true}`),
+					ContentType:            aws.String("application/json"),
+					Description:            aws.String("Deployed by Copilot"),
+				}).Return(&appconfig.CreateHostedConfigurationVersionOutput{
+					VersionNumber: aws.Int64(3),
+				}, nil)
+				m.EXPECT().StartDeployment(&appconfig.StartDeploymentInput{
+					ApplicationId:          aws.String("app1"),
+					EnvironmentId:          aws.String("env1"),
+					ConfigurationProfileId: aws.String("prof1"),
+					ConfigurationVersion:   aws.String("3"),
+					DeploymentStrategyId:   aws.String("strat1"),
+					Description:            aws.String("Deployed by Copilot"),
+				}).Return(&appconfig.StartDeploymentOutput{
+					ApplicationId:        aws.String("app1"),
+					EnvironmentId:        aws.String("env1"),
+					DeploymentNumber:     aws.Int64(7),
+					ConfigurationVersion: aws.String("3"),
+					State:                aws.String(appconfig.DeploymentStateDeploying),
+				}, nil)
+			},
+			wanted: &Deployment{
+				ApplicationID: "app1",
+				EnvironmentID: "env1",
+				Number:        7,
+				ConfigVersion: "3",
+				State:         appconfig.DeploymentStateDeploying,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockAPI(m)
+			client := AppConfig{client: m}
+
+			got, err := client.DeployConfig(in)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestAppConfig_Deployment(t *testing.T) {
+	testCases := map[string]struct {
+		mockAPI func(m *mocks.Mockapi)
+
+		wanted    *Deployment
+		wantedErr string
+	}{
+		"error if the deployment cannot be retrieved": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "get deployment 7: some error",
+		},
+		"return the state of the deployment": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(&appconfig.GetDeploymentInput{
+					ApplicationId:    aws.String("app1"),
+					EnvironmentId:    aws.String("env1"),
+					DeploymentNumber: aws.Int64(7),
+				}).Return(&appconfig.GetDeploymentOutput{
+					ApplicationId:        aws.String("app1"),
+					EnvironmentId:        aws.String("env1"),
+					DeploymentNumber:     aws.Int64(7),
+					ConfigurationVersion: aws.String("3"),
+					State:                aws.String(appconfig.DeploymentStateBaking),
+					PercentageComplete:   aws.Float64(100),
+				}, nil)
+			},
+			wanted: &Deployment{
+				ApplicationID:      "app1",
+				EnvironmentID:      "env1",
+				Number:             7,
+				ConfigVersion:      "3",
+				State:              appconfig.DeploymentStateBaking,
+				PercentageComplete: 100,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockAPI(m)
+			client := AppConfig{client: m}
+
+			got, err := client.Deployment("app1", "env1", 7)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+			require.False(t, got.IsDone())
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/appconfig/appconfig.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	appconfig "github.com/aws/aws-sdk-go/service/appconfig"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// Mockapi is a mock of api interface.
+type Mockapi struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiMockRecorder
+}
+
+// MockapiMockRecorder is the mock recorder for Mockapi.
+type MockapiMockRecorder struct {
+	mock *Mockapi
+}
+
+// NewMockapi creates a new mock instance.
+func NewMockapi(ctrl *gomock.Controller) *Mockapi {
+	mock := &Mockapi{ctrl: ctrl}
+	mock.recorder = &MockapiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockapi) EXPECT() *MockapiMockRecorder {
+	return m.recorder
+}
+
+// CreateHostedConfigurationVersion mocks base method.
+func (m *Mockapi) CreateHostedConfigurationVersion(input *appconfig.CreateHostedConfigurationVersionInput) (*appconfig.CreateHostedConfigurationVersionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHostedConfigurationVersion", input)
+	ret0, _ := ret[0].(*appconfig.CreateHostedConfigurationVersionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHostedConfigurationVersion indicates an expected call of CreateHostedConfigurationVersion.
+func (mr *MockapiMockRecorder) CreateHostedConfigurationVersion(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHostedConfigurationVersion", reflect.TypeOf((*Mockapi)(nil).CreateHostedConfigurationVersion), input)
+}
+
+// GetDeployment mocks base method.
+func (m *Mockapi) GetDeployment(input *appconfig.GetDeploymentInput) (*appconfig.GetDeploymentOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeployment", input)
+	ret0, _ := ret[0].(*appconfig.GetDeploymentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeployment indicates an expected call of GetDeployment.
+func (mr *MockapiMockRecorder) GetDeployment(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeployment", reflect.TypeOf((*Mockapi)(nil).GetDeployment), input)
+}
+
+// StartDeployment mocks base method.
+func (m *Mockapi) StartDeployment(input *appconfig.StartDeploymentInput) (*appconfig.StartDeploymentOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartDeployment", input)
+	ret0, _ := ret[0].(*appconfig.StartDeploymentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartDeployment indicates an expected call of StartDeployment.
+func (mr *MockapiMockRecorder) StartDeployment(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartDeployment", reflect.TypeOf((*Mockapi)(nil).StartDeployment), input)
+}
@@ -0,0 +1,107 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+// driftDetectionPollInterval is how long to wait in between polls of the status of a drift detection.
+var driftDetectionPollInterval = 5 * time.Second
+
+// StackDrift is the result of a drift detection on a stack.
+type StackDrift struct {
+	// Status is the drift status of the stack, either "DRIFTED" or "IN_SYNC".
+	Status string
+	// DetectionFailure is the reason why the drift of some resources couldn't be detected, empty if all were checked.
+	DetectionFailure string
+	// Resources are the resources modified or deleted outside of CloudFormation.
+	Resources []*StackResourceDrift
+}
+
+// DetectDrift detects the drift of the resources of a stack against its template, and waits until the detection completes.
+// Nested stacks aren't checked: detect their drift with their stack ID.
+// If the stack does not exist, returns ErrStackNotFound.
+func (c *CloudFormation) DetectDrift(ctx context.Context, stackName string) (*StackDrift, error) {
+	out, err := c.client.DetectStackDrift(&cloudformation.DetectStackDriftInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		if stackDoesNotExist(err) {
+			return nil, &ErrStackNotFound{name: stackName}
+		}
+		return nil, fmt.Errorf("detect drift of stack %s: %w", stackName, err)
+	}
+	status, err := c.waitForDriftDetection(ctx, aws.StringValue(out.StackDriftDetectionId))
+	if err != nil {
+		return nil, fmt.Errorf("wait for drift detection of stack %s: %w", stackName, err)
+	}
+	drift := &StackDrift{
+		Status: aws.StringValue(status.StackDriftStatus),
+	}
+	if aws.StringValue(status.DetectionStatus) == cloudformation.StackDriftDetectionStatusDetectionFailed {
+		drift.DetectionFailure = aws.StringValue(status.DetectionStatusReason)
+	}
+	drift.Resources, err = c.resourceDrifts(stackName)
+	if err != nil {
+		return nil, err
+	}
+	return drift, nil
+}
+
+func (c *CloudFormation) waitForDriftDetection(ctx context.Context, detectionID string) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	for {
+		out, err := c.client.DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: aws.String(detectionID),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe drift detection %s: %w", detectionID, err)
+		}
+		// A failed detection still has the results of the resources that were checked.
+		if aws.StringValue(out.DetectionStatus) != cloudformation.StackDriftDetectionStatusDetectionInProgress {
+			return out, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(driftDetectionPollInterval):
+		}
+	}
+}
+
+// resourceDrifts returns the resources of the stack that were modified or deleted by the last drift detection.
+func (c *CloudFormation) resourceDrifts(stackName string) ([]*StackResourceDrift, error) {
+	var nextToken *string
+	var drifts []*StackResourceDrift
+	for {
+		out, err := c.client.DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+			StackName: aws.String(stackName),
+			NextToken: nextToken,
+			StackResourceDriftStatusFilters: aws.StringSlice([]string{
+				cloudformation.StackResourceDriftStatusModified,
+				cloudformation.StackResourceDriftStatusDeleted,
+			}),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe resource drifts of stack %s: %w", stackName, err)
+		}
+		for _, drift := range out.StackResourceDrifts {
+			if drift == nil {
+				continue
+			}
+			d := StackResourceDrift(*drift)
+			drifts = append(drifts, &d)
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return drifts, nil
+}
@@ -0,0 +1,133 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudFormation_DetectDrift(t *testing.T) {
+	const (
+		mockStackName   = "phonetool-test-api"
+		mockDetectionID = "detection-id"
+	)
+	driftFilters := aws.StringSlice([]string{
+		cloudformation.StackResourceDriftStatusModified,
+		cloudformation.StackResourceDriftStatusDeleted,
+	})
+	defer func(interval time.Duration) { driftDetectionPollInterval = interval }(driftDetectionPollInterval)
+	driftDetectionPollInterval = 0
+
+	testCases := map[string]struct {
+		setupMock func(m *mocks.Mockclient)
+
+		wanted    *StackDrift
+		wantedErr error
+	}{
+		"error if the stack does not exist": {
+			setupMock: func(m *mocks.Mockclient) {
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(nil, errDoesNotExist)
+			},
+			wantedErr: &ErrStackNotFound{name: mockStackName},
+		},
+		"error if the detection status can't be described": {
+			setupMock: func(m *mocks.Mockclient) {
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String(mockDetectionID),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("wait for drift detection of stack phonetool-test-api: describe drift detection detection-id: some error"),
+		},
+		"polls until the detection completes and returns the drifted resources of all pages": {
+			setupMock: func(m *mocks.Mockclient) {
+				m.EXPECT().DetectStackDrift(&cloudformation.DetectStackDriftInput{
+					StackName: aws.String(mockStackName),
+				}).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String(mockDetectionID),
+				}, nil)
+				gomock.InOrder(
+					m.EXPECT().DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+						StackDriftDetectionId: aws.String(mockDetectionID),
+					}).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+						DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionInProgress),
+					}, nil),
+					m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+						DetectionStatus:  aws.String(cloudformation.StackDriftDetectionStatusDetectionComplete),
+						StackDriftStatus: aws.String(cloudformation.StackDriftStatusDrifted),
+					}, nil),
+					m.EXPECT().DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+						StackName:                       aws.String(mockStackName),
+						StackResourceDriftStatusFilters: driftFilters,
+					}).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+						StackResourceDrifts: []*cloudformation.StackResourceDrift{
+							{LogicalResourceId: aws.String("EnvironmentSecurityGroup")},
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+						StackName:                       aws.String(mockStackName),
+						StackResourceDriftStatusFilters: driftFilters,
+						NextToken:                       aws.String("token"),
+					}).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+						StackResourceDrifts: []*cloudformation.StackResourceDrift{
+							{LogicalResourceId: aws.String("LogGroup")},
+						},
+					}, nil),
+				)
+			},
+			wanted: &StackDrift{
+				Status: cloudformation.StackDriftStatusDrifted,
+				Resources: []*StackResourceDrift{
+					{LogicalResourceId: aws.String("EnvironmentSecurityGroup")},
+					{LogicalResourceId: aws.String("LogGroup")},
+				},
+			},
+		},
+		"returns the reason of a failed detection with the resources that were checked": {
+			setupMock: func(m *mocks.Mockclient) {
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String(mockDetectionID),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus:       aws.String(cloudformation.StackDriftDetectionStatusDetectionFailed),
+					DetectionStatusReason: aws.String("Failed to detect drift on resource [Service]"),
+					StackDriftStatus:      aws.String(cloudformation.StackDriftStatusInSync),
+				}, nil)
+				m.EXPECT().DescribeStackResourceDrifts(gomock.Any()).Return(&cloudformation.DescribeStackResourceDriftsOutput{}, nil)
+			},
+			wanted: &StackDrift{
+				Status:           cloudformation.StackDriftStatusInSync,
+				DetectionFailure: "Failed to detect drift on resource [Service]",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockclient(ctrl)
+			tc.setupMock(m)
+			c := CloudFormation{client: m}
+
+			drift, err := c.DetectDrift(context.Background(), mockStackName)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, drift)
+		})
+	}
+}
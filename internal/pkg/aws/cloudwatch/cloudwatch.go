@@ -6,6 +6,7 @@ package cloudwatch
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -27,8 +28,12 @@ const (
 // humanizeDuration is overridden in tests so that its output is constant as time passes.
 var humanizeDuration = humanize.RelTime
 
+// now is overridden in tests so that metric time windows are constant.
+var now = time.Now
+
 type api interface {
 	DescribeAlarms(input *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error)
+	GetMetricStatistics(input *cloudwatch.GetMetricStatisticsInput) (*cloudwatch.GetMetricStatisticsOutput, error)
 }
 
 type resourceGetter interface {
@@ -87,6 +92,51 @@ func (cw *CloudWatch) AlarmsWithTags(tags map[string]string) ([]AlarmStatus, err
 	return cw.AlarmStatuses(WithNames(alarmNames))
 }
 
+// Metric identifies a CloudWatch metric.
+type Metric struct {
+	Namespace  string
+	Name       string
+	Dimensions map[string]string
+}
+
+// LatestMaximum returns the maximum value of the metric in its most recent period with datapoints within the lookback window.
+// The boolean is false if the metric has no datapoints in the window.
+func (cw *CloudWatch) LatestMaximum(metric Metric, period, lookback time.Duration) (float64, bool, error) {
+	var dimensions []*cloudwatch.Dimension
+	for name, value := range metric.Dimensions {
+		dimensions = append(dimensions, &cloudwatch.Dimension{
+			Name:  aws.String(name),
+			Value: aws.String(value),
+		})
+	}
+	sort.Slice(dimensions, func(i, j int) bool {
+		return aws.StringValue(dimensions[i].Name) < aws.StringValue(dimensions[j].Name)
+	})
+	end := now()
+	out, err := cw.client.GetMetricStatistics(&cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String(metric.Namespace),
+		MetricName: aws.String(metric.Name),
+		Dimensions: dimensions,
+		StartTime:  aws.Time(end.Add(-lookback)),
+		EndTime:    aws.Time(end),
+		Period:     aws.Int64(int64(period.Seconds())),
+		Statistics: aws.StringSlice([]string{cloudwatch.StatisticMaximum}),
+	})
+	if err != nil {
+		return 0, false, fmt.Errorf("get statistics of metric %s: %w", metric.Name, err)
+	}
+	var latest *cloudwatch.Datapoint
+	for _, dp := range out.Datapoints {
+		if latest == nil || aws.TimeValue(dp.Timestamp).After(aws.TimeValue(latest.Timestamp)) {
+			latest = dp
+		}
+	}
+	if latest == nil {
+		return 0, false, nil
+	}
+	return aws.Float64Value(latest.Maximum), true, nil
+}
+
 // DescribeAlarmOpts sets the optional parameter for DescribeAlarms
 type DescribeAlarmOpts func(input *cloudwatch.DescribeAlarmsInput)
 
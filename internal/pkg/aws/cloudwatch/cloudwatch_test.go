@@ -602,3 +602,94 @@ func TestCloudWatch_AlarmDescriptions(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudWatch_LatestMaximum(t *testing.T) {
+	mockNow := time.Date(2023, time.November, 1, 12, 0, 0, 0, time.UTC)
+	mockMetric := Metric{
+		Namespace: "AWS/SQS",
+		Name:      "ApproximateAgeOfOldestMessage",
+		Dimensions: map[string]string{
+			"QueueName": "mockQueue",
+		},
+	}
+	mockInput := &cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String("AWS/SQS"),
+		MetricName: aws.String("ApproximateAgeOfOldestMessage"),
+		Dimensions: []*cloudwatch.Dimension{
+			{
+				Name:  aws.String("QueueName"),
+				Value: aws.String("mockQueue"),
+			},
+		},
+		StartTime:  aws.Time(mockNow.Add(-15 * time.Minute)),
+		EndTime:    aws.Time(mockNow),
+		Period:     aws.Int64(60),
+		Statistics: aws.StringSlice([]string{"Maximum"}),
+	}
+	testCases := map[string]struct {
+		setupMocks func(m cloudWatchMocks)
+
+		wantedValue float64
+		wantedFound bool
+		wantedErr   error
+	}{
+		"errors if failed to get metric statistics": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().GetMetricStatistics(mockInput).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get statistics of metric ApproximateAgeOfOldestMessage: some error"),
+		},
+		"not found if there are no datapoints": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().GetMetricStatistics(mockInput).Return(&cloudwatch.GetMetricStatisticsOutput{}, nil)
+			},
+		},
+		"return the maximum of the latest datapoint": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().GetMetricStatistics(mockInput).Return(&cloudwatch.GetMetricStatisticsOutput{
+					Datapoints: []*cloudwatch.Datapoint{
+						{
+							Timestamp: aws.Time(mockNow.Add(-2 * time.Minute)),
+							Maximum:   aws.Float64(120),
+						},
+						{
+							Timestamp: aws.Time(mockNow.Add(-1 * time.Minute)),
+							Maximum:   aws.Float64(180),
+						},
+						{
+							Timestamp: aws.Time(mockNow.Add(-3 * time.Minute)),
+							Maximum:   aws.Float64(60),
+						},
+					},
+				}, nil)
+			},
+			wantedValue: 180,
+			wantedFound: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := cloudWatchMocks{
+				cw: mocks.NewMockapi(ctrl),
+			}
+			tc.setupMocks(m)
+			now = func() time.Time { return mockNow }
+			defer func() { now = time.Now }()
+			cw := CloudWatch{
+				client: m.cw,
+			}
+
+			value, found, err := cw.LatestMaximum(mockMetric, time.Minute, 15*time.Minute)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedValue, value)
+			require.Equal(t, tc.wantedFound, found)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codecommit provides a client to make API requests to AWS CodeCommit.
+package codecommit
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+)
+
+type api interface {
+	GetPullRequest(input *codecommit.GetPullRequestInput) (*codecommit.GetPullRequestOutput, error)
+	PostCommentForPullRequest(input *codecommit.PostCommentForPullRequestInput) (*codecommit.PostCommentForPullRequestOutput, error)
+}
+
+// CodeCommit wraps an AWS CodeCommit client.
+type CodeCommit struct {
+	client api
+}
+
+// New returns a CodeCommit client configured against the input session.
+func New(s *session.Session) *CodeCommit {
+	return &CodeCommit{
+		client: codecommit.New(s),
+	}
+}
+
+// CommentOnPullRequest posts a comment on the latest changes of the pull request.
+func (c *CodeCommit) CommentOnPullRequest(id, content string) error {
+	out, err := c.client.GetPullRequest(&codecommit.GetPullRequestInput{
+		PullRequestId: aws.String(id),
+	})
+	if err != nil {
+		return fmt.Errorf("get pull request %s: %w", id, err)
+	}
+	if out.PullRequest == nil || len(out.PullRequest.PullRequestTargets) == 0 {
+		return fmt.Errorf("pull request %s has no target", id)
+	}
+	target := out.PullRequest.PullRequestTargets[0]
+	if _, err := c.client.PostCommentForPullRequest(&codecommit.PostCommentForPullRequestInput{
+		PullRequestId:  aws.String(id),
+		RepositoryName: target.RepositoryName,
+		BeforeCommitId: target.DestinationCommit,
+		AfterCommitId:  target.SourceCommit,
+		Content:        aws.String(content),
+	}); err != nil {
+		return fmt.Errorf("post comment on pull request %s: %w", id, err)
+	}
+	return nil
+}
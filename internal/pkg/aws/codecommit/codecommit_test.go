@@ -0,0 +1,90 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codecommit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codecommit/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeCommit_CommentOnPullRequest(t *testing.T) {
+	testCases := map[string]struct {
+		mockAPI func(m *mocks.Mockapi)
+
+		wantedErr string
+	}{
+		"error if the pull request cannot be retrieved": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().GetPullRequest(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "get pull request 12: some error",
+		},
+		"error if the pull request has no target": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().GetPullRequest(gomock.Any()).Return(&codecommit.GetPullRequestOutput{
+					PullRequest: &codecommit.PullRequest{},
+				}, nil)
+			},
+			wantedErr: "pull request 12 has no target",
+		},
+		"error if the comment cannot be posted": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().GetPullRequest(gomock.Any()).Return(&codecommit.GetPullRequestOutput{
+					PullRequest: &codecommit.PullRequest{
+						PullRequestTargets: []*codecommit.PullRequestTarget{{RepositoryName: aws.String("phonetool")}},
+					},
+				}, nil)
+				m.EXPECT().PostCommentForPullRequest(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "post comment on pull request 12: some error",
+		},
+		"comment on the latest changes of the pull request": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().GetPullRequest(&codecommit.GetPullRequestInput{
+					PullRequestId: aws.String("12"),
+				}).Return(&codecommit.GetPullRequestOutput{
+					PullRequest: &codecommit.PullRequest{
+						PullRequestTargets: []*codecommit.PullRequestTarget{
+							{
+								RepositoryName:    aws.String("phonetool"),
+								DestinationCommit: aws.String("abc"),
+								SourceCommit:      aws.String("def"),
+							},
+						},
+					},
+				}, nil)
+				m.EXPECT().PostCommentForPullRequest(&codecommit.PostCommentForPullRequestInput{
+					PullRequestId:  aws.String("12"),
+					RepositoryName: aws.String("phonetool"),
+					BeforeCommitId: aws.String("abc"),
+					AfterCommitId:  aws.String("def"),
+					Content:        aws.String("hello"),
+				}).Return(&codecommit.PostCommentForPullRequestOutput{}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockAPI(m)
+			cc := &CodeCommit{client: m}
+
+			err := cc.CommentOnPullRequest("12", "hello")
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/codecommit/codecommit.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	codecommit "github.com/aws/aws-sdk-go/service/codecommit"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// Mockapi is a mock of api interface.
+type Mockapi struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiMockRecorder
+}
+
+// MockapiMockRecorder is the mock recorder for Mockapi.
+type MockapiMockRecorder struct {
+	mock *Mockapi
+}
+
+// NewMockapi creates a new mock instance.
+func NewMockapi(ctrl *gomock.Controller) *Mockapi {
+	mock := &Mockapi{ctrl: ctrl}
+	mock.recorder = &MockapiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockapi) EXPECT() *MockapiMockRecorder {
+	return m.recorder
+}
+
+// GetPullRequest mocks base method.
+func (m *Mockapi) GetPullRequest(input *codecommit.GetPullRequestInput) (*codecommit.GetPullRequestOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPullRequest", input)
+	ret0, _ := ret[0].(*codecommit.GetPullRequestOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPullRequest indicates an expected call of GetPullRequest.
+func (mr *MockapiMockRecorder) GetPullRequest(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequest", reflect.TypeOf((*Mockapi)(nil).GetPullRequest), input)
+}
+
+// PostCommentForPullRequest mocks base method.
+func (m *Mockapi) PostCommentForPullRequest(input *codecommit.PostCommentForPullRequestInput) (*codecommit.PostCommentForPullRequestOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostCommentForPullRequest", input)
+	ret0, _ := ret[0].(*codecommit.PostCommentForPullRequestOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostCommentForPullRequest indicates an expected call of PostCommentForPullRequest.
+func (mr *MockapiMockRecorder) PostCommentForPullRequest(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostCommentForPullRequest", reflect.TypeOf((*Mockapi)(nil).PostCommentForPullRequest), input)
+}
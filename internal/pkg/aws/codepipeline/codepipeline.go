@@ -7,6 +7,7 @@ package codepipeline
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/xlab/treeprint"
@@ -24,6 +25,9 @@ type api interface {
 	GetPipelineState(*cp.GetPipelineStateInput) (*cp.GetPipelineStateOutput, error)
 	ListPipelineExecutions(input *cp.ListPipelineExecutionsInput) (*cp.ListPipelineExecutionsOutput, error)
 	RetryStageExecution(input *cp.RetryStageExecutionInput) (*cp.RetryStageExecutionOutput, error)
+	StartPipelineExecution(input *cp.StartPipelineExecutionInput) (*cp.StartPipelineExecutionOutput, error)
+	GetPipelineExecution(input *cp.GetPipelineExecutionInput) (*cp.GetPipelineExecutionOutput, error)
+	ListActionExecutions(input *cp.ListActionExecutionsInput) (*cp.ListActionExecutionsOutput, error)
 }
 
 type resourceGetter interface {
@@ -53,6 +57,8 @@ type Stage struct {
 	Category string `json:"category"`
 	Provider string `json:"provider"`
 	Details  string `json:"details"`
+
+	Actions []string `json:"-"` // Names of the actions of the stage.
 }
 
 // PipelineState represents a Pipeline's status.
@@ -75,6 +81,28 @@ type StageAction struct {
 	Status string `json:"status"`
 }
 
+// Execution represents an execution of a pipeline.
+type Execution struct {
+	ID     string `json:"executionId"`
+	Status string `json:"status"`
+}
+
+// IsInProgress returns true if the execution hasn't completed yet.
+func (e *Execution) IsInProgress() bool {
+	return e.Status == cp.PipelineExecutionStatusInProgress || e.Status == cp.PipelineExecutionStatusStopping
+}
+
+// BuildAction represents the execution of a CodeBuild action, such as the build, test commands,
+// pre-deployments and post-deployments, in a pipeline execution.
+type BuildAction struct {
+	StageName  string
+	ActionName string
+	Status     string
+	// BuildID is the ID of the CodeBuild build, in the format "<project>:<uuid>".
+	BuildID   string
+	StartTime time.Time
+}
+
 // AggregateStatus returns the collective status of a stage by looking at each individual action's status.
 // It returns "InProgress" if there are any actions that are in progress.
 // It returns "Failed" if there are actions that failed or were abandoned.
@@ -176,6 +204,112 @@ func (c *CodePipeline) RetryStageExecution(pipelineName, stageName string) error
 	return nil
 }
 
+// StartExecution starts an execution of the pipeline and returns its ID.
+// If the commit ID is not empty, the execution runs at the commit instead of the latest commit of the source.
+func (c *CodePipeline) StartExecution(pipelineName, commitID string) (string, error) {
+	in := &cp.StartPipelineExecutionInput{
+		Name: aws.String(pipelineName),
+	}
+	if commitID != "" {
+		action, err := c.sourceActionName(pipelineName)
+		if err != nil {
+			return "", err
+		}
+		in.SourceRevisions = []*cp.SourceRevisionOverride{
+			{
+				ActionName:    aws.String(action),
+				RevisionType:  aws.String(cp.SourceRevisionTypeCommitId),
+				RevisionValue: aws.String(commitID),
+			},
+		}
+	}
+	out, err := c.client.StartPipelineExecution(in)
+	if err != nil {
+		return "", fmt.Errorf("start execution of pipeline %s: %w", pipelineName, err)
+	}
+	return aws.StringValue(out.PipelineExecutionId), nil
+}
+
+// LatestExecutionID returns the ID of the most recent execution of the pipeline.
+func (c *CodePipeline) LatestExecutionID(pipelineName string) (string, error) {
+	return c.pipelineExecutionID(pipelineName)
+}
+
+// Execution returns the execution of the pipeline with the given ID.
+func (c *CodePipeline) Execution(pipelineName, executionID string) (*Execution, error) {
+	out, err := c.client.GetPipelineExecution(&cp.GetPipelineExecutionInput{
+		PipelineName:        aws.String(pipelineName),
+		PipelineExecutionId: aws.String(executionID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get execution %s of pipeline %s: %w", executionID, pipelineName, err)
+	}
+	return &Execution{
+		ID:     aws.StringValue(out.PipelineExecution.PipelineExecutionId),
+		Status: aws.StringValue(out.PipelineExecution.Status),
+	}, nil
+}
+
+// BuildActions returns the CodeBuild actions that started in the execution of the pipeline, ordered by start time.
+func (c *CodePipeline) BuildActions(pipelineName, executionID string) ([]BuildAction, error) {
+	var actions []BuildAction
+	var token *string
+	for {
+		out, err := c.client.ListActionExecutions(&cp.ListActionExecutionsInput{
+			PipelineName: aws.String(pipelineName),
+			Filter: &cp.ActionExecutionFilter{
+				PipelineExecutionId: aws.String(executionID),
+			},
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list actions of execution %s of pipeline %s: %w", executionID, pipelineName, err)
+		}
+		for _, detail := range out.ActionExecutionDetails {
+			if detail.Input == nil || detail.Input.ActionTypeId == nil || aws.StringValue(detail.Input.ActionTypeId.Provider) != "CodeBuild" {
+				continue
+			}
+			if detail.Output == nil || detail.Output.ExecutionResult == nil || detail.Output.ExecutionResult.ExternalExecutionId == nil {
+				// The build hasn't started yet.
+				continue
+			}
+			actions = append(actions, BuildAction{
+				StageName:  aws.StringValue(detail.StageName),
+				ActionName: aws.StringValue(detail.ActionName),
+				Status:     aws.StringValue(detail.Status),
+				BuildID:    aws.StringValue(detail.Output.ExecutionResult.ExternalExecutionId),
+				StartTime:  aws.TimeValue(detail.StartTime),
+			})
+		}
+		token = out.NextToken
+		if token == nil {
+			break
+		}
+	}
+	sort.SliceStable(actions, func(i, j int) bool {
+		return actions[i].StartTime.Before(actions[j].StartTime)
+	})
+	return actions, nil
+}
+
+// sourceActionName returns the name of the action in the source stage of the pipeline.
+func (c *CodePipeline) sourceActionName(pipelineName string) (string, error) {
+	out, err := c.client.GetPipeline(&cp.GetPipelineInput{
+		Name: aws.String(pipelineName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get pipeline %s: %w", pipelineName, err)
+	}
+	for _, stage := range out.Pipeline.Stages {
+		for _, action := range stage.Actions {
+			if aws.StringValue(action.ActionTypeId.Category) == cp.ActionCategorySource {
+				return aws.StringValue(action.Name), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("pipeline %s has no source action", pipelineName)
+}
+
 // GetPipelineState retrieves status information from a given pipeline.
 func (c *CodePipeline) GetPipelineState(name string) (*PipelineState, error) {
 	input := &cp.GetPipelineStateInput{
@@ -273,6 +407,9 @@ func (c *CodePipeline) getStage(s *cp.StageDeclaration) (*Stage, error) {
 		Provider: provider,
 		Details:  details,
 	}
+	for _, action := range s.Actions {
+		stage.Actions = append(stage.Actions, aws.StringValue(action.Name))
+	}
 	return stage, nil
 }
 
@@ -153,18 +153,21 @@ func TestCodePipeline_GetPipeline(t *testing.T) {
 						Category: "Source",
 						Provider: "GitHub",
 						Details:  "Repository: badgoose/repo",
+						Actions:  []string{"SourceCodeFor-dinder"},
 					},
 					{
 						Name:     "Build",
 						Category: "Build",
 						Provider: "CodeBuild",
 						Details:  "BuildProject: pipeline-dinder-badgoose-repo-BuildProject",
+						Actions:  []string{"Build"},
 					},
 					{
 						Name:     "DeployTo-test",
 						Category: "Deploy",
 						Provider: "CloudFormation",
 						Details:  "StackName: dinder-test-test",
+						Actions:  []string{"CreateOrUpdate-test-test"},
 					},
 				},
 				CreatedAt: mockTime,
@@ -201,6 +204,7 @@ func TestCodePipeline_GetPipeline(t *testing.T) {
 						Category: "Source",
 						Provider: "GitHub",
 						Details:  "Repository: badgoose/repo",
+						Actions:  []string{"SourceCodeFor-dinder"},
 					},
 					{
 						Name:     "DummyStage",
@@ -579,3 +583,183 @@ func TestCodePipeline_RetryStageExecution(t *testing.T) {
 		})
 	}
 }
+
+func TestCodePipeline_StartExecution(t *testing.T) {
+	const mockPipelineName = "pipeline-dinder-badgoose-repo"
+	tests := map[string]struct {
+		inCommitID string
+		callMocks  func(m codepipelineMocks)
+
+		wantedID      string
+		expectedError error
+	}{
+		"start an execution at the latest commit": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().StartPipelineExecution(&codepipeline.StartPipelineExecutionInput{
+					Name: aws.String(mockPipelineName),
+				}).Return(&codepipeline.StartPipelineExecutionOutput{PipelineExecutionId: aws.String("exec-1")}, nil)
+			},
+			wantedID: "exec-1",
+		},
+		"start an execution at a commit of the source action": {
+			inCommitID: "abc123",
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(&codepipeline.GetPipelineInput{
+					Name: aws.String(mockPipelineName),
+				}).Return(&codepipeline.GetPipelineOutput{
+					Pipeline: &codepipeline.PipelineDeclaration{
+						Stages: []*codepipeline.StageDeclaration{
+							{
+								Name: aws.String("Source"),
+								Actions: []*codepipeline.ActionDeclaration{
+									{
+										Name:         aws.String("SourceCodeFor-dinder"),
+										ActionTypeId: &codepipeline.ActionTypeId{Category: aws.String("Source")},
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+				m.cp.EXPECT().StartPipelineExecution(&codepipeline.StartPipelineExecutionInput{
+					Name: aws.String(mockPipelineName),
+					SourceRevisions: []*codepipeline.SourceRevisionOverride{
+						{
+							ActionName:    aws.String("SourceCodeFor-dinder"),
+							RevisionType:  aws.String("COMMIT_ID"),
+							RevisionValue: aws.String("abc123"),
+						},
+					},
+				}).Return(&codepipeline.StartPipelineExecutionOutput{PipelineExecutionId: aws.String("exec-1")}, nil)
+			},
+			wantedID: "exec-1",
+		},
+		"returns wrapped error if the pipeline has no source action": {
+			inCommitID: "abc123",
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(gomock.Any()).Return(&codepipeline.GetPipelineOutput{
+					Pipeline: &codepipeline.PipelineDeclaration{},
+				}, nil)
+			},
+			expectedError: errors.New("pipeline pipeline-dinder-badgoose-repo has no source action"),
+		},
+		"returns wrapped error if the execution can't be started": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().StartPipelineExecution(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("start execution of pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{cp: mockClient})
+			cp := CodePipeline{client: mockClient}
+
+			// WHEN
+			id, err := cp.StartExecution(mockPipelineName, tc.inCommitID)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedID, id)
+		})
+	}
+}
+
+func TestCodePipeline_BuildActions(t *testing.T) {
+	const (
+		mockPipelineName = "pipeline-dinder-badgoose-repo"
+		mockExecutionID  = "exec-1"
+	)
+	mockTime := time.Unix(1700000000, 0)
+	action := func(stage, name, provider, buildID string, start time.Time) *codepipeline.ActionExecutionDetail {
+		detail := &codepipeline.ActionExecutionDetail{
+			StageName:  aws.String(stage),
+			ActionName: aws.String(name),
+			Status:     aws.String("Succeeded"),
+			StartTime:  aws.Time(start),
+			Input: &codepipeline.ActionExecutionInput{
+				ActionTypeId: &codepipeline.ActionTypeId{Provider: aws.String(provider)},
+			},
+		}
+		if buildID != "" {
+			detail.Output = &codepipeline.ActionExecutionOutput{
+				ExecutionResult: &codepipeline.ActionExecutionResult{ExternalExecutionId: aws.String(buildID)},
+			}
+		}
+		return detail
+	}
+	tests := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		wantedActions []BuildAction
+		expectedError error
+	}{
+		"returns the started CodeBuild actions ordered by start time": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListActionExecutions(&codepipeline.ListActionExecutionsInput{
+					PipelineName: aws.String(mockPipelineName),
+					Filter:       &codepipeline.ActionExecutionFilter{PipelineExecutionId: aws.String(mockExecutionID)},
+				}).Return(&codepipeline.ListActionExecutionsOutput{
+					ActionExecutionDetails: []*codepipeline.ActionExecutionDetail{
+						action("DeployTo-test", "PostDeployment-test", "CodeBuild", "", mockTime.Add(2*time.Minute)),
+						action("Build", "Build", "CodeBuild", "dinder-build:1111", mockTime.Add(time.Minute)),
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.cp.EXPECT().ListActionExecutions(&codepipeline.ListActionExecutionsInput{
+					PipelineName: aws.String(mockPipelineName),
+					Filter:       &codepipeline.ActionExecutionFilter{PipelineExecutionId: aws.String(mockExecutionID)},
+					NextToken:    aws.String("token"),
+				}).Return(&codepipeline.ListActionExecutionsOutput{
+					ActionExecutionDetails: []*codepipeline.ActionExecutionDetail{
+						action("DeployTo-test", "CreateOrUpdate-test", "CloudFormation", "stack-id", mockTime.Add(time.Minute)),
+						action("DeployTo-test", "PreDeployment-test", "CodeBuild", "dinder-pre:2222", mockTime.Add(-time.Minute)),
+					},
+				}, nil)
+			},
+			wantedActions: []BuildAction{
+				{StageName: "DeployTo-test", ActionName: "PreDeployment-test", Status: "Succeeded", BuildID: "dinder-pre:2222", StartTime: mockTime.Add(-time.Minute)},
+				{StageName: "Build", ActionName: "Build", Status: "Succeeded", BuildID: "dinder-build:1111", StartTime: mockTime.Add(time.Minute)},
+			},
+		},
+		"returns wrapped error if ListActionExecutions fails": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListActionExecutions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("list actions of execution exec-1 of pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{cp: mockClient})
+			cp := CodePipeline{client: mockClient}
+
+			// WHEN
+			actions, err := cp.BuildActions(mockPipelineName, mockExecutionID)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedActions, actions)
+		})
+	}
+}
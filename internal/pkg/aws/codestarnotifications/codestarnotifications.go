@@ -0,0 +1,91 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codestarnotifications provides a client to make API requests to AWS CodeStar Notifications.
+package codestarnotifications
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	csn "github.com/aws/aws-sdk-go/service/codestarnotifications"
+)
+
+type api interface {
+	ListNotificationRulesPages(input *csn.ListNotificationRulesInput, fn func(*csn.ListNotificationRulesOutput, bool) bool) error
+	DescribeNotificationRule(input *csn.DescribeNotificationRuleInput) (*csn.DescribeNotificationRuleOutput, error)
+}
+
+// CodeStarNotifications wraps the AWS CodeStar Notifications client.
+type CodeStarNotifications struct {
+	client api
+}
+
+// New returns a CodeStarNotifications configured against the input session.
+func New(s *session.Session) *CodeStarNotifications {
+	return &CodeStarNotifications{
+		client: csn.New(s),
+	}
+}
+
+// Rule is a notification rule that sends the events of a resource to its targets.
+type Rule struct {
+	Name    string   `json:"name"`
+	Status  string   `json:"status"`
+	Events  []string `json:"events"`
+	Targets []Target `json:"targets"`
+}
+
+// Target receives the notifications of a rule.
+type Target struct {
+	Type    string `json:"type"`
+	Address string `json:"address"`
+	Status  string `json:"status"`
+}
+
+// RulesForResource returns the notification rules of the resource with the given ARN.
+func (c *CodeStarNotifications) RulesForResource(resourceARN string) ([]Rule, error) {
+	var arns []string
+	err := c.client.ListNotificationRulesPages(&csn.ListNotificationRulesInput{
+		Filters: []*csn.ListNotificationRulesFilter{
+			{
+				Name:  aws.String(csn.ListNotificationRulesFilterNameResource),
+				Value: aws.String(resourceARN),
+			},
+		},
+	}, func(out *csn.ListNotificationRulesOutput, lastPage bool) bool {
+		for _, rule := range out.NotificationRules {
+			arns = append(arns, aws.StringValue(rule.Arn))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list notification rules of %s: %w", resourceARN, err)
+	}
+	var rules []Rule
+	for _, ruleARN := range arns {
+		out, err := c.client.DescribeNotificationRule(&csn.DescribeNotificationRuleInput{
+			Arn: aws.String(ruleARN),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe notification rule %s: %w", ruleARN, err)
+		}
+		rule := Rule{
+			Name:   aws.StringValue(out.Name),
+			Status: aws.StringValue(out.Status),
+		}
+		for _, event := range out.EventTypes {
+			rule.Events = append(rule.Events, aws.StringValue(event.EventTypeName))
+		}
+		for _, target := range out.Targets {
+			rule.Targets = append(rule.Targets, Target{
+				Type:    aws.StringValue(target.TargetType),
+				Address: aws.StringValue(target.TargetAddress),
+				Status:  aws.StringValue(target.TargetStatus),
+			})
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
@@ -0,0 +1,110 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codestarnotifications
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	csn "github.com/aws/aws-sdk-go/service/codestarnotifications"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codestarnotifications/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeStarNotifications_RulesForResource(t *testing.T) {
+	const (
+		mockPipelineARN = "arn:aws:codepipeline:us-west-2:123456789012:pipeline-phonetool-release"
+		mockRuleARN     = "arn:aws:codestar-notifications:us-west-2:123456789012:notificationrule/abcd"
+	)
+	listRules := func(m *mocks.Mockapi) *gomock.Call {
+		return m.EXPECT().ListNotificationRulesPages(&csn.ListNotificationRulesInput{
+			Filters: []*csn.ListNotificationRulesFilter{
+				{
+					Name:  aws.String("RESOURCE"),
+					Value: aws.String(mockPipelineARN),
+				},
+			},
+		}, gomock.Any()).DoAndReturn(func(_ *csn.ListNotificationRulesInput, fn func(*csn.ListNotificationRulesOutput, bool) bool) error {
+			fn(&csn.ListNotificationRulesOutput{
+				NotificationRules: []*csn.NotificationRuleSummary{{Arn: aws.String(mockRuleARN)}},
+			}, true)
+			return nil
+		})
+	}
+	testCases := map[string]struct {
+		mockAPI func(m *mocks.Mockapi)
+
+		wanted    []Rule
+		wantedErr string
+	}{
+		"error if the rules cannot be listed": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ListNotificationRulesPages(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: "list notification rules of " + mockPipelineARN + ": some error",
+		},
+		"error if a rule cannot be described": {
+			mockAPI: func(m *mocks.Mockapi) {
+				listRules(m)
+				m.EXPECT().DescribeNotificationRule(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "describe notification rule " + mockRuleARN + ": some error",
+		},
+		"return the events and targets of the rules": {
+			mockAPI: func(m *mocks.Mockapi) {
+				listRules(m)
+				m.EXPECT().DescribeNotificationRule(&csn.DescribeNotificationRuleInput{
+					Arn: aws.String(mockRuleARN),
+				}).Return(&csn.DescribeNotificationRuleOutput{
+					Name:   aws.String("pipeline-phonetool-release-notify-0"),
+					Status: aws.String("ENABLED"),
+					EventTypes: []*csn.EventTypeSummary{
+						{EventTypeName: aws.String("Manual approval needed")},
+					},
+					Targets: []*csn.TargetSummary{
+						{
+							TargetType:    aws.String("AWSChatbotSlack"),
+							TargetAddress: aws.String("arn:aws:chatbot::123456789012:chat-configuration/slack-channel/deploys"),
+							TargetStatus:  aws.String("ACTIVE"),
+						},
+					},
+				}, nil)
+			},
+			wanted: []Rule{
+				{
+					Name:   "pipeline-phonetool-release-notify-0",
+					Status: "ENABLED",
+					Events: []string{"Manual approval needed"},
+					Targets: []Target{
+						{
+							Type:    "AWSChatbotSlack",
+							Address: "arn:aws:chatbot::123456789012:chat-configuration/slack-channel/deploys",
+							Status:  "ACTIVE",
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockAPI(m)
+			client := &CodeStarNotifications{client: m}
+
+			rules, err := client.RulesForResource(mockPipelineARN)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, rules)
+		})
+	}
+}
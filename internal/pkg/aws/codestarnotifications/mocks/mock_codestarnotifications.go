@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./codestarnotifications.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	codestarnotifications "github.com/aws/aws-sdk-go/service/codestarnotifications"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// Mockapi is a mock of api interface.
+type Mockapi struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiMockRecorder
+}
+
+// MockapiMockRecorder is the mock recorder for Mockapi.
+type MockapiMockRecorder struct {
+	mock *Mockapi
+}
+
+// NewMockapi creates a new mock instance.
+func NewMockapi(ctrl *gomock.Controller) *Mockapi {
+	mock := &Mockapi{ctrl: ctrl}
+	mock.recorder = &MockapiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockapi) EXPECT() *MockapiMockRecorder {
+	return m.recorder
+}
+
+// DescribeNotificationRule mocks base method.
+func (m *Mockapi) DescribeNotificationRule(input *codestarnotifications.DescribeNotificationRuleInput) (*codestarnotifications.DescribeNotificationRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNotificationRule", input)
+	ret0, _ := ret[0].(*codestarnotifications.DescribeNotificationRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNotificationRule indicates an expected call of DescribeNotificationRule.
+func (mr *MockapiMockRecorder) DescribeNotificationRule(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNotificationRule", reflect.TypeOf((*Mockapi)(nil).DescribeNotificationRule), input)
+}
+
+// ListNotificationRulesPages mocks base method.
+func (m *Mockapi) ListNotificationRulesPages(input *codestarnotifications.ListNotificationRulesInput, fn func(*codestarnotifications.ListNotificationRulesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationRulesPages", input, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListNotificationRulesPages indicates an expected call of ListNotificationRulesPages.
+func (mr *MockapiMockRecorder) ListNotificationRulesPages(input, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationRulesPages", reflect.TypeOf((*Mockapi)(nil).ListNotificationRulesPages), input, fn)
+}
@@ -5,10 +5,14 @@
 package ecr
 
 import (
+	"context"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -19,6 +23,18 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 )
 
+// Media types of image manifests that reference the manifests of each platform of a multi-platform image.
+const (
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeOCIImageIndex      = "application/vnd.oci.image.index.v1+json"
+)
+
+// Media types of the manifest of a single image.
+const (
+	mediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeOCIManifest    = "application/vnd.oci.image.manifest.v1+json"
+)
+
 const (
 	urlFmtString      = "%s.dkr.ecr.%s.amazonaws.com/%s"
 	urlFmtStringForCN = "%s.dkr.ecr.%s.amazonaws.com.cn/%s"
@@ -26,11 +42,31 @@ const (
 	batchDeleteLimit  = 100
 )
 
+// registryHostExp matches the host of a private ECR registry, such as "012345678910.dkr.ecr.us-west-2.amazonaws.com".
+var registryHostExp = regexp.MustCompile(`^(\d{12})\.dkr\.ecr(?:-fips)?\.([a-z0-9-]+)\.amazonaws\.com(?:\.cn)?$`)
+
+// scanPollInterval is the time to wait between two checks of the status of an image scan.
+var scanPollInterval = 5 * time.Second
+
+// Severities of image scan findings, from the most to the least severe.
+var FindingSeverities = []string{
+	ecr.FindingSeverityCritical,
+	ecr.FindingSeverityHigh,
+	ecr.FindingSeverityMedium,
+	ecr.FindingSeverityLow,
+	ecr.FindingSeverityInformational,
+	ecr.FindingSeverityUndefined,
+}
+
 type api interface {
 	DescribeImages(*ecr.DescribeImagesInput) (*ecr.DescribeImagesOutput, error)
 	GetAuthorizationToken(*ecr.GetAuthorizationTokenInput) (*ecr.GetAuthorizationTokenOutput, error)
 	DescribeRepositories(*ecr.DescribeRepositoriesInput) (*ecr.DescribeRepositoriesOutput, error)
 	BatchDeleteImage(*ecr.BatchDeleteImageInput) (*ecr.BatchDeleteImageOutput, error)
+	PutLifecyclePolicy(*ecr.PutLifecyclePolicyInput) (*ecr.PutLifecyclePolicyOutput, error)
+	PutImageScanningConfiguration(*ecr.PutImageScanningConfigurationInput) (*ecr.PutImageScanningConfigurationOutput, error)
+	DescribeImageScanFindings(*ecr.DescribeImageScanFindingsInput) (*ecr.DescribeImageScanFindingsOutput, error)
+	BatchGetImage(*ecr.BatchGetImageInput) (*ecr.BatchGetImageOutput, error)
 }
 
 // ECR wraps an AWS ECR client.
@@ -127,6 +163,51 @@ func (c ECR) ListImages(repoName string) ([]Image, error) {
 	return images, nil
 }
 
+// TagDigest returns the digest of the image that the tag refers to in the repository of the registry.
+// The digest of a multi-platform image is the digest of its manifest list.
+// If registryID is empty, the repository is in the registry of the account of the session.
+func (c ECR) TagDigest(registryID, repoName, tag string) (string, error) {
+	in := &ecr.DescribeImagesInput{
+		RepositoryName: aws.String(repoName),
+		ImageIds: []*ecr.ImageIdentifier{
+			{
+				ImageTag: aws.String(tag),
+			},
+		},
+	}
+	if registryID != "" {
+		in.RegistryId = aws.String(registryID)
+	}
+	resp, err := c.client.DescribeImages(in)
+	if err != nil {
+		return "", fmt.Errorf("ecr repo %s describe image %s: %w", repoName, tag, err)
+	}
+	if len(resp.ImageDetails) == 0 {
+		return "", fmt.Errorf("image %s not found in ecr repo %s", tag, repoName)
+	}
+	return aws.StringValue(resp.ImageDetails[0].ImageDigest), nil
+}
+
+// ImageExists returns whether the image with the digest is in the repository.
+// Images can be expired by the lifecycle policy of the repository.
+func (c ECR) ImageExists(repoName, digest string) (bool, error) {
+	_, err := c.client.DescribeImages(&ecr.DescribeImagesInput{
+		RepositoryName: aws.String(repoName),
+		ImageIds: []*ecr.ImageIdentifier{
+			{
+				ImageDigest: aws.String(digest),
+			},
+		},
+	})
+	if err != nil {
+		if isImageNotFoundErr(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("ecr repo %s describe image %s: %w", repoName, digest, err)
+	}
+	return true, nil
+}
+
 // DeleteImages calls the ECR BatchDeleteImage API with the input image list and repository name.
 func (c ECR) DeleteImages(images []Image, repoName string) error {
 	if len(images) == 0 {
@@ -175,6 +256,282 @@ func (c ECR) ClearRepository(repoName string) error {
 	return err
 }
 
+// retainedImageCount is more images than a repository can hold, so that a rule that expires images beyond this count
+// never expires the images it selects. An image selected by a rule can't be expired by the rules of lower priority.
+const retainedImageCount = 100000
+
+// LifecyclePolicy holds the rules that expire images from a repository.
+// A rule is ignored if its value is not positive.
+type LifecyclePolicy struct {
+	UntaggedExpiryDays int // Expire untagged images pushed more than this number of days ago.
+	KeepLast           int // Expire the oldest tagged images beyond this number of images.
+	// RetainedTagPatterns are the patterns of tags, such as the tags of build caches and signatures,
+	// whose images are never counted nor expired by KeepLast.
+	RetainedTagPatterns []string
+}
+
+type lifecyclePolicyRule struct {
+	RulePriority int                      `json:"rulePriority"`
+	Description  string                   `json:"description"`
+	Selection    lifecyclePolicySelection `json:"selection"`
+	Action       lifecyclePolicyAction    `json:"action"`
+}
+
+type lifecyclePolicySelection struct {
+	TagStatus      string   `json:"tagStatus"`
+	TagPatternList []string `json:"tagPatternList,omitempty"`
+	CountType      string   `json:"countType"`
+	CountUnit      string   `json:"countUnit,omitempty"`
+	CountNumber    int      `json:"countNumber"`
+}
+
+type lifecyclePolicyAction struct {
+	Type string `json:"type"`
+}
+
+// text returns the JSON document of the lifecycle policy.
+func (p LifecyclePolicy) text() (string, error) {
+	var rules []lifecyclePolicyRule
+	if p.KeepLast > 0 && len(p.RetainedTagPatterns) > 0 {
+		rules = append(rules, lifecyclePolicyRule{
+			Description: "Retain build caches and signatures",
+			Selection: lifecyclePolicySelection{
+				TagStatus:      "tagged",
+				TagPatternList: p.RetainedTagPatterns,
+				CountType:      "imageCountMoreThan",
+				CountNumber:    retainedImageCount,
+			},
+		})
+	}
+	if p.UntaggedExpiryDays > 0 {
+		rules = append(rules, lifecyclePolicyRule{
+			Description: fmt.Sprintf("Expire untagged images older than %d days", p.UntaggedExpiryDays),
+			Selection: lifecyclePolicySelection{
+				TagStatus:   "untagged",
+				CountType:   "sinceImagePushed",
+				CountUnit:   "days",
+				CountNumber: p.UntaggedExpiryDays,
+			},
+		})
+	}
+	if p.KeepLast > 0 {
+		// Untagged images, such as the images of previous deployments whose tags were pushed again, are left to UntaggedExpiryDays.
+		rules = append(rules, lifecyclePolicyRule{
+			Description: fmt.Sprintf("Keep the last %d tagged images", p.KeepLast),
+			Selection: lifecyclePolicySelection{
+				TagStatus:      "tagged",
+				TagPatternList: []string{"*"},
+				CountType:      "imageCountMoreThan",
+				CountNumber:    p.KeepLast,
+			},
+		})
+	}
+	for i := range rules {
+		rules[i].RulePriority = i + 1
+		rules[i].Action = lifecyclePolicyAction{Type: "expire"}
+	}
+	text, err := json.Marshal(struct {
+		Rules []lifecyclePolicyRule `json:"rules"`
+	}{
+		Rules: rules,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(text), nil
+}
+
+// PutLifecyclePolicy replaces the lifecycle policy of the repository.
+func (c ECR) PutLifecyclePolicy(repoName string, policy LifecyclePolicy) error {
+	text, err := policy.text()
+	if err != nil {
+		return fmt.Errorf("marshal lifecycle policy of ecr repo %s: %w", repoName, err)
+	}
+	if _, err := c.client.PutLifecyclePolicy(&ecr.PutLifecyclePolicyInput{
+		RepositoryName:      aws.String(repoName),
+		LifecyclePolicyText: aws.String(text),
+	}); err != nil {
+		return fmt.Errorf("ecr repo %s put lifecycle policy: %w", repoName, err)
+	}
+	return nil
+}
+
+// PutScanOnPush turns the scan of images on push on or off for the repository.
+func (c ECR) PutScanOnPush(repoName string, scanOnPush bool) error {
+	if _, err := c.client.PutImageScanningConfiguration(&ecr.PutImageScanningConfigurationInput{
+		RepositoryName: aws.String(repoName),
+		ImageScanningConfiguration: &ecr.ImageScanningConfiguration{
+			ScanOnPush: aws.Bool(scanOnPush),
+		},
+	}); err != nil {
+		return fmt.Errorf("ecr repo %s put image scanning configuration: %w", repoName, err)
+	}
+	return nil
+}
+
+// ScanFinding is a vulnerability found by the scan of an image.
+type ScanFinding struct {
+	Name     string // Name of the vulnerability, such as the CVE ID.
+	Severity string
+	Package  string // Name and version of the vulnerable package, if known.
+	URI      string // Link to the description of the vulnerability.
+}
+
+// ErrScanFailed is returned when the scan of an image cannot complete.
+type ErrScanFailed struct {
+	Digest string
+	Status string
+	Reason string
+}
+
+func (e *ErrScanFailed) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("scan of image %s ended with status %s", e.Digest, e.Status)
+	}
+	return fmt.Sprintf("scan of image %s ended with status %s: %s", e.Digest, e.Status, e.Reason)
+}
+
+// ImageScanFindings waits until the scan of the image completes, and returns its findings.
+// It stops waiting when the context is done.
+func (c ECR) ImageScanFindings(ctx context.Context, repoName, digest string) ([]ScanFinding, error) {
+	in := &ecr.DescribeImageScanFindingsInput{
+		RepositoryName: aws.String(repoName),
+		ImageId: &ecr.ImageIdentifier{
+			ImageDigest: aws.String(digest),
+		},
+	}
+	var findings []ScanFinding
+	for {
+		resp, err := c.client.DescribeImageScanFindings(in)
+		if err != nil && !isScanNotFoundErr(err) {
+			return nil, fmt.Errorf("ecr repo %s describe image scan findings of %s: %w", repoName, digest, err)
+		}
+		var status, reason string
+		if resp != nil && resp.ImageScanStatus != nil {
+			status, reason = aws.StringValue(resp.ImageScanStatus.Status), aws.StringValue(resp.ImageScanStatus.Description)
+		}
+		switch status {
+		case ecr.ScanStatusComplete, ecr.ScanStatusActive:
+			findings = append(findings, scanFindings(resp.ImageScanFindings)...)
+			if resp.NextToken == nil {
+				return findings, nil
+			}
+			in.NextToken = resp.NextToken
+			continue
+		case ecr.ScanStatusFailed, ecr.ScanStatusUnsupportedImage, ecr.ScanStatusFindingsUnavailable, ecr.ScanStatusScanEligibilityExpired:
+			return nil, &ErrScanFailed{
+				Digest: digest,
+				Status: status,
+				Reason: reason,
+			}
+		}
+		// The scan is not started or in progress.
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("wait for the scan of image %s in ecr repo %s: %w", digest, repoName, ctx.Err())
+		case <-time.After(scanPollInterval):
+		}
+	}
+}
+
+// PlatformDigests returns the digests of the images of each platform if digest refers to a multi-platform image,
+// otherwise it returns digest. Registries only scan the images of each platform, not the manifest list that refers to them.
+func (c ECR) PlatformDigests(repoName, digest string) ([]string, error) {
+	resp, err := c.client.BatchGetImage(&ecr.BatchGetImageInput{
+		RepositoryName: aws.String(repoName),
+		ImageIds: []*ecr.ImageIdentifier{
+			{
+				ImageDigest: aws.String(digest),
+			},
+		},
+		AcceptedMediaTypes: aws.StringSlice([]string{
+			mediaTypeDockerManifestList,
+			mediaTypeOCIImageIndex,
+			mediaTypeDockerManifest,
+			mediaTypeOCIManifest,
+		}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ecr repo %s batch get image %s: %w", repoName, digest, err)
+	}
+	if len(resp.Images) == 0 {
+		return nil, fmt.Errorf("image %s not found in ecr repo %s", digest, repoName)
+	}
+	image := resp.Images[0]
+	switch aws.StringValue(image.ImageManifestMediaType) {
+	case mediaTypeDockerManifestList, mediaTypeOCIImageIndex:
+	default:
+		return []string{digest}, nil
+	}
+	var index struct {
+		Manifests []struct {
+			Digest   string `json:"digest"`
+			Platform *struct {
+				OS string `json:"os"`
+			} `json:"platform"`
+		} `json:"manifests"`
+	}
+	if err := json.Unmarshal([]byte(aws.StringValue(image.ImageManifest)), &index); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest list of image %s: %w", digest, err)
+	}
+	var digests []string
+	for _, manifest := range index.Manifests {
+		// Build attestations are stored as manifests with an "unknown" platform.
+		if manifest.Platform != nil && manifest.Platform.OS == "unknown" {
+			continue
+		}
+		digests = append(digests, manifest.Digest)
+	}
+	return digests, nil
+}
+
+func scanFindings(in *ecr.ImageScanFindings) []ScanFinding {
+	if in == nil {
+		return nil
+	}
+	var findings []ScanFinding
+	for _, finding := range in.Findings {
+		out := ScanFinding{
+			Name:     aws.StringValue(finding.Name),
+			Severity: aws.StringValue(finding.Severity),
+			URI:      aws.StringValue(finding.Uri),
+		}
+		var pkg, version string
+		for _, attr := range finding.Attributes {
+			switch aws.StringValue(attr.Key) {
+			case "package_name":
+				pkg = aws.StringValue(attr.Value)
+			case "package_version":
+				version = aws.StringValue(attr.Value)
+			}
+		}
+		out.Package = packageWithVersion(pkg, version)
+		findings = append(findings, out)
+	}
+	for _, finding := range in.EnhancedFindings {
+		out := ScanFinding{
+			Name:     aws.StringValue(finding.Title),
+			Severity: aws.StringValue(finding.Severity),
+		}
+		if details := finding.PackageVulnerabilityDetails; details != nil {
+			out.Name = aws.StringValue(details.VulnerabilityId)
+			out.URI = aws.StringValue(details.SourceUrl)
+			if len(details.VulnerablePackages) > 0 {
+				out.Package = packageWithVersion(aws.StringValue(details.VulnerablePackages[0].Name), aws.StringValue(details.VulnerablePackages[0].Version))
+			}
+		}
+		findings = append(findings, out)
+	}
+	return findings
+}
+
+func packageWithVersion(pkg, version string) string {
+	if pkg == "" || version == "" {
+		return pkg
+	}
+	return pkg + "@" + version
+}
+
 // URIFromARN converts an ECR Repo ARN to a Repository URI
 func URIFromARN(repositoryARN string) (string, error) {
 	repoARN, err := arn.Parse(repositoryARN)
@@ -194,6 +551,38 @@ func URIFromARN(repositoryARN string) (string, error) {
 		repoName), nil
 }
 
+// ImageURI is the reference of an image by tag in a private ECR repository.
+type ImageURI struct {
+	RegistryID string // ID of the AWS account of the registry.
+	Region     string
+	Repository string
+	Tag        string
+}
+
+// ParseImageURI parses the reference of an image by tag in a private ECR repository,
+// such as "012345678910.dkr.ecr.us-west-2.amazonaws.com/myproject/myapp:v1".
+// It returns false if the image is in another registry or is referenced by digest.
+func ParseImageURI(uri string) (ImageURI, bool) {
+	host, path, ok := strings.Cut(uri, "/")
+	if !ok || strings.Contains(path, "@") {
+		return ImageURI{}, false
+	}
+	match := registryHostExp.FindStringSubmatch(host)
+	if match == nil {
+		return ImageURI{}, false
+	}
+	repo, tag := path, "latest"
+	if i := strings.LastIndex(path, ":"); i != -1 {
+		repo, tag = path[:i], path[i+1:]
+	}
+	return ImageURI{
+		RegistryID: match[1],
+		Region:     match[2],
+		Repository: repo,
+		Tag:        tag,
+	}, true
+}
+
 func isRepoNotFoundErr(err error) bool {
 	aerr, ok := err.(awserr.Error)
 	if !ok {
@@ -204,3 +593,19 @@ func isRepoNotFoundErr(err error) bool {
 	}
 	return false
 }
+
+func isImageNotFoundErr(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	return aerr.Code() == ecr.ErrCodeImageNotFoundException
+}
+
+func isScanNotFoundErr(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	return aerr.Code() == ecr.ErrCodeScanNotFoundException
+}
@@ -4,10 +4,12 @@
 package ecr
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -264,6 +266,197 @@ func TestListImages(t *testing.T) {
 	}
 }
 
+func TestImageExists(t *testing.T) {
+	testCases := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wanted    bool
+		wantedErr error
+	}{
+		"returns true if the image is in the repository": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(&ecr.DescribeImagesInput{
+					RepositoryName: aws.String("phonetool/api"),
+					ImageIds: []*ecr.ImageIdentifier{
+						{
+							ImageDigest: aws.String("sha256:abc"),
+						},
+					},
+				}).Return(&ecr.DescribeImagesOutput{
+					ImageDetails: []*ecr.ImageDetail{
+						{
+							ImageDigest: aws.String("sha256:abc"),
+						},
+					},
+				}, nil)
+			},
+			wanted: true,
+		},
+		"returns false if the image is not found": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(nil, awserr.New(ecr.ErrCodeImageNotFoundException, "not found", nil))
+			},
+			wanted: false,
+		},
+		"wraps other errors": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("ecr repo phonetool/api describe image sha256:abc: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+			client := ECR{
+				client: mockECRAPI,
+			}
+
+			got, err := client.ImageExists("phonetool/api", "sha256:abc")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestTagDigest(t *testing.T) {
+	testCases := map[string]struct {
+		registryID    string
+		mockECRClient func(m *mocks.Mockapi)
+
+		wanted    string
+		wantedErr error
+	}{
+		"returns the digest of the tag in the registry": {
+			registryID: "012345678910",
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(&ecr.DescribeImagesInput{
+					RegistryId:     aws.String("012345678910"),
+					RepositoryName: aws.String("phonetool/api"),
+					ImageIds: []*ecr.ImageIdentifier{
+						{
+							ImageTag: aws.String("v1"),
+						},
+					},
+				}).Return(&ecr.DescribeImagesOutput{
+					ImageDetails: []*ecr.ImageDetail{
+						{
+							ImageDigest: aws.String("sha256:abc"),
+						},
+					},
+				}, nil)
+			},
+			wanted: "sha256:abc",
+		},
+		"defaults to the registry of the account": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(&ecr.DescribeImagesInput{
+					RepositoryName: aws.String("phonetool/api"),
+					ImageIds: []*ecr.ImageIdentifier{
+						{
+							ImageTag: aws.String("v1"),
+						},
+					},
+				}).Return(&ecr.DescribeImagesOutput{
+					ImageDetails: []*ecr.ImageDetail{
+						{
+							ImageDigest: aws.String("sha256:abc"),
+						},
+					},
+				}, nil)
+			},
+			wanted: "sha256:abc",
+		},
+		"returns an error if the image is not found": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(&ecr.DescribeImagesOutput{}, nil)
+			},
+			wantedErr: errors.New("image v1 not found in ecr repo phonetool/api"),
+		},
+		"wraps errors": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("ecr repo phonetool/api describe image v1: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+			client := ECR{
+				client: mockECRAPI,
+			}
+
+			got, err := client.TagDigest(tc.registryID, "phonetool/api", "v1")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestParseImageURI(t *testing.T) {
+	testCases := map[string]struct {
+		uri string
+
+		wanted   ImageURI
+		wantedOK bool
+	}{
+		"image with a tag": {
+			uri: "012345678910.dkr.ecr.us-west-2.amazonaws.com/myproject/myapp:v1",
+			wanted: ImageURI{
+				RegistryID: "012345678910",
+				Region:     "us-west-2",
+				Repository: "myproject/myapp",
+				Tag:        "v1",
+			},
+			wantedOK: true,
+		},
+		"image without a tag in the china partition": {
+			uri: "012345678910.dkr.ecr.cn-north-1.amazonaws.com.cn/myrepo",
+			wanted: ImageURI{
+				RegistryID: "012345678910",
+				Region:     "cn-north-1",
+				Repository: "myrepo",
+				Tag:        "latest",
+			},
+			wantedOK: true,
+		},
+		"image referenced by digest": {
+			uri: "012345678910.dkr.ecr.us-west-2.amazonaws.com/myrepo@sha256:abc",
+		},
+		"public ecr image": {
+			uri: "public.ecr.aws/nginx/nginx:1.25",
+		},
+		"docker hub image": {
+			uri: "nginx:1.25",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, ok := ParseImageURI(tc.uri)
+
+			require.Equal(t, tc.wantedOK, ok)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func TestDeleteImages(t *testing.T) {
 	mockRepoName := "mockRepoName"
 	mockError := errors.New("mockError")
@@ -450,3 +643,248 @@ func TestClearRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestPutLifecyclePolicy(t *testing.T) {
+	testCases := map[string]struct {
+		in     LifecyclePolicy
+		wanted string
+	}{
+		"expires untagged images": {
+			in:     LifecyclePolicy{UntaggedExpiryDays: 7},
+			wanted: `{"rules":[{"rulePriority":1,"description":"Expire untagged images older than 7 days","selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":7},"action":{"type":"expire"}}]}`,
+		},
+		"keeps the last tagged images after expiring untagged images": {
+			in:     LifecyclePolicy{UntaggedExpiryDays: 1, KeepLast: 50},
+			wanted: `{"rules":[{"rulePriority":1,"description":"Expire untagged images older than 1 days","selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":1},"action":{"type":"expire"}},{"rulePriority":2,"description":"Keep the last 50 tagged images","selection":{"tagStatus":"tagged","tagPatternList":["*"],"countType":"imageCountMoreThan","countNumber":50},"action":{"type":"expire"}}]}`,
+		},
+		"retains the images with retained tags before keeping the last tagged images": {
+			in:     LifecyclePolicy{KeepLast: 50, RetainedTagPatterns: []string{"cache", "sha256-*"}},
+			wanted: `{"rules":[{"rulePriority":1,"description":"Retain build caches and signatures","selection":{"tagStatus":"tagged","tagPatternList":["cache","sha256-*"],"countType":"imageCountMoreThan","countNumber":100000},"action":{"type":"expire"}},{"rulePriority":2,"description":"Keep the last 50 tagged images","selection":{"tagStatus":"tagged","tagPatternList":["*"],"countType":"imageCountMoreThan","countNumber":50},"action":{"type":"expire"}}]}`,
+		},
+		"ignores retained tags without a rule to keep the last images": {
+			in:     LifecyclePolicy{UntaggedExpiryDays: 7, RetainedTagPatterns: []string{"cache"}},
+			wanted: `{"rules":[{"rulePriority":1,"description":"Expire untagged images older than 7 days","selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":7},"action":{"type":"expire"}}]}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			m.EXPECT().PutLifecyclePolicy(&ecr.PutLifecyclePolicyInput{
+				RepositoryName:      aws.String("app/svc"),
+				LifecyclePolicyText: aws.String(tc.wanted),
+			}).Return(&ecr.PutLifecyclePolicyOutput{}, nil)
+			client := ECR{
+				client: m,
+			}
+
+			err := client.PutLifecyclePolicy("app/svc", tc.in)
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestImageScanFindings(t *testing.T) {
+	scanPollInterval = 0
+	defer func() { scanPollInterval = 5 * time.Second }()
+	in := &ecr.DescribeImageScanFindingsInput{
+		RepositoryName: aws.String("app/svc"),
+		ImageId: &ecr.ImageIdentifier{
+			ImageDigest: aws.String("sha256:abc"),
+		},
+	}
+	testCases := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wanted    []ScanFinding
+		wantedErr string
+	}{
+		"waits for the scan to complete": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().DescribeImageScanFindings(in).Return(nil, awserr.New(ecr.ErrCodeScanNotFoundException, "not found", nil)),
+					m.EXPECT().DescribeImageScanFindings(in).Return(&ecr.DescribeImageScanFindingsOutput{
+						ImageScanStatus: &ecr.ImageScanStatus{Status: aws.String(ecr.ScanStatusInProgress)},
+					}, nil),
+					m.EXPECT().DescribeImageScanFindings(in).Return(&ecr.DescribeImageScanFindingsOutput{
+						ImageScanStatus: &ecr.ImageScanStatus{Status: aws.String(ecr.ScanStatusComplete)},
+						ImageScanFindings: &ecr.ImageScanFindings{
+							Findings: []*ecr.ImageScanFinding{
+								{
+									Name:     aws.String("CVE-2023-0001"),
+									Severity: aws.String("HIGH"),
+									Uri:      aws.String("https://cve"),
+									Attributes: []*ecr.Attribute{
+										{Key: aws.String("package_name"), Value: aws.String("openssl")},
+										{Key: aws.String("package_version"), Value: aws.String("3.0.1")},
+									},
+								},
+							},
+						},
+						NextToken: aws.String("next"),
+					}, nil),
+					m.EXPECT().DescribeImageScanFindings(&ecr.DescribeImageScanFindingsInput{
+						RepositoryName: in.RepositoryName,
+						ImageId:        in.ImageId,
+						NextToken:      aws.String("next"),
+					}).Return(&ecr.DescribeImageScanFindingsOutput{
+						ImageScanStatus: &ecr.ImageScanStatus{Status: aws.String(ecr.ScanStatusActive)},
+						ImageScanFindings: &ecr.ImageScanFindings{
+							EnhancedFindings: []*ecr.EnhancedImageScanFinding{
+								{
+									Title:    aws.String("CVE-2023-0002 - curl"),
+									Severity: aws.String("CRITICAL"),
+									PackageVulnerabilityDetails: &ecr.PackageVulnerabilityDetails{
+										VulnerabilityId: aws.String("CVE-2023-0002"),
+										SourceUrl:       aws.String("https://nvd"),
+										VulnerablePackages: []*ecr.VulnerablePackage{
+											{Name: aws.String("curl"), Version: aws.String("7.0")},
+										},
+									},
+								},
+							},
+						},
+					}, nil),
+				)
+			},
+			wanted: []ScanFinding{
+				{Name: "CVE-2023-0001", Severity: "HIGH", Package: "openssl@3.0.1", URI: "https://cve"},
+				{Name: "CVE-2023-0002", Severity: "CRITICAL", Package: "curl@7.0", URI: "https://nvd"},
+			},
+		},
+		"returns an error if the scan fails": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(in).Return(&ecr.DescribeImageScanFindingsOutput{
+					ImageScanStatus: &ecr.ImageScanStatus{
+						Status:      aws.String(ecr.ScanStatusUnsupportedImage),
+						Description: aws.String("unsupported OS"),
+					},
+				}, nil)
+			},
+			wantedErr: "scan of image sha256:abc ended with status UNSUPPORTED_IMAGE: unsupported OS",
+		},
+		"returns an error if findings cannot be described": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(in).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "ecr repo app/svc describe image scan findings of sha256:abc: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(m)
+			client := ECR{
+				client: m,
+			}
+
+			got, err := client.ImageScanFindings(context.Background(), "app/svc", "sha256:abc")
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestImageScanFindings_Timeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockapi(ctrl)
+	m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(&ecr.DescribeImageScanFindingsOutput{
+		ImageScanStatus: &ecr.ImageScanStatus{Status: aws.String(ecr.ScanStatusPending)},
+	}, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ECR{client: m}.ImageScanFindings(ctx, "app/svc", "sha256:abc")
+
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestPlatformDigests(t *testing.T) {
+	testCases := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wanted    []string
+		wantedErr string
+	}{
+		"returns the digest of a single-platform image": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(gomock.Any()).Return(&ecr.BatchGetImageOutput{
+					Images: []*ecr.Image{
+						{
+							ImageManifestMediaType: aws.String("application/vnd.docker.distribution.manifest.v2+json"),
+							ImageManifest:          aws.String(`{"layers":[]}`),
+						},
+					},
+				}, nil)
+			},
+			wanted: []string{"sha256:abc"},
+		},
+		"returns the digests of each platform of a multi-platform image": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(&ecr.BatchGetImageInput{
+					RepositoryName: aws.String("app/svc"),
+					ImageIds:       []*ecr.ImageIdentifier{{ImageDigest: aws.String("sha256:abc")}},
+					AcceptedMediaTypes: aws.StringSlice([]string{
+						"application/vnd.docker.distribution.manifest.list.v2+json",
+						"application/vnd.oci.image.index.v1+json",
+						"application/vnd.docker.distribution.manifest.v2+json",
+						"application/vnd.oci.image.manifest.v1+json",
+					}),
+				}).Return(&ecr.BatchGetImageOutput{
+					Images: []*ecr.Image{
+						{
+							ImageManifestMediaType: aws.String("application/vnd.oci.image.index.v1+json"),
+							ImageManifest: aws.String(`{
+  "manifests": [
+    {"digest": "sha256:amd64", "platform": {"architecture": "amd64", "os": "linux"}},
+    {"digest": "sha256:arm64", "platform": {"architecture": "arm64", "os": "linux"}},
+    {"digest": "sha256:attestation", "platform": {"architecture": "unknown", "os": "unknown"}}
+  ]
+}`),
+						},
+					},
+				}, nil)
+			},
+			wanted: []string{"sha256:amd64", "sha256:arm64"},
+		},
+		"error if the image is not found": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(gomock.Any()).Return(&ecr.BatchGetImageOutput{}, nil)
+			},
+			wantedErr: "image sha256:abc not found in ecr repo app/svc",
+		},
+		"wraps api errors": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "ecr repo app/svc batch get image sha256:abc: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(m)
+
+			got, err := ECR{client: m}.PlatformDigests("app/svc", "sha256:abc")
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
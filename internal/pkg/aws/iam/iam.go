@@ -26,6 +26,8 @@ type api interface {
 	DeleteRole(input *iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error)
 	CreateServiceLinkedRole(input *iam.CreateServiceLinkedRoleInput) (*iam.CreateServiceLinkedRoleOutput, error)
 	ListPolicies(input *iam.ListPoliciesInput) (*iam.ListPoliciesOutput, error)
+	ListOpenIDConnectProviders(input *iam.ListOpenIDConnectProvidersInput) (*iam.ListOpenIDConnectProvidersOutput, error)
+	ListOpenIDConnectProviderTags(input *iam.ListOpenIDConnectProviderTagsInput) (*iam.ListOpenIDConnectProviderTagsOutput, error)
 }
 
 // IAM wraps the AWS SDK's IAM client.
@@ -125,6 +127,58 @@ func (c *IAM) ListPolicyNames() ([]string, error) {
 	return policyNames, nil
 }
 
+// OIDCProvider is an OpenID Connect identity provider of the account.
+type OIDCProvider struct {
+	ARN  string
+	Tags map[string]string
+}
+
+// OIDCProvider returns the OpenID Connect identity provider of the account for the URL, such as "https://gitlab.com".
+// If the account doesn't have a provider for the URL, it returns nil.
+func (c *IAM) OIDCProvider(url string) (*OIDCProvider, error) {
+	out, err := c.client.ListOpenIDConnectProviders(&iam.ListOpenIDConnectProvidersInput{})
+	if err != nil {
+		return nil, fmt.Errorf("list OIDC providers: %w", err)
+	}
+	suffix := ":oidc-provider/" + strings.TrimPrefix(url, "https://")
+	for _, provider := range out.OpenIDConnectProviderList {
+		providerARN := aws.StringValue(provider.Arn)
+		if !strings.HasSuffix(providerARN, suffix) {
+			continue
+		}
+		tags, err := c.listOIDCProviderTags(providerARN)
+		if err != nil {
+			return nil, err
+		}
+		return &OIDCProvider{
+			ARN:  providerARN,
+			Tags: tags,
+		}, nil
+	}
+	return nil, nil
+}
+
+func (c *IAM) listOIDCProviderTags(providerARN string) (map[string]string, error) {
+	tags := make(map[string]string)
+	var marker *string
+	for {
+		out, err := c.client.ListOpenIDConnectProviderTags(&iam.ListOpenIDConnectProviderTagsInput{
+			OpenIDConnectProviderArn: aws.String(providerARN),
+			Marker:                   marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list tags for OIDC provider %s: %w", providerARN, err)
+		}
+		for _, tag := range out.Tags {
+			tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+		if !aws.BoolValue(out.IsTruncated) {
+			return tags, nil
+		}
+		marker = out.Marker
+	}
+}
+
 func (c *IAM) deleteRolePolicies(roleName string) error {
 	policyNames, err := c.listRolePolicyNames(roleName)
 	if err != nil {
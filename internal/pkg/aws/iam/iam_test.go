@@ -5,6 +5,7 @@ package iam
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -303,3 +304,90 @@ func TestIAM_ListPolicies(t *testing.T) {
 		})
 	}
 }
+
+func TestIAM_OIDCProvider(t *testing.T) {
+	const providerARN = "arn:aws:iam::123456789012:oidc-provider/token.actions.githubusercontent.com"
+	testCases := map[string]struct {
+		inClient func(ctrl *gomock.Controller) *mocks.Mockapi
+
+		wanted    *OIDCProvider
+		wantedErr error
+	}{
+		"wraps error on failure to list providers": {
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ListOpenIDConnectProviders(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("list OIDC providers: some error"),
+		},
+		"returns nil if there is no provider for the URL": {
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ListOpenIDConnectProviders(gomock.Any()).Return(&iam.ListOpenIDConnectProvidersOutput{
+					OpenIDConnectProviderList: []*iam.OpenIDConnectProviderListEntry{
+						{Arn: aws.String("arn:aws:iam::123456789012:oidc-provider/gitlab.com")},
+					},
+				}, nil)
+				return m
+			},
+		},
+		"wraps error on failure to list tags": {
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ListOpenIDConnectProviders(gomock.Any()).Return(&iam.ListOpenIDConnectProvidersOutput{
+					OpenIDConnectProviderList: []*iam.OpenIDConnectProviderListEntry{
+						{Arn: aws.String(providerARN)},
+					},
+				}, nil)
+				m.EXPECT().ListOpenIDConnectProviderTags(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("list tags for OIDC provider %s: some error", providerARN),
+		},
+		"returns the provider matching the URL with its tags": {
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ListOpenIDConnectProviders(gomock.Any()).Return(&iam.ListOpenIDConnectProvidersOutput{
+					OpenIDConnectProviderList: []*iam.OpenIDConnectProviderListEntry{
+						{Arn: aws.String("arn:aws:iam::123456789012:oidc-provider/gitlab.com")},
+						{Arn: aws.String(providerARN)},
+					},
+				}, nil)
+				m.EXPECT().ListOpenIDConnectProviderTags(&iam.ListOpenIDConnectProviderTagsInput{
+					OpenIDConnectProviderArn: aws.String(providerARN),
+				}).Return(&iam.ListOpenIDConnectProviderTagsOutput{
+					Tags: []*iam.Tag{
+						{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+					},
+				}, nil)
+				return m
+			},
+			wanted: &OIDCProvider{
+				ARN:  providerARN,
+				Tags: map[string]string{"copilot-application": "phonetool"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			iam := &IAM{
+				client: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			provider, err := iam.OIDCProvider("https://token.actions.githubusercontent.com")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, provider)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	awsarn "github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
@@ -28,7 +29,9 @@ const (
 	EndpointsID = s3.EndpointsID
 
 	// Error codes.
-	errCodeNotFound = "NotFound"
+	errCodeNotFound                   = "NotFound"
+	errCodePreconditionFailed         = "PreconditionFailed"
+	errCodeConditionalRequestConflict = "ConditionalRequestConflict"
 
 	// Object location prefixes.
 	s3URIPrefix = "s3://"
@@ -46,6 +49,7 @@ type s3API interface {
 	ListObjectsV2(input *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)
 	DeleteObjects(input *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
 	HeadBucket(input *s3.HeadBucketInput) (*s3.HeadBucketOutput, error)
+	GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error)
 }
 
 // NamedBinary is a named binary to be uploaded.
@@ -78,6 +82,92 @@ func (s *S3) Upload(bucket, key string, data io.Reader) (string, error) {
 	return s.upload(bucket, key, data)
 }
 
+// UploadIfNotExists uploads a file to an S3 bucket under the specified key, unless an object already exists under the key.
+// If it does, it returns an *ErrObjectExists.
+func (s *S3) UploadIfNotExists(bucket, key string, data io.Reader) (string, error) {
+	// The SDK doesn't model conditional writes, so the condition is set as a header of the request.
+	url, err := s.upload(bucket, key, data, func(u *s3manager.Uploader) {
+		u.RequestOptions = append(u.RequestOptions, request.WithSetRequestHeaders(map[string]string{
+			"If-None-Match": "*",
+		}))
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && (aerr.Code() == errCodePreconditionFailed || aerr.Code() == errCodeConditionalRequestConflict) {
+			return "", &ErrObjectExists{bucket: bucket, key: key}
+		}
+		return "", err
+	}
+	return url, nil
+}
+
+// ErrObjectExists is returned when an object already exists in a bucket.
+type ErrObjectExists struct {
+	bucket string
+	key    string
+}
+
+// Error implements the error interface.
+func (e *ErrObjectExists) Error() string {
+	return fmt.Sprintf("object %s already exists in bucket %s", e.key, e.bucket)
+}
+
+// ListKeys returns the keys of the objects in a bucket that start with prefix, in lexicographical order.
+func (s *S3) ListKeys(bucket, prefix string) ([]string, error) {
+	var keys []string
+	listResp := &s3.ListObjectsV2Output{}
+	for {
+		var err error
+		listResp, err = s.s3Client.ListObjectsV2(&s3.ListObjectsV2Input{
+			Bucket:            aws.String(bucket),
+			Prefix:            aws.String(prefix),
+			ContinuationToken: listResp.NextContinuationToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list objects with prefix %s in bucket %s: %w", prefix, bucket, err)
+		}
+		for _, object := range listResp.Contents {
+			keys = append(keys, aws.StringValue(object.Key))
+		}
+		if listResp.NextContinuationToken == nil {
+			return keys, nil
+		}
+	}
+}
+
+// Download returns the content of the object stored in a bucket under the specified key.
+// If the object doesn't exist, it returns an *ErrObjectNotFound.
+func (s *S3) Download(bucket, key string) ([]byte, error) {
+	out, err := s.s3Client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, &ErrObjectNotFound{bucket: bucket, key: key}
+		}
+		return nil, fmt.Errorf("get object %s from bucket %s: %w", key, bucket, err)
+	}
+	defer out.Body.Close()
+	content, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read object %s from bucket %s: %w", key, bucket, err)
+	}
+	return content, nil
+}
+
+// ErrObjectNotFound is returned when an object doesn't exist in a bucket.
+type ErrObjectNotFound struct {
+	bucket string
+	key    string
+}
+
+// Error implements the error interface.
+func (e *ErrObjectNotFound) Error() string {
+	return fmt.Sprintf("object %s not found in bucket %s", e.key, e.bucket)
+}
+
 // EmptyBucket deletes all objects within the bucket.
 func (s *S3) EmptyBucket(bucket string) error {
 	var listResp *s3.ListObjectVersionsOutput
@@ -314,7 +404,7 @@ func (s *S3) addNodes(tree treeprint.Tree, prefixes []*s3.CommonPrefix, bucket s
 	return nil
 }
 
-func (s *S3) upload(bucket, key string, buf io.Reader) (string, error) {
+func (s *S3) upload(bucket, key string, buf io.Reader, opts ...func(*s3manager.Uploader)) (string, error) {
 	in := &s3manager.UploadInput{
 		Body:        buf,
 		Bucket:      aws.String(bucket),
@@ -322,7 +412,7 @@ func (s *S3) upload(bucket, key string, buf io.Reader) (string, error) {
 		ACL:         aws.String(s3.ObjectCannedACLBucketOwnerFullControl),
 		ContentType: defaultContentTypeFromExt(key),
 	}
-	resp, err := s.s3Manager.Upload(in)
+	resp, err := s.s3Manager.Upload(in, opts...)
 	if err != nil {
 		return "", fmt.Errorf("upload %s to bucket %s: %w", key, bucket, err)
 	}
@@ -8,11 +8,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3/mocks"
@@ -79,6 +81,76 @@ func TestS3_Upload(t *testing.T) {
 	}
 }
 
+func TestS3_UploadIfNotExists(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3ManagerClient func(m *mocks.Mocks3ManagerAPI)
+
+		wantedURL string
+		wantError error
+	}{
+		"should upload the object only if the key doesn't exist": {
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerAPI) {
+				m.EXPECT().Upload(gomock.Any(), gomock.Any()).Do(func(in *s3manager.UploadInput, opts ...func(*s3manager.Uploader)) {
+					require.Equal(t, "mockBucket", aws.StringValue(in.Bucket))
+					require.Equal(t, "deployments/000001.json", aws.StringValue(in.Key))
+					uploader := &s3manager.Uploader{}
+					for _, opt := range opts {
+						opt(uploader)
+					}
+					req := &request.Request{HTTPRequest: &http.Request{Header: http.Header{}}}
+					req.ApplyOptions(uploader.RequestOptions...)
+					require.Equal(t, "*", req.HTTPRequest.Header.Get("If-None-Match"))
+				}).Return(&s3manager.UploadOutput{
+					Location: "mockURL",
+				}, nil)
+			},
+			wantedURL: "mockURL",
+		},
+		"should return ErrObjectExists if the precondition fails": {
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerAPI) {
+				m.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(nil, awserr.New("PreconditionFailed", "At least one of the pre-conditions you specified did not hold", nil))
+			},
+			wantError: &ErrObjectExists{bucket: "mockBucket", key: "deployments/000001.json"},
+		},
+		"should return ErrObjectExists if a concurrent write conflicts": {
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerAPI) {
+				m.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(nil, awserr.New("ConditionalRequestConflict", "conflict", nil))
+			},
+			wantError: &ErrObjectExists{bucket: "mockBucket", key: "deployments/000001.json"},
+		},
+		"should wrap other errors": {
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerAPI) {
+				m.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantError: errors.New("upload deployments/000001.json to bucket mockBucket: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3ManagerClient := mocks.NewMocks3ManagerAPI(ctrl)
+			tc.mockS3ManagerClient(mockS3ManagerClient)
+
+			service := S3{
+				s3Manager: mockS3ManagerClient,
+			}
+
+			gotURL, gotErr := service.UploadIfNotExists("mockBucket", "deployments/000001.json", bytes.NewBuffer([]byte("bar")))
+
+			if tc.wantError != nil {
+				require.EqualError(t, gotErr, tc.wantError.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wantedURL, gotURL)
+		})
+	}
+}
+
 func TestS3_EmptyBucket(t *testing.T) {
 	batchObject1 := make([]*s3.ObjectVersion, 1000)
 	batchObject2 := make([]*s3.ObjectVersion, 10)
@@ -333,6 +405,126 @@ first failed on key "mock/key": some error`),
 	}
 }
 
+func TestS3_ListKeys(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wantedKeys []string
+		wantedErr  error
+	}{
+		"should wrap the error if objects can't be listed": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().ListObjectsV2(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list objects with prefix deployments/test/api/ in bucket mockBucket: some error"),
+		},
+		"should return the keys of all the pages": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().ListObjectsV2(&s3.ListObjectsV2Input{
+					Bucket: aws.String("mockBucket"),
+					Prefix: aws.String("deployments/test/api/"),
+				}).Return(&s3.ListObjectsV2Output{
+					Contents: []*s3.Object{
+						{Key: aws.String("deployments/test/api/000001.json")},
+					},
+					NextContinuationToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().ListObjectsV2(&s3.ListObjectsV2Input{
+					Bucket:            aws.String("mockBucket"),
+					Prefix:            aws.String("deployments/test/api/"),
+					ContinuationToken: aws.String("token"),
+				}).Return(&s3.ListObjectsV2Output{
+					Contents: []*s3.Object{
+						{Key: aws.String("deployments/test/api/000002.json")},
+					},
+				}, nil)
+			},
+			wantedKeys: []string{"deployments/test/api/000001.json", "deployments/test/api/000002.json"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			// WHEN
+			keys, err := service.ListKeys("mockBucket", "deployments/test/api/")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedKeys, keys)
+		})
+	}
+}
+
+func TestS3_Download(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wantedContent []byte
+		wantedErr     error
+	}{
+		"should return ErrObjectNotFound if the key doesn't exist": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeNoSuchKey, "message", nil))
+			},
+			wantedErr: &ErrObjectNotFound{bucket: "mockBucket", key: "mockKey"},
+		},
+		"should wrap other errors": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get object mockKey from bucket mockBucket: some error"),
+		},
+		"should return the content of the object": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(&s3.GetObjectInput{
+					Bucket: aws.String("mockBucket"),
+					Key:    aws.String("mockKey"),
+				}).Return(&s3.GetObjectOutput{
+					Body: io.NopCloser(bytes.NewBufferString("hello")),
+				}, nil)
+			},
+			wantedContent: []byte("hello"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			// WHEN
+			content, err := service.Download("mockBucket", "mockKey")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, content)
+		})
+	}
+}
+
 func TestS3_ParseURL(t *testing.T) {
 	testCases := map[string]struct {
 		inURL string
@@ -0,0 +1,246 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sqs provides a client to make API requests to Amazon Simple Queue Service.
+package sqs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+const (
+	// maxReceiveBatchSize is the maximum number of messages that can be received in a single call.
+	maxReceiveBatchSize = 10
+
+	// MaxRedriveRate is the maximum number of messages per second that a redrive task can move.
+	MaxRedriveRate = 500
+)
+
+// Statuses of a message move task.
+const (
+	MessageMoveTaskStatusRunning    = "RUNNING"
+	MessageMoveTaskStatusCompleted  = "COMPLETED"
+	MessageMoveTaskStatusCancelling = "CANCELLING"
+	MessageMoveTaskStatusCancelled  = "CANCELLED"
+	MessageMoveTaskStatusFailed     = "FAILED"
+)
+
+type api interface {
+	GetQueueAttributes(input *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error)
+	ReceiveMessage(input *sqs.ReceiveMessageInput) (*sqs.ReceiveMessageOutput, error)
+	PurgeQueue(input *sqs.PurgeQueueInput) (*sqs.PurgeQueueOutput, error)
+	StartMessageMoveTask(input *sqs.StartMessageMoveTaskInput) (*sqs.StartMessageMoveTaskOutput, error)
+	ListMessageMoveTasks(input *sqs.ListMessageMoveTasksInput) (*sqs.ListMessageMoveTasksOutput, error)
+}
+
+// SQS wraps an Amazon SQS client.
+type SQS struct {
+	client api
+}
+
+// New returns a SQS configured against the input session.
+func New(s *session.Session) *SQS {
+	return &SQS{
+		client: sqs.New(s),
+	}
+}
+
+// QueueAttributes holds the depth and redrive configuration of a queue.
+type QueueAttributes struct {
+	ARN              string
+	FIFO             bool
+	VisibleMessages  int
+	InFlightMessages int
+	DelayedMessages  int
+
+	// DeadLetterTargetARN is the ARN of the dead-letter queue, empty if the queue doesn't have one.
+	DeadLetterTargetARN string
+	MaxReceiveCount     int
+}
+
+// Message is a message peeked from a queue.
+type Message struct {
+	ID             string    `json:"id"`
+	Body           string    `json:"body"`
+	SentAt         time.Time `json:"sentAt"`
+	ReceiveCount   int       `json:"receiveCount"`
+	MessageGroupID string    `json:"messageGroupId,omitempty"`
+}
+
+// MessageMoveTask is an asynchronous task that moves messages from a dead-letter queue back to their source queue.
+type MessageMoveTask struct {
+	Handle        string
+	Status        string
+	MovedMessages int
+	TotalMessages int
+	FailureReason string
+}
+
+// IsDone returns true if the task is no longer moving messages.
+func (t *MessageMoveTask) IsDone() bool {
+	return t.Status != MessageMoveTaskStatusRunning && t.Status != MessageMoveTaskStatusCancelling
+}
+
+// QueueAttributes returns the approximate number of messages in the queue and its redrive configuration.
+func (s *SQS) QueueAttributes(url string) (*QueueAttributes, error) {
+	out, err := s.client.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(url),
+		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameAll}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get attributes of queue %s: %w", url, err)
+	}
+	attrs := aws.StringValueMap(out.Attributes)
+	queue := &QueueAttributes{
+		ARN:  attrs[sqs.QueueAttributeNameQueueArn],
+		FIFO: attrs[sqs.QueueAttributeNameFifoQueue] == "true",
+	}
+	counts := map[string]*int{
+		sqs.QueueAttributeNameApproximateNumberOfMessages:           &queue.VisibleMessages,
+		sqs.QueueAttributeNameApproximateNumberOfMessagesNotVisible: &queue.InFlightMessages,
+		sqs.QueueAttributeNameApproximateNumberOfMessagesDelayed:    &queue.DelayedMessages,
+	}
+	for name, count := range counts {
+		if attrs[name] == "" {
+			continue
+		}
+		if *count, err = strconv.Atoi(attrs[name]); err != nil {
+			return nil, fmt.Errorf("parse attribute %s of queue %s: %w", name, url, err)
+		}
+	}
+	if policy := attrs[sqs.QueueAttributeNameRedrivePolicy]; policy != "" {
+		var redrive struct {
+			DeadLetterTargetARN string      `json:"deadLetterTargetArn"`
+			MaxReceiveCount     json.Number `json:"maxReceiveCount"`
+		}
+		if err := json.Unmarshal([]byte(policy), &redrive); err != nil {
+			return nil, fmt.Errorf("unmarshal redrive policy of queue %s: %w", url, err)
+		}
+		maxReceiveCount, err := strconv.Atoi(redrive.MaxReceiveCount.String())
+		if err != nil {
+			return nil, fmt.Errorf("parse max receive count of queue %s: %w", url, err)
+		}
+		queue.DeadLetterTargetARN, queue.MaxReceiveCount = redrive.DeadLetterTargetARN, maxReceiveCount
+	}
+	return queue, nil
+}
+
+// PeekMessages receives up to count messages from the queue without deleting them.
+// The messages are received with a visibility timeout of zero so that they are immediately visible to consumers again,
+// but each receive increments the receive count of the message.
+func (s *SQS) PeekMessages(url string, count int) ([]*Message, error) {
+	var messages []*Message
+	seen := make(map[string]bool)
+	// Messages stay visible, so the same message can be received more than once: make a bounded number of attempts.
+	for attempts := (count + maxReceiveBatchSize - 1) / maxReceiveBatchSize; attempts > 0 && len(messages) < count; attempts-- {
+		batchSize := count - len(messages)
+		if batchSize > maxReceiveBatchSize {
+			batchSize = maxReceiveBatchSize
+		}
+		out, err := s.client.ReceiveMessage(&sqs.ReceiveMessageInput{
+			QueueUrl:            aws.String(url),
+			MaxNumberOfMessages: aws.Int64(int64(batchSize)),
+			VisibilityTimeout:   aws.Int64(0),
+			AttributeNames:      aws.StringSlice([]string{sqs.QueueAttributeNameAll}),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("receive messages from queue %s: %w", url, err)
+		}
+		if len(out.Messages) == 0 {
+			break
+		}
+		for _, msg := range out.Messages {
+			id := aws.StringValue(msg.MessageId)
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			m, err := toMessage(msg)
+			if err != nil {
+				return nil, err
+			}
+			messages = append(messages, m)
+		}
+	}
+	return messages, nil
+}
+
+// Purge deletes all the messages in the queue.
+func (s *SQS) Purge(url string) error {
+	if _, err := s.client.PurgeQueue(&sqs.PurgeQueueInput{
+		QueueUrl: aws.String(url),
+	}); err != nil {
+		return fmt.Errorf("purge queue %s: %w", url, err)
+	}
+	return nil
+}
+
+// StartRedrive starts a task to move the messages of the dead-letter queue back to their source queue, and returns the handle of the task.
+// If maxPerSecond is zero, SQS optimizes the rate of the task.
+func (s *SQS) StartRedrive(deadLetterQueueARN string, maxPerSecond int) (string, error) {
+	in := &sqs.StartMessageMoveTaskInput{
+		SourceArn: aws.String(deadLetterQueueARN),
+	}
+	if maxPerSecond != 0 {
+		in.MaxNumberOfMessagesPerSecond = aws.Int64(int64(maxPerSecond))
+	}
+	out, err := s.client.StartMessageMoveTask(in)
+	if err != nil {
+		return "", fmt.Errorf("start moving messages from queue %s: %w", deadLetterQueueARN, err)
+	}
+	return aws.StringValue(out.TaskHandle), nil
+}
+
+// RedriveTask returns the task with the handle that moves messages from the dead-letter queue.
+func (s *SQS) RedriveTask(deadLetterQueueARN, handle string) (*MessageMoveTask, error) {
+	out, err := s.client.ListMessageMoveTasks(&sqs.ListMessageMoveTasksInput{
+		SourceArn: aws.String(deadLetterQueueARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list message move tasks of queue %s: %w", deadLetterQueueARN, err)
+	}
+	for _, task := range out.Results {
+		if aws.StringValue(task.TaskHandle) != handle {
+			continue
+		}
+		return &MessageMoveTask{
+			Handle:        handle,
+			Status:        aws.StringValue(task.Status),
+			MovedMessages: int(aws.Int64Value(task.ApproximateNumberOfMessagesMoved)),
+			TotalMessages: int(aws.Int64Value(task.ApproximateNumberOfMessagesToMove)),
+			FailureReason: aws.StringValue(task.FailureReason),
+		}, nil
+	}
+	return nil, fmt.Errorf("message move task %s of queue %s not found", handle, deadLetterQueueARN)
+}
+
+func toMessage(msg *sqs.Message) (*Message, error) {
+	attrs := aws.StringValueMap(msg.Attributes)
+	m := &Message{
+		ID:             aws.StringValue(msg.MessageId),
+		Body:           aws.StringValue(msg.Body),
+		MessageGroupID: attrs[sqs.MessageSystemAttributeNameMessageGroupId],
+	}
+	if sent := attrs[sqs.MessageSystemAttributeNameSentTimestamp]; sent != "" {
+		ms, err := strconv.ParseInt(sent, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse sent timestamp of message %s: %w", m.ID, err)
+		}
+		m.SentAt = time.UnixMilli(ms).UTC()
+	}
+	if count := attrs[sqs.MessageSystemAttributeNameApproximateReceiveCount]; count != "" {
+		n, err := strconv.Atoi(count)
+		if err != nil {
+			return nil, fmt.Errorf("parse receive count of message %s: %w", m.ID, err)
+		}
+		m.ReceiveCount = n
+	}
+	return m, nil
+}
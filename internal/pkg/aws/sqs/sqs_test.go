@@ -0,0 +1,303 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sqs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockQueueURL = "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-EventsQueue"
+	mockDLQARN   = "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-DeadLetterQueue"
+)
+
+func TestSQS_QueueAttributes(t *testing.T) {
+	testCases := map[string]struct {
+		mockAPI func(m *mocks.Mockapi)
+
+		wanted    *QueueAttributes
+		wantedErr string
+	}{
+		"error if the attributes cannot be retrieved": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().GetQueueAttributes(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "get attributes of queue " + mockQueueURL + ": some error",
+		},
+		"error if the redrive policy is malformed": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().GetQueueAttributes(gomock.Any()).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: aws.StringMap(map[string]string{
+						"RedrivePolicy": "{",
+					}),
+				}, nil)
+			},
+			wantedErr: "unmarshal redrive policy of queue " + mockQueueURL + ": unexpected end of JSON input",
+		},
+		"return the depth and redrive configuration of the queue": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().GetQueueAttributes(&sqs.GetQueueAttributesInput{
+					QueueUrl:       aws.String(mockQueueURL),
+					AttributeNames: aws.StringSlice([]string{"All"}),
+				}).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: aws.StringMap(map[string]string{
+						"QueueArn":                              "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-EventsQueue",
+						"ApproximateNumberOfMessages":           "42",
+						"ApproximateNumberOfMessagesNotVisible": "3",
+						"ApproximateNumberOfMessagesDelayed":    "1",
+						"RedrivePolicy":                         `{"deadLetterTargetArn":"` + mockDLQARN + `","maxReceiveCount":5}`,
+					}),
+				}, nil)
+			},
+			wanted: &QueueAttributes{
+				ARN:                 "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-EventsQueue",
+				VisibleMessages:     42,
+				InFlightMessages:    3,
+				DelayedMessages:     1,
+				DeadLetterTargetARN: mockDLQARN,
+				MaxReceiveCount:     5,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockAPI(m)
+			client := SQS{client: m}
+
+			got, err := client.QueueAttributes(mockQueueURL)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestSQS_PeekMessages(t *testing.T) {
+	mockMessage := func(id string) *sqs.Message {
+		return &sqs.Message{
+			MessageId: aws.String(id),
+			Body:      aws.String("hello"),
+			Attributes: aws.StringMap(map[string]string{
+				"SentTimestamp":           "1698840000000",
+				"ApproximateReceiveCount": "2",
+			}),
+		}
+	}
+	wantedMessage := func(id string) *Message {
+		return &Message{
+			ID:           id,
+			Body:         "hello",
+			SentAt:       time.Date(2023, time.November, 1, 12, 0, 0, 0, time.UTC),
+			ReceiveCount: 2,
+		}
+	}
+	testCases := map[string]struct {
+		count   int
+		mockAPI func(m *mocks.Mockapi)
+
+		wanted    []*Message
+		wantedErr string
+	}{
+		"error if messages cannot be received": {
+			count: 5,
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ReceiveMessage(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "receive messages from queue " + mockQueueURL + ": some error",
+		},
+		"receive messages with a zero visibility timeout": {
+			count: 5,
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ReceiveMessage(&sqs.ReceiveMessageInput{
+					QueueUrl:            aws.String(mockQueueURL),
+					MaxNumberOfMessages: aws.Int64(5),
+					VisibilityTimeout:   aws.Int64(0),
+					AttributeNames:      aws.StringSlice([]string{"All"}),
+				}).Return(&sqs.ReceiveMessageOutput{
+					Messages: []*sqs.Message{mockMessage("1"), mockMessage("2")},
+				}, nil)
+			},
+			wanted: []*Message{wantedMessage("1"), wantedMessage("2")},
+		},
+		"receive messages in batches and skip duplicates": {
+			count: 12,
+			mockAPI: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().ReceiveMessage(gomock.Any()).DoAndReturn(func(in *sqs.ReceiveMessageInput) (*sqs.ReceiveMessageOutput, error) {
+						require.Equal(t, int64(10), aws.Int64Value(in.MaxNumberOfMessages))
+						return &sqs.ReceiveMessageOutput{Messages: []*sqs.Message{mockMessage("1")}}, nil
+					}),
+					m.EXPECT().ReceiveMessage(gomock.Any()).DoAndReturn(func(in *sqs.ReceiveMessageInput) (*sqs.ReceiveMessageOutput, error) {
+						require.Equal(t, int64(10), aws.Int64Value(in.MaxNumberOfMessages))
+						return &sqs.ReceiveMessageOutput{Messages: []*sqs.Message{mockMessage("1"), mockMessage("2")}}, nil
+					}),
+				)
+			},
+			wanted: []*Message{wantedMessage("1"), wantedMessage("2")},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockAPI(m)
+			client := SQS{client: m}
+
+			got, err := client.PeekMessages(mockQueueURL, tc.count)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestSQS_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockapi(ctrl)
+	m.EXPECT().PurgeQueue(&sqs.PurgeQueueInput{QueueUrl: aws.String(mockQueueURL)}).Return(nil, errors.New("some error"))
+	client := SQS{client: m}
+
+	err := client.Purge(mockQueueURL)
+
+	require.EqualError(t, err, "purge queue "+mockQueueURL+": some error")
+}
+
+func TestSQS_StartRedrive(t *testing.T) {
+	testCases := map[string]struct {
+		rate    int
+		mockAPI func(m *mocks.Mockapi)
+
+		wanted    string
+		wantedErr string
+	}{
+		"error if the task cannot be started": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().StartMessageMoveTask(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "start moving messages from queue " + mockDLQARN + ": some error",
+		},
+		"let SQS optimize the rate if it is not set": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().StartMessageMoveTask(&sqs.StartMessageMoveTaskInput{
+					SourceArn: aws.String(mockDLQARN),
+				}).Return(&sqs.StartMessageMoveTaskOutput{TaskHandle: aws.String("mockHandle")}, nil)
+			},
+			wanted: "mockHandle",
+		},
+		"limit the rate of the task": {
+			rate: 10,
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().StartMessageMoveTask(&sqs.StartMessageMoveTaskInput{
+					SourceArn:                    aws.String(mockDLQARN),
+					MaxNumberOfMessagesPerSecond: aws.Int64(10),
+				}).Return(&sqs.StartMessageMoveTaskOutput{TaskHandle: aws.String("mockHandle")}, nil)
+			},
+			wanted: "mockHandle",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockAPI(m)
+			client := SQS{client: m}
+
+			got, err := client.StartRedrive(mockDLQARN, tc.rate)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestSQS_RedriveTask(t *testing.T) {
+	testCases := map[string]struct {
+		mockAPI func(m *mocks.Mockapi)
+
+		wanted    *MessageMoveTask
+		wantedErr string
+	}{
+		"error if the tasks cannot be listed": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ListMessageMoveTasks(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "list message move tasks of queue " + mockDLQARN + ": some error",
+		},
+		"error if the task is not found": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ListMessageMoveTasks(gomock.Any()).Return(&sqs.ListMessageMoveTasksOutput{
+					Results: []*sqs.ListMessageMoveTasksResultEntry{{TaskHandle: aws.String("other")}},
+				}, nil)
+			},
+			wantedErr: "message move task mockHandle of queue " + mockDLQARN + " not found",
+		},
+		"return the progress of the task": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ListMessageMoveTasks(&sqs.ListMessageMoveTasksInput{
+					SourceArn: aws.String(mockDLQARN),
+				}).Return(&sqs.ListMessageMoveTasksOutput{
+					Results: []*sqs.ListMessageMoveTasksResultEntry{
+						{
+							TaskHandle:                        aws.String("mockHandle"),
+							Status:                            aws.String("RUNNING"),
+							ApproximateNumberOfMessagesMoved:  aws.Int64(4),
+							ApproximateNumberOfMessagesToMove: aws.Int64(10),
+						},
+					},
+				}, nil)
+			},
+			wanted: &MessageMoveTask{
+				Handle:        "mockHandle",
+				Status:        "RUNNING",
+				MovedMessages: 4,
+				TotalMessages: 10,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockAPI(m)
+			client := SQS{client: m}
+
+			got, err := client.RedriveTask(mockDLQARN, "mockHandle")
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+			require.False(t, got.IsDone())
+		})
+	}
+}
@@ -25,6 +25,9 @@ Applications are a collection of services and environments.`,
 	cmd.AddCommand(buildAppShowCmd())
 	cmd.AddCommand(buildAppDeleteCommand())
 	cmd.AddCommand(buildAppUpgradeCmd())
+	cmd.AddCommand(buildAppRouteCmd())
+	cmd.AddCommand(buildAppMigrateStoreCmd())
+	cmd.AddCommand(buildAppExportCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -8,14 +8,11 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -33,7 +30,7 @@ const (
 	appDeleteNamePrompt = "Which application would you like to delete?"
 
 	fmtDeleteAppConfirmPrompt = "Are you sure you want to delete application %s?"
-	deleteAppConfirmHelp      = "This will delete all resources in your application: including services, environments, and pipelines."
+	deleteAppConfirmHelp      = "This will delete all resources in your application: including services, environments, pipelines, and routes."
 
 	deleteAppCleanResourcesStartMsg = "Cleaning up deployment resources."
 	deleteAppCleanResourcesStopMsg  = "Cleaned up deployment resources.\n"
@@ -70,6 +67,7 @@ type deleteAppOpts struct {
 	envDeleteExecutor      func(appName, envName string) (executeAsker, error)
 	taskDeleteExecutor     func(appName, envName, taskName string) (executor, error)
 	pipelineDeleteExecutor func(appName, pipelineName string) (executor, error)
+	routeDeleteExecutor    func(appName, routeName string) (executor, error)
 	existingWorkSpace      func() (wsAppManagerDeleter, error)
 }
 
@@ -80,7 +78,7 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 		return nil, fmt.Errorf("default session: %w", err)
 	}
 	prompter := prompt.New()
-	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	store := config.NewStore(defaultSession)
 	return &deleteAppOpts{
 		deleteAppVars: vars,
 		spinner:       termprogress.NewSpinner(log.DiagnosticWriter),
@@ -150,8 +148,19 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 			}
 			return opts, nil
 		},
+		routeDeleteExecutor: func(appName, routeName string) (executor, error) {
+			opts, err := newAppRouteDeleteOpts(appRouteDeleteVars{
+				appName:          appName,
+				name:             routeName,
+				skipConfirmation: true,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return opts, nil
+		},
 		existingWorkSpace: func() (wsAppManagerDeleter, error) {
-			return workspace.Use(afero.NewOsFs())
+			return workspace.UseApp(vars.name, afero.NewOsFs())
 		},
 	}, nil
 }
@@ -184,13 +193,17 @@ func (o *deleteAppOpts) Ask() error {
 }
 
 // Execute deletes the application.
-// It removes the pipelines, all the services from each environment, the environments, the pipeline S3 buckets,
+// It removes the pipelines, the routes, all the services from each environment, the environments, the pipeline S3 buckets,
 // the application, removes the variables from the config store, and deletes the local workspace.
 func (o *deleteAppOpts) Execute() error {
 	if err := o.deletePipelines(); err != nil {
 		return err
 	}
 
+	if err := o.deleteRoutes(); err != nil {
+		return err
+	}
+
 	if err := o.deleteSvcs(); err != nil {
 		return err
 	}
@@ -352,6 +365,24 @@ func (o *deleteAppOpts) deletePipelines() error {
 	return nil
 }
 
+func (o *deleteAppOpts) deleteRoutes() error {
+	routes, err := o.store.ListRoutes(o.name)
+	if err != nil {
+		return fmt.Errorf("list routes for application %s: %w", o.name, err)
+	}
+
+	for _, route := range routes {
+		cmd, err := o.routeDeleteExecutor(o.name, route.Name)
+		if err != nil {
+			return err
+		}
+		if err := cmd.Execute(); err != nil {
+			return fmt.Errorf("execute route delete: %w", err)
+		}
+	}
+	return nil
+}
+
 func (o *deleteAppOpts) deleteAppResources() error {
 	if err := o.cfn.DeleteApp(o.name); err != nil {
 		return fmt.Errorf("delete app resources: %w", err)
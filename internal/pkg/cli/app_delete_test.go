@@ -34,6 +34,7 @@ type deleteAppMocks struct {
 	taskDeleter     *mocks.Mockexecutor
 	bucketEmptier   *mocks.MockbucketEmptier
 	pipelineDeleter *mocks.Mockexecutor
+	routeDeleter    *mocks.Mockexecutor
 	prompt          *mocks.Mockprompter
 	sel             *mocks.MockappSelector
 }
@@ -169,6 +170,11 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 			S3Bucket: "goose-bucket",
 		},
 	}
+	mockRoutes := []*config.Route{
+		{
+			Name: "api",
+		},
+	}
 	mockTaskStacks := []deploy.TaskStackInfo{
 		{
 			StackName: "task-db-migrate",
@@ -190,6 +196,10 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 					mocks.codepipeline.EXPECT().ListDeployedPipelines(mockAppName).Return(mockPipelines, nil),
 					mocks.pipelineDeleter.EXPECT().Execute().Return(nil).Times(2),
 
+					// deleteRoutes
+					mocks.store.EXPECT().ListRoutes(mockAppName).Return(mockRoutes, nil),
+					mocks.routeDeleter.EXPECT().Execute().Return(nil),
+
 					// deleteSvcs
 					mocks.store.EXPECT().ListServices(mockAppName).Return(mockServices, nil),
 					mocks.svcDeleter.EXPECT().Execute().Return(nil).Times(2),
@@ -240,6 +250,10 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 					mocks.codepipeline.EXPECT().ListDeployedPipelines(mockAppName).Return(mockPipelines, nil),
 					mocks.pipelineDeleter.EXPECT().Execute().Return(nil).Times(2),
 
+					// deleteRoutes
+					mocks.store.EXPECT().ListRoutes(mockAppName).Return(mockRoutes, nil),
+					mocks.routeDeleter.EXPECT().Execute().Return(nil),
+
 					// deleteSvcs
 					mocks.store.EXPECT().ListServices(mockAppName).Return(mockServices, nil),
 					mocks.svcDeleter.EXPECT().Execute().Return(nil).Times(2),
@@ -325,6 +339,11 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 				return mockPipelineDeleteExecutor, nil
 			}
 
+			mockRouteDeleteExecutor := mocks.NewMockexecutor(ctrl)
+			mockRouteExecutorProvider := func(appName, routeName string) (executor, error) {
+				return mockRouteDeleteExecutor, nil
+			}
+
 			mocks := deleteAppMocks{
 				spinner:         mockSpinner,
 				store:           mockStore,
@@ -338,6 +357,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 				taskDeleter:     mockTaskDeleteExecutor,
 				bucketEmptier:   mockBucketEmptier,
 				pipelineDeleter: mockPipelineDeleteExecutor,
+				routeDeleter:    mockRouteDeleteExecutor,
 			}
 			test.setupMocks(mocks)
 
@@ -359,6 +379,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 				envDeleteExecutor:      mockAskExecutorProvider,
 				taskDeleteExecutor:     mockTaskDeleteProvider,
 				pipelineDeleteExecutor: mockPipelineExecutorProvider,
+				routeDeleteExecutor:    mockRouteExecutorProvider,
 			}
 
 			// WHEN
@@ -0,0 +1,378 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/export"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appExportFormatPrompt     = "Which format would you like to export your application to?"
+	appExportFormatHelpPrompt = `A CloudFormation project holds the templates and parameters files of each stack.
+A CDK project wraps each template with the CfnInclude construct.`
+	appExportOutputDirPrompt = "Which directory should hold the exported project?"
+)
+
+type exportAppVars struct {
+	name      string
+	outputDir string
+	format    string
+	tag       string
+}
+
+type exportAppOpts struct {
+	exportAppVars
+
+	store       store
+	ws          wsExportReader
+	deployStore deployedEnvironmentLister
+	prompt      prompter
+
+	newProject    func() (exportProject, error)
+	appStacks     func(app *config.Application) ([]*export.Stack, error)
+	envStack      func(env *config.Environment) (*export.Stack, error)
+	workloadStack func(name string, env *config.Environment) (*export.Stack, error)
+	pipelineStack func(app *config.Application, pipeline workspace.PipelineManifest) (*export.Stack, error)
+}
+
+func newExportAppOpts(vars exportAppVars) (*exportAppOpts, error) {
+	fs := afero.NewOsFs()
+	ws, err := workspace.UseApp(vars.name, fs)
+	if err != nil {
+		return nil, err
+	}
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("app export"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	store := config.NewStore(defaultSess)
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &exportAppOpts{
+		exportAppVars: vars,
+		store:         store,
+		ws:            ws,
+		deployStore:   deployStore,
+		prompt:        prompt.New(),
+	}
+	opts.newProject = func() (exportProject, error) {
+		return export.New(fs, opts.name, opts.outputDir, opts.format)
+	}
+	opts.appStacks = func(app *config.Application) ([]*export.Stack, error) {
+		region := aws.StringValue(defaultSess.Config.Region)
+		cfn := awscfn.New(defaultSess)
+		name := stack.NameForAppStack(app.Name)
+		descr, err := cfn.Describe(name)
+		if err != nil {
+			return nil, fmt.Errorf("describe stack %s: %w", name, err)
+		}
+		tpl, err := cfn.TemplateBody(name)
+		if err != nil {
+			return nil, fmt.Errorf("get template of stack %s: %w", name, err)
+		}
+		params, err := export.TemplateConfiguration(descr.Parameters, descr.Tags)
+		if err != nil {
+			return nil, err
+		}
+		stacks := []*export.Stack{
+			{
+				Name:       name,
+				AccountID:  app.AccountID,
+				Region:     region,
+				Template:   tpl,
+				Parameters: params,
+			},
+		}
+		// The stack set deploys the same template to each region of the application.
+		stackSetName := stack.NameForAppStackSet(app.Name)
+		stackSet, err := stackset.New(defaultSess).Describe(stackSetName)
+		if err != nil {
+			return nil, fmt.Errorf("describe stack set %s: %w", stackSetName, err)
+		}
+		resources, err := deploycfn.New(defaultSess).GetRegionalAppResources(app)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range resources {
+			stacks = append(stacks, &export.Stack{
+				Name:      fmt.Sprintf("%s-%s", stackSetName, r.Region),
+				AccountID: app.AccountID,
+				Region:    r.Region,
+				Template:  stackSet.Template,
+			})
+		}
+		return stacks, nil
+	}
+	opts.envStack = func(env *config.Environment) (*export.Stack, error) {
+		cmd, err := newPackageEnvOpts(packageEnvVars{
+			name:    env.Name,
+			appName: opts.name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		tpl, params, addons := newClosableStringBuilder(), newClosableStringBuilder(), newClosableStringBuilder()
+		cmd.tplWriter, cmd.paramsWriter, cmd.addonsWriter = tpl, params, addons
+		if err := cmd.Execute(); err != nil {
+			return nil, fmt.Errorf("package environment %s: %w", env.Name, err)
+		}
+		return &export.Stack{
+			Name:       stack.NameForEnv(opts.name, env.Name),
+			AccountID:  env.AccountID,
+			Region:     env.Region,
+			Template:   tpl.String(),
+			Parameters: params.String(),
+			Addons:     addons.String(),
+		}, nil
+	}
+	opts.workloadStack = func(name string, env *config.Environment) (*export.Stack, error) {
+		cmd, err := newPackageSvcOpts(packageSvcVars{
+			name:    name,
+			envName: env.Name,
+			appName: opts.name,
+			tag:     opts.tag,
+		})
+		if err != nil {
+			return nil, err
+		}
+		tpl, params, addons := newClosableStringBuilder(), newClosableStringBuilder(), newClosableStringBuilder()
+		cmd.templateWriter, cmd.paramsWriter, cmd.addonsWriter = tpl, params, addons
+		if err := cmd.Execute(); err != nil {
+			return nil, fmt.Errorf("package %s for environment %s: %w", name, env.Name, err)
+		}
+		return &export.Stack{
+			Name:       stack.NameForWorkload(opts.name, env.Name, name),
+			AccountID:  env.AccountID,
+			Region:     env.Region,
+			Template:   tpl.String(),
+			Parameters: params.String(),
+			Addons:     addons.String(),
+		}, nil
+	}
+	opts.pipelineStack = func(app *config.Application, pipeline workspace.PipelineManifest) (*export.Stack, error) {
+		cmd, err := newPackagePipelineOpts(packagePipelineVars{
+			name:    pipeline.Name,
+			appName: opts.name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		tpl := newClosableStringBuilder()
+		cmd.tmplWriter = tpl
+		if err := cmd.Execute(); err != nil {
+			return nil, fmt.Errorf("package pipeline %s: %w", pipeline.Name, err)
+		}
+		isLegacy, err := cmd.isLegacy(pipeline.Name)
+		if err != nil {
+			return nil, err
+		}
+		return &export.Stack{
+			Name:      stack.NameForPipeline(opts.name, pipeline.Name, isLegacy),
+			AccountID: app.AccountID,
+			Region:    aws.StringValue(defaultSess.Config.Region),
+			Template:  tpl.String(),
+		}, nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *exportAppOpts) Validate() error {
+	if o.format != "" && !slices.Contains(export.Formats, o.format) {
+		return fmt.Errorf("invalid format %q: must be one of %s", o.format, strings.Join(export.Formats, ", "))
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *exportAppOpts) Ask() error {
+	if o.name == "" {
+		// This command is required to be executed under a workspace. We don't prompt for it.
+		return errNoAppInWorkspace
+	}
+	if _, err := o.store.GetApplication(o.name); err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	if o.format == "" {
+		format, err := o.prompt.SelectOne(appExportFormatPrompt, appExportFormatHelpPrompt, export.Formats, prompt.WithFinalMessage("Format:"))
+		if err != nil {
+			return fmt.Errorf("select format: %w", err)
+		}
+		o.format = format
+	}
+	if o.outputDir == "" {
+		dir, err := o.prompt.Get(appExportOutputDirPrompt, "", prompt.RequireNonEmpty,
+			prompt.WithDefaultInput(fmt.Sprintf("%s-%s", o.name, o.format)), prompt.WithFinalMessage("Directory:"))
+		if err != nil {
+			return fmt.Errorf("get output directory: %w", err)
+		}
+		o.outputDir = dir
+	}
+	return nil
+}
+
+// Execute reads the deployed stacks of the application, renders every environment, workload and pipeline stack,
+// and writes them to the project.
+func (o *exportAppOpts) Execute() error {
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	project, err := o.newProject()
+	if err != nil {
+		return err
+	}
+	log.Infof("Reading the stacks of application %s.\n", color.HighlightUserInput(o.name))
+	appStacks, err := o.appStacks(app)
+	if err != nil {
+		return fmt.Errorf("get the stacks of application %s: %w", o.name, err)
+	}
+	for _, s := range appStacks {
+		if err := project.Add(s); err != nil {
+			return err
+		}
+	}
+	envs, err := o.localEnvironments()
+	if err != nil {
+		return err
+	}
+	for _, env := range envs {
+		log.Infof("Rendering the stack of environment %s.\n", color.HighlightUserInput(env.Name))
+		s, err := o.envStack(env)
+		if err != nil {
+			return err
+		}
+		if err := project.Add(s); err != nil {
+			return err
+		}
+	}
+	workloads, err := o.ws.ListWorkloads()
+	if err != nil {
+		return fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	for _, wkld := range workloads {
+		deployedTo, err := o.deployStore.ListEnvironmentsDeployedTo(o.name, wkld)
+		if err != nil {
+			return fmt.Errorf("list environments where %s is deployed: %w", wkld, err)
+		}
+		for _, env := range envs {
+			if !slices.Contains(deployedTo, env.Name) {
+				continue
+			}
+			log.Infof("Rendering the stack of %s for environment %s.\n", color.HighlightUserInput(wkld), color.HighlightUserInput(env.Name))
+			s, err := o.workloadStack(wkld, env)
+			if err != nil {
+				return err
+			}
+			if err := project.Add(s); err != nil {
+				return err
+			}
+		}
+	}
+	pipelines, err := o.ws.ListPipelines()
+	if err != nil {
+		return fmt.Errorf("list pipelines in the workspace: %w", err)
+	}
+	for _, pipeline := range pipelines {
+		log.Infof("Rendering the stack of pipeline %s.\n", color.HighlightUserInput(pipeline.Name))
+		s, err := o.pipelineStack(app, pipeline)
+		if err != nil {
+			return err
+		}
+		if err := project.Add(s); err != nil {
+			return err
+		}
+	}
+	if err := project.Write(); err != nil {
+		return fmt.Errorf("write project: %w", err)
+	}
+	log.Successf("Exported application %s to %s.\n", color.HighlightUserInput(o.name), color.HighlightResource(o.outputDir))
+	return nil
+}
+
+// RecommendActions prints follow-up actions after the application is exported.
+func (o *exportAppOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Follow the instructions in %s to deploy the stacks without Copilot.",
+			color.HighlightResource(fmt.Sprintf("%s/README.md", o.outputDir))),
+	})
+	return nil
+}
+
+// localEnvironments returns the environments of the application that have a manifest in the workspace,
+// in the order they were created.
+func (o *exportAppOpts) localEnvironments() ([]*config.Environment, error) {
+	envs, err := o.store.ListEnvironments(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.name, err)
+	}
+	local, err := o.ws.ListEnvironments()
+	if err != nil {
+		return nil, fmt.Errorf("list environments in the workspace: %w", err)
+	}
+	var out []*config.Environment
+	for _, env := range envs {
+		if !slices.Contains(local, env.Name) {
+			log.Warningf("Skipping environment %s because its manifest is not in the workspace.\n", env.Name)
+			continue
+		}
+		out = append(out, env)
+	}
+	return out, nil
+}
+
+func newClosableStringBuilder() *closableStringBuilder {
+	return &closableStringBuilder{
+		Builder: new(strings.Builder),
+	}
+}
+
+// buildAppExportCmd builds the command to export the stacks of an application to a standalone project.
+func buildAppExportCmd() *cobra.Command {
+	vars := exportAppVars{}
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the AWS CloudFormation stacks of an application to a standalone project.",
+		Long: `Exports the AWS CloudFormation stacks of an application and of every environment, workload, addon and pipeline
+to a standalone CloudFormation or CDK project that deploys them without Copilot.`,
+		Example: `
+  Export the "my-app" application to a CloudFormation project under "./infrastructure".
+  /code $ copilot app export -n my-app --format cloudformation --output-dir ./infrastructure
+  Export the application of the workspace to a CDK project.
+  /code $ copilot app export --format cdk --output-dir ./cdk`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newExportAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.format, exportFormatFlag, "", exportFormatFlagDescription)
+	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", exportOutputDirFlagDescription)
+	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", imageTagFlagDescription)
+	return cmd
+}
@@ -0,0 +1,245 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/export"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inFormat string
+
+		wantedErr error
+	}{
+		"no format": {},
+		"valid format": {
+			inFormat: "cdk",
+		},
+		"invalid format": {
+			inFormat:  "terraform",
+			wantedErr: errors.New(`invalid format "terraform": must be one of cloudformation, cdk`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &exportAppOpts{
+				exportAppVars: exportAppVars{
+					format: tc.inFormat,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExportAppOpts_Ask(t *testing.T) {
+	testErr := errors.New("some error")
+	testCases := map[string]struct {
+		inVars     exportAppVars
+		setupMocks func(store *mocks.Mockstore, prompt *mocks.Mockprompter)
+
+		wantedVars exportAppVars
+		wantedErr  error
+	}{
+		"returns an error outside of a workspace": {
+			setupMocks: func(store *mocks.Mockstore, prompt *mocks.Mockprompter) {},
+			wantedErr:  errNoAppInWorkspace,
+		},
+		"returns an error if the application does not exist": {
+			inVars: exportAppVars{name: "phonetool"},
+			setupMocks: func(store *mocks.Mockstore, prompt *mocks.Mockprompter) {
+				store.EXPECT().GetApplication("phonetool").Return(nil, testErr)
+			},
+			wantedErr: fmt.Errorf("get application phonetool: %w", testErr),
+		},
+		"prompts for the format and directory": {
+			inVars: exportAppVars{name: "phonetool"},
+			setupMocks: func(store *mocks.Mockstore, prompt *mocks.Mockprompter) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				prompt.EXPECT().SelectOne(appExportFormatPrompt, gomock.Any(), export.Formats, gomock.Any()).Return("cdk", nil)
+				prompt.EXPECT().Get(appExportOutputDirPrompt, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("./infrastructure", nil)
+			},
+			wantedVars: exportAppVars{name: "phonetool", format: "cdk", outputDir: "./infrastructure"},
+		},
+		"does not prompt when flags are set": {
+			inVars: exportAppVars{name: "phonetool", format: "cloudformation", outputDir: "./infrastructure"},
+			setupMocks: func(store *mocks.Mockstore, prompt *mocks.Mockprompter) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			wantedVars: exportAppVars{name: "phonetool", format: "cloudformation", outputDir: "./infrastructure"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockPrompter := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockStore, mockPrompter)
+			opts := &exportAppOpts{
+				exportAppVars: tc.inVars,
+				store:         mockStore,
+				prompt:        mockPrompter,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedVars, opts.exportAppVars)
+			}
+		})
+	}
+}
+
+type exportAppMocks struct {
+	store       *mocks.Mockstore
+	ws          *mocks.MockwsExportReader
+	deployStore *mocks.MockdeployedEnvironmentLister
+	project     *mocks.MockexportProject
+}
+
+func TestExportAppOpts_Execute(t *testing.T) {
+	testErr := errors.New("some error")
+	app := &config.Application{Name: "phonetool", AccountID: "123456789012"}
+	test := &config.Environment{App: "phonetool", Name: "test", Region: "us-west-2"}
+	prod := &config.Environment{App: "phonetool", Name: "prod", Region: "us-east-1"}
+	testCases := map[string]struct {
+		setupMocks func(m exportAppMocks)
+		failOn     string
+
+		wantedStacks []string
+		wantedErr    error
+	}{
+		"exports environments, deployed workloads and pipelines in order": {
+			setupMocks: func(m exportAppMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(app, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{test, prod}, nil)
+				m.ws.EXPECT().ListEnvironments().Return([]string{"test", "prod"}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api", "worker"}, nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "api").Return([]string{"prod", "test"}, nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "worker").Return([]string{"test"}, nil)
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "release"}}, nil)
+				m.project.EXPECT().Add(gomock.Any()).Return(nil).Times(7)
+				m.project.EXPECT().Write().Return(nil)
+			},
+			wantedStacks: []string{"app/phonetool", "env/test", "env/prod", "api/test", "api/prod", "worker/test", "pipeline/release"},
+		},
+		"skips environments without a local manifest": {
+			setupMocks: func(m exportAppMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(app, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{test, prod}, nil)
+				m.ws.EXPECT().ListEnvironments().Return([]string{"test"}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "api").Return([]string{"prod", "test"}, nil)
+				m.ws.EXPECT().ListPipelines().Return(nil, nil)
+				m.project.EXPECT().Add(gomock.Any()).Return(nil).Times(3)
+				m.project.EXPECT().Write().Return(nil)
+			},
+			wantedStacks: []string{"app/phonetool", "env/test", "api/test"},
+		},
+		"returns an error if a workload cannot be rendered": {
+			failOn: "api/test",
+			setupMocks: func(m exportAppMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(app, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{test}, nil)
+				m.ws.EXPECT().ListEnvironments().Return([]string{"test"}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "api").Return([]string{"test"}, nil)
+				m.project.EXPECT().Add(gomock.Any()).Return(nil).Times(2)
+			},
+			wantedStacks: []string{"app/phonetool", "env/test", "api/test"},
+			wantedErr:    testErr,
+		},
+		"returns an error if the stacks of the application cannot be read": {
+			failOn: "app/phonetool",
+			setupMocks: func(m exportAppMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(app, nil)
+			},
+			wantedStacks: []string{"app/phonetool"},
+			wantedErr:    testErr,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := exportAppMocks{
+				store:       mocks.NewMockstore(ctrl),
+				ws:          mocks.NewMockwsExportReader(ctrl),
+				deployStore: mocks.NewMockdeployedEnvironmentLister(ctrl),
+				project:     mocks.NewMockexportProject(ctrl),
+			}
+			tc.setupMocks(m)
+			var rendered []string
+			render := func(id string) (*export.Stack, error) {
+				rendered = append(rendered, id)
+				if id == tc.failOn {
+					return nil, testErr
+				}
+				return &export.Stack{Name: id}, nil
+			}
+			opts := &exportAppOpts{
+				exportAppVars: exportAppVars{
+					name:      "phonetool",
+					format:    "cloudformation",
+					outputDir: "./infrastructure",
+				},
+				store:       m.store,
+				ws:          m.ws,
+				deployStore: m.deployStore,
+				newProject: func() (exportProject, error) {
+					return m.project, nil
+				},
+				appStacks: func(app *config.Application) ([]*export.Stack, error) {
+					s, err := render("app/" + app.Name)
+					if err != nil {
+						return nil, err
+					}
+					return []*export.Stack{s}, nil
+				},
+				envStack: func(env *config.Environment) (*export.Stack, error) {
+					return render("env/" + env.Name)
+				},
+				workloadStack: func(name string, env *config.Environment) (*export.Stack, error) {
+					return render(name + "/" + env.Name)
+				},
+				pipelineStack: func(app *config.Application, pipeline workspace.PipelineManifest) (*export.Stack, error) {
+					return render("pipeline/" + pipeline.Name)
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.ErrorIs(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedStacks, rendered)
+		})
+	}
+}
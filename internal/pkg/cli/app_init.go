@@ -13,9 +13,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/spf13/afero"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
-	"github.com/aws/aws-sdk-go/service/ssm"
 
 	"github.com/spf13/cobra"
 
@@ -77,7 +75,7 @@ func newInitAppOpts(vars initAppVars) (*initAppOpts, error) {
 	return &initAppOpts{
 		initAppVars:    vars,
 		identity:       identity,
-		store:          config.NewSSMStore(identity, ssm.New(sess), aws.StringValue(sess.Config.Region)),
+		store:          config.NewStore(sess),
 		route53:        route53.New(sess),
 		cfn:            cloudformation.New(sess, cloudformation.WithProgressTracker(os.Stderr)),
 		prompt:         prompt.New(),
@@ -88,7 +86,7 @@ func newInitAppOpts(vars initAppVars) (*initAppOpts, error) {
 			return sessions.AreCredsFromEnvVars(sess)
 		},
 		existingWorkspace: func() (wsAppManager, error) {
-			return workspace.Use(fs)
+			return workspace.UseApp(vars.name, fs)
 		},
 		newWorkspace: func(appName string) (wsAppManager, error) {
 			return workspace.Create(appName, fs)
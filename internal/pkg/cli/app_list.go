@@ -8,10 +8,6 @@ import (
 	"io"
 	"os"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
-
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -53,7 +49,7 @@ func buildAppListCommand() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("default session: %v", err)
 			}
-			opts.store = config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
+			opts.store = config.NewStore(sess)
 			return opts.Execute()
 		}),
 	}
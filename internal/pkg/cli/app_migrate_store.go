@@ -0,0 +1,214 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/spf13/cobra"
+)
+
+const (
+	migrateStoreBackendPrompt     = "Which backend should hold the configuration of your applications?"
+	migrateStoreBackendHelpPrompt = `SSM Parameter Store is the default backend.
+DynamoDB avoids SSM throttling in accounts with many workloads.
+A local directory holds a copy of the configuration for offline development and tests.`
+	migrateStoreTablePrompt     = "What is the name of the DynamoDB table?"
+	migrateStoreTableHelpPrompt = `The table must already exist, with a string partition key "path" and a string sort key "name".`
+	migrateStoreDirPrompt       = "Which local directory should hold the configuration?"
+
+	fmtMigrateStoreStart    = "Copying the configuration of your applications to %s."
+	fmtMigrateStoreFailed   = "Failed to copy the configuration of your applications to %s.\n"
+	fmtMigrateStoreComplete = "Copied the configuration of your applications to %s.\n"
+)
+
+type migrateStoreVars struct {
+	backend string
+	table   string
+	dir     string
+}
+
+type migrateStoreOpts struct {
+	migrateStoreVars
+
+	source  configStoreCopier
+	prompt  prompter
+	spinner progress
+
+	currentBackend func() (*config.BackendConfig, error)
+	newBackend     func(cfg *config.BackendConfig) (config.Backend, error)
+	setBackend     func(cfg *config.BackendConfig) error
+}
+
+func newMigrateStoreOpts(vars migrateStoreVars) (*migrateStoreOpts, error) {
+	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("app migrate-store")).Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	ssmClient := ssm.New(sess)
+	return &migrateStoreOpts{
+		migrateStoreVars: vars,
+		source:           config.NewStore(sess),
+		prompt:           prompt.New(),
+		spinner:          termprogress.NewSpinner(log.DiagnosticWriter),
+		currentBackend: func() (*config.BackendConfig, error) {
+			if dir := os.Getenv(config.EnvFileBackendDir); dir != "" {
+				return &config.BackendConfig{
+					Type: config.BackendTypeFile,
+					Dir:  dir,
+				}, nil
+			}
+			return config.GetBackendConfig(ssmClient)
+		},
+		newBackend: func(cfg *config.BackendConfig) (config.Backend, error) {
+			return config.NewBackend(sess, cfg)
+		},
+		setBackend: func(cfg *config.BackendConfig) error {
+			return config.SetBackendConfig(ssmClient, cfg)
+		},
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *migrateStoreOpts) Validate() error {
+	if o.backend == "" {
+		return nil
+	}
+	if !slices.Contains(config.BackendTypes, o.backend) {
+		return fmt.Errorf("invalid backend %q: must be one of %s", o.backend, strings.Join(config.BackendTypes, ", "))
+	}
+	if o.table != "" && o.backend != config.BackendTypeDynamoDB {
+		return fmt.Errorf("--%s can only be used with the %q backend", storeTableFlag, config.BackendTypeDynamoDB)
+	}
+	if o.dir != "" && o.backend != config.BackendTypeFile {
+		return fmt.Errorf("--%s can only be used with the %q backend", storeDirFlag, config.BackendTypeFile)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *migrateStoreOpts) Ask() error {
+	if o.backend == "" {
+		backend, err := o.prompt.SelectOne(migrateStoreBackendPrompt, migrateStoreBackendHelpPrompt, config.BackendTypes, prompt.WithFinalMessage("Backend:"))
+		if err != nil {
+			return fmt.Errorf("select backend: %w", err)
+		}
+		o.backend = backend
+	}
+	switch {
+	case o.backend == config.BackendTypeDynamoDB && o.table == "":
+		table, err := o.prompt.Get(migrateStoreTablePrompt, migrateStoreTableHelpPrompt, prompt.RequireNonEmpty, prompt.WithFinalMessage("Table:"))
+		if err != nil {
+			return fmt.Errorf("get table name: %w", err)
+		}
+		o.table = table
+	case o.backend == config.BackendTypeFile && o.dir == "":
+		dir, err := o.prompt.Get(migrateStoreDirPrompt, "", prompt.RequireNonEmpty, prompt.WithFinalMessage("Directory:"))
+		if err != nil {
+			return fmt.Errorf("get directory: %w", err)
+		}
+		o.dir = dir
+	}
+	return nil
+}
+
+// Execute copies the configuration of all applications to the backend, and points the account to it.
+// Commands that started before the account points to the backend keep writing to the previous backend,
+// so the configuration that changed during the copy is copied again afterwards.
+func (o *migrateStoreOpts) Execute() error {
+	target := &config.BackendConfig{
+		Type:  o.backend,
+		Table: o.table,
+		Dir:   o.dir,
+	}
+	current, err := o.currentBackend()
+	if err != nil {
+		return err
+	}
+	if *current == *target {
+		log.Infof("The configuration of your applications is already stored in %s.\n", describeBackend(target))
+		return nil
+	}
+	dst, err := o.newBackend(target)
+	if err != nil {
+		return err
+	}
+	o.spinner.Start(fmt.Sprintf(fmtMigrateStoreStart, describeBackend(target)))
+	copied, err := o.source.CopyTo(dst, nil)
+	if err != nil {
+		o.spinner.Stop(log.Serrorf(fmtMigrateStoreFailed, describeBackend(target)))
+		return fmt.Errorf("copy configuration from %s: %w", describeBackend(current), err)
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtMigrateStoreComplete, describeBackend(target)))
+	if target.Type == config.BackendTypeFile {
+		log.Infof("Set %s to use the copy instead of your AWS account.\n",
+			color.HighlightCode(fmt.Sprintf("%s=%s", config.EnvFileBackendDir, target.Dir)))
+		return nil
+	}
+	if err := o.setBackend(target); err != nil {
+		return err
+	}
+	// The source keeps reading the previous backend, which it resolved before the account pointed to the new one.
+	if _, err := o.source.CopyTo(dst, copied); err != nil {
+		return fmt.Errorf("copy configuration that changed in %s during the migration: %w", describeBackend(current), err)
+	}
+	log.Successf("Copilot now reads and writes the configuration of your applications in %s.\n", describeBackend(target))
+	log.Infof("The configuration in %s was left in place and is no longer used.\n", describeBackend(current))
+	if target.Type == config.BackendTypeDynamoDB {
+		log.Infof(`Run %s and %s to let your pipelines and environment manager roles read the table.
+`, color.HighlightCode("copilot pipeline deploy"), color.HighlightCode("copilot env deploy --force"))
+	}
+	return nil
+}
+
+func describeBackend(cfg *config.BackendConfig) string {
+	switch cfg.Type {
+	case config.BackendTypeDynamoDB:
+		return fmt.Sprintf("DynamoDB table %s", color.HighlightResource(cfg.Table))
+	case config.BackendTypeFile:
+		return fmt.Sprintf("local directory %s", color.HighlightResource(cfg.Dir))
+	}
+	return "SSM Parameter Store"
+}
+
+// buildAppMigrateStoreCmd builds the command to move the configuration of the applications to another backend.
+func buildAppMigrateStoreCmd() *cobra.Command {
+	vars := migrateStoreVars{}
+	cmd := &cobra.Command{
+		Use:   "migrate-store",
+		Short: "Moves the configuration of your applications to another backend.",
+		Long: `Moves the configuration of your applications, environments, workloads and routes to another backend.
+Every application in the account and region is copied, and Copilot uses the new backend from then on.
+Configuration that changes while it is copied is copied again once the account points to the new backend,
+but avoid running other Copilot commands against the account during the migration.`,
+		Example: `
+  Move the configuration to the DynamoDB table "copilot-config".
+  /code $ copilot app migrate-store --to dynamodb --table copilot-config
+  Move the configuration back to SSM Parameter Store.
+  /code $ copilot app migrate-store --to ssm
+  Copy the configuration to a local directory for offline development.
+  /code $ copilot app migrate-store --to file --dir ./copilot-config`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newMigrateStoreOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVar(&vars.backend, storeBackendFlag, "", storeBackendFlagDescription)
+	cmd.Flags().StringVar(&vars.table, storeTableFlag, "", storeTableFlagDescription)
+	cmd.Flags().StringVar(&vars.dir, storeDirFlag, "", storeDirFlagDescription)
+	return cmd
+}
@@ -0,0 +1,214 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStoreOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inVars migrateStoreVars
+
+		wantedErr error
+	}{
+		"no flags": {},
+		"invalid backend": {
+			inVars:    migrateStoreVars{backend: "s3"},
+			wantedErr: errors.New(`invalid backend "s3": must be one of ssm, dynamodb, file`),
+		},
+		"table without the dynamodb backend": {
+			inVars:    migrateStoreVars{backend: "ssm", table: "copilot-config"},
+			wantedErr: errors.New(`--table can only be used with the "dynamodb" backend`),
+		},
+		"dir without the file backend": {
+			inVars:    migrateStoreVars{backend: "dynamodb", dir: "./config"},
+			wantedErr: errors.New(`--dir can only be used with the "file" backend`),
+		},
+		"valid dynamodb backend": {
+			inVars: migrateStoreVars{backend: "dynamodb", table: "copilot-config"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &migrateStoreOpts{
+				migrateStoreVars: tc.inVars,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMigrateStoreOpts_Ask(t *testing.T) {
+	testErr := errors.New("some error")
+	testCases := map[string]struct {
+		inVars     migrateStoreVars
+		setupMocks func(prompt *mocks.Mockprompter)
+
+		wantedVars migrateStoreVars
+		wantedErr  error
+	}{
+		"prompts for the backend and table": {
+			setupMocks: func(prompt *mocks.Mockprompter) {
+				prompt.EXPECT().SelectOne(migrateStoreBackendPrompt, gomock.Any(), config.BackendTypes, gomock.Any()).Return("dynamodb", nil)
+				prompt.EXPECT().Get(migrateStoreTablePrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return("copilot-config", nil)
+			},
+			wantedVars: migrateStoreVars{backend: "dynamodb", table: "copilot-config"},
+		},
+		"prompts for the directory": {
+			inVars: migrateStoreVars{backend: "file"},
+			setupMocks: func(prompt *mocks.Mockprompter) {
+				prompt.EXPECT().Get(migrateStoreDirPrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return("./config", nil)
+			},
+			wantedVars: migrateStoreVars{backend: "file", dir: "./config"},
+		},
+		"does not prompt when flags are set": {
+			inVars:     migrateStoreVars{backend: "ssm"},
+			setupMocks: func(prompt *mocks.Mockprompter) {},
+			wantedVars: migrateStoreVars{backend: "ssm"},
+		},
+		"returns an error if the backend cannot be selected": {
+			setupMocks: func(prompt *mocks.Mockprompter) {
+				prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", testErr)
+			},
+			wantedErr: fmt.Errorf("select backend: %w", testErr),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrompter := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockPrompter)
+			opts := &migrateStoreOpts{
+				migrateStoreVars: tc.inVars,
+				prompt:           mockPrompter,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedVars, opts.migrateStoreVars)
+			}
+		})
+	}
+}
+
+func TestMigrateStoreOpts_Execute(t *testing.T) {
+	testErr := errors.New("some error")
+	ssmBackend := &config.BackendConfig{Type: config.BackendTypeSSM}
+	mockCopied := map[string]string{
+		"/copilot/applications/phonetool": `{"name":"phonetool"}`,
+	}
+	testCases := map[string]struct {
+		inVars     migrateStoreVars
+		current    *config.BackendConfig
+		setupMocks func(source *mocks.MockconfigStoreCopier, spinner *mocks.Mockprogress)
+
+		wantedSet *config.BackendConfig
+		wantedErr error
+	}{
+		"does nothing if the account already uses the backend": {
+			inVars:     migrateStoreVars{backend: "ssm"},
+			current:    ssmBackend,
+			setupMocks: func(source *mocks.MockconfigStoreCopier, spinner *mocks.Mockprogress) {},
+		},
+		"does not point the account to the backend if the copy fails": {
+			inVars:  migrateStoreVars{backend: "dynamodb", table: "copilot-config"},
+			current: ssmBackend,
+			setupMocks: func(source *mocks.MockconfigStoreCopier, spinner *mocks.Mockprogress) {
+				spinner.EXPECT().Start(gomock.Any())
+				source.EXPECT().CopyTo(gomock.Any(), nil).Return(nil, testErr)
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: fmt.Errorf("copy configuration from SSM Parameter Store: %w", testErr),
+		},
+		"points the account to the dynamodb backend and copies the configuration that changed in the meantime": {
+			inVars:  migrateStoreVars{backend: "dynamodb", table: "copilot-config"},
+			current: ssmBackend,
+			setupMocks: func(source *mocks.MockconfigStoreCopier, spinner *mocks.Mockprogress) {
+				spinner.EXPECT().Start(gomock.Any())
+				source.EXPECT().CopyTo(gomock.Any(), nil).Return(mockCopied, nil)
+				spinner.EXPECT().Stop(gomock.Any())
+				source.EXPECT().CopyTo(gomock.Any(), mockCopied).Return(mockCopied, nil)
+			},
+			wantedSet: &config.BackendConfig{Type: "dynamodb", Table: "copilot-config"},
+		},
+		"wraps the error if the configuration that changed in the meantime cannot be copied": {
+			inVars:  migrateStoreVars{backend: "dynamodb", table: "copilot-config"},
+			current: ssmBackend,
+			setupMocks: func(source *mocks.MockconfigStoreCopier, spinner *mocks.Mockprogress) {
+				spinner.EXPECT().Start(gomock.Any())
+				source.EXPECT().CopyTo(gomock.Any(), nil).Return(mockCopied, nil)
+				spinner.EXPECT().Stop(gomock.Any())
+				source.EXPECT().CopyTo(gomock.Any(), mockCopied).Return(nil, testErr)
+			},
+			wantedSet: &config.BackendConfig{Type: "dynamodb", Table: "copilot-config"},
+			wantedErr: fmt.Errorf("copy configuration that changed in SSM Parameter Store during the migration: %w", testErr),
+		},
+		"does not point the account to a local directory": {
+			inVars:  migrateStoreVars{backend: "file", dir: "./config"},
+			current: ssmBackend,
+			setupMocks: func(source *mocks.MockconfigStoreCopier, spinner *mocks.Mockprogress) {
+				spinner.EXPECT().Start(gomock.Any())
+				source.EXPECT().CopyTo(gomock.Any(), nil).Return(mockCopied, nil)
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSource := mocks.NewMockconfigStoreCopier(ctrl)
+			mockSpinner := mocks.NewMockprogress(ctrl)
+			tc.setupMocks(mockSource, mockSpinner)
+			var gotSet *config.BackendConfig
+			opts := &migrateStoreOpts{
+				migrateStoreVars: tc.inVars,
+				source:           mockSource,
+				spinner:          mockSpinner,
+				currentBackend: func() (*config.BackendConfig, error) {
+					return tc.current, nil
+				},
+				newBackend: func(cfg *config.BackendConfig) (config.Backend, error) {
+					return nil, nil
+				},
+				setBackend: func(cfg *config.BackendConfig) error {
+					gotSet = cfg
+					return nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedSet, gotSet)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+)
+
+// buildAppRouteCmd builds the command for managing the multi-region routes of an application.
+func buildAppRouteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "route",
+		Short: "Commands for routes.",
+		Long: `Commands for routes.
+Routes send traffic for one hostname to a service deployed in several environments
+using Route 53 latency, weighted, or failover routing.`,
+	}
+
+	cmd.AddCommand(buildAppRouteDeployCmd())
+	cmd.AddCommand(buildAppRouteDeleteCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
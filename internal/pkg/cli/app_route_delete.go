@@ -0,0 +1,138 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fmtRouteDeleteConfirmPrompt = "Are you sure you want to stop routing %s to its environments?"
+	routeDeleteConfirmHelp      = "This will delete the Route 53 records of the route."
+
+	fmtRouteDeleteStart    = "Deleting route %s from application %s."
+	fmtRouteDeleteFailed   = "Failed to delete route %s from application %s: %v.\n"
+	fmtRouteDeleteComplete = "Deleted route %s from application %s.\n"
+)
+
+type appRouteDeleteVars struct {
+	appName          string
+	name             string
+	skipConfirmation bool
+}
+
+type appRouteDeleteOpts struct {
+	appRouteDeleteVars
+
+	store    store
+	deleter  routeDeployer
+	spinner  progress
+	prompt   prompter
+	sel      appSelector
+	hostname string // cached after the route is read from the store.
+}
+
+func newAppRouteDeleteOpts(vars appRouteDeleteVars) (*appRouteDeleteOpts, error) {
+	defaultSess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("app route delete")).Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	configStore := config.NewStore(defaultSess)
+	prompter := prompt.New()
+	return &appRouteDeleteOpts{
+		appRouteDeleteVars: vars,
+		store:              configStore,
+		deleter:            cloudformation.New(defaultSess, cloudformation.WithProgressTracker(os.Stderr)),
+		spinner:            termprogress.NewSpinner(log.DiagnosticWriter),
+		prompt:             prompter,
+		sel:                selector.NewAppEnvSelector(prompter, configStore),
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *appRouteDeleteOpts) Validate() error {
+	if o.name == "" {
+		return fmt.Errorf("--%s is required", nameFlag)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *appRouteDeleteOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(appRouteAppNamePrompt, appRouteAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	route, err := o.store.GetRoute(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get route %s: %w", o.name, err)
+	}
+	o.hostname = route.Hostname
+	if o.skipConfirmation {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(
+		fmt.Sprintf(fmtRouteDeleteConfirmPrompt, color.HighlightUserInput(o.hostname)),
+		routeDeleteConfirmHelp,
+		prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("confirm route deletion: %w", err)
+	}
+	if !confirmed {
+		return errOperationCancelled
+	}
+	return nil
+}
+
+// Execute deletes the route's stack and removes the route from the config store.
+func (o *appRouteDeleteOpts) Execute() error {
+	o.spinner.Start(fmt.Sprintf(fmtRouteDeleteStart, o.name, o.appName))
+	if err := o.deleter.DeleteRoute(o.appName, o.name); err != nil {
+		o.spinner.Stop(log.Serrorf(fmtRouteDeleteFailed, o.name, o.appName, err))
+		return fmt.Errorf("delete route %s: %w", o.name, err)
+	}
+	if err := o.store.DeleteRoute(o.appName, o.name); err != nil {
+		o.spinner.Stop(log.Serrorf(fmtRouteDeleteFailed, o.name, o.appName, err))
+		return fmt.Errorf("delete route %s configuration: %w", o.name, err)
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtRouteDeleteComplete, o.name, o.appName))
+	return nil
+}
+
+// buildAppRouteDeleteCmd builds the command to delete a route of an application.
+func buildAppRouteDeleteCmd() *cobra.Command {
+	vars := appRouteDeleteVars{}
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Deletes a route from an application.",
+		Example: `
+  Delete the route "api" without confirmation.
+  /code $ copilot app route delete -n api --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAppRouteDeleteOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", routeFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,126 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppRouteDeleteOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		skipConfirmation bool
+		setupMocks       func(store *mocks.Mockstore, prompt *mocks.Mockprompter)
+
+		wantedErr error
+	}{
+		"returns an error if the route does not exist": {
+			setupMocks: func(store *mocks.Mockstore, prompt *mocks.Mockprompter) {
+				store.EXPECT().GetRoute("phonetool", "api").Return(nil, &config.ErrNoSuchRoute{App: "phonetool", Name: "api"})
+			},
+			wantedErr: fmt.Errorf("get route api: %w", &config.ErrNoSuchRoute{App: "phonetool", Name: "api"}),
+		},
+		"skips confirmation": {
+			skipConfirmation: true,
+			setupMocks: func(store *mocks.Mockstore, prompt *mocks.Mockprompter) {
+				store.EXPECT().GetRoute("phonetool", "api").Return(&config.Route{Hostname: "api.example.com"}, nil)
+			},
+		},
+		"returns an error if the user cancels": {
+			setupMocks: func(store *mocks.Mockstore, prompt *mocks.Mockprompter) {
+				store.EXPECT().GetRoute("phonetool", "api").Return(&config.Route{Hostname: "api.example.com"}, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), routeDeleteConfirmHelp, gomock.Any()).Return(false, nil)
+			},
+			wantedErr: errOperationCancelled,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockPrompter := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockStore, mockPrompter)
+			opts := &appRouteDeleteOpts{
+				appRouteDeleteVars: appRouteDeleteVars{
+					appName:          "phonetool",
+					name:             "api",
+					skipConfirmation: tc.skipConfirmation,
+				},
+				store:  mockStore,
+				prompt: mockPrompter,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func TestAppRouteDeleteOpts_Execute(t *testing.T) {
+	testErr := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(store *mocks.Mockstore, deleter *mocks.MockrouteDeployer, spinner *mocks.Mockprogress)
+
+		wantedErr error
+	}{
+		"keeps the configuration if the stack cannot be deleted": {
+			setupMocks: func(store *mocks.Mockstore, deleter *mocks.MockrouteDeployer, spinner *mocks.Mockprogress) {
+				spinner.EXPECT().Start(fmt.Sprintf(fmtRouteDeleteStart, "api", "phonetool"))
+				deleter.EXPECT().DeleteRoute("phonetool", "api").Return(testErr)
+				spinner.EXPECT().Stop(log.Serrorf(fmtRouteDeleteFailed, "api", "phonetool", testErr))
+			},
+			wantedErr: fmt.Errorf("delete route api: %w", testErr),
+		},
+		"deletes the stack and the configuration": {
+			setupMocks: func(store *mocks.Mockstore, deleter *mocks.MockrouteDeployer, spinner *mocks.Mockprogress) {
+				gomock.InOrder(
+					spinner.EXPECT().Start(fmt.Sprintf(fmtRouteDeleteStart, "api", "phonetool")),
+					deleter.EXPECT().DeleteRoute("phonetool", "api").Return(nil),
+					store.EXPECT().DeleteRoute("phonetool", "api").Return(nil),
+					spinner.EXPECT().Stop(log.Ssuccessf(fmtRouteDeleteComplete, "api", "phonetool")),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockDeleter := mocks.NewMockrouteDeployer(ctrl)
+			mockSpinner := mocks.NewMockprogress(ctrl)
+			tc.setupMocks(mockStore, mockDeleter, mockSpinner)
+			opts := &appRouteDeleteOpts{
+				appRouteDeleteVars: appRouteDeleteVars{
+					appName: "phonetool",
+					name:    "api",
+				},
+				store:   mockStore,
+				deleter: mockDeleter,
+				spinner: mockSpinner,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
@@ -0,0 +1,371 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appRouteAppNamePrompt     = "Which application does the route belong to?"
+	appRouteAppNameHelpPrompt = "An application groups the environments that serve the route's hostname."
+
+	envOutputPublicLoadBalancerDNSName    = "PublicLoadBalancerDNSName"
+	envOutputPublicLoadBalancerHostedZone = "PublicLoadBalancerHostedZone"
+
+	fmtRouteDeployStart    = "Deploying route %s for %s.\n"
+	fmtRouteDeployFailed   = "Failed to deploy route %s.\n"
+	fmtRouteDeployComplete = "Deployed route %s: %s is served by %s.\n"
+)
+
+type appRouteDeployVars struct {
+	appName       string
+	name          string
+	svcName       string
+	envNames      []string
+	hostname      string
+	routingPolicy string
+	weights       map[string]string
+	primaryEnv    string
+}
+
+type appRouteDeployOpts struct {
+	appRouteDeployVars
+
+	store       store
+	deployStore deployedEnvironmentLister
+	deployer    routeDeployer
+	sel         appSelector
+
+	newEnvDescriber func(appName, envName string) (routeEnvDescriber, error)
+}
+
+func newAppRouteDeployOpts(vars appRouteDeployVars) (*appRouteDeployOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("app route deploy"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	configStore := config.NewStore(defaultSess)
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &appRouteDeployOpts{
+		appRouteDeployVars: vars,
+		store:              configStore,
+		deployStore:        deployStore,
+		deployer:           cloudformation.New(defaultSess, cloudformation.WithProgressTracker(os.Stderr)),
+		sel:                selector.NewAppEnvSelector(prompt.New(), configStore),
+		newEnvDescriber: func(appName, envName string) (routeEnvDescriber, error) {
+			d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+				App:         appName,
+				Env:         envName,
+				ConfigStore: configStore,
+				DeployStore: deployStore,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("initiate environment describer: %w", err)
+			}
+			return d, nil
+		},
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *appRouteDeployOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	for _, required := range []struct {
+		flag  string
+		isSet bool
+	}{
+		{flag: nameFlag, isSet: o.name != ""},
+		{flag: routeSvcFlag, isSet: o.svcName != ""},
+		{flag: envsFlag, isSet: len(o.envNames) != 0},
+		{flag: routeHostnameFlag, isSet: o.hostname != ""},
+		{flag: routeRoutingPolicyFlag, isSet: o.routingPolicy != ""},
+	} {
+		if !required.isSet {
+			return fmt.Errorf("--%s is required", required.flag)
+		}
+	}
+	if err := validateRouteName(o.name); err != nil {
+		return err
+	}
+	return o.validateRoutingPolicy()
+}
+
+// Ask prompts for and validates any required flags.
+func (o *appRouteDeployOpts) Ask() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(appRouteAppNamePrompt, appRouteAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// Execute stores the route and deploys the Route 53 records that send
+// traffic for the hostname to the public load balancer of each environment.
+func (o *appRouteDeployOpts) Execute() error {
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	hostedZoneName, err := routeHostedZoneName(app, o.hostname)
+	if err != nil {
+		return err
+	}
+	if err := o.validateService(); err != nil {
+		return err
+	}
+	route, err := o.route()
+	if err != nil {
+		return err
+	}
+	targets, err := o.targets(route)
+	if err != nil {
+		return err
+	}
+	if err := o.store.CreateRoute(route); err != nil {
+		return fmt.Errorf("save route %s: %w", o.name, err)
+	}
+
+	log.Infof(fmtRouteDeployStart, color.HighlightUserInput(o.name), color.HighlightUserInput(o.hostname))
+	if err := o.deployer.DeployRoute(&deploy.CreateRouteInput{
+		App:            o.appName,
+		Name:           o.name,
+		Hostname:       o.hostname,
+		HostedZoneName: hostedZoneName,
+		RoutingPolicy:  o.routingPolicy,
+		Targets:        targets,
+		AdditionalTags: app.Tags,
+	}); err != nil {
+		log.Errorf(fmtRouteDeployFailed, color.HighlightUserInput(o.name))
+		return fmt.Errorf("deploy route %s: %w", o.name, err)
+	}
+	log.Successf(fmtRouteDeployComplete, color.HighlightUserInput(o.name), color.HighlightResource(o.hostname),
+		english.WordSeries(o.envNames, "and"))
+	return nil
+}
+
+func (o *appRouteDeployOpts) validateRoutingPolicy() error {
+	switch o.routingPolicy {
+	case config.RoutingPolicyLatency, config.RoutingPolicyWeighted:
+		if o.primaryEnv != "" {
+			return fmt.Errorf("--%s can only be specified with --%s %s", routePrimaryEnvFlag, routeRoutingPolicyFlag, config.RoutingPolicyFailover)
+		}
+	case config.RoutingPolicyFailover:
+		if len(o.envNames) != 2 {
+			return fmt.Errorf("%s routing requires exactly two environments", config.RoutingPolicyFailover)
+		}
+		if o.primaryEnv == "" {
+			return fmt.Errorf("--%s is required with --%s %s", routePrimaryEnvFlag, routeRoutingPolicyFlag, config.RoutingPolicyFailover)
+		}
+		if !slices.Contains(o.envNames, o.primaryEnv) {
+			return fmt.Errorf("primary environment %s must be one of the environments %s", o.primaryEnv, english.WordSeries(o.envNames, "and"))
+		}
+	default:
+		return fmt.Errorf("routing policy %s is invalid: must be one of %s", o.routingPolicy, english.WordSeries(config.RoutingPolicies, "or"))
+	}
+	if len(o.weights) != 0 && o.routingPolicy != config.RoutingPolicyWeighted {
+		return fmt.Errorf("--%s can only be specified with --%s %s", routeWeightsFlag, routeRoutingPolicyFlag, config.RoutingPolicyWeighted)
+	}
+	for env := range o.weights {
+		if !slices.Contains(o.envNames, env) {
+			return fmt.Errorf("weight for environment %s must be one of the environments %s", env, english.WordSeries(o.envNames, "and"))
+		}
+	}
+	return nil
+}
+
+func (o *appRouteDeployOpts) validateService() error {
+	svc, err := o.store.GetService(o.appName, o.svcName)
+	if err != nil {
+		return fmt.Errorf("get service %s: %w", o.svcName, err)
+	}
+	if svc.Type != manifestinfo.LoadBalancedWebServiceType {
+		return fmt.Errorf("service %s is a %s: routes only support services of type %s", o.svcName, svc.Type, manifestinfo.LoadBalancedWebServiceType)
+	}
+	for _, env := range o.envNames {
+		deployed, err := o.deployStore.IsServiceDeployed(o.appName, env, o.svcName)
+		if err != nil {
+			return fmt.Errorf("check if service %s is deployed in environment %s: %w", o.svcName, env, err)
+		}
+		if !deployed {
+			return fmt.Errorf("service %s is not deployed in environment %s", o.svcName, env)
+		}
+		if err := o.validateServiceAlias(env); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateServiceAlias returns an error if the hostname is not one of the service's aliases in the environment.
+// The environment's load balancer only has a certificate and listener rules for the aliases of its services,
+// so the hostname must be an alias for the load balancer to serve it.
+func (o *appRouteDeployOpts) validateServiceAlias(env string) error {
+	d, err := o.newEnvDescriber(o.appName, env)
+	if err != nil {
+		return err
+	}
+	params, err := d.Params()
+	if err != nil {
+		return fmt.Errorf("get parameters of environment %s: %w", env, err)
+	}
+	var aliases map[string][]string
+	if raw := params[stack.EnvParamAliasesKey]; raw != "" {
+		if err := json.Unmarshal([]byte(raw), &aliases); err != nil {
+			return fmt.Errorf("unmarshal aliases %q of environment %s: %w", raw, env, err)
+		}
+	}
+	for _, alias := range aliases[o.svcName] {
+		if strings.EqualFold(alias, o.hostname) {
+			return nil
+		}
+	}
+	return fmt.Errorf(`hostname %s is not an alias of service %s in environment %s: add it to "http.alias" in the service's manifest and run "copilot svc deploy --name %s --env %s"`,
+		o.hostname, o.svcName, env, o.svcName, env)
+}
+
+func (o *appRouteDeployOpts) route() (*config.Route, error) {
+	route := &config.Route{
+		App:           o.appName,
+		Name:          o.name,
+		Hostname:      o.hostname,
+		Service:       o.svcName,
+		RoutingPolicy: o.routingPolicy,
+	}
+	for _, env := range o.envNames {
+		target := &config.RouteTarget{
+			Env:     env,
+			Primary: env == o.primaryEnv,
+		}
+		if raw, ok := o.weights[env]; ok {
+			weight, err := strconv.Atoi(raw)
+			if err != nil || weight < 0 || weight > 255 {
+				return nil, fmt.Errorf("weight %q for environment %s must be an integer between 0 and 255", raw, env)
+			}
+			target.Weight = aws.Int(weight)
+		}
+		route.Targets = append(route.Targets, target)
+	}
+	return route, nil
+}
+
+func (o *appRouteDeployOpts) targets(route *config.Route) ([]deploy.RouteTargetInput, error) {
+	var targets []deploy.RouteTargetInput
+	for _, target := range route.Targets {
+		env, err := o.store.GetEnvironment(o.appName, target.Env)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s: %w", target.Env, err)
+		}
+		d, err := o.newEnvDescriber(o.appName, target.Env)
+		if err != nil {
+			return nil, err
+		}
+		outputs, err := d.Outputs()
+		if err != nil {
+			return nil, fmt.Errorf("get outputs of environment %s: %w", target.Env, err)
+		}
+		dnsName, zoneID := outputs[envOutputPublicLoadBalancerDNSName], outputs[envOutputPublicLoadBalancerHostedZone]
+		if dnsName == "" || zoneID == "" {
+			return nil, fmt.Errorf("environment %s does not have a public load balancer", target.Env)
+		}
+		targets = append(targets, deploy.RouteTargetInput{
+			Env:                target.Env,
+			Region:             env.Region,
+			LoadBalancerDNS:    dnsName,
+			LoadBalancerZoneID: zoneID,
+			Weight:             target.Weight,
+			Primary:            target.Primary,
+		})
+	}
+	return targets, nil
+}
+
+// routeHostedZoneName returns the name of the hosted zone that holds the records for the hostname.
+// Hostnames under the application subdomain use the hosted zone that Copilot created for the application.
+func routeHostedZoneName(app *config.Application, hostname string) (string, error) {
+	if app.Domain == "" {
+		return "", fmt.Errorf("application %s is not associated with a domain: routes require an application created with --%s", app.Name, domainNameFlag)
+	}
+	appSubdomain := fmt.Sprintf("%s.%s", app.Name, app.Domain)
+	switch {
+	case hostname == appSubdomain || strings.HasSuffix(hostname, "."+appSubdomain):
+		return appSubdomain + ".", nil
+	case hostname == app.Domain || strings.HasSuffix(hostname, "."+app.Domain):
+		return app.Domain + ".", nil
+	default:
+		return "", fmt.Errorf("hostname %s must be within the application's domain %s", hostname, app.Domain)
+	}
+}
+
+// buildAppRouteDeployCmd builds the command to deploy a route for an application.
+func buildAppRouteDeployCmd() *cobra.Command {
+	vars := appRouteDeployVars{}
+	cmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Routes a hostname to a service deployed in several environments.",
+		Long: `Routes a hostname to a service deployed in several environments.
+Copilot creates an alias record for each environment's load balancer that uses latency,
+weighted, or failover routing, and that evaluates the health of the load balancer's targets.`,
+		Example: `
+  Send users to the closest of the "us-prod" and "eu-prod" environments.
+  /code $ copilot app route deploy -n api --svc frontend --hostname api.example.com \
+  /code --environments us-prod,eu-prod --routing latency
+
+  Fail over to "eu-prod" when "us-prod" is unhealthy.
+  /code $ copilot app route deploy -n api --svc frontend --hostname api.example.com \
+  /code --environments us-prod,eu-prod --routing failover --primary us-prod
+
+  Send three quarters of the traffic to "us-prod".
+  /code $ copilot app route deploy -n api --svc frontend --hostname api.example.com \
+  /code --environments us-prod,eu-prod --routing weighted --weights us-prod=3,eu-prod=1`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAppRouteDeployOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", routeFlagDescription)
+	cmd.Flags().StringVar(&vars.svcName, routeSvcFlag, "", routeSvcFlagDescription)
+	cmd.Flags().StringSliceVarP(&vars.envNames, envsFlag, envsFlagShort, nil, routeEnvsFlagDescription)
+	cmd.Flags().StringVar(&vars.hostname, routeHostnameFlag, "", routeHostnameFlagDescription)
+	cmd.Flags().StringVar(&vars.routingPolicy, routeRoutingPolicyFlag, "", routeRoutingPolicyFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.weights, routeWeightsFlag, nil, routeWeightsFlagDescription)
+	cmd.Flags().StringVar(&vars.primaryEnv, routePrimaryEnvFlag, "", routePrimaryEnvFlagDescription)
+	return cmd
+}
@@ -0,0 +1,300 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type appRouteDeployMocks struct {
+	store         *mocks.Mockstore
+	deployStore   *mocks.MockdeployedEnvironmentLister
+	deployer      *mocks.MockrouteDeployer
+	envDescribers map[string]*mocks.MockrouteEnvDescriber
+}
+
+func TestAppRouteDeployOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inVars appRouteDeployVars
+
+		wantedErr string
+	}{
+		"missing hostname": {
+			inVars: appRouteDeployVars{
+				name:     "api",
+				svcName:  "frontend",
+				envNames: []string{"us", "eu"},
+			},
+			wantedErr: "--hostname is required",
+		},
+		"invalid routing policy": {
+			inVars: appRouteDeployVars{
+				name:          "api",
+				svcName:       "frontend",
+				envNames:      []string{"us", "eu"},
+				hostname:      "api.example.com",
+				routingPolicy: "geolocation",
+			},
+			wantedErr: "routing policy geolocation is invalid: must be one of latency, weighted or failover",
+		},
+		"failover without primary": {
+			inVars: appRouteDeployVars{
+				name:          "api",
+				svcName:       "frontend",
+				envNames:      []string{"us", "eu"},
+				hostname:      "api.example.com",
+				routingPolicy: "failover",
+			},
+			wantedErr: "--primary is required with --routing failover",
+		},
+		"failover with more than two environments": {
+			inVars: appRouteDeployVars{
+				name:          "api",
+				svcName:       "frontend",
+				envNames:      []string{"us", "eu", "ap"},
+				hostname:      "api.example.com",
+				routingPolicy: "failover",
+				primaryEnv:    "us",
+			},
+			wantedErr: "failover routing requires exactly two environments",
+		},
+		"primary is not a target environment": {
+			inVars: appRouteDeployVars{
+				name:          "api",
+				svcName:       "frontend",
+				envNames:      []string{"us", "eu"},
+				hostname:      "api.example.com",
+				routingPolicy: "failover",
+				primaryEnv:    "ap",
+			},
+			wantedErr: "primary environment ap must be one of the environments us and eu",
+		},
+		"weights without weighted routing": {
+			inVars: appRouteDeployVars{
+				name:          "api",
+				svcName:       "frontend",
+				envNames:      []string{"us", "eu"},
+				hostname:      "api.example.com",
+				routingPolicy: "latency",
+				weights:       map[string]string{"us": "3"},
+			},
+			wantedErr: "--weights can only be specified with --routing weighted",
+		},
+		"valid weighted route": {
+			inVars: appRouteDeployVars{
+				name:          "api",
+				svcName:       "frontend",
+				envNames:      []string{"us", "eu"},
+				hostname:      "api.example.com",
+				routingPolicy: "weighted",
+				weights:       map[string]string{"us": "3"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &appRouteDeployOpts{
+				appRouteDeployVars: tc.inVars,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAppRouteDeployOpts_Execute(t *testing.T) {
+	mockApp := &config.Application{
+		Name:   "phonetool",
+		Domain: "example.com",
+	}
+	mockLBWS := &config.Workload{
+		App:  "phonetool",
+		Name: "frontend",
+		Type: manifestinfo.LoadBalancedWebServiceType,
+	}
+	mockEnvAliases := func(m *mocks.MockrouteEnvDescriber, aliases string) {
+		m.EXPECT().Params().Return(map[string]string{
+			"Aliases": aliases,
+		}, nil)
+	}
+	mockEnvOutputs := func(m *mocks.MockrouteEnvDescriber, dnsName string) {
+		m.EXPECT().Outputs().Return(map[string]string{
+			envOutputPublicLoadBalancerDNSName:    dnsName,
+			envOutputPublicLoadBalancerHostedZone: "Z35SXDOTRQ7X7K",
+		}, nil)
+	}
+	testCases := map[string]struct {
+		inVars     appRouteDeployVars
+		setupMocks func(m *appRouteDeployMocks)
+
+		wantedErr string
+	}{
+		"hostname outside of the application's domain": {
+			inVars: appRouteDeployVars{
+				hostname: "api.example.org",
+			},
+			setupMocks: func(m *appRouteDeployMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+			},
+			wantedErr: "hostname api.example.org must be within the application's domain example.com",
+		},
+		"service is not a Load Balanced Web Service": {
+			inVars: appRouteDeployVars{
+				hostname: "api.example.com",
+			},
+			setupMocks: func(m *appRouteDeployMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.store.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{
+					Name: "frontend",
+					Type: manifestinfo.BackendServiceType,
+				}, nil)
+			},
+			wantedErr: "service frontend is a Backend Service: routes only support services of type Load Balanced Web Service",
+		},
+		"service is not deployed in an environment": {
+			inVars: appRouteDeployVars{
+				hostname: "api.example.com",
+			},
+			setupMocks: func(m *appRouteDeployMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.store.EXPECT().GetService("phonetool", "frontend").Return(mockLBWS, nil)
+				m.deployStore.EXPECT().IsServiceDeployed("phonetool", "us", "frontend").Return(false, nil)
+			},
+			wantedErr: "service frontend is not deployed in environment us",
+		},
+		"hostname is not an alias of the service": {
+			inVars: appRouteDeployVars{
+				hostname: "api.example.com",
+			},
+			setupMocks: func(m *appRouteDeployMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.store.EXPECT().GetService("phonetool", "frontend").Return(mockLBWS, nil)
+				m.deployStore.EXPECT().IsServiceDeployed("phonetool", gomock.Any(), "frontend").Return(true, nil).Times(2)
+				mockEnvAliases(m.envDescribers["us"], `{"frontend":["api.example.com"]}`)
+				mockEnvAliases(m.envDescribers["eu"], `{"frontend":["eu.api.example.com"],"api":["api.example.com"]}`)
+			},
+			wantedErr: `hostname api.example.com is not an alias of service frontend in environment eu: add it to "http.alias" in the service's manifest and run "copilot svc deploy --name frontend --env eu"`,
+		},
+		"wraps deployment errors": {
+			inVars: appRouteDeployVars{
+				hostname: "api.example.com",
+			},
+			setupMocks: func(m *appRouteDeployMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.store.EXPECT().GetService("phonetool", "frontend").Return(mockLBWS, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "us").Return(&config.Environment{Name: "us", Region: "us-east-1"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "eu").Return(&config.Environment{Name: "eu", Region: "eu-west-1"}, nil)
+				m.deployStore.EXPECT().IsServiceDeployed("phonetool", gomock.Any(), "frontend").Return(true, nil).Times(2)
+				mockEnvAliases(m.envDescribers["us"], `{"frontend":["api.example.com"]}`)
+				mockEnvAliases(m.envDescribers["eu"], `{"frontend":["api.example.com"]}`)
+				mockEnvOutputs(m.envDescribers["us"], "us.elb.amazonaws.com")
+				mockEnvOutputs(m.envDescribers["eu"], "eu.elb.amazonaws.com")
+				m.store.EXPECT().CreateRoute(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().DeployRoute(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: "deploy route api: some error",
+		},
+		"deploys a weighted route under the application subdomain": {
+			inVars: appRouteDeployVars{
+				hostname: "api.phonetool.example.com",
+				weights:  map[string]string{"us": "3"},
+			},
+			setupMocks: func(m *appRouteDeployMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.store.EXPECT().GetService("phonetool", "frontend").Return(mockLBWS, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "us").Return(&config.Environment{Name: "us", Region: "us-east-1"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "eu").Return(&config.Environment{Name: "eu", Region: "eu-west-1"}, nil)
+				m.deployStore.EXPECT().IsServiceDeployed("phonetool", gomock.Any(), "frontend").Return(true, nil).Times(2)
+				mockEnvAliases(m.envDescribers["us"], `{"frontend":["api.phonetool.example.com"]}`)
+				mockEnvAliases(m.envDescribers["eu"], `{"frontend":["API.phonetool.example.com"]}`)
+				mockEnvOutputs(m.envDescribers["us"], "us.elb.amazonaws.com")
+				mockEnvOutputs(m.envDescribers["eu"], "eu.elb.amazonaws.com")
+				m.store.EXPECT().CreateRoute(&config.Route{
+					App:           "phonetool",
+					Name:          "api",
+					Hostname:      "api.phonetool.example.com",
+					Service:       "frontend",
+					RoutingPolicy: "weighted",
+					Targets: []*config.RouteTarget{
+						{Env: "us", Weight: aws.Int(3)},
+						{Env: "eu"},
+					},
+				}).Return(nil)
+				m.deployer.EXPECT().DeployRoute(&deploy.CreateRouteInput{
+					App:            "phonetool",
+					Name:           "api",
+					Hostname:       "api.phonetool.example.com",
+					HostedZoneName: "phonetool.example.com.",
+					RoutingPolicy:  "weighted",
+					Targets: []deploy.RouteTargetInput{
+						{Env: "us", Region: "us-east-1", LoadBalancerDNS: "us.elb.amazonaws.com", LoadBalancerZoneID: "Z35SXDOTRQ7X7K", Weight: aws.Int(3)},
+						{Env: "eu", Region: "eu-west-1", LoadBalancerDNS: "eu.elb.amazonaws.com", LoadBalancerZoneID: "Z35SXDOTRQ7X7K"},
+					},
+				}).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &appRouteDeployMocks{
+				store:       mocks.NewMockstore(ctrl),
+				deployStore: mocks.NewMockdeployedEnvironmentLister(ctrl),
+				deployer:    mocks.NewMockrouteDeployer(ctrl),
+				envDescribers: map[string]*mocks.MockrouteEnvDescriber{
+					"us": mocks.NewMockrouteEnvDescriber(ctrl),
+					"eu": mocks.NewMockrouteEnvDescriber(ctrl),
+				},
+			}
+			tc.setupMocks(m)
+			vars := tc.inVars
+			vars.appName = "phonetool"
+			vars.name = "api"
+			vars.svcName = "frontend"
+			vars.envNames = []string{"us", "eu"}
+			vars.routingPolicy = "weighted"
+			opts := &appRouteDeployOpts{
+				appRouteDeployVars: vars,
+				store:              m.store,
+				deployStore:        m.deployStore,
+				deployer:           m.deployer,
+				newEnvDescriber: func(_, envName string) (routeEnvDescriber, error) {
+					return m.envDescribers[envName], nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
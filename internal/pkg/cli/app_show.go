@@ -7,9 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 
 	"io"
@@ -59,7 +56,7 @@ func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("default session: %w", err)
 	}
-	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	store := config.NewStore(defaultSession)
 	deployStore, err := deploy.NewStore(sessProvider, store)
 	if err != nil {
 		return nil, fmt.Errorf("connect to deploy store: %w", err)
@@ -216,6 +213,10 @@ func (o *showAppOpts) description() (*describe.App, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get version for application %s: %w", o.name, err)
 	}
+	routes, err := o.store.ListRoutes(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list routes in application %s: %w", o.name, err)
+	}
 	return &describe.App{
 		Name:                app.Name,
 		Version:             version,
@@ -225,6 +226,7 @@ func (o *showAppOpts) description() (*describe.App, error) {
 		Services:            trimmedSvcs,
 		Jobs:                trimmedJobs,
 		Pipelines:           pipelineInfo,
+		Routes:              routes,
 		WkldDeployedtoEnvs:  wkldDeployedtoEnvs,
 	}, nil
 }
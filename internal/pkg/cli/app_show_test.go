@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -231,6 +232,7 @@ func TestShowAppOpts_Execute(t *testing.T) {
 					Name: "bad-goose",
 				}, nil)
 				m.versionGetter.EXPECT().Version().Return("v0.0.0", nil)
+				m.storeSvc.EXPECT().ListRoutes("my-app").Return(nil, nil)
 			},
 
 			wantedContent: "{\"name\":\"my-app\",\"version\":\"v0.0.0\",\"uri\":\"example.com\",\"permissionsBoundary\":\"examplePermissionsBoundaryPolicy\",\"environments\":[{\"app\":\"\",\"name\":\"test\",\"region\":\"us-west-2\",\"accountID\":\"123456789\",\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},{\"app\":\"\",\"name\":\"prod\",\"region\":\"us-west-1\",\"accountID\":\"123456789\",\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"}],\"services\":[{\"app\":\"\",\"name\":\"my-svc\",\"type\":\"lb-web-svc\"}],\"jobs\":[{\"app\":\"\",\"name\":\"my-job\",\"type\":\"Scheduled Job\"}],\"pipelines\":[{\"pipelineName\":\"my-pipeline-repo\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"},{\"pipelineName\":\"bad-goose\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"}]}\n",
@@ -280,6 +282,7 @@ func TestShowAppOpts_Execute(t *testing.T) {
 					Name: "bad-goose",
 				}, nil)
 				m.versionGetter.EXPECT().Version().Return("v0.0.0", nil)
+				m.storeSvc.EXPECT().ListRoutes("my-app").Return(nil, nil)
 			},
 
 			wantedContent: `About
@@ -309,6 +312,111 @@ Pipelines
   ----
   my-pipeline-repo
   bad-goose
+`,
+		},
+		"correctly shows human output with routes": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:                "my-app",
+					Domain:              "example.com",
+					PermissionsBoundary: "examplePermissionsBoundaryPolicy",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "lb-web-svc",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListJobs("my-app").Return([]*config.Workload{
+					{
+						Name: "my-job",
+						Type: "Scheduled Job",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+					{
+						Name:      "prod",
+						AccountID: "123456789",
+						Region:    "us-west-1",
+					},
+				}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("my-app", "test").Return([]string{"my-job"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("my-app", "prod").Return([]string{"my-job"}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("my-app", "test").Return([]string{"my-svc"}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("my-app", "prod").Return([]string{}, nil)
+				m.pipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline, mockLegacyPipeline}, nil)
+				m.pipelineGetter.EXPECT().
+					GetPipeline("pipeline-my-app-my-pipeline-repo").Return(&codepipeline.Pipeline{
+					Name: "my-pipeline-repo",
+				}, nil)
+				m.pipelineGetter.EXPECT().
+					GetPipeline("bad-goose").Return(&codepipeline.Pipeline{
+					Name: "bad-goose",
+				}, nil)
+				m.versionGetter.EXPECT().Version().Return("v0.0.0", nil)
+				m.storeSvc.EXPECT().ListRoutes("my-app").Return([]*config.Route{
+					{
+						Name:          "api",
+						Hostname:      "api.example.com",
+						Service:       "my-svc",
+						RoutingPolicy: config.RoutingPolicyFailover,
+						Targets: []*config.RouteTarget{
+							{Env: "test", Primary: true},
+							{Env: "prod"},
+						},
+					},
+					{
+						Name:          "www",
+						Hostname:      "www.example.com",
+						Service:       "my-svc",
+						RoutingPolicy: config.RoutingPolicyWeighted,
+						Targets: []*config.RouteTarget{
+							{Env: "test", Weight: aws.Int(3)},
+							{Env: "prod"},
+						},
+					},
+				}, nil)
+			},
+
+			wantedContent: `About
+
+  Name                  my-app
+  Version               v0.0.0
+  URI                   example.com
+  Permissions Boundary  examplePermissionsBoundaryPolicy
+
+Environments
+
+  Name    AccountID  Region
+  ----    ---------  ------
+  test    123456789  us-west-2
+  prod    123456789  us-west-1
+
+Workloads
+
+  Name    Type           Environments
+  ----    ----           ------------
+  my-svc  lb-web-svc     test
+  my-job  Scheduled Job  prod, test
+
+Pipelines
+
+  Name
+  ----
+  my-pipeline-repo
+  bad-goose
+
+Routes
+
+  Name    Hostname         Service   Routing   Environments
+  ----    --------         -------   -------   ------------
+  api     api.example.com  my-svc    failover  test (primary), prod
+  www     www.example.com  my-svc    weighted  test (weight 3), prod (weight 1)
 `,
 		},
 		"correctly shows human output with latest version": {
@@ -348,6 +456,7 @@ Pipelines
 				m.deployStore.EXPECT().ListDeployedServices("my-app", "prod").Return([]string{"my-svc"}, nil)
 				m.pipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{}, nil)
 				m.versionGetter.EXPECT().Version().Return(mockTemplateVersion, nil)
+				m.storeSvc.EXPECT().ListRoutes("my-app").Return(nil, nil)
 			},
 
 			wantedContent: `About
@@ -414,6 +523,7 @@ Pipelines
 				m.deployStore.EXPECT().ListDeployedServices("my-app", "prod").Return([]string{"my-svc"}, nil)
 				m.pipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{}, nil)
 				m.versionGetter.EXPECT().Version().Return(mockTemplateVersion, nil)
+				m.storeSvc.EXPECT().ListRoutes("my-app").Return(nil, nil)
 
 			},
 
@@ -485,6 +595,7 @@ Pipelines
 					Name: "my-pipeline-repo",
 				}, nil)
 				m.versionGetter.EXPECT().Version().Return(mockTemplateVersion, nil)
+				m.storeSvc.EXPECT().ListRoutes("my-app").Return(nil, nil)
 			},
 
 			wantedContent: `About
@@ -577,6 +688,7 @@ Pipelines
 					Name: "my-pipeline-repo",
 				}, nil)
 				m.versionGetter.EXPECT().Version().Return(mockTemplateVersion, nil)
+				m.storeSvc.EXPECT().ListRoutes("my-app").Return(nil, nil)
 			},
 
 			wantedContent: `About
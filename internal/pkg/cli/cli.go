@@ -29,8 +29,16 @@ const (
 
 // tryReadingAppName retrieves the application's name from the workspace if it exists and returns it.
 // If there is an error while retrieving the workspace summary, returns the empty string.
+// In particular, a workspace index of several applications yields the empty string so that
+// the application is selected with the --app flag or a prompt instead.
 func tryReadingAppName() string {
-	ws, err := workspace.Use(afero.NewOsFs())
+	return tryReadingWorkspaceAppName("")
+}
+
+// tryReadingWorkspaceAppName is like tryReadingAppName, but resolves the workspace of the application
+// appName when the workspace index holds several applications.
+func tryReadingWorkspaceAppName(appName string) string {
+	ws, err := workspace.UseApp(appName, afero.NewOsFs())
 	if err != nil {
 		return ""
 	}
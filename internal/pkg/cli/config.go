@@ -0,0 +1,27 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildConfigCmd is the top level command for config.
+func BuildConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "config",
+		Short: `Commands for configuration profiles.
+Configuration profiles are hosted in AWS AppConfig and can be updated without redeploying your workloads.`,
+	}
+
+	cmd.AddCommand(buildConfigDeployCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Extend,
+	}
+	return cmd
+}
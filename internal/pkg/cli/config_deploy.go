@@ -0,0 +1,355 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/appconfig"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	stackdescr "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	configDeployWkldNamePrompt = "Which workload's configuration profiles would you like to deploy?"
+	configDeployEnvNamePrompt  = "Which environment would you like to deploy the configuration profiles to?"
+
+	configDeployDescription = "Deployed with copilot config deploy"
+
+	fmtConfigDeployStart    = "Deploying configuration profile %s to environment %s."
+	fmtConfigDeployProgress = "Deploying configuration profile %s to environment %s (%.0f%% complete)."
+	fmtConfigDeployStarted  = "Started deployment of configuration profile %s to environment %s.\n"
+	fmtConfigDeployComplete = "Deployed configuration profile %s to environment %s.\n"
+	fmtConfigDeployFailed   = "Failed to deploy configuration profile %s to environment %s.\n"
+)
+
+// Logical IDs of the AppConfig resources in the workload stack.
+const (
+	appConfigApplicationLogicalID = "AppConfigApplication"
+	appConfigEnvironmentLogicalID = "AppConfigEnvironment"
+	appConfigStrategyLogicalID    = "AppConfigDeploymentStrategy"
+	fmtAppConfigProfileLogicalID  = "%sConfigProfile"
+)
+
+// configDeployPollInterval is the time to wait between two checks of the state of a configuration deployment.
+var configDeployPollInterval = 5 * time.Second
+
+type configDeployVars struct {
+	appName  string
+	name     string
+	envName  string
+	profiles []string
+	detach   bool
+}
+
+type configDeployOpts struct {
+	configDeployVars
+
+	store             store
+	ws                wsWlDirReader
+	sel               wsSelector
+	fs                afero.Fs
+	spinner           progress
+	unmarshal         func([]byte) (manifest.DynamicWorkload, error)
+	newInterpolator   func(app, env string) interpolator
+	envSession        func() (*session.Session, error)
+	newStackDescriber func() (stackDescriber, error)
+	newConfigDeployer func() (appConfigDeployer, error)
+}
+
+func newConfigDeployOpts(vars configDeployVars) (*configDeployOpts, error) {
+	fs := afero.NewOsFs()
+	ws, err := workspace.UseApp(vars.appName, fs)
+	if err != nil {
+		return nil, err
+	}
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("config deploy"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewStore(defaultSess)
+	prompter := prompt.New()
+	opts := &configDeployOpts{
+		configDeployVars: vars,
+		store:            store,
+		ws:               ws,
+		sel:              selector.NewLocalWorkloadSelector(prompter, store, ws, selector.OnlyInitializedWorkloads),
+		fs:               fs,
+		spinner:          termprogress.NewSpinner(log.DiagnosticWriter),
+		unmarshal:        manifest.UnmarshalWorkload,
+		newInterpolator:  newManifestInterpolator,
+	}
+	opts.envSession = func() (*session.Session, error) {
+		env, err := opts.store.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s configuration: %w", opts.envName, err)
+		}
+		return sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+	}
+	opts.newStackDescriber = func() (stackDescriber, error) {
+		sess, err := opts.envSession()
+		if err != nil {
+			return nil, err
+		}
+		return stackdescr.NewStackDescriber(stack.NameForWorkload(opts.appName, opts.envName, opts.name), sess), nil
+	}
+	opts.newConfigDeployer = func() (appConfigDeployer, error) {
+		sess, err := opts.envSession()
+		if err != nil {
+			return nil, err
+		}
+		return appconfig.New(sess), nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *configDeployOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *configDeployOpts) Ask() error {
+	if o.appName == "" {
+		// NOTE: This command is required to be executed under a workspace. We don't prompt for it.
+		return errNoAppInWorkspace
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s configuration: %w", o.appName, err)
+	}
+	if err := o.validateOrAskWorkloadName(); err != nil {
+		return err
+	}
+	return o.validateOrAskEnvName()
+}
+
+// Execute deploys a new version of the configuration profiles of the workload to the environment.
+func (o *configDeployOpts) Execute() error {
+	cfg, err := o.appConfig()
+	if err != nil {
+		return err
+	}
+	if o.detach && len(cfg.Profiles) > 1 {
+		return fmt.Errorf("--%s can only be used to deploy a single configuration profile: AWS AppConfig runs one deployment at a time per environment", detachFlag)
+	}
+	contents, err := clideploy.ReadAppConfigContents(o.fs, o.ws.Path(), cfg)
+	if err != nil {
+		return err
+	}
+	ids, err := o.resourceIDs(cfg)
+	if err != nil {
+		return err
+	}
+	deployer, err := o.newConfigDeployer()
+	if err != nil {
+		return err
+	}
+	for _, name := range cfg.ProfileNames() {
+		if err := o.deployProfile(deployer, ids, name, cfg.Profiles[name], contents[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appConfig returns the configuration profiles in the manifest of the workload to deploy.
+func (o *configDeployOpts) appConfig() (manifest.AppConfig, error) {
+	sess, err := o.envSession()
+	if err != nil {
+		return manifest.AppConfig{}, err
+	}
+	mft, err := workloadManifest(&workloadManifestInput{
+		name:         o.name,
+		appName:      o.appName,
+		envName:      o.envName,
+		ws:           o.ws,
+		interpolator: o.newInterpolator(o.appName, o.envName),
+		sess:         sess,
+		unmarshal:    o.unmarshal,
+	})
+	if err != nil {
+		return manifest.AppConfig{}, err
+	}
+	type appConfigurer interface {
+		AppConfig() manifest.AppConfig
+	}
+	wkld, ok := mft.Manifest().(appConfigurer)
+	if !ok {
+		return manifest.AppConfig{}, fmt.Errorf("workload %s does not support configuration profiles", o.name)
+	}
+	cfg := wkld.AppConfig()
+	if cfg.IsEmpty() {
+		return manifest.AppConfig{}, fmt.Errorf(`no configuration profiles found under "config" in the manifest of %s`, o.name)
+	}
+	if len(o.profiles) == 0 {
+		return cfg, nil
+	}
+	selected := make(map[string]*manifest.AppConfigProfile, len(o.profiles))
+	for _, name := range o.profiles {
+		profile, ok := cfg.Profiles[name]
+		if !ok {
+			return manifest.AppConfig{}, fmt.Errorf("configuration profile %s is not one of the profiles of %s: %s",
+				color.HighlightUserInput(name), o.name, strings.Join(cfg.ProfileNames(), ", "))
+		}
+		selected[name] = profile
+	}
+	cfg.Profiles = selected
+	return cfg, nil
+}
+
+type appConfigResourceIDs struct {
+	application string
+	environment string
+	strategy    string
+	profiles    map[string]string
+}
+
+// resourceIDs returns the IDs of the AppConfig resources created by the workload stack in the environment.
+func (o *configDeployOpts) resourceIDs(cfg manifest.AppConfig) (*appConfigResourceIDs, error) {
+	describer, err := o.newStackDescriber()
+	if err != nil {
+		return nil, err
+	}
+	resources, err := describer.Resources()
+	if err != nil {
+		return nil, fmt.Errorf("describe resources of %s in environment %s: %w", o.name, o.envName, err)
+	}
+	physicalIDs := make(map[string]string, len(resources))
+	for _, r := range resources {
+		physicalIDs[r.LogicalID] = r.PhysicalID
+	}
+	ids := &appConfigResourceIDs{
+		application: physicalIDs[appConfigApplicationLogicalID],
+		environment: physicalIDs[appConfigEnvironmentLogicalID],
+		strategy:    physicalIDs[appConfigStrategyLogicalID],
+		profiles:    make(map[string]string, len(cfg.Profiles)),
+	}
+	for _, name := range cfg.ProfileNames() {
+		ids.profiles[name] = physicalIDs[fmt.Sprintf(fmtAppConfigProfileLogicalID, template.StripNonAlphaNumFunc(name))]
+		if ids.application == "" || ids.environment == "" || ids.strategy == "" || ids.profiles[name] == "" {
+			return nil, fmt.Errorf("configuration profile %s of %s is not deployed in environment %s: run %s first",
+				name, o.name, o.envName, color.HighlightCode("copilot deploy"))
+		}
+	}
+	return ids, nil
+}
+
+func (o *configDeployOpts) deployProfile(deployer appConfigDeployer, ids *appConfigResourceIDs, name string, profile *manifest.AppConfigProfile, content string) error {
+	o.spinner.Start(fmt.Sprintf(fmtConfigDeployStart, name, o.envName))
+	deployment, err := deployer.DeployConfig(&appconfig.DeployConfigInput{
+		ApplicationID: ids.application,
+		EnvironmentID: ids.environment,
+		ProfileID:     ids.profiles[name],
+		StrategyID:    ids.strategy,
+		Content:       []byte(content),
+		ContentType:   profile.ContentType(),
+		Description:   configDeployDescription,
+	})
+	if err != nil {
+		o.spinner.Stop(log.Serrorf(fmtConfigDeployFailed, name, o.envName))
+		return fmt.Errorf("deploy configuration profile %s: %w", name, err)
+	}
+	if o.detach {
+		o.spinner.Stop(log.Ssuccessf(fmtConfigDeployStarted, name, o.envName))
+		return nil
+	}
+	for !deployment.IsDone() {
+		time.Sleep(configDeployPollInterval)
+		deployment, err = deployer.Deployment(ids.application, ids.environment, deployment.Number)
+		if err != nil {
+			o.spinner.Stop(log.Serrorf(fmtConfigDeployFailed, name, o.envName))
+			return fmt.Errorf("get state of the deployment of configuration profile %s: %w", name, err)
+		}
+		o.spinner.Start(fmt.Sprintf(fmtConfigDeployProgress, name, o.envName, deployment.PercentageComplete))
+	}
+	if deployment.State == appconfig.DeploymentStateRolledBack {
+		o.spinner.Stop(log.Serrorf(fmtConfigDeployFailed, name, o.envName))
+		return fmt.Errorf("deployment %d of configuration profile %s was rolled back", deployment.Number, name)
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtConfigDeployComplete, name, o.envName))
+	return nil
+}
+
+func (o *configDeployOpts) validateOrAskWorkloadName() error {
+	if o.name == "" {
+		name, err := o.sel.Workload(configDeployWkldNamePrompt, "")
+		if err != nil {
+			return fmt.Errorf("select workload: %w", err)
+		}
+		o.name = name
+		return nil
+	}
+	names, err := o.ws.ListWorkloads()
+	if err != nil {
+		return fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	for _, name := range names {
+		if o.name == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("workload %s not found in the workspace", color.HighlightUserInput(o.name))
+}
+
+func (o *configDeployOpts) validateOrAskEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+		}
+		return nil
+	}
+	name, err := o.sel.Environment(configDeployEnvNamePrompt, "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = name
+	return nil
+}
+
+// buildConfigDeployCmd builds the command for deploying configuration profiles.
+func buildConfigDeployCmd() *cobra.Command {
+	vars := configDeployVars{}
+	cmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploys the configuration profiles of a service or job to an environment.",
+		Long: `Deploys the configuration profiles of a service or job to an environment.
+Creates a new version of each profile in AWS AppConfig from the files in your workspace
+and rolls it out with the deployment strategy of the manifest, without redeploying the workload.`,
+		Example: `
+  Deploys all the configuration profiles of the "api" service to the "test" environment.
+  /code $ copilot config deploy -n api -e test
+  Deploys only the "flags" profile and returns once the deployment has started.
+  /code $ copilot config deploy -n api -e test --profiles flags --detach`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newConfigDeployOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", workloadFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.profiles, configProfilesFlag, nil, configProfilesFlagDescription)
+	cmd.Flags().BoolVar(&vars.detach, detachFlag, false, configDetachFlagDescription)
+	return cmd
+}
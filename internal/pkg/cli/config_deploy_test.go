@@ -0,0 +1,259 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/appconfig"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	stackdescr "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigDeployOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inVars     configDeployVars
+		setupMocks func(m configDeployMocks)
+
+		wantedName string
+		wantedEnv  string
+		wantedErr  error
+	}{
+		"error if not in a workspace": {
+			setupMocks: func(m configDeployMocks) {},
+			wantedErr:  errNoAppInWorkspace,
+		},
+		"error if the workload is not in the workspace": {
+			inVars: configDeployVars{appName: "phonetool", name: "api"},
+			setupMocks: func(m configDeployMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"frontend"}, nil)
+			},
+			wantedErr: errors.New("workload api not found in the workspace"),
+		},
+		"prompt for the workload and environment": {
+			inVars: configDeployVars{appName: "phonetool"},
+			setupMocks: func(m configDeployMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				m.sel.EXPECT().Workload(configDeployWkldNamePrompt, "").Return("api", nil)
+				m.sel.EXPECT().Environment(configDeployEnvNamePrompt, "", "phonetool").Return("test", nil)
+			},
+			wantedName: "api",
+			wantedEnv:  "test",
+		},
+		"validate the flags": {
+			inVars: configDeployVars{appName: "phonetool", name: "api", envName: "test"},
+			setupMocks: func(m configDeployMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, nil)
+			},
+			wantedName: "api",
+			wantedEnv:  "test",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newConfigDeployMocks(ctrl)
+			tc.setupMocks(m)
+			opts := &configDeployOpts{
+				configDeployVars: tc.inVars,
+				store:            m.store,
+				ws:               m.ws,
+				sel:              m.sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.name)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+func TestConfigDeployOpts_Execute(t *testing.T) {
+	const mft = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+config:
+  profiles:
+    settings:
+      path: config/settings.json
+    flags:
+      path: config/flags.yml
+      type: feature-flags
+`
+	resources := []*stackdescr.Resource{
+		{LogicalID: "AppConfigApplication", PhysicalID: "app1"},
+		{LogicalID: "AppConfigEnvironment", PhysicalID: "env1"},
+		{LogicalID: "AppConfigDeploymentStrategy", PhysicalID: "strat1"},
+		{LogicalID: "settingsConfigProfile", PhysicalID: "prof1"},
+		{LogicalID: "flagsConfigProfile", PhysicalID: "prof2"},
+	}
+	testCases := map[string]struct {
+		inProfiles []string
+		inDetach   bool
+		setupMocks func(m configDeployMocks)
+
+		wantedErr error
+	}{
+		"error if a profile is not in the manifest": {
+			inProfiles: []string{"secrets"},
+			setupMocks: func(m configDeployMocks) {},
+			wantedErr:  errors.New("configuration profile secrets is not one of the profiles of api: flags, settings"),
+		},
+		"error if detaching while deploying multiple profiles": {
+			inDetach:   true,
+			setupMocks: func(m configDeployMocks) {},
+			wantedErr:  errors.New("--detach can only be used to deploy a single configuration profile: AWS AppConfig runs one deployment at a time per environment"),
+		},
+		"error if the profile is not deployed yet": {
+			inProfiles: []string{"settings"},
+			setupMocks: func(m configDeployMocks) {
+				m.stack.EXPECT().Resources().Return(resources[:3], nil)
+			},
+			wantedErr: errors.New("configuration profile settings of api is not deployed in environment test: run `copilot deploy` first"),
+		},
+		"error if the deployment is rolled back": {
+			inProfiles: []string{"settings"},
+			setupMocks: func(m configDeployMocks) {
+				m.stack.EXPECT().Resources().Return(resources, nil)
+				m.spinner.EXPECT().Start(gomock.Any()).AnyTimes()
+				m.deployer.EXPECT().DeployConfig(gomock.Any()).Return(&appconfig.Deployment{Number: 2, State: "DEPLOYING"}, nil)
+				m.deployer.EXPECT().Deployment("app1", "env1", int64(2)).Return(&appconfig.Deployment{Number: 2, State: appconfig.DeploymentStateRolledBack}, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: errors.New("deployment 2 of configuration profile settings was rolled back"),
+		},
+		"start the deployment of a single profile": {
+			inProfiles: []string{"flags"},
+			inDetach:   true,
+			setupMocks: func(m configDeployMocks) {
+				m.stack.EXPECT().Resources().Return(resources, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().DeployConfig(&appconfig.DeployConfigInput{
+					ApplicationID: "app1",
+					EnvironmentID: "env1",
+					ProfileID:     "prof2",
+					StrategyID:    "strat1",
+					Content:       []byte(`{"flags":{"beta":{"name":"beta"}},"values":{"beta":{"enabled":true}},"version":"1"}`),
+					ContentType:   "application/json",
+					Description:   configDeployDescription,
+				}).Return(&appconfig.Deployment{Number: 1, State: "DEPLOYING"}, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"deploy all the profiles one after the other": {
+			setupMocks: func(m configDeployMocks) {
+				m.stack.EXPECT().Resources().Return(resources, nil)
+				m.spinner.EXPECT().Start(gomock.Any()).AnyTimes()
+				gomock.InOrder(
+					m.deployer.EXPECT().DeployConfig(gomock.Any()).Return(&appconfig.Deployment{Number: 1, State: "DEPLOYING"}, nil),
+					m.deployer.EXPECT().Deployment("app1", "env1", int64(1)).Return(&appconfig.Deployment{Number: 1, State: appconfig.DeploymentStateComplete}, nil),
+					m.deployer.EXPECT().DeployConfig(&appconfig.DeployConfigInput{
+						ApplicationID: "app1",
+						EnvironmentID: "env1",
+						ProfileID:     "prof1",
+						StrategyID:    "strat1",
+						Content:       []byte(`{"timeout": 30}`),
+						ContentType:   "application/json",
+						Description:   configDeployDescription,
+					}).Return(&appconfig.Deployment{Number: 2, State: appconfig.DeploymentStateComplete}, nil),
+				)
+				m.spinner.EXPECT().Stop(gomock.Any()).Times(2)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newConfigDeployMocks(ctrl)
+			m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(mft), nil)
+			m.ws.EXPECT().Path().Return("/ws").AnyTimes()
+			m.interpolator.EXPECT().Interpolate(mft).Return(mft, nil)
+			tc.setupMocks(m)
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "/ws/config/settings.json", []byte(`{"timeout": 30}`), 0644))
+			require.NoError(t, afero.WriteFile(fs, "/ws/config/flags.yml", []byte(`version: "1"
+flags:
+  beta:
+    name: beta
+values:
+  beta:
+    enabled: true
+`), 0644))
+			configDeployPollInterval = 0
+			opts := &configDeployOpts{
+				configDeployVars: configDeployVars{
+					appName:  "phonetool",
+					name:     "api",
+					envName:  "test",
+					profiles: tc.inProfiles,
+					detach:   tc.inDetach,
+				},
+				ws:        m.ws,
+				fs:        fs,
+				spinner:   m.spinner,
+				unmarshal: manifest.UnmarshalWorkload,
+				newInterpolator: func(app, env string) interpolator {
+					return m.interpolator
+				},
+				envSession: func() (*session.Session, error) {
+					return session.NewSession()
+				},
+				newStackDescriber: func() (stackDescriber, error) {
+					return m.stack, nil
+				},
+				newConfigDeployer: func() (appConfigDeployer, error) {
+					return m.deployer, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+type configDeployMocks struct {
+	store        *mocks.Mockstore
+	ws           *mocks.MockwsWlDirReader
+	sel          *mocks.MockwsSelector
+	interpolator *mocks.Mockinterpolator
+	spinner      *mocks.Mockprogress
+	stack        *mocks.MockstackDescriber
+	deployer     *mocks.MockappConfigDeployer
+}
+
+func newConfigDeployMocks(ctrl *gomock.Controller) configDeployMocks {
+	return configDeployMocks{
+		store:        mocks.NewMockstore(ctrl),
+		ws:           mocks.NewMockwsWlDirReader(ctrl),
+		sel:          mocks.NewMockwsSelector(ctrl),
+		interpolator: mocks.NewMockinterpolator(ctrl),
+		spinner:      mocks.NewMockprogress(ctrl),
+		stack:        mocks.NewMockstackDescriber(ctrl),
+		deployer:     mocks.NewMockappConfigDeployer(ctrl),
+	}
+}
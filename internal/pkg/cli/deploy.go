@@ -7,13 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"slices"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -81,8 +79,8 @@ func newDeployOpts(vars deployVars) (*deployOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("default session: %v", err)
 	}
-	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
-	ws, err := workspace.Use(afero.NewOsFs())
+	store := config.NewStore(defaultSess)
+	ws, err := workspace.UseApp(vars.appName, afero.NewOsFs())
 	if err != nil {
 		return nil, err
 	}
@@ -80,7 +80,10 @@ func (d *backendSvcDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionReco
 	if err != nil {
 		return nil, err
 	}
-	if err := d.deploy(in.Options, *stackConfigOutput); err != nil {
+	if err := d.runPreDeployTask(in, stackConfigOutput.conf); err != nil {
+		return nil, err
+	}
+	if err := d.deploy(in, *stackConfigOutput); err != nil {
 		return nil, err
 	}
 	return noopActionRecommender{}, nil
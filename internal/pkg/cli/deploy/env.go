@@ -13,7 +13,10 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
@@ -96,6 +99,7 @@ type envDeployer struct {
 	envDescriber             envDescriber
 	lbDescriber              lbDescriber
 	newServiceStackDescriber func(string) stackDescriber
+	configStoreTableARN      func() (string, error)
 
 	// Dependencies for parsing addons.
 	ws          WorkspaceAddonsReaderPathGetter
@@ -174,6 +178,27 @@ func NewEnvDeployer(in *NewEnvDeployerInput) (*envDeployer, error) {
 			return addon.ParseFromEnv(in.Workspace)
 		}),
 		ws: in.Workspace,
+		configStoreTableARN: func() (string, error) {
+			backend, err := config.GetBackendConfig(ssm.New(defaultSession))
+			if err != nil {
+				return "", err
+			}
+			if backend.Type != config.BackendTypeDynamoDB {
+				return "", nil
+			}
+			region := aws.StringValue(defaultSession.Config.Region)
+			partition, err := partitions.Region(region).Partition()
+			if err != nil {
+				return "", err
+			}
+			return arn.ARN{
+				Partition: partition.ID(),
+				Service:   "dynamodb",
+				Region:    region,
+				AccountID: in.App.AccountID,
+				Resource:  "table/" + backend.Table,
+			}.String(), nil
+		},
 	}
 	return deployer, nil
 }
@@ -398,6 +423,10 @@ func (d *envDeployer) buildStackInput(in *DeployEnvironmentInput) (*cfnstack.Env
 	if err != nil {
 		return nil, err
 	}
+	configStoreTableARN, err := d.configStoreTableARN()
+	if err != nil {
+		return nil, err
+	}
 	return &cfnstack.EnvConfig{
 		Name: d.env.Name,
 		App: deploy.AppInformation{
@@ -416,6 +445,7 @@ func (d *envDeployer) buildStackInput(in *DeployEnvironmentInput) (*cfnstack.Env
 		ForceUpdate:          in.ForceNewUpdate,
 		RawMft:               in.RawManifest,
 		PermissionsBoundary:  in.PermissionsBoundary,
+		ConfigStoreTableARN:  configStoreTableARN,
 		Version:              in.Version,
 	}, nil
 }
@@ -547,6 +547,9 @@ func TestEnvDeployer_GenerateCloudFormationTemplate(t *testing.T) {
 					return m.stackSerializer, nil
 				},
 				parseAddons: m.parseAddons,
+				configStoreTableARN: func() (string, error) {
+					return "", nil
+				},
 			}
 			actual, err := d.GenerateCloudFormationTemplate(&DeployEnvironmentInput{
 				Manifest: &tc.inManifest,
@@ -708,6 +711,9 @@ func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 				newStack: func(_ *cfnstack.EnvConfig, _ string, _ []*awscfn.Parameter) (cloudformation.StackConfiguration, error) {
 					return m.stackSerializer, nil
 				},
+				configStoreTableARN: func() (string, error) {
+					return "", nil
+				},
 			}
 			mockIn := &DeployEnvironmentInput{
 				RootUserARN: "mockRootUserARN",
@@ -5,9 +5,12 @@ package deploy
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/dustin/go-humanize/english"
 )
@@ -84,3 +87,47 @@ If you'd like to use %s without a CDN, ensure %s A record is pointed to the ALB.
 		english.PluralWord(len(e.services), "its", "each service's"),
 	)
 }
+
+type imageScanFinding struct {
+	container string
+	ecr.ScanFinding
+}
+
+type errImageScanFindings struct {
+	threshold string
+	findings  []imageScanFinding
+}
+
+func (e *errImageScanFindings) Error() string {
+	return fmt.Sprintf("image scan found %d %s of severity %s or higher",
+		len(e.findings), english.PluralWord(len(e.findings), "vulnerability", "vulnerabilities"), e.threshold)
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e *errImageScanFindings) RecommendActions() string {
+	findings := slices.Clone(e.findings)
+	slices.SortStableFunc(findings, func(a, b imageScanFinding) int {
+		if a.container != b.container {
+			return strings.Compare(a.container, b.container)
+		}
+		return slices.Index(ecr.FindingSeverities, a.Severity) - slices.Index(ecr.FindingSeverities, b.Severity)
+	})
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 2, 2, 2, ' ', tabwriter.DiscardEmptyColumns)
+	fmt.Fprintln(w, "  Container\tSeverity\tVulnerability\tPackage\tLink")
+	fmt.Fprintln(w, "  ---------\t--------\t-------------\t-------\t----")
+	for _, f := range findings {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", f.container, f.Severity, f.Name, dashIfEmpty(f.Package), dashIfEmpty(f.URI))
+	}
+	w.Flush()
+	return fmt.Sprintf(`Fix the following vulnerabilities, or raise the threshold with %s in the manifest:
+%s`, color.HighlightCode("image.repository.scan.fail_on"), b.String())
+}
+
+func dashIfEmpty(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -85,6 +85,7 @@ func (d *jobDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecommender
 	if err := d.deployer.DeployService(stackConfigOutput.conf, d.resources.S3Bucket, in.Detach, opts...); err != nil {
 		return nil, fmt.Errorf("deploy job: %w", err)
 	}
+	d.recordDeployment(in, stackConfigOutput.conf)
 	return noopActionRecommender{}, nil
 }
 
@@ -137,7 +137,10 @@ func (d *lbWebSvcDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecomm
 	if err != nil {
 		return nil, err
 	}
-	if err := d.deploy(in.Options, *stackConfigOutput); err != nil {
+	if err := d.runPreDeployTask(in, stackConfigOutput.conf); err != nil {
+		return nil, err
+	}
+	if err := d.deploy(in, *stackConfigOutput); err != nil {
 		return nil, err
 	}
 	return noopActionRecommender{}, nil
@@ -0,0 +1,315 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/cli/deploy/predeploy.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
+	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockserviceTaskDescriber is a mock of serviceTaskDescriber interface.
+type MockserviceTaskDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceTaskDescriberMockRecorder
+}
+
+// MockserviceTaskDescriberMockRecorder is the mock recorder for MockserviceTaskDescriber.
+type MockserviceTaskDescriberMockRecorder struct {
+	mock *MockserviceTaskDescriber
+}
+
+// NewMockserviceTaskDescriber creates a new mock instance.
+func NewMockserviceTaskDescriber(ctrl *gomock.Controller) *MockserviceTaskDescriber {
+	mock := &MockserviceTaskDescriber{ctrl: ctrl}
+	mock.recorder = &MockserviceTaskDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceTaskDescriber) EXPECT() *MockserviceTaskDescriberMockRecorder {
+	return m.recorder
+}
+
+// ClusterARN mocks base method.
+func (m *MockserviceTaskDescriber) ClusterARN(app, env string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterARN", app, env)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterARN indicates an expected call of ClusterARN.
+func (mr *MockserviceTaskDescriberMockRecorder) ClusterARN(app, env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterARN", reflect.TypeOf((*MockserviceTaskDescriber)(nil).ClusterARN), app, env)
+}
+
+// HasNonZeroExitCode mocks base method.
+func (m *MockserviceTaskDescriber) HasNonZeroExitCode(taskARNs []string, cluster string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasNonZeroExitCode", taskARNs, cluster)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HasNonZeroExitCode indicates an expected call of HasNonZeroExitCode.
+func (mr *MockserviceTaskDescriberMockRecorder) HasNonZeroExitCode(taskARNs, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasNonZeroExitCode", reflect.TypeOf((*MockserviceTaskDescriber)(nil).HasNonZeroExitCode), taskARNs, cluster)
+}
+
+// NetworkConfiguration mocks base method.
+func (m *MockserviceTaskDescriber) NetworkConfiguration(app, env, svc string) (*ecs.NetworkConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkConfiguration", app, env, svc)
+	ret0, _ := ret[0].(*ecs.NetworkConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NetworkConfiguration indicates an expected call of NetworkConfiguration.
+func (mr *MockserviceTaskDescriberMockRecorder) NetworkConfiguration(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkConfiguration", reflect.TypeOf((*MockserviceTaskDescriber)(nil).NetworkConfiguration), app, env, svc)
+}
+
+// Service mocks base method.
+func (m *MockserviceTaskDescriber) Service(app, env, svc string) (*ecs.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", app, env, svc)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockserviceTaskDescriberMockRecorder) Service(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockserviceTaskDescriber)(nil).Service), app, env, svc)
+}
+
+// TaskDefinition mocks base method.
+func (m *MockserviceTaskDescriber) TaskDefinition(app, env, svc string) (*ecs.TaskDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDefinition", app, env, svc)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDefinition indicates an expected call of TaskDefinition.
+func (mr *MockserviceTaskDescriberMockRecorder) TaskDefinition(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MockserviceTaskDescriber)(nil).TaskDefinition), app, env, svc)
+}
+
+// MockworkloadStackReverter is a mock of workloadStackReverter interface.
+type MockworkloadStackReverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkloadStackReverterMockRecorder
+}
+
+// MockworkloadStackReverterMockRecorder is the mock recorder for MockworkloadStackReverter.
+type MockworkloadStackReverterMockRecorder struct {
+	mock *MockworkloadStackReverter
+}
+
+// NewMockworkloadStackReverter creates a new mock instance.
+func NewMockworkloadStackReverter(ctrl *gomock.Controller) *MockworkloadStackReverter {
+	mock := &MockworkloadStackReverter{ctrl: ctrl}
+	mock.recorder = &MockworkloadStackReverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkloadStackReverter) EXPECT() *MockworkloadStackReverterMockRecorder {
+	return m.recorder
+}
+
+// DeleteWorkload mocks base method.
+func (m *MockworkloadStackReverter) DeleteWorkload(in deploy.DeleteWorkloadInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkload", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkload indicates an expected call of DeleteWorkload.
+func (mr *MockworkloadStackReverterMockRecorder) DeleteWorkload(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkload", reflect.TypeOf((*MockworkloadStackReverter)(nil).DeleteWorkload), in)
+}
+
+// DeployedWorkload mocks base method.
+func (m *MockworkloadStackReverter) DeployedWorkload(app, env, name string) (cloudformation0.StackConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployedWorkload", app, env, name)
+	ret0, _ := ret[0].(cloudformation0.StackConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployedWorkload indicates an expected call of DeployedWorkload.
+func (mr *MockworkloadStackReverterMockRecorder) DeployedWorkload(app, env, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedWorkload", reflect.TypeOf((*MockworkloadStackReverter)(nil).DeployedWorkload), app, env, name)
+}
+
+// MocktaskStackDeployer is a mock of taskStackDeployer interface.
+type MocktaskStackDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskStackDeployerMockRecorder
+}
+
+// MocktaskStackDeployerMockRecorder is the mock recorder for MocktaskStackDeployer.
+type MocktaskStackDeployerMockRecorder struct {
+	mock *MocktaskStackDeployer
+}
+
+// NewMocktaskStackDeployer creates a new mock instance.
+func NewMocktaskStackDeployer(ctrl *gomock.Controller) *MocktaskStackDeployer {
+	mock := &MocktaskStackDeployer{ctrl: ctrl}
+	mock.recorder = &MocktaskStackDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskStackDeployer) EXPECT() *MocktaskStackDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployTask mocks base method.
+func (m *MocktaskStackDeployer) DeployTask(input *deploy.CreateTaskResourcesInput, opts ...cloudformation.StackOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployTask", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployTask indicates an expected call of DeployTask.
+func (mr *MocktaskStackDeployerMockRecorder) DeployTask(input interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployTask", reflect.TypeOf((*MocktaskStackDeployer)(nil).DeployTask), varargs...)
+}
+
+// MocktaskStarter is a mock of taskStarter interface.
+type MocktaskStarter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskStarterMockRecorder
+}
+
+// MocktaskStarterMockRecorder is the mock recorder for MocktaskStarter.
+type MocktaskStarterMockRecorder struct {
+	mock *MocktaskStarter
+}
+
+// NewMocktaskStarter creates a new mock instance.
+func NewMocktaskStarter(ctrl *gomock.Controller) *MocktaskStarter {
+	mock := &MocktaskStarter{ctrl: ctrl}
+	mock.recorder = &MocktaskStarterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskStarter) EXPECT() *MocktaskStarterMockRecorder {
+	return m.recorder
+}
+
+// DefaultCluster mocks base method.
+func (m *MocktaskStarter) DefaultCluster() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultCluster")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DefaultCluster indicates an expected call of DefaultCluster.
+func (mr *MocktaskStarterMockRecorder) DefaultCluster() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultCluster", reflect.TypeOf((*MocktaskStarter)(nil).DefaultCluster))
+}
+
+// RunTask mocks base method.
+func (m *MocktaskStarter) RunTask(input ecs.RunTaskInput) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunTask", input)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunTask indicates an expected call of RunTask.
+func (mr *MocktaskStarterMockRecorder) RunTask(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTask", reflect.TypeOf((*MocktaskStarter)(nil).RunTask), input)
+}
+
+// StopTasks mocks base method.
+func (m *MocktaskStarter) StopTasks(tasks []string, opts ...ecs.StopTasksOpts) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{tasks}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopTasks", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopTasks indicates an expected call of StopTasks.
+func (mr *MocktaskStarterMockRecorder) StopTasks(tasks interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{tasks}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTasks", reflect.TypeOf((*MocktaskStarter)(nil).StopTasks), varargs...)
+}
+
+// MocktaskLogWriter is a mock of taskLogWriter interface.
+type MocktaskLogWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskLogWriterMockRecorder
+}
+
+// MocktaskLogWriterMockRecorder is the mock recorder for MocktaskLogWriter.
+type MocktaskLogWriterMockRecorder struct {
+	mock *MocktaskLogWriter
+}
+
+// NewMocktaskLogWriter creates a new mock instance.
+func NewMocktaskLogWriter(ctrl *gomock.Controller) *MocktaskLogWriter {
+	mock := &MocktaskLogWriter{ctrl: ctrl}
+	mock.recorder = &MocktaskLogWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskLogWriter) EXPECT() *MocktaskLogWriterMockRecorder {
+	return m.recorder
+}
+
+// WriteEventsUntilStopped mocks base method.
+func (m *MocktaskLogWriter) WriteEventsUntilStopped() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteEventsUntilStopped")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteEventsUntilStopped indicates an expected call of WriteEventsUntilStopped.
+func (mr *MocktaskLogWriterMockRecorder) WriteEventsUntilStopped() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteEventsUntilStopped", reflect.TypeOf((*MocktaskLogWriter)(nil).WriteEventsUntilStopped))
+}
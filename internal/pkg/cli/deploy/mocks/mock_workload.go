@@ -11,8 +11,11 @@ import (
 
 	addon "github.com/aws/copilot-cli/internal/pkg/addon"
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	ecr "github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	dockerengine "github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	signature "github.com/aws/copilot-cli/internal/pkg/docker/signature"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -121,6 +124,214 @@ func (mr *MockrepositoryServiceMockRecorder) Login() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockrepositoryService)(nil).Login))
 }
 
+// MockimageRepositoryConfigurer is a mock of imageRepositoryConfigurer interface.
+type MockimageRepositoryConfigurer struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageRepositoryConfigurerMockRecorder
+}
+
+// MockimageRepositoryConfigurerMockRecorder is the mock recorder for MockimageRepositoryConfigurer.
+type MockimageRepositoryConfigurerMockRecorder struct {
+	mock *MockimageRepositoryConfigurer
+}
+
+// NewMockimageRepositoryConfigurer creates a new mock instance.
+func NewMockimageRepositoryConfigurer(ctrl *gomock.Controller) *MockimageRepositoryConfigurer {
+	mock := &MockimageRepositoryConfigurer{ctrl: ctrl}
+	mock.recorder = &MockimageRepositoryConfigurerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageRepositoryConfigurer) EXPECT() *MockimageRepositoryConfigurerMockRecorder {
+	return m.recorder
+}
+
+// ImageScanFindings mocks base method.
+func (m *MockimageRepositoryConfigurer) ImageScanFindings(ctx context.Context, repoName, digest string) ([]ecr.ScanFinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageScanFindings", ctx, repoName, digest)
+	ret0, _ := ret[0].([]ecr.ScanFinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageScanFindings indicates an expected call of ImageScanFindings.
+func (mr *MockimageRepositoryConfigurerMockRecorder) ImageScanFindings(ctx, repoName, digest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageScanFindings", reflect.TypeOf((*MockimageRepositoryConfigurer)(nil).ImageScanFindings), ctx, repoName, digest)
+}
+
+// PlatformDigests mocks base method.
+func (m *MockimageRepositoryConfigurer) PlatformDigests(repoName, digest string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlatformDigests", repoName, digest)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlatformDigests indicates an expected call of PlatformDigests.
+func (mr *MockimageRepositoryConfigurerMockRecorder) PlatformDigests(repoName, digest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlatformDigests", reflect.TypeOf((*MockimageRepositoryConfigurer)(nil).PlatformDigests), repoName, digest)
+}
+
+// PutLifecyclePolicy mocks base method.
+func (m *MockimageRepositoryConfigurer) PutLifecyclePolicy(repoName string, policy ecr.LifecyclePolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutLifecyclePolicy", repoName, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutLifecyclePolicy indicates an expected call of PutLifecyclePolicy.
+func (mr *MockimageRepositoryConfigurerMockRecorder) PutLifecyclePolicy(repoName, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutLifecyclePolicy", reflect.TypeOf((*MockimageRepositoryConfigurer)(nil).PutLifecyclePolicy), repoName, policy)
+}
+
+// PutScanOnPush mocks base method.
+func (m *MockimageRepositoryConfigurer) PutScanOnPush(repoName string, scanOnPush bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutScanOnPush", repoName, scanOnPush)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutScanOnPush indicates an expected call of PutScanOnPush.
+func (mr *MockimageRepositoryConfigurerMockRecorder) PutScanOnPush(repoName, scanOnPush interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutScanOnPush", reflect.TypeOf((*MockimageRepositoryConfigurer)(nil).PutScanOnPush), repoName, scanOnPush)
+}
+
+// MockimageSigner is a mock of imageSigner interface.
+type MockimageSigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageSignerMockRecorder
+}
+
+// MockimageSignerMockRecorder is the mock recorder for MockimageSigner.
+type MockimageSignerMockRecorder struct {
+	mock *MockimageSigner
+}
+
+// NewMockimageSigner creates a new mock instance.
+func NewMockimageSigner(ctrl *gomock.Controller) *MockimageSigner {
+	mock := &MockimageSigner{ctrl: ctrl}
+	mock.recorder = &MockimageSignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageSigner) EXPECT() *MockimageSignerMockRecorder {
+	return m.recorder
+}
+
+// Sign mocks base method.
+func (m *MockimageSigner) Sign(ctx context.Context, tool signature.Tool, key, ref string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", ctx, tool, key, ref)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockimageSignerMockRecorder) Sign(ctx, tool, key, ref interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockimageSigner)(nil).Sign), ctx, tool, key, ref)
+}
+
+// Verify mocks base method.
+func (m *MockimageSigner) Verify(ctx context.Context, tool signature.Tool, key, ref string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, tool, key, ref)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockimageSignerMockRecorder) Verify(ctx, tool, key, ref interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockimageSigner)(nil).Verify), ctx, tool, key, ref)
+}
+
+// MockimageDigestResolver is a mock of imageDigestResolver interface.
+type MockimageDigestResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageDigestResolverMockRecorder
+}
+
+// MockimageDigestResolverMockRecorder is the mock recorder for MockimageDigestResolver.
+type MockimageDigestResolverMockRecorder struct {
+	mock *MockimageDigestResolver
+}
+
+// NewMockimageDigestResolver creates a new mock instance.
+func NewMockimageDigestResolver(ctrl *gomock.Controller) *MockimageDigestResolver {
+	mock := &MockimageDigestResolver{ctrl: ctrl}
+	mock.recorder = &MockimageDigestResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageDigestResolver) EXPECT() *MockimageDigestResolverMockRecorder {
+	return m.recorder
+}
+
+// ImageDigest mocks base method.
+func (m *MockimageDigestResolver) ImageDigest(ctx context.Context, ref string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageDigest", ctx, ref)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageDigest indicates an expected call of ImageDigest.
+func (mr *MockimageDigestResolverMockRecorder) ImageDigest(ctx, ref interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageDigest", reflect.TypeOf((*MockimageDigestResolver)(nil).ImageDigest), ctx, ref)
+}
+
+// MockimageTagDigester is a mock of imageTagDigester interface.
+type MockimageTagDigester struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageTagDigesterMockRecorder
+}
+
+// MockimageTagDigesterMockRecorder is the mock recorder for MockimageTagDigester.
+type MockimageTagDigesterMockRecorder struct {
+	mock *MockimageTagDigester
+}
+
+// NewMockimageTagDigester creates a new mock instance.
+func NewMockimageTagDigester(ctrl *gomock.Controller) *MockimageTagDigester {
+	mock := &MockimageTagDigester{ctrl: ctrl}
+	mock.recorder = &MockimageTagDigesterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageTagDigester) EXPECT() *MockimageTagDigesterMockRecorder {
+	return m.recorder
+}
+
+// TagDigest mocks base method.
+func (m *MockimageTagDigester) TagDigest(registryID, repoName, tag string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagDigest", registryID, repoName, tag)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagDigest indicates an expected call of TagDigest.
+func (mr *MockimageTagDigesterMockRecorder) TagDigest(registryID, repoName, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagDigest", reflect.TypeOf((*MockimageTagDigester)(nil).TagDigest), registryID, repoName, tag)
+}
+
 // Mocktemplater is a mock of templater interface.
 type Mocktemplater struct {
 	ctrl     *gomock.Controller
@@ -359,6 +570,43 @@ func (mr *MockserviceDeployerMockRecorder) DeployService(conf, bucketName, detac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MockserviceDeployer)(nil).DeployService), varargs...)
 }
 
+// MockdeploymentRecorder is a mock of deploymentRecorder interface.
+type MockdeploymentRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeploymentRecorderMockRecorder
+}
+
+// MockdeploymentRecorderMockRecorder is the mock recorder for MockdeploymentRecorder.
+type MockdeploymentRecorderMockRecorder struct {
+	mock *MockdeploymentRecorder
+}
+
+// NewMockdeploymentRecorder creates a new mock instance.
+func NewMockdeploymentRecorder(ctrl *gomock.Controller) *MockdeploymentRecorder {
+	mock := &MockdeploymentRecorder{ctrl: ctrl}
+	mock.recorder = &MockdeploymentRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeploymentRecorder) EXPECT() *MockdeploymentRecorderMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockdeploymentRecorder) Record(deployment *deploy.WorkloadDeployment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", deployment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockdeploymentRecorderMockRecorder) Record(deployment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockdeploymentRecorder)(nil).Record), deployment)
+}
+
 // MockdeployedTemplateGetter is a mock of deployedTemplateGetter interface.
 type MockdeployedTemplateGetter struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,347 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/template/override"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	fmtPreDeployTaskGroupName   = "%s-%s-%s-predeploy"
+	defaultPreDeployTaskTimeout = 30 * time.Minute
+	preDeployTaskStopReason     = "Pre-deployment task timed out."
+)
+
+type serviceTaskDescriber interface {
+	Service(app, env, svc string) (*awsecs.Service, error)
+	TaskDefinition(app, env, svc string) (*awsecs.TaskDefinition, error)
+	NetworkConfiguration(app, env, svc string) (*awsecs.NetworkConfiguration, error)
+	ClusterARN(app, env string) (string, error)
+	HasNonZeroExitCode(taskARNs []string, cluster string) error
+}
+
+type workloadStackReverter interface {
+	DeployedWorkload(app, env, name string) (cloudformation.StackConfiguration, error)
+	DeleteWorkload(in deploy.DeleteWorkloadInput) error
+}
+
+type taskStackDeployer interface {
+	DeployTask(input *deploy.CreateTaskResourcesInput, opts ...awscloudformation.StackOption) error
+}
+
+type taskStarter interface {
+	RunTask(input awsecs.RunTaskInput) ([]*awsecs.Task, error)
+	DefaultCluster() (string, error)
+	StopTasks(tasks []string, opts ...awsecs.StopTasksOpts) error
+}
+
+type taskLogWriter interface {
+	WriteEventsUntilStopped() error
+}
+
+// preDeployTask returns the one-off task to run before the service is updated.
+func preDeployTask(mft interface{}) manifest.PreDeployTask {
+	type preDeployTaskProvider interface {
+		PreDeployTask() manifest.PreDeployTask
+	}
+	if mft, ok := mft.(preDeployTaskProvider); ok {
+		return mft.PreDeployTask()
+	}
+	return manifest.PreDeployTask{}
+}
+
+// runPreDeployTask runs the pre-deployment task of the service with the new image, and the task role, execution role,
+// environment variables, secrets and network settings of the new version of the service.
+// It streams the logs of the task and returns an error if the task exits with a non-zero exit code or times out,
+// in which case the service stack is reverted to the version that was deployed before the task.
+func (d *svcDeployer) runPreDeployTask(in *DeployWorkloadInput, conf cloudformation.StackConfiguration) error {
+	cfg := preDeployTask(d.mft)
+	if cfg.IsEmpty() {
+		return nil
+	}
+	image, err := d.preDeployTaskImage(cfg, in.ImageDigests)
+	if err != nil {
+		return err
+	}
+	command, err := cfg.Command.ToStringSlice()
+	if err != nil {
+		return fmt.Errorf("convert pre-deployment task command to string slice: %w", err)
+	}
+	previous, err := d.registerPreDeployTaskDefinition(in, conf)
+	if err != nil {
+		return err
+	}
+	if err := d.runRegisteredPreDeployTask(cfg, image, command); err != nil {
+		return d.revertPreDeployTaskDefinition(in, previous, err)
+	}
+	return nil
+}
+
+// runRegisteredPreDeployTask runs the pre-deployment task with the task definition that was registered for it.
+func (d *svcDeployer) runRegisteredPreDeployTask(cfg manifest.PreDeployTask, image string, command []string) error {
+	taskDef, err := d.taskDescriber.TaskDefinition(d.app.Name, d.env.Name, d.name)
+	if err != nil {
+		return fmt.Errorf("describe the new task definition of service %q: %w", d.name, err)
+	}
+	network, err := d.taskDescriber.NetworkConfiguration(d.app.Name, d.env.Name, d.name)
+	if err != nil {
+		return fmt.Errorf("get network configuration of service %q: %w", d.name, err)
+	}
+	cluster, err := d.taskDescriber.ClusterARN(d.app.Name, d.env.Name)
+	if err != nil {
+		return fmt.Errorf("get cluster of environment %q: %w", d.env.Name, err)
+	}
+
+	groupName := fmt.Sprintf(fmtPreDeployTaskGroupName, d.app.Name, d.env.Name, d.name)
+	input, err := d.preDeployTaskResourcesInput(groupName, image, command, taskDef)
+	if err != nil {
+		return err
+	}
+	if err := d.taskDeployer.DeployTask(input, awscloudformation.WithRoleARN(d.env.ExecutionRoleARN)); err != nil {
+		return fmt.Errorf("provision resources for pre-deployment task %s: %w", groupName, err)
+	}
+
+	runner := &task.ConfigRunner{
+		Count:     1,
+		GroupName: groupName,
+
+		Cluster:        cluster,
+		Subnets:        network.Subnets,
+		SecurityGroups: network.SecurityGroups,
+		OS:             input.OS,
+
+		ClusterGetter:         d.taskStarter,
+		Starter:               d.taskStarter,
+		NonZeroExitCodeGetter: d.taskDescriber,
+	}
+	d.spinner.Start(fmt.Sprintf("Waiting for pre-deployment task %s to be running.", groupName))
+	tasks, err := runner.Run()
+	if err != nil {
+		d.spinner.Stop(log.Serrorf("Failed to run pre-deployment task %s.\n\n", groupName))
+		return fmt.Errorf("run pre-deployment task %s: %w", groupName, err)
+	}
+	d.spinner.Stop(log.Ssuccessf("Pre-deployment task %s is running.\n\n", groupName))
+
+	timeout := defaultPreDeployTaskTimeout
+	if cfg.Timeout != nil {
+		timeout = *cfg.Timeout
+	}
+	if err := d.waitForPreDeployTask(groupName, tasks, cluster, timeout); err != nil {
+		return err
+	}
+	if err := runner.CheckNonZeroExitCode(tasks); err != nil {
+		return fmt.Errorf("pre-deployment task %s failed, aborting the deployment of %q: %w", groupName, d.name, err)
+	}
+	log.Successf("Pre-deployment task %s completed.\n", groupName)
+	return nil
+}
+
+// registerPreDeployTaskDefinition deploys the new template of the service while the ECS service keeps running
+// the task definition that it already runs, or no tasks at all if the service is deployed for the first time.
+// Afterwards, the new task definition, along with its roles, secrets and addons, is registered for the pre-deployment task
+// but the service has not rolled out the new version yet.
+// It returns the configuration of the service stack that was deployed before, or nil if there was none.
+func (d *svcDeployer) registerPreDeployTaskDefinition(in *DeployWorkloadInput, conf cloudformation.StackConfiguration) (cloudformation.StackConfiguration, error) {
+	pin := &preDeployServicePin{}
+	previous, err := d.stackReverter.DeployedWorkload(d.app.Name, d.env.Name, d.name)
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if !errors.As(err, &errNotFound) {
+			return nil, fmt.Errorf("retrieve the deployed stack of %q: %w", d.name, err)
+		}
+	} else {
+		svc, err := d.taskDescriber.Service(d.app.Name, d.env.Name, d.name)
+		if err != nil {
+			return nil, fmt.Errorf("describe the deployed service %q: %w", d.name, err)
+		}
+		pin.taskDefARN = aws.StringValue(svc.TaskDefinition)
+	}
+	opts := []awscloudformation.StackOption{
+		awscloudformation.WithRoleARN(d.env.ExecutionRoleARN),
+	}
+	if in.DisableRollback {
+		opts = append(opts, awscloudformation.WithDisableRollback())
+	}
+	log.Infof("Registering the new task definition of %q before running its pre-deployment task.\n", d.name)
+	if err := d.deployer.DeployService(cloudformation.WrapWithTemplateOverrider(conf, pin), d.resources.S3Bucket, false, opts...); err != nil {
+		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
+		if !errors.As(err, &errEmptyCS) {
+			return nil, fmt.Errorf("register the new task definition of %q: %w", d.name, err)
+		}
+	}
+	return previous, nil
+}
+
+// revertPreDeployTaskDefinition undoes the deployment that registered the task definition of a failed pre-deployment task,
+// so that none of the new resources of the service, such as its roles, secrets, and addons, stay live.
+// The previous template of the service stack is deployed again, or the stack is deleted if the service was deployed for the first time.
+// The stack is left as is if rollback is disabled.
+func (d *svcDeployer) revertPreDeployTaskDefinition(in *DeployWorkloadInput, previous cloudformation.StackConfiguration, taskErr error) error {
+	if in.DisableRollback {
+		log.Warningf("Rollback is disabled, the stack of %q keeps the resources deployed for its pre-deployment task.\n", d.name)
+		return taskErr
+	}
+	if previous == nil {
+		log.Infof("Deleting the stack of %q as its pre-deployment task failed on its first deployment.\n", d.name)
+		if err := d.stackReverter.DeleteWorkload(deploy.DeleteWorkloadInput{
+			Name:             d.name,
+			EnvName:          d.env.Name,
+			AppName:          d.app.Name,
+			ExecutionRoleARN: d.env.ExecutionRoleARN,
+		}); err != nil {
+			return fmt.Errorf("%w: delete the stack of %q: %v", taskErr, d.name, err)
+		}
+		return taskErr
+	}
+	log.Infof("Reverting %q to the version deployed before its pre-deployment task.\n", d.name)
+	if err := d.deployer.DeployService(previous, d.resources.S3Bucket, false, awscloudformation.WithRoleARN(d.env.ExecutionRoleARN)); err != nil {
+		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
+		if !errors.As(err, &errEmptyCS) {
+			return fmt.Errorf("%w: revert %q: %v", taskErr, d.name, err)
+		}
+	}
+	return taskErr
+}
+
+// preDeployServicePin overrides the template of a service so that its ECS service runs the task definition taskDefARN,
+// or no tasks if taskDefARN is empty.
+type preDeployServicePin struct {
+	taskDefARN string
+}
+
+// Override implements the cloudformation.Overrider interface.
+func (p *preDeployServicePin) Override(body []byte) ([]byte, error) {
+	if p.taskDefARN != "" {
+		return override.CloudFormationTemplate([]override.Rule{
+			{
+				Path:  "Resources.Service.Properties.TaskDefinition",
+				Value: yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: p.taskDefARN},
+			},
+		}, body)
+	}
+	var tpl struct {
+		Resources map[string]yaml.Node `yaml:"Resources"`
+	}
+	if err := yaml.Unmarshal(body, &tpl); err != nil {
+		return nil, fmt.Errorf("unmarshal template: %w", err)
+	}
+	zero := yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: "0"}
+	rules := []override.Rule{
+		{
+			Path:  "Resources.Service.Properties.DesiredCount",
+			Value: zero,
+		},
+	}
+	if _, ok := tpl.Resources["AutoScalingTarget"]; ok {
+		// Otherwise, Application Auto Scaling would scale the service out to its minimum capacity.
+		rules = append(rules, override.Rule{
+			Path:  "Resources.AutoScalingTarget.Properties.MinCapacity",
+			Value: zero,
+		})
+	}
+	return override.CloudFormationTemplate(rules, body)
+}
+
+// preDeployTaskImage returns the image override of the pre-deployment task if there is one,
+// otherwise the new image of the main container.
+func (d *svcDeployer) preDeployTaskImage(cfg manifest.PreDeployTask, images map[string]ContainerImageIdentifier) (string, error) {
+	if cfg.Image != nil {
+		return aws.StringValue(cfg.Image), nil
+	}
+	if ref, ok := d.pushedImageRefs(images)[d.name]; ok {
+		return ref, nil
+	}
+	if location := d.imageLocations()[d.name]; location != "" {
+		return location, nil
+	}
+	return "", fmt.Errorf(`cannot determine the image of the pre-deployment task for %q, please specify "image"`, d.name)
+}
+
+func (d *svcDeployer) preDeployTaskResourcesInput(groupName, image string, command []string, taskDef *awsecs.TaskDefinition) (*deploy.CreateTaskResourcesInput, error) {
+	cpu, err := strconv.Atoi(aws.StringValue(taskDef.Cpu))
+	if err != nil {
+		return nil, fmt.Errorf("parse CPU %q of the service %q: %w", aws.StringValue(taskDef.Cpu), d.name, err)
+	}
+	memory, err := strconv.Atoi(aws.StringValue(taskDef.Memory))
+	if err != nil {
+		return nil, fmt.Errorf("parse memory %q of the service %q: %w", aws.StringValue(taskDef.Memory), d.name, err)
+	}
+	envVars := make(map[string]string)
+	for _, envVar := range taskDef.EnvironmentVariables() {
+		if envVar.Container == d.name {
+			envVars[envVar.Name] = envVar.Value
+		}
+	}
+	ssmParamSecrets, secretsManagerSecrets := make(map[string]string), make(map[string]string)
+	for _, secret := range taskDef.Secrets() {
+		if secret.Container != d.name {
+			continue
+		}
+		if template.IsARNFunc(secret.ValueFrom) && strings.Contains(secret.ValueFrom, ":secretsmanager:") {
+			secretsManagerSecrets[secret.Name] = secret.ValueFrom
+			continue
+		}
+		ssmParamSecrets[secret.Name] = secret.ValueFrom
+	}
+	input := &deploy.CreateTaskResourcesInput{
+		Name:                  groupName,
+		CPU:                   cpu,
+		Memory:                memory,
+		Image:                 image,
+		PermissionsBoundary:   d.app.PermissionsBoundary,
+		TaskRole:              aws.StringValue(taskDef.TaskRoleArn),
+		ExecutionRole:         aws.StringValue(taskDef.ExecutionRoleArn),
+		Command:               command,
+		EnvVars:               envVars,
+		SSMParamSecrets:       ssmParamSecrets,
+		SecretsManagerSecrets: secretsManagerSecrets,
+		App:                   d.app.Name,
+		Env:                   d.env.Name,
+	}
+	if platform := taskDef.Platform(); platform != nil {
+		input.OS, input.Arch = platform.OperatingSystem, platform.Architecture
+	}
+	return input, nil
+}
+
+// waitForPreDeployTask streams the logs of the tasks until they stop.
+// The tasks are stopped if they are still running after the timeout.
+func (d *svcDeployer) waitForPreDeployTask(groupName string, tasks []*task.Task, cluster string, timeout time.Duration) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- d.newTaskLogWriter(groupName, tasks).WriteEventsUntilStopped()
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("write log events for pre-deployment task %s: %w", groupName, err)
+		}
+		return nil
+	case <-time.After(timeout):
+		taskARNs := make([]string, len(tasks))
+		for i, t := range tasks {
+			taskARNs[i] = t.TaskARN
+		}
+		if err := d.taskStarter.StopTasks(taskARNs, awsecs.WithStopTaskCluster(cluster), awsecs.WithStopTaskReason(preDeployTaskStopReason)); err != nil {
+			return fmt.Errorf("stop pre-deployment task %s after it timed out: %w", groupName, err)
+		}
+		return fmt.Errorf("pre-deployment task %s did not complete within %s, aborting the deployment of %q", groupName, timeout, d.name)
+	}
+}
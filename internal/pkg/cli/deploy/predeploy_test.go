@@ -0,0 +1,438 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	cfnmocks "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type taskLogWriterDouble struct {
+	WriteEventsUntilStoppedFn func() error
+}
+
+func (d *taskLogWriterDouble) WriteEventsUntilStopped() error {
+	return d.WriteEventsUntilStoppedFn()
+}
+
+type preDeployTaskMocks struct {
+	stackReverter *mocks.MockworkloadStackReverter
+	deployer      *mocks.MockserviceDeployer
+	conf          *cfnmocks.MockStackConfiguration
+	previous      *cfnmocks.MockStackConfiguration
+	taskDescriber *mocks.MockserviceTaskDescriber
+	taskDeployer  *mocks.MocktaskStackDeployer
+	taskStarter   *mocks.MocktaskStarter
+	spinner       *mocks.Mockspinner
+}
+
+func TestSvcDeployer_runPreDeployTask(t *testing.T) {
+	const (
+		mockApp       = "phonetool"
+		mockEnv       = "test"
+		mockSvc       = "api"
+		mockCluster   = "arn:aws:ecs:us-west-2:123456789012:cluster/phonetool-test"
+		mockTaskARN   = "arn:aws:ecs:us-west-2:123456789012:task/phonetool-test/abc"
+		mockGroupName = "phonetool-test-api-predeploy"
+		mockBucket    = "mockBucket"
+		mockOldARN    = "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:1"
+		mockExecRole  = "arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole"
+		mockTemplate  = `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      TaskDefinition: !Ref TaskDefinition
+      DesiredCount: !GetAtt DynamicDesiredCountAction.DesiredCount
+  AutoScalingTarget:
+    Type: AWS::ApplicationAutoScaling::ScalableTarget
+    Properties:
+      MinCapacity: 1
+`
+	)
+	mockTaskDef := &ecs.TaskDefinition{
+		Cpu:              aws.String("256"),
+		Memory:           aws.String("512"),
+		TaskRoleArn:      aws.String("mockTaskRole"),
+		ExecutionRoleArn: aws.String("mockExecutionRole"),
+		RuntimePlatform: &sdkecs.RuntimePlatform{
+			OperatingSystemFamily: aws.String("LINUX"),
+			CpuArchitecture:       aws.String("ARM64"),
+		},
+		ContainerDefinitions: []*sdkecs.ContainerDefinition{
+			{
+				Name: aws.String(mockSvc),
+				Environment: []*sdkecs.KeyValuePair{
+					{Name: aws.String("COPILOT_ENVIRONMENT_NAME"), Value: aws.String(mockEnv)},
+				},
+				Secrets: []*sdkecs.Secret{
+					{Name: aws.String("DB_PASSWORD"), ValueFrom: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:db")},
+					{Name: aws.String("API_KEY"), ValueFrom: aws.String("/copilot/phonetool/test/secrets/api_key")},
+				},
+			},
+			{
+				Name: aws.String("nginx"),
+				Environment: []*sdkecs.KeyValuePair{
+					{Name: aws.String("SIDECAR_ONLY"), Value: aws.String("true")},
+				},
+			},
+		},
+	}
+	mockNetwork := &ecs.NetworkConfiguration{
+		Subnets:        []string{"subnet-1", "subnet-2"},
+		SecurityGroups: []string{"sg-1"},
+	}
+	mockPreDeployTask := manifest.PreDeployTask{
+		Command: manifest.CommandOverride{String: aws.String("bin/migrate up")},
+	}
+	mockImages := map[string]ContainerImageIdentifier{
+		mockSvc: {Digest: "sha256:1234"},
+	}
+	mockTimeout := time.Millisecond
+	var gotTaskInput *deploy.CreateTaskResourcesInput
+	var gotTemplate string
+	mockRegisterTaskDef := func(m *preDeployTaskMocks) {
+		m.deployer.EXPECT().DeployService(gomock.Any(), mockBucket, false, gomock.Any()).
+			DoAndReturn(func(conf deploycfn.StackConfiguration, _ string, _ bool, _ ...cloudformation.StackOption) error {
+				m.conf.EXPECT().Template().Return(mockTemplate, nil)
+				tpl, err := conf.Template()
+				require.NoError(t, err)
+				gotTemplate = tpl
+				return nil
+			})
+	}
+	mockUpdate := func(m *preDeployTaskMocks) {
+		m.stackReverter.EXPECT().DeployedWorkload(mockApp, mockEnv, mockSvc).Return(m.previous, nil)
+		m.taskDescriber.EXPECT().Service(mockApp, mockEnv, mockSvc).Return(&ecs.Service{TaskDefinition: aws.String(mockOldARN)}, nil)
+		mockRegisterTaskDef(m)
+	}
+	mockRevert := func(m *preDeployTaskMocks) {
+		m.deployer.EXPECT().DeployService(m.previous, mockBucket, false, gomock.Any()).Return(nil)
+	}
+	mockRunTasks := func(m *preDeployTaskMocks) {
+		m.taskDescriber.EXPECT().TaskDefinition(mockApp, mockEnv, mockSvc).Return(mockTaskDef, nil)
+		m.taskDescriber.EXPECT().NetworkConfiguration(mockApp, mockEnv, mockSvc).Return(mockNetwork, nil)
+		m.taskDescriber.EXPECT().ClusterARN(mockApp, mockEnv).Return(mockCluster, nil)
+		m.taskDeployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(in *deploy.CreateTaskResourcesInput, _ ...cloudformation.StackOption) error {
+				gotTaskInput = in
+				return nil
+			})
+		m.spinner.EXPECT().Start(gomock.Any())
+		m.taskStarter.EXPECT().RunTask(ecs.RunTaskInput{
+			Cluster:         mockCluster,
+			Count:           1,
+			Subnets:         []string{"subnet-1", "subnet-2"},
+			SecurityGroups:  []string{"sg-1"},
+			TaskFamilyName:  "copilot-" + mockGroupName,
+			StartedBy:       "copilot-task",
+			PlatformVersion: "LATEST",
+			EnableExec:      true,
+		}).Return([]*ecs.Task{{TaskArn: aws.String(mockTaskARN)}}, nil)
+		m.spinner.EXPECT().Stop(gomock.Any())
+	}
+
+	testCases := map[string]struct {
+		preDeployTask   manifest.PreDeployTask
+		images          map[string]ContainerImageIdentifier
+		disableRollback bool
+		writeEventsFn   func() error
+		setupMocks      func(m *preDeployTaskMocks)
+		wantedTemplate  string
+		wantedTaskInput *deploy.CreateTaskResourcesInput
+		wantedErr       string
+	}{
+		"do nothing if there is no pre-deployment task": {
+			setupMocks: func(m *preDeployTaskMocks) {},
+		},
+		"run the task before the service scales up if the service is not deployed yet": {
+			preDeployTask: mockPreDeployTask,
+			images:        mockImages,
+			writeEventsFn: func() error { return nil },
+			setupMocks: func(m *preDeployTaskMocks) {
+				m.stackReverter.EXPECT().DeployedWorkload(mockApp, mockEnv, mockSvc).Return(nil, &cloudformation.ErrStackNotFound{})
+				mockRegisterTaskDef(m)
+				mockRunTasks(m)
+				m.taskDescriber.EXPECT().HasNonZeroExitCode([]string{mockTaskARN}, mockCluster).Return(nil)
+			},
+			wantedTemplate: `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      TaskDefinition: !Ref TaskDefinition
+      DesiredCount: 0
+  AutoScalingTarget:
+    Type: AWS::ApplicationAutoScaling::ScalableTarget
+    Properties:
+      MinCapacity: 0
+`,
+		},
+		"error if the deployed stack cannot be retrieved": {
+			preDeployTask: mockPreDeployTask,
+			images:        mockImages,
+			setupMocks: func(m *preDeployTaskMocks) {
+				m.stackReverter.EXPECT().DeployedWorkload(mockApp, mockEnv, mockSvc).Return(nil, errors.New("some error"))
+			},
+			wantedErr: `retrieve the deployed stack of "api": some error`,
+		},
+		"error if the deployed service cannot be described": {
+			preDeployTask: mockPreDeployTask,
+			images:        mockImages,
+			setupMocks: func(m *preDeployTaskMocks) {
+				m.stackReverter.EXPECT().DeployedWorkload(mockApp, mockEnv, mockSvc).Return(m.previous, nil)
+				m.taskDescriber.EXPECT().Service(mockApp, mockEnv, mockSvc).Return(nil, errors.New("some error"))
+			},
+			wantedErr: `describe the deployed service "api": some error`,
+		},
+		"error if the new task definition cannot be registered": {
+			preDeployTask: mockPreDeployTask,
+			images:        mockImages,
+			setupMocks: func(m *preDeployTaskMocks) {
+				m.stackReverter.EXPECT().DeployedWorkload(mockApp, mockEnv, mockSvc).Return(m.previous, nil)
+				m.taskDescriber.EXPECT().Service(mockApp, mockEnv, mockSvc).Return(&ecs.Service{TaskDefinition: aws.String(mockOldARN)}, nil)
+				m.deployer.EXPECT().DeployService(gomock.Any(), mockBucket, false, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: `register the new task definition of "api": some error`,
+		},
+		"revert the service and error if the new task definition cannot be described": {
+			preDeployTask: mockPreDeployTask,
+			images:        mockImages,
+			setupMocks: func(m *preDeployTaskMocks) {
+				mockUpdate(m)
+				m.taskDescriber.EXPECT().TaskDefinition(mockApp, mockEnv, mockSvc).Return(nil, errors.New("some error"))
+				mockRevert(m)
+			},
+			wantedErr: `describe the new task definition of service "api": some error`,
+		},
+		"error if the image cannot be determined": {
+			preDeployTask: mockPreDeployTask,
+			setupMocks:    func(m *preDeployTaskMocks) {},
+			wantedErr:     `cannot determine the image of the pre-deployment task for "api", please specify "image"`,
+		},
+		"revert the service and abort the deployment if the task exits with a non-zero exit code": {
+			preDeployTask: mockPreDeployTask,
+			images:        mockImages,
+			writeEventsFn: func() error { return nil },
+			setupMocks: func(m *preDeployTaskMocks) {
+				mockUpdate(m)
+				mockRunTasks(m)
+				m.taskDescriber.EXPECT().HasNonZeroExitCode([]string{mockTaskARN}, mockCluster).Return(errors.New("container api exited with status code 1"))
+				mockRevert(m)
+			},
+			wantedErr: `pre-deployment task phonetool-test-api-predeploy failed, aborting the deployment of "api": container api exited with status code 1`,
+		},
+		"ignore an empty change set when reverting the service": {
+			preDeployTask: mockPreDeployTask,
+			images:        mockImages,
+			writeEventsFn: func() error { return nil },
+			setupMocks: func(m *preDeployTaskMocks) {
+				mockUpdate(m)
+				mockRunTasks(m)
+				m.taskDescriber.EXPECT().HasNonZeroExitCode([]string{mockTaskARN}, mockCluster).Return(errors.New("container api exited with status code 1"))
+				m.deployer.EXPECT().DeployService(m.previous, mockBucket, false, gomock.Any()).Return(&cloudformation.ErrChangeSetEmpty{})
+			},
+			wantedErr: `pre-deployment task phonetool-test-api-predeploy failed, aborting the deployment of "api": container api exited with status code 1`,
+		},
+		"wrap the error if the service cannot be reverted": {
+			preDeployTask: mockPreDeployTask,
+			images:        mockImages,
+			writeEventsFn: func() error { return nil },
+			setupMocks: func(m *preDeployTaskMocks) {
+				mockUpdate(m)
+				mockRunTasks(m)
+				m.taskDescriber.EXPECT().HasNonZeroExitCode([]string{mockTaskARN}, mockCluster).Return(errors.New("container api exited with status code 1"))
+				m.deployer.EXPECT().DeployService(m.previous, mockBucket, false, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: `pre-deployment task phonetool-test-api-predeploy failed, aborting the deployment of "api": container api exited with status code 1: revert "api": some error`,
+		},
+		"keep the stack if rollback is disabled": {
+			preDeployTask:   mockPreDeployTask,
+			images:          mockImages,
+			disableRollback: true,
+			writeEventsFn:   func() error { return nil },
+			setupMocks: func(m *preDeployTaskMocks) {
+				mockUpdate(m)
+				mockRunTasks(m)
+				m.taskDescriber.EXPECT().HasNonZeroExitCode([]string{mockTaskARN}, mockCluster).Return(errors.New("container api exited with status code 1"))
+			},
+			wantedErr: `pre-deployment task phonetool-test-api-predeploy failed, aborting the deployment of "api": container api exited with status code 1`,
+		},
+		"delete the stack if the task fails on the first deployment of the service": {
+			preDeployTask: mockPreDeployTask,
+			images:        mockImages,
+			writeEventsFn: func() error { return nil },
+			setupMocks: func(m *preDeployTaskMocks) {
+				m.stackReverter.EXPECT().DeployedWorkload(mockApp, mockEnv, mockSvc).Return(nil, &cloudformation.ErrStackNotFound{})
+				mockRegisterTaskDef(m)
+				mockRunTasks(m)
+				m.taskDescriber.EXPECT().HasNonZeroExitCode([]string{mockTaskARN}, mockCluster).Return(errors.New("container api exited with status code 1"))
+				m.stackReverter.EXPECT().DeleteWorkload(deploy.DeleteWorkloadInput{
+					Name:             mockSvc,
+					EnvName:          mockEnv,
+					AppName:          mockApp,
+					ExecutionRoleARN: mockExecRole,
+				}).Return(nil)
+			},
+			wantedErr: `pre-deployment task phonetool-test-api-predeploy failed, aborting the deployment of "api": container api exited with status code 1`,
+		},
+		"stop the task and abort the deployment if the task times out": {
+			preDeployTask: manifest.PreDeployTask{
+				Command: manifest.CommandOverride{String: aws.String("bin/migrate up")},
+				Timeout: &mockTimeout,
+			},
+			images: mockImages,
+			writeEventsFn: func() error {
+				time.Sleep(time.Second)
+				return nil
+			},
+			setupMocks: func(m *preDeployTaskMocks) {
+				mockUpdate(m)
+				mockRunTasks(m)
+				m.taskStarter.EXPECT().StopTasks([]string{mockTaskARN}, gomock.Any(), gomock.Any()).Return(nil)
+				mockRevert(m)
+			},
+			wantedErr: `pre-deployment task phonetool-test-api-predeploy did not complete within 1ms, aborting the deployment of "api"`,
+		},
+		"run the task with the new image and the settings of the new task definition": {
+			preDeployTask: mockPreDeployTask,
+			images:        mockImages,
+			writeEventsFn: func() error { return nil },
+			setupMocks: func(m *preDeployTaskMocks) {
+				mockUpdate(m)
+				mockRunTasks(m)
+				m.taskDescriber.EXPECT().HasNonZeroExitCode([]string{mockTaskARN}, mockCluster).Return(nil)
+			},
+			wantedTemplate: `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      TaskDefinition: arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:1
+      DesiredCount: !GetAtt DynamicDesiredCountAction.DesiredCount
+  AutoScalingTarget:
+    Type: AWS::ApplicationAutoScaling::ScalableTarget
+    Properties:
+      MinCapacity: 1
+`,
+			wantedTaskInput: &deploy.CreateTaskResourcesInput{
+				Name:          mockGroupName,
+				CPU:           256,
+				Memory:        512,
+				Image:         "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:1234",
+				TaskRole:      "mockTaskRole",
+				ExecutionRole: "mockExecutionRole",
+				Command:       []string{"bin/migrate", "up"},
+				EnvVars: map[string]string{
+					"COPILOT_ENVIRONMENT_NAME": mockEnv,
+				},
+				SSMParamSecrets: map[string]string{
+					"API_KEY": "/copilot/phonetool/test/secrets/api_key",
+				},
+				SecretsManagerSecrets: map[string]string{
+					"DB_PASSWORD": "arn:aws:secretsmanager:us-west-2:123456789012:secret:db",
+				},
+				OS:   "LINUX",
+				Arch: "ARM64",
+				App:  mockApp,
+				Env:  mockEnv,
+			},
+		},
+		"run the task with the image override": {
+			preDeployTask: manifest.PreDeployTask{
+				Command: manifest.CommandOverride{StringSlice: []string{"bin/migrate", "up"}},
+				Image:   aws.String("public.ecr.aws/phonetool/migrations:latest"),
+			},
+			images:        mockImages,
+			writeEventsFn: func() error { return nil },
+			setupMocks: func(m *preDeployTaskMocks) {
+				mockUpdate(m)
+				mockRunTasks(m)
+				m.taskDescriber.EXPECT().HasNonZeroExitCode([]string{mockTaskARN}, mockCluster).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &preDeployTaskMocks{
+				stackReverter: mocks.NewMockworkloadStackReverter(ctrl),
+				deployer:      mocks.NewMockserviceDeployer(ctrl),
+				conf:          cfnmocks.NewMockStackConfiguration(ctrl),
+				previous:      cfnmocks.NewMockStackConfiguration(ctrl),
+				taskDescriber: mocks.NewMockserviceTaskDescriber(ctrl),
+				taskDeployer:  mocks.NewMocktaskStackDeployer(ctrl),
+				taskStarter:   mocks.NewMocktaskStarter(ctrl),
+				spinner:       mocks.NewMockspinner(ctrl),
+			}
+			gotTaskInput, gotTemplate = nil, ""
+			tc.setupMocks(m)
+			deployer := &svcDeployer{
+				workloadDeployer: &workloadDeployer{
+					name: mockSvc,
+					app:  &config.Application{Name: mockApp},
+					env:  &config.Environment{Name: mockEnv, ExecutionRoleARN: mockExecRole},
+					resources: &stack.AppRegionalResources{
+						S3Bucket: mockBucket,
+						RepositoryURLs: map[string]string{
+							mockSvc: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api",
+						},
+					},
+					mft: &manifest.BackendService{
+						BackendServiceConfig: manifest.BackendServiceConfig{
+							DeployConfig: manifest.DeploymentConfig{
+								PreDeployTask: tc.preDeployTask,
+							},
+						},
+					},
+					deployer: m.deployer,
+					spinner:  m.spinner,
+				},
+				taskDescriber: m.taskDescriber,
+				stackReverter: m.stackReverter,
+				taskDeployer:  m.taskDeployer,
+				taskStarter:   m.taskStarter,
+				newTaskLogWriter: func(groupName string, tasks []*task.Task) taskLogWriter {
+					require.Equal(t, mockGroupName, groupName)
+					return &taskLogWriterDouble{WriteEventsUntilStoppedFn: tc.writeEventsFn}
+				},
+			}
+
+			err := deployer.runPreDeployTask(&DeployWorkloadInput{
+				StackRuntimeConfiguration: StackRuntimeConfiguration{
+					ImageDigests: tc.images,
+				},
+				Options: Options{
+					DisableRollback: tc.disableRollback,
+				},
+			}, m.conf)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantedTemplate != "" {
+				require.Equal(t, tc.wantedTemplate, gotTemplate)
+			}
+			if tc.wantedTaskInput != nil {
+				require.Equal(t, tc.wantedTaskInput, gotTaskInput)
+			}
+		})
+	}
+}
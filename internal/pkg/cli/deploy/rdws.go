@@ -112,7 +112,7 @@ func (d *rdwsDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecommende
 	if err != nil {
 		return nil, err
 	}
-	if err := d.deploy(in.Options, stackConfigOutput.svcStackConfigurationOutput); err != nil {
+	if err := d.deploy(in, stackConfigOutput.svcStackConfigurationOutput); err != nil {
 		return nil, err
 	}
 	return &rdwsDeployOutput{
@@ -57,7 +57,7 @@ func NewStaticSiteDeployer(in *WorkloadDeployerInput) (*staticSiteDeployer, erro
 	if !ok {
 		return nil, fmt.Errorf("manifest is not of type %s", manifestinfo.StaticSiteType)
 	}
-	ws, err := workspace.Use(svcDeployer.fs)
+	ws, err := workspace.UseApp(in.App.Name, svcDeployer.fs)
 	if err != nil {
 		return nil, err
 	}
@@ -111,22 +111,23 @@ func (d *staticSiteDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionReco
 	if err != nil {
 		return nil, err
 	}
-	if err := d.deploy(in.Options, svcStackConfigurationOutput{conf: conf}); err != nil {
+	if err := d.deploy(in, svcStackConfigurationOutput{conf: conf}); err != nil {
 		return nil, err
 	}
 	return noopActionRecommender{}, nil
 }
 
-func (d *staticSiteDeployer) deploy(deployOptions Options, stackConfigOutput svcStackConfigurationOutput) error {
+func (d *staticSiteDeployer) deploy(in *DeployWorkloadInput, stackConfigOutput svcStackConfigurationOutput) error {
 	opts := []awscloudformation.StackOption{
 		awscloudformation.WithRoleARN(d.env.ExecutionRoleARN),
 	}
-	if deployOptions.DisableRollback {
+	if in.DisableRollback {
 		opts = append(opts, awscloudformation.WithDisableRollback())
 	}
-	if err := d.deployer.DeployService(stackConfigOutput.conf, d.resources.S3Bucket, deployOptions.Detach, opts...); err != nil {
+	if err := d.deployer.DeployService(stackConfigOutput.conf, d.resources.S3Bucket, in.Detach, opts...); err != nil {
 		return fmt.Errorf("deploy service: %w", err)
 	}
+	d.recordDeployment(in, stackConfigOutput.conf)
 	return nil
 }
 
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"regexp"
 	"time"
 
@@ -15,8 +16,12 @@ import (
 	"golang.org/x/mod/semver"
 
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/task"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 )
@@ -42,6 +47,13 @@ type svcDeployer struct {
 	*workloadDeployer
 	newSvcUpdater func(func(*session.Session) serviceForceUpdater) serviceForceUpdater
 	now           func() time.Time
+
+	// Dependencies to run the pre-deployment task.
+	taskDescriber    serviceTaskDescriber
+	stackReverter    workloadStackReverter
+	taskDeployer     taskStackDeployer
+	taskStarter      taskStarter
+	newTaskLogWriter func(groupName string, tasks []*task.Task) taskLogWriter
 }
 
 func newSvcDeployer(in *WorkloadDeployerInput) (*svcDeployer, error) {
@@ -54,30 +66,37 @@ func newSvcDeployer(in *WorkloadDeployerInput) (*svcDeployer, error) {
 		newSvcUpdater: func(f func(*session.Session) serviceForceUpdater) serviceForceUpdater {
 			return f(wkldDeployer.envSess)
 		},
-		now: time.Now,
+		now:           time.Now,
+		taskDescriber: ecs.New(wkldDeployer.envSess),
+		stackReverter: cloudformation.New(wkldDeployer.envSess, cloudformation.WithProgressTracker(os.Stderr)),
+		taskDeployer:  cloudformation.New(wkldDeployer.envSess, cloudformation.WithProgressTracker(os.Stderr)),
+		taskStarter:   awsecs.New(wkldDeployer.envSess),
+		newTaskLogWriter: func(groupName string, tasks []*task.Task) taskLogWriter {
+			return logging.NewTaskClient(wkldDeployer.envSess, groupName, tasks)
+		},
 	}, nil
 }
 
-func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackConfigurationOutput) error {
+func (d *svcDeployer) deploy(in *DeployWorkloadInput, stackConfigOutput svcStackConfigurationOutput) error {
 	opts := []awscloudformation.StackOption{
 		awscloudformation.WithRoleARN(d.env.ExecutionRoleARN),
 	}
-	if deployOptions.DisableRollback {
+	if in.DisableRollback {
 		opts = append(opts, awscloudformation.WithDisableRollback())
 	}
 	cmdRunAt := d.now()
-	if err := d.deployer.DeployService(stackConfigOutput.conf, d.resources.S3Bucket, deployOptions.Detach, opts...); err != nil {
+	if err := d.deployer.DeployService(stackConfigOutput.conf, d.resources.S3Bucket, in.Detach, opts...); err != nil {
 		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
 		if !errors.As(err, &errEmptyCS) {
 			return fmt.Errorf("deploy service: %w", err)
 		}
-		if !deployOptions.ForceNewUpdate {
+		if !in.ForceNewUpdate {
 			log.Warningln("Set --force to force an update for the service.")
 			return fmt.Errorf("deploy service: %w", err)
 		}
 	}
 	// Force update the service if --force is set and the service is not updated by the CFN.
-	if deployOptions.ForceNewUpdate {
+	if in.ForceNewUpdate {
 		lastUpdatedAt, err := stackConfigOutput.svcUpdater.LastUpdatedAt(d.app.Name, d.env.Name, d.name)
 		if err != nil {
 			return fmt.Errorf("get the last updated deployment time for %s: %w", d.name, err)
@@ -91,6 +110,7 @@ func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackCo
 			}
 		}
 	}
+	d.recordDeployment(in, stackConfigOutput.conf)
 	return nil
 }
 
@@ -4,6 +4,7 @@
 package deploy
 
 import (
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -22,6 +24,8 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
@@ -37,7 +41,8 @@ type snsTopicsLister interface {
 
 type workerSvcDeployer struct {
 	*svcDeployer
-	wsMft *manifest.WorkerService
+	wsMft     *manifest.WorkerService
+	envAddons stackBuilder
 
 	// Overriden in tests.
 	topicLister snsTopicsLister
@@ -64,10 +69,24 @@ func NewWorkerSvcDeployer(in *WorkloadDeployerInput) (*workerSvcDeployer, error)
 	if !ok {
 		return nil, fmt.Errorf("manifest is not of type %s", manifestinfo.WorkerServiceType)
 	}
+	ws, err := workspace.UseApp(in.App.Name, afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	var envAddons stackBuilder
+	envAddons, err = addon.ParseFromEnv(ws)
+	if err != nil {
+		var notFoundErr *addon.ErrAddonsNotFound
+		if !errors.As(err, &notFoundErr) {
+			return nil, fmt.Errorf("parse addons stack for environments: %w", err)
+		}
+		envAddons = nil // so that we can check for no addons with nil comparison
+	}
 	return &workerSvcDeployer{
 		svcDeployer: svcDeployer,
 		topicLister: deployStore,
 		wsMft:       wsMft,
+		envAddons:   envAddons,
 	}, nil
 }
 
@@ -130,7 +149,10 @@ func (d *workerSvcDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecom
 	if err != nil {
 		return nil, err
 	}
-	if err := d.deploy(in.Options, stackConfigOutput.svcStackConfigurationOutput); err != nil {
+	if err := d.runPreDeployTask(in, stackConfigOutput.conf); err != nil {
+		return nil, err
+	}
+	if err := d.deploy(in, stackConfigOutput.svcStackConfigurationOutput); err != nil {
 		return nil, err
 	}
 	return &workerSvcDeployOutput{
@@ -188,6 +210,7 @@ func (d *workerSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*
 			ArtifactBucketName: d.resources.S3Bucket,
 			RuntimeConfig:      *rc,
 			Addons:             d.addons,
+			EnvAddons:          d.envAddons,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("create stack configuration: %w", err)
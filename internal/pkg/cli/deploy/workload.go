@@ -6,11 +6,13 @@ package deploy
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -18,20 +20,20 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/docker/signature"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
@@ -56,13 +58,27 @@ const (
 )
 const (
 	imageTagLatest = "latest"
+	imageTagCache  = "cache" // Tag of the registry cache exported by builds.
 )
 
+// retainedImageTagPatterns match the tags of the registry caches of the containers, including the cache of each platform,
+// and the tags of the signatures that cosign pushes next to the images, so that the lifecycle policy never expires them.
+var retainedImageTagPatterns = []string{
+	imageTagCache,
+	imageTagCache + "-*",
+	"*-" + imageTagCache,
+	"*-" + imageTagCache + "-*",
+	"sha256-*.sig",
+}
+
 const (
 	labelForBuilder       = "com.aws.copilot.image.builder"
 	labelForVersion       = "com.aws.copilot.image.version"
 	labelForContainerName = "com.aws.copilot.image.container.name"
 )
+const (
+	defaultImageScanTimeout = 10 * time.Minute
+)
 const (
 	paddingInSpacesForBuildAndPush = 5
 	pollIntervalForBuildAndPush    = 60 * time.Millisecond
@@ -86,6 +102,26 @@ type repositoryService interface {
 	Build(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) (string, error)
 }
 
+type imageRepositoryConfigurer interface {
+	PutLifecyclePolicy(repoName string, policy ecr.LifecyclePolicy) error
+	PutScanOnPush(repoName string, scanOnPush bool) error
+	ImageScanFindings(ctx context.Context, repoName, digest string) ([]ecr.ScanFinding, error)
+	PlatformDigests(repoName, digest string) ([]string, error)
+}
+
+type imageSigner interface {
+	Sign(ctx context.Context, tool signature.Tool, key, ref string) error
+	Verify(ctx context.Context, tool signature.Tool, key, ref string) error
+}
+
+type imageDigestResolver interface {
+	ImageDigest(ctx context.Context, ref string) (string, error)
+}
+
+type imageTagDigester interface {
+	TagDigest(registryID, repoName, tag string) (string, error)
+}
+
 type templater interface {
 	Template() (string, error)
 }
@@ -109,6 +145,10 @@ type serviceDeployer interface {
 	DeployService(conf cloudformation.StackConfiguration, bucketName string, detach bool, opts ...awscloudformation.StackOption) error
 }
 
+type deploymentRecorder interface {
+	Record(deployment *deploy.WorkloadDeployment) error
+}
+
 type deployedTemplateGetter interface {
 	Template(stackName string) (string, error)
 }
@@ -144,6 +184,13 @@ type StackRuntimeConfiguration struct {
 type DeployWorkloadInput struct {
 	StackRuntimeConfiguration
 	Options
+	Provenance
+}
+
+// Provenance identifies the origin of a deployment in the deployment history of the workload.
+type Provenance struct {
+	GitCommit  string
+	DeployedBy string // ARN of the IAM identity that deploys the workload.
 }
 
 // Options specifies options for the deployment.
@@ -184,7 +231,12 @@ type workloadDeployer struct {
 	s3Client           uploader
 	addons             stackBuilder
 	repository         repositoryService
+	registry           imageRepositoryConfigurer
+	signer             imageSigner
+	digestResolver     imageDigestResolver
+	registryInRegion   func(region string) (imageTagDigester, error)
 	deployer           serviceDeployer
+	history            deploymentRecorder
 	tmplGetter         deployedTemplateGetter
 	endpointGetter     endpointGetter
 	spinner            spinner
@@ -250,7 +302,7 @@ type ImageActionInput struct {
 
 // newWorkloadDeployer is the constructor for workloadDeployer.
 func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
-	ws, err := workspace.Use(afero.NewOsFs())
+	ws, err := workspace.UseApp(in.App.Name, afero.NewOsFs())
 	if err != nil {
 		return nil, err
 	}
@@ -282,9 +334,9 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 	}
 
 	repoName := RepoName(in.App.Name, in.Name)
-	repository := repository.NewWithURI(
-		ecr.New(defaultSessEnvRegion), repoName, resources.RepositoryURLs[in.Name])
-	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	registry := ecr.New(defaultSessEnvRegion)
+	repository := repository.NewWithURI(registry, repoName, resources.RepositoryURLs[in.Name])
+	store := config.NewStore(defaultSession)
 	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         in.App.Name,
 		Env:         in.Env.Name,
@@ -309,6 +361,13 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 		return syncbuffer.NewLabeledTermPrinter(fw, bufs, opts...)
 	}
 	docker := dockerengine.New(exec.NewCmd())
+	registryInRegion := func(region string) (imageTagDigester, error) {
+		sess, err := in.SessionProvider.DefaultWithRegion(region)
+		if err != nil {
+			return nil, fmt.Errorf("create default session with region %s: %w", region, err)
+		}
+		return ecr.New(sess), nil
+	}
 	return &workloadDeployer{
 		name:                     in.Name,
 		app:                      in.App,
@@ -320,7 +379,12 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 		s3Client:                 s3.New(envSession),
 		addons:                   addons,
 		repository:               repository,
+		registry:                 registry,
+		signer:                   signature.New(exec.NewCmd()),
+		digestResolver:           docker,
+		registryInRegion:         registryInRegion,
 		deployer:                 cfn,
+		history:                  deploy.NewDeploymentHistory(resources.S3Bucket, s3.New(envSession)),
 		tmplGetter:               cfn,
 		endpointGetter:           envDescriber,
 		spinner:                  termprogress.NewSpinner(log.DiagnosticWriter),
@@ -419,6 +483,20 @@ func (img ContainerImageIdentifier) Tag() string {
 }
 
 func (d *workloadDeployer) buildAndPushContainerImages(out *UploadArtifactsOutput) error {
+	repo := imageRepository(d.mft)
+	if err := d.configureImageRepository(repo); err != nil {
+		return err
+	}
+	if err := d.pushContainerImages(out); err != nil {
+		return err
+	}
+	if err := d.checkImageScanFindings(repo.Scan, out.ImageDigests); err != nil {
+		return err
+	}
+	return d.signContainerImages(imageSigning(d.mft), out.ImageDigests)
+}
+
+func (d *workloadDeployer) pushContainerImages(out *UploadArtifactsOutput) error {
 	return processContainerImages(&ImageActionInput{
 		Name:               d.name,
 		WorkspacePath:      d.workspacePath,
@@ -433,6 +511,237 @@ func (d *workloadDeployer) buildAndPushContainerImages(out *UploadArtifactsOutpu
 
 }
 
+// imageRepository returns the configuration of the workload's image repository, if the manifest supports it.
+func imageRepository(mft interface{}) manifest.ImageRepository {
+	type imageRepositoryProvider interface {
+		ImageRepository() manifest.ImageRepository
+	}
+	if mft, ok := mft.(imageRepositoryProvider); ok {
+		return mft.ImageRepository()
+	}
+	return manifest.ImageRepository{}
+}
+
+// configureImageRepository applies the lifecycle policy and scan settings of the manifest to the workload's repository.
+// Settings that are not in the manifest are left unchanged.
+func (d *workloadDeployer) configureImageRepository(repo manifest.ImageRepository) error {
+	repoName := RepoName(d.app.Name, d.name)
+	if !repo.Lifecycle.IsEmpty() {
+		if err := d.registry.PutLifecyclePolicy(repoName, ecr.LifecyclePolicy{
+			KeepLast:            aws.IntValue(repo.Lifecycle.KeepLast),
+			UntaggedExpiryDays:  aws.IntValue(repo.Lifecycle.UntaggedExpiryDays),
+			RetainedTagPatterns: retainedImageTagPatterns,
+		}); err != nil {
+			return fmt.Errorf("apply lifecycle policy to image repository: %w", err)
+		}
+	}
+	if scanOnPush := repo.Scan.ScanOnPush(); scanOnPush != nil {
+		if err := d.registry.PutScanOnPush(repoName, aws.BoolValue(scanOnPush)); err != nil {
+			return fmt.Errorf("configure scan on push for image repository: %w", err)
+		}
+	}
+	return nil
+}
+
+// checkImageScanFindings waits for the scan of the pushed images, and returns an error if any finding
+// is at least as severe as the threshold of the manifest.
+func (d *workloadDeployer) checkImageScanFindings(scan manifest.ImageScan, images map[string]ContainerImageIdentifier) error {
+	if scan.FailOn == nil || len(images) == 0 {
+		return nil
+	}
+	threshold := strings.ToUpper(aws.StringValue(scan.FailOn))
+	timeout := defaultImageScanTimeout
+	if scan.Timeout != nil {
+		timeout = *scan.Timeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	containers := make([]string, 0, len(images))
+	for name := range images {
+		containers = append(containers, name)
+	}
+	sort.Strings(containers)
+	repoName := RepoName(d.app.Name, d.name)
+	d.spinner.Start(fmt.Sprintf("Waiting for the vulnerability scan of the container images in repository %s", color.HighlightResource(repoName)))
+	var blocking []imageScanFinding
+	for _, container := range containers {
+		digest := images[container].Digest
+		if digest == "" {
+			continue
+		}
+		// The digest of a multi-platform image refers to a manifest list, which isn't scanned itself.
+		digests, err := d.registry.PlatformDigests(repoName, digest)
+		if err != nil {
+			d.spinner.Stop(log.Serrorf("Failed to get the scan findings of the container image %q.\n", container))
+			return fmt.Errorf("get the platform images of the image %q: %w", container, err)
+		}
+		for _, digest := range digests {
+			findings, err := d.registry.ImageScanFindings(ctx, repoName, digest)
+			if err != nil {
+				d.spinner.Stop(log.Serrorf("Failed to get the scan findings of the container image %q.\n", container))
+				return fmt.Errorf("get scan findings of the image %q: %w", container, err)
+			}
+			for _, finding := range findings {
+				if isAtLeastAsSevere(finding.Severity, threshold) {
+					blocking = append(blocking, imageScanFinding{
+						container:   container,
+						ScanFinding: finding,
+					})
+				}
+			}
+		}
+	}
+	if len(blocking) == 0 {
+		d.spinner.Stop(log.Ssuccessf("No vulnerabilities of severity %s or higher were found in the container images.\n", threshold))
+		return nil
+	}
+	d.spinner.Stop(log.Serrorf("Found vulnerabilities of severity %s or higher in the container images.\n", threshold))
+	return &errImageScanFindings{
+		threshold: threshold,
+		findings:  blocking,
+	}
+}
+
+// isAtLeastAsSevere returns true if severity ranks at or above threshold in ecr.FindingSeverities.
+func isAtLeastAsSevere(severity, threshold string) bool {
+	rank := slices.Index(ecr.FindingSeverities, severity)
+	return rank != -1 && rank <= slices.Index(ecr.FindingSeverities, threshold)
+}
+
+// imageSigning returns the configuration to sign the workload's images, if the manifest supports it.
+func imageSigning(mft interface{}) manifest.ImageSigning {
+	type imageSigningProvider interface {
+		ImageSigning() manifest.ImageSigning
+	}
+	if mft, ok := mft.(imageSigningProvider); ok {
+		return mft.ImageSigning()
+	}
+	return manifest.ImageSigning{}
+}
+
+// imageLocations returns the location of each container image that is not built by Copilot, keyed by container name.
+func (d *workloadDeployer) imageLocations() map[string]string {
+	type sidecarImageLocationsProvider interface {
+		SidecarImageLocations() map[string]string
+	}
+	type imageLocationProvider interface {
+		ImageLocation() string
+	}
+	locations := make(map[string]string)
+	if mft, ok := d.mft.(sidecarImageLocationsProvider); ok {
+		locations = mft.SidecarImageLocations()
+	}
+	if mft, ok := d.mft.(imageLocationProvider); ok && mft.ImageLocation() != "" {
+		locations[d.name] = mft.ImageLocation()
+	}
+	return locations
+}
+
+// pinImageDigest returns the image reference without its tag, pinned to the digest.
+// For example, "public.ecr.aws/nginx/nginx:1.25" is pinned to "public.ecr.aws/nginx/nginx@sha256:...".
+func pinImageDigest(ref, digest string) string {
+	if i := strings.Index(ref, "@"); i != -1 {
+		ref = ref[:i]
+	}
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		ref = ref[:i]
+	}
+	return fmt.Sprintf("%s@%s", ref, digest)
+}
+
+// pushedImageRefs returns the references pinned to the digest of each pushed image by container name.
+func (d *workloadDeployer) pushedImageRefs(images map[string]ContainerImageIdentifier) map[string]string {
+	refs := make(map[string]string, len(images))
+	for container, image := range images {
+		if image.Digest == "" {
+			continue
+		}
+		refs[container] = fmt.Sprintf("%s@%s", d.resources.RepositoryURLs[d.name], image.Digest)
+	}
+	return refs
+}
+
+// signContainerImages signs the digests of the pushed images.
+func (d *workloadDeployer) signContainerImages(signing manifest.ImageSigning, images map[string]ContainerImageIdentifier) error {
+	refs := d.pushedImageRefs(images)
+	if signing.IsEmpty() || len(refs) == 0 {
+		return nil
+	}
+	tool := signature.Tool(aws.StringValue(signing.Tool))
+	d.spinner.Start(fmt.Sprintf("Signing the container images with %s", tool))
+	for _, container := range sortedKeys(refs) {
+		if err := d.signer.Sign(context.Background(), tool, aws.StringValue(signing.Key), refs[container]); err != nil {
+			d.spinner.Stop(log.Serrorf("Failed to sign the container image %q.\n", container))
+			return fmt.Errorf("sign the image %q: %w", container, err)
+		}
+	}
+	d.spinner.Stop(log.Ssuccessf("Signed the container images with %s.\n", tool))
+	return nil
+}
+
+// verifyImageSignatures returns the image of each container pinned to the digest that was verified,
+// if the environment requires signed images. Images that are not built by Copilot are resolved to their current digest,
+// so that the template deploys the verified image instead of whatever its tag points to later.
+// It returns an error if an image of the workload is not signed with a trusted signature.
+func (d *workloadDeployer) verifyImageSignatures(images map[string]ContainerImageIdentifier) (map[string]string, error) {
+	if d.envConfig == nil || d.envConfig.ImageVerification.IsEmpty() {
+		return nil, nil
+	}
+	verification := d.envConfig.ImageVerification
+	refs := d.pushedImageRefs(images)
+	locations := d.imageLocations()
+	if len(refs) == 0 && len(locations) == 0 {
+		return nil, nil
+	}
+	ctx := context.Background()
+	tool := signature.Tool(aws.StringValue(verification.Tool))
+	d.spinner.Start(fmt.Sprintf("Verifying the signatures of the container images required by environment %s", color.HighlightUserInput(d.env.Name)))
+	for _, container := range sortedKeys(locations) {
+		digest, err := d.imageDigest(ctx, locations[container])
+		if err != nil {
+			d.spinner.Stop(log.Serrorf("Failed to resolve the digest of the container image %q.\n", container))
+			return nil, fmt.Errorf("resolve the digest of the image %q: %w", container, err)
+		}
+		refs[container] = pinImageDigest(locations[container], digest)
+	}
+	for _, container := range sortedKeys(refs) {
+		if err := d.signer.Verify(ctx, tool, aws.StringValue(verification.Key), refs[container]); err != nil {
+			d.spinner.Stop(log.Serrorf("The container image %q is not signed with a trusted signature.\n", container))
+			return nil, fmt.Errorf("environment %s only accepts signed images: %w", d.env.Name, err)
+		}
+	}
+	d.spinner.Stop(log.Ssuccessf("Verified the signatures of the container images.\n"))
+	return refs, nil
+}
+
+// imageDigest returns the digest that the image reference resolves to.
+// Images in private ECR repositories are resolved with ECR instead of the container engine,
+// which can only resolve the digest of images in other registries if it's docker.
+func (d *workloadDeployer) imageDigest(ctx context.Context, ref string) (string, error) {
+	if _, digest, ok := strings.Cut(ref, "@"); ok {
+		return digest, nil
+	}
+	img, ok := ecr.ParseImageURI(ref)
+	if !ok {
+		return d.digestResolver.ImageDigest(ctx, ref)
+	}
+	registry, err := d.registryInRegion(img.Region)
+	if err != nil {
+		return "", err
+	}
+	return registry.TagDigest(img.RegistryID, img.Repository, img.Tag)
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // BuildContainerImages builds the all the images given the build arguments
 func BuildContainerImages(in *ImageActionInput, out *UploadArtifactsOutput) error {
 	return processContainerImages(in, out, in.Builder.Build)
@@ -496,12 +805,13 @@ func buildContainerImagesInParallel(in *ImageActionInput, uri string, buildArgsP
 		buildArgs := buildArgs
 
 		buildArgs.URI = uri
-		buildArgsList, err := buildArgs.GenerateDockerBuildArgs(dockerengine.New(exec.NewCmd()))
+		engine := dockerengine.New(exec.NewCmd())
+		buildArgsList, err := buildArgs.GenerateDockerBuildArgs(engine)
 		if err != nil {
 			return fmt.Errorf("generate docker build args for %q: %w", name, err)
 		}
 		buf := syncbuffer.New()
-		labeledBuffers = append(labeledBuffers, buf.WithLabel(fmt.Sprintf("Building your container image %q: docker %s", name, strings.Join(buildArgsList, " "))))
+		labeledBuffers = append(labeledBuffers, buf.WithLabel(fmt.Sprintf("Building your container image %q: %s %s", name, engine.Name(), strings.Join(buildArgsList, " "))))
 		pr, pw := io.Pipe()
 		g.Go(func() error {
 			defer pw.Close()
@@ -560,6 +870,10 @@ func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentif
 		BuildArgs(rootDirectory string) (map[string]*manifest.DockerBuildArgs, error)
 		ContainerPlatform() string
 	}
+	// multiPlatformer is implemented by manifests of workloads that can build a manifest list for multiple platforms.
+	type multiPlatformer interface {
+		ContainerPlatforms() []string
+	}
 	mf, ok := unmarshaledManifest.(dfArgs)
 	if !ok {
 		return nil, fmt.Errorf("%T does not have required methods BuildArgs() and ContainerPlatform()", name)
@@ -568,6 +882,10 @@ func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentif
 	if err != nil {
 		return nil, fmt.Errorf("check if manifest requires building from local Dockerfile: %w", err)
 	}
+	var platforms []string
+	if mp, ok := unmarshaledManifest.(multiPlatformer); ok {
+		platforms = mp.ContainerPlatforms()
+	}
 	dArgs := make(map[string]*dockerengine.BuildArguments, len(argsPerContainer))
 	for container, buildArgs := range argsPerContainer {
 		tags := []string{imageTagLatest}
@@ -593,13 +911,45 @@ func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentif
 			CacheFrom:  buildArgs.CacheFrom,
 			Target:     aws.StringValue(buildArgs.Target),
 			Platform:   mf.ContainerPlatform(),
+			Platforms:  platforms,
+			Secrets:    buildSecrets(buildArgs.Secrets),
+			SSH:        buildArgs.SSH.ToStringSlice(),
 			Tags:       tags,
 			Labels:     labels,
 		}
+		switch {
+		case buildArgs.CacheTo.IsAdvanced():
+			dArgs[container].CacheTo = buildArgs.CacheTo.Advanced
+		case aws.BoolValue(buildArgs.CacheTo.Basic):
+			// Export the build cache to the workload's repository, next to the images of the container.
+			dArgs[container].RegistryCache = imageTagCache
+			if container != name {
+				dArgs[container].RegistryCache = fmt.Sprintf("%s-%s", container, imageTagCache)
+			}
+		}
 	}
 	return dArgs, nil
 }
 
+// buildSecrets returns the secrets of a build sorted by their ID.
+func buildSecrets(secrets map[string]manifest.BuildSecret) []dockerengine.BuildSecret {
+	if len(secrets) == 0 {
+		return nil
+	}
+	out := make([]dockerengine.BuildSecret, 0, len(secrets))
+	for id, secret := range secrets {
+		out = append(out, dockerengine.BuildSecret{
+			ID:  id,
+			Env: aws.StringValue(secret.Env),
+			Src: aws.StringValue(secret.File),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
 func (d *workloadDeployer) uploadArtifactsToS3(out *UploadArtifactsOutput) error {
 	var err error
 	out.EnvFileARNs, err = d.pushEnvFilesToS3Bucket(&pushEnvFilesToS3BucketInput{
@@ -764,6 +1114,42 @@ func (d *workloadDeployer) pushAddonsTemplateToS3Bucket() (string, error) {
 	return url, nil
 }
 
+// ReadAppConfigContents reads the local file of each AppConfig configuration profile
+// and returns the content to host keyed by profile name.
+func ReadAppConfigContents(fs afero.Fs, workspacePath string, cfg manifest.AppConfig) (map[string]string, error) {
+	if cfg.IsEmpty() {
+		return nil, nil
+	}
+	contents := make(map[string]string, len(cfg.Profiles))
+	for _, name := range cfg.ProfileNames() {
+		profile := cfg.Profiles[name]
+		path := aws.StringValue(profile.Path)
+		file, err := afero.ReadFile(fs, filepath.Join(workspacePath, path))
+		if err != nil {
+			return nil, fmt.Errorf("read configuration file %s of profile %q: %w", path, name, err)
+		}
+		content, err := profile.Content(file)
+		if err != nil {
+			return nil, err
+		}
+		contents[name] = string(content)
+	}
+	return contents, nil
+}
+
+// appConfigContents returns the content of the AppConfig configuration profiles of the workload, if any.
+func (d *workloadDeployer) appConfigContents() (map[string]string, error) {
+	type appConfigurer interface {
+		AppConfig() manifest.AppConfig
+	}
+	mft, ok := d.mft.(appConfigurer)
+	if !ok {
+		// If the manifest type doesn't support AppConfig, ignore and move forward.
+		return nil, nil
+	}
+	return ReadAppConfigContents(d.fs, d.workspacePath, mft.AppConfig())
+}
+
 func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.RuntimeConfig, error) {
 	endpoint, err := d.endpointGetter.ServiceDiscoveryEndpoint()
 	if err != nil {
@@ -773,6 +1159,16 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 	if err != nil {
 		return nil, fmt.Errorf("get version of environment %q: %w", d.env.Name, err)
 	}
+	appConfigContents, err := d.appConfigContents()
+	if err != nil {
+		return nil, err
+	}
+	// Verify the images when the template is generated, so that every deployment and package of the workload
+	// references the digests that were verified.
+	pinnedImages, err := d.verifyImageSignatures(in.ImageDigests)
+	if err != nil {
+		return nil, err
+	}
 	if len(in.ImageDigests) == 0 {
 		return &stack.RuntimeConfig{
 			AddonsTemplateURL:        in.AddonsURL,
@@ -782,12 +1178,32 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 			AccountID:                d.env.AccountID,
 			Region:                   d.env.Region,
 			CustomResourcesURL:       in.CustomResourceURLs,
+			AppConfigContents:        appConfigContents,
+			PinnedImages:             pinnedImages,
 			EnvVersion:               envVersion,
 			Version:                  in.Version,
 		}, nil
 	}
-	images := make(map[string]stack.ECRImage, len(in.ImageDigests))
-	for container, img := range in.ImageDigests {
+	return &stack.RuntimeConfig{
+		AddonsTemplateURL:        in.AddonsURL,
+		EnvFileARNs:              in.EnvFileARNs,
+		AdditionalTags:           in.Tags,
+		PushedImages:             d.ecrImages(in.ImageDigests),
+		ServiceDiscoveryEndpoint: endpoint,
+		AccountID:                d.env.AccountID,
+		Region:                   d.env.Region,
+		CustomResourcesURL:       in.CustomResourceURLs,
+		AppConfigContents:        appConfigContents,
+		PinnedImages:             pinnedImages,
+		EnvVersion:               envVersion,
+		Version:                  in.Version,
+	}, nil
+}
+
+// ecrImages returns the images to reference in the template of the workload by container name.
+func (d *workloadDeployer) ecrImages(digests map[string]ContainerImageIdentifier) map[string]stack.ECRImage {
+	images := make(map[string]stack.ECRImage, len(digests))
+	for container, img := range digests {
 		// Currently we do not tag sidecar container images with custom tag provided by the user.
 		// This is the reason for having different ImageTag for main and sidecar container images
 		// that is needed to create CloudFormation stack.
@@ -803,18 +1219,66 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 			ContainerName:     container,
 		}
 	}
-	return &stack.RuntimeConfig{
-		AddonsTemplateURL:        in.AddonsURL,
-		EnvFileARNs:              in.EnvFileARNs,
-		AdditionalTags:           in.Tags,
-		PushedImages:             images,
-		ServiceDiscoveryEndpoint: endpoint,
-		AccountID:                d.env.AccountID,
-		Region:                   d.env.Region,
-		CustomResourcesURL:       in.CustomResourceURLs,
-		EnvVersion:               envVersion,
-		Version:                  in.Version,
-	}, nil
+	return images
+}
+
+// recordDeployment adds the deployment of the workload to its deployment history.
+// The deployment already succeeded, so a failure to record it is only reported as a warning.
+func (d *workloadDeployer) recordDeployment(in *DeployWorkloadInput, conf cloudformation.StackConfiguration) {
+	if in.Detach {
+		// The outcome of the deployment is unknown.
+		return
+	}
+	if err := d.addToHistory(in, conf); err != nil {
+		log.Warningf("Failed to record the deployment of %s to environment %s in its history: %v\n", d.name, d.env.Name, err)
+	}
+}
+
+func (d *workloadDeployer) addToHistory(in *DeployWorkloadInput, conf cloudformation.StackConfiguration) error {
+	tmpl, err := conf.Template()
+	if err != nil {
+		return fmt.Errorf("generate template: %w", err)
+	}
+	// The template is content-addressed: this is the same object as the one deployed, kept for rollbacks.
+	templateURL, err := d.s3Client.Upload(d.resources.S3Bucket, artifactpath.CFNTemplate(conf.StackName(), []byte(tmpl)), strings.NewReader(tmpl))
+	if err != nil {
+		return fmt.Errorf("upload template: %w", err)
+	}
+	params, err := conf.Parameters()
+	if err != nil {
+		return err
+	}
+	deployment := &deploy.WorkloadDeployment{
+		App:         d.app.Name,
+		Env:         d.env.Name,
+		Name:        d.name,
+		DeployedAt:  time.Now().UTC(),
+		DeployedBy:  in.DeployedBy,
+		GitCommit:   in.GitCommit,
+		TemplateURL: templateURL,
+		Parameters:  make(map[string]string, len(params)),
+		Tags:        make(map[string]string),
+	}
+	if len(d.rawMft) != 0 {
+		deployment.ManifestSHA256 = fmt.Sprintf("%x", sha256.Sum256(d.rawMft))
+	}
+	for _, param := range params {
+		deployment.Parameters[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	for _, tag := range conf.Tags() {
+		deployment.Tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	for container, img := range d.ecrImages(in.ImageDigests) {
+		if deployment.Images == nil {
+			deployment.Images = make(map[string]deploy.DeployedImage)
+		}
+		deployment.Images[container] = deploy.DeployedImage{
+			Repository: img.RepoURL,
+			URI:        img.URI(),
+			Digest:     img.Digest,
+		}
+	}
+	return d.history.Record(deployment)
 }
 
 type timeoutError interface {
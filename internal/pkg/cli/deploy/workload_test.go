@@ -5,6 +5,7 @@ package deploy
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -21,6 +22,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	sdkcfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -28,6 +30,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/docker/signature"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/override"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -62,6 +65,7 @@ type deployMocks struct {
 	mockValidator              *mocks.MockaliasCertValidator
 	mockLabeledTermPrinter     *mocks.MockLabeledTermPrinter
 	mockdockerEngineRunChecker *mocks.MockdockerEngineRunChecker
+	mockDeploymentRecorder     *mocks.MockdeploymentRecorder
 }
 
 type mockTemplateFS struct {
@@ -118,6 +122,7 @@ type mockWorkloadMft struct {
 	dockerBuildArgs map[string]*manifest.DockerBuildArgs
 	workloadName    string
 	customEnvFiles  map[string]string
+	platforms       []string
 }
 
 func (m *mockWorkloadMft) EnvFiles() map[string]string {
@@ -137,6 +142,10 @@ func (m *mockWorkloadMft) ContainerPlatform() string {
 	return "mockContainerPlatform"
 }
 
+func (m *mockWorkloadMft) ContainerPlatforms() []string {
+	return m.platforms
+}
+
 // stubCloudFormationStack implements the cloudformation.StackConfiguration interface.
 type stubCloudFormationStack struct{}
 
@@ -194,6 +203,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 		inRegion          string
 		inMockUserTag     string
 		inMockGitTag      string
+		inPlatforms       []string
 		inDockerBuildArgs map[string]*manifest.DockerBuildArgs
 
 		mock                func(t *testing.T, m *deployMocks)
@@ -281,6 +291,90 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				},
 			},
 		},
+		"build and push a manifest list for multiple platforms successfully": {
+			inMockGitTag: "gitTag",
+			inPlatforms:  []string{"linux/amd64", "linux/arm64"},
+			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"mockWkld": {
+					Dockerfile: aws.String("mockDockerfile"),
+					Context:    aws.String("mockContext"),
+				},
+			},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockdockerEngineRunChecker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
+				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+					URI:        mockURI,
+					Dockerfile: "mockDockerfile",
+					Context:    "mockContext",
+					Platform:   "mockContainerPlatform",
+					Platforms:  []string{"linux/amd64", "linux/arm64"},
+					Tags:       []string{"latest", "gitTag"},
+					Labels: map[string]string{
+						"com.aws.copilot.image.builder":        "copilot-cli",
+						"com.aws.copilot.image.container.name": "mockWkld",
+					},
+				}, gomock.Any()).Return("mockDigest", nil)
+				m.mockAddons = nil
+			},
+			wantImages: map[string]ContainerImageIdentifier{
+				mockName: {
+					Digest:            "mockDigest",
+					GitShortCommitTag: "gitTag",
+					RepoTags: []string{
+						"mockRepoURI:gitTag",
+						"mockRepoURI:latest",
+					},
+				},
+			},
+		},
+		"build and push image with secrets and a registry cache successfully": {
+			inMockGitTag: "gitTag",
+			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"mockWkld": {
+					Dockerfile: aws.String("mockDockerfile"),
+					Context:    aws.String("mockContext"),
+					Secrets: map[string]manifest.BuildSecret{
+						"npmrc":    {File: aws.String("/root/.npmrc")},
+						"gh_token": {Env: aws.String("GITHUB_TOKEN")},
+					},
+					SSH:     manifest.StringSliceOrString{String: aws.String("default")},
+					CacheTo: manifest.BasicToUnion[*bool, []string](aws.Bool(true)),
+				},
+			},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockdockerEngineRunChecker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
+				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+					URI:        mockURI,
+					Dockerfile: "mockDockerfile",
+					Context:    "mockContext",
+					Platform:   "mockContainerPlatform",
+					Secrets: []dockerengine.BuildSecret{
+						{ID: "gh_token", Env: "GITHUB_TOKEN"},
+						{ID: "npmrc", Src: "/root/.npmrc"},
+					},
+					SSH:           []string{"default"},
+					RegistryCache: "cache",
+					Tags:          []string{"latest", "gitTag"},
+					Labels: map[string]string{
+						"com.aws.copilot.image.builder":        "copilot-cli",
+						"com.aws.copilot.image.container.name": "mockWkld",
+					},
+				}, gomock.Any()).Return("mockDigest", nil)
+				m.mockAddons = nil
+			},
+			wantImages: map[string]ContainerImageIdentifier{
+				mockName: {
+					Digest:            "mockDigest",
+					GitShortCommitTag: "gitTag",
+					RepoTags: []string{
+						"mockRepoURI:gitTag",
+						"mockRepoURI:latest",
+					},
+				},
+			},
+		},
 		"build and push image with gitshortcommit successfully": {
 			inMockGitTag: "gitTag",
 			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
@@ -675,6 +769,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 					fileName:        tc.inEnvFile,
 					customEnvFiles:  tc.customEnvFiles,
 					dockerBuildArgs: tc.inDockerBuildArgs,
+					platforms:       tc.inPlatforms,
 				},
 				fs:              m.mockFileSystem,
 				s3Client:        m.mockUploader,
@@ -1168,6 +1263,8 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 					Return(nil)
 				m.mockServiceForceUpdater.EXPECT().LastUpdatedAt(mockAppName, mockEnvName, mockName).
 					Return(mockAfterTime, nil)
+				m.mockUploader.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("mockTemplateURL", nil)
+				m.mockDeploymentRecorder.EXPECT().Record(gomock.Any()).Return(nil)
 			},
 		},
 		"error if fail to force an update": {
@@ -1231,6 +1328,8 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", false, gomock.Any()).Return(nil)
+				m.mockUploader.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("mockTemplateURL", nil)
+				m.mockDeploymentRecorder.EXPECT().Record(gomock.Any()).Return(nil)
 			},
 		},
 		"success": {
@@ -1255,6 +1354,8 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockValidator.EXPECT().ValidateCertAliases([]string{"example.com", "foobar.com"}, mockCertARNs).Return(nil).Times(2)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", false, gomock.Any()).Return(nil)
+				m.mockUploader.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("mockTemplateURL", nil)
+				m.mockDeploymentRecorder.EXPECT().Record(gomock.Any()).Return(nil)
 			},
 		},
 		"success with http redirect disabled and alb certs imported": {
@@ -1279,6 +1380,8 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockValidator.EXPECT().ValidateCertAliases([]string{"example.com", "foobar.com"}, mockCertARNs).Return(nil).Times(2)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", false, gomock.Any()).Return(nil)
+				m.mockUploader.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("mockTemplateURL", nil)
+				m.mockDeploymentRecorder.EXPECT().Record(gomock.Any()).Return(nil)
 			},
 		},
 		"success with only cdn certs imported": {
@@ -1302,6 +1405,8 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockValidator.EXPECT().ValidateCertAliases([]string{"example.com", "foobar.com"}, []string{mockCDNCertARN}).Return(nil).Times(2)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", false, gomock.Any()).Return(nil)
+				m.mockUploader.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("mockTemplateURL", nil)
+				m.mockDeploymentRecorder.EXPECT().Record(gomock.Any()).Return(nil)
 			},
 		},
 		"success with http redirect disabled and domain imported": {
@@ -1330,6 +1435,8 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockAppVersionGetter.EXPECT().Version().Return("v1.0.0", nil).Times(2)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", false, gomock.Any()).Return(nil)
+				m.mockUploader.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("mockTemplateURL", nil)
+				m.mockDeploymentRecorder.EXPECT().Record(gomock.Any()).Return(nil)
 			},
 		},
 		"success with force update": {
@@ -1351,6 +1458,24 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockSpinner.EXPECT().Start(fmt.Sprintf(fmtForceUpdateSvcStart, mockName, mockEnvName))
 				m.mockServiceForceUpdater.EXPECT().ForceUpdateService(mockAppName, mockEnvName, mockName).Return(nil)
 				m.mockSpinner.EXPECT().Stop(log.Ssuccessf(fmtForceUpdateSvcComplete, mockName, mockEnvName))
+				m.mockUploader.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("mockTemplateURL", nil)
+				m.mockDeploymentRecorder.EXPECT().Record(gomock.Any()).Return(nil)
+			},
+		},
+		"success even if the deployment can't be recorded": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", false, gomock.Any()).Return(nil)
+				m.mockUploader.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("mockTemplateURL", nil)
+				m.mockDeploymentRecorder.EXPECT().Record(gomock.Any()).Return(mockError)
 			},
 		},
 	}
@@ -1369,6 +1494,8 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				mockSpinner:                mocks.NewMockspinner(ctrl),
 				mockPublicCIDRBlocksGetter: mocks.NewMockpublicCIDRBlocksGetter(ctrl),
 				mockValidator:              mocks.NewMockaliasCertValidator(ctrl),
+				mockUploader:               mocks.NewMockuploader(ctrl),
+				mockDeploymentRecorder:     mocks.NewMockdeploymentRecorder(ctrl),
 			}
 			tc.mock(m)
 
@@ -1386,6 +1513,8 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 						envConfig:        tc.inEnvironmentConfig(),
 						resources:        mockResources,
 						deployer:         m.mockServiceDeployer,
+						s3Client:         m.mockUploader,
+						history:          m.mockDeploymentRecorder,
 						endpointGetter:   m.mockEndpointGetter,
 						spinner:          m.mockSpinner,
 						envVersionGetter: m.mockEnvVersionGetter,
@@ -1458,6 +1587,398 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 	}
 }
 
+func TestWorkloadDeployer_configureImageRepository(t *testing.T) {
+	testCases := map[string]struct {
+		in   manifest.ImageRepository
+		mock func(m *mocks.MockimageRepositoryConfigurer)
+
+		wantedErr error
+	}{
+		"does not change the repository if it's not configured": {
+			mock: func(m *mocks.MockimageRepositoryConfigurer) {},
+		},
+		"applies the lifecycle policy and turns on scanning if findings are checked": {
+			in: manifest.ImageRepository{
+				Lifecycle: manifest.RepositoryLifecycle{
+					KeepLast: aws.Int(30),
+				},
+				Scan: manifest.ImageScan{
+					FailOn: aws.String("high"),
+				},
+			},
+			mock: func(m *mocks.MockimageRepositoryConfigurer) {
+				m.EXPECT().PutLifecyclePolicy("phonetool/frontend", ecr.LifecyclePolicy{
+					KeepLast:            30,
+					RetainedTagPatterns: []string{"cache", "cache-*", "*-cache", "*-cache-*", "sha256-*.sig"},
+				}).Return(nil)
+				m.EXPECT().PutScanOnPush("phonetool/frontend", true).Return(nil)
+			},
+		},
+		"wraps errors from the registry": {
+			in: manifest.ImageRepository{
+				Scan: manifest.ImageScan{
+					OnPush: aws.Bool(false),
+				},
+			},
+			mock: func(m *mocks.MockimageRepositoryConfigurer) {
+				m.EXPECT().PutScanOnPush("phonetool/frontend", false).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("configure scan on push for image repository: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockimageRepositoryConfigurer(ctrl)
+			tc.mock(m)
+			d := &workloadDeployer{
+				name:     "frontend",
+				app:      &config.Application{Name: "phonetool"},
+				registry: m,
+			}
+
+			err := d.configureImageRepository(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWorkloadDeployer_checkImageScanFindings(t *testing.T) {
+	images := map[string]ContainerImageIdentifier{
+		"frontend": {Digest: "sha256:frontend"},
+		"nginx":    {Digest: "sha256:nginx"},
+	}
+	testCases := map[string]struct {
+		in   manifest.ImageScan
+		mock func(m *mocks.MockimageRepositoryConfigurer)
+
+		wantedErr     error
+		wantedActions string
+	}{
+		"does not wait for the scan without a threshold": {
+			in: manifest.ImageScan{
+				OnPush: aws.Bool(true),
+			},
+			mock: func(m *mocks.MockimageRepositoryConfigurer) {},
+		},
+		"passes if findings are less severe than the threshold": {
+			in: manifest.ImageScan{
+				FailOn: aws.String("high"),
+			},
+			mock: func(m *mocks.MockimageRepositoryConfigurer) {
+				m.EXPECT().PlatformDigests("phonetool/frontend", "sha256:frontend").Return([]string{"sha256:frontend"}, nil)
+				m.EXPECT().PlatformDigests("phonetool/frontend", "sha256:nginx").Return([]string{"sha256:nginx"}, nil)
+				m.EXPECT().ImageScanFindings(gomock.Any(), "phonetool/frontend", "sha256:frontend").Return([]ecr.ScanFinding{
+					{Name: "CVE-1", Severity: "MEDIUM"},
+				}, nil)
+				m.EXPECT().ImageScanFindings(gomock.Any(), "phonetool/frontend", "sha256:nginx").Return(nil, nil)
+			},
+		},
+		"fails with the findings at or above the threshold": {
+			in: manifest.ImageScan{
+				FailOn: aws.String("high"),
+			},
+			mock: func(m *mocks.MockimageRepositoryConfigurer) {
+				m.EXPECT().PlatformDigests("phonetool/frontend", "sha256:frontend").Return([]string{"sha256:frontend"}, nil)
+				m.EXPECT().PlatformDigests("phonetool/frontend", "sha256:nginx").Return([]string{"sha256:nginx"}, nil)
+				m.EXPECT().ImageScanFindings(gomock.Any(), "phonetool/frontend", "sha256:frontend").Return([]ecr.ScanFinding{
+					{Name: "CVE-1", Severity: "HIGH", Package: "openssl@3.0.1", URI: "https://cve-1"},
+					{Name: "CVE-2", Severity: "LOW"},
+				}, nil)
+				m.EXPECT().ImageScanFindings(gomock.Any(), "phonetool/frontend", "sha256:nginx").Return([]ecr.ScanFinding{
+					{Name: "CVE-3", Severity: "CRITICAL"},
+				}, nil)
+			},
+			wantedErr: errors.New("image scan found 2 vulnerabilities of severity HIGH or higher"),
+			wantedActions: fmt.Sprintf(`Fix the following vulnerabilities, or raise the threshold with %s in the manifest:
+  Container  Severity  Vulnerability  Package        Link
+  ---------  --------  -------------  -------        ----
+  frontend   HIGH      CVE-1          openssl@3.0.1  https://cve-1
+  nginx      CRITICAL  CVE-3          -              -
+`, color.HighlightCode("image.repository.scan.fail_on")),
+		},
+		"checks the image of each platform of a multi-platform image": {
+			in: manifest.ImageScan{
+				FailOn: aws.String("critical"),
+			},
+			mock: func(m *mocks.MockimageRepositoryConfigurer) {
+				m.EXPECT().PlatformDigests("phonetool/frontend", "sha256:frontend").Return([]string{"sha256:amd64", "sha256:arm64"}, nil)
+				m.EXPECT().ImageScanFindings(gomock.Any(), "phonetool/frontend", "sha256:amd64").Return(nil, nil)
+				m.EXPECT().ImageScanFindings(gomock.Any(), "phonetool/frontend", "sha256:arm64").Return([]ecr.ScanFinding{
+					{Name: "CVE-1", Severity: "CRITICAL"},
+				}, nil)
+				m.EXPECT().PlatformDigests("phonetool/frontend", "sha256:nginx").Return([]string{"sha256:nginx"}, nil)
+				m.EXPECT().ImageScanFindings(gomock.Any(), "phonetool/frontend", "sha256:nginx").Return(nil, nil)
+			},
+			wantedErr: errors.New("image scan found 1 vulnerability of severity CRITICAL or higher"),
+		},
+		"wraps errors while resolving the platform images": {
+			in: manifest.ImageScan{
+				FailOn: aws.String("critical"),
+			},
+			mock: func(m *mocks.MockimageRepositoryConfigurer) {
+				m.EXPECT().PlatformDigests("phonetool/frontend", "sha256:frontend").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New(`get the platform images of the image "frontend": some error`),
+		},
+		"wraps errors while waiting for the scan": {
+			in: manifest.ImageScan{
+				FailOn: aws.String("critical"),
+			},
+			mock: func(m *mocks.MockimageRepositoryConfigurer) {
+				m.EXPECT().PlatformDigests("phonetool/frontend", "sha256:frontend").Return([]string{"sha256:frontend"}, nil)
+				m.EXPECT().ImageScanFindings(gomock.Any(), "phonetool/frontend", "sha256:frontend").Return(nil, context.DeadlineExceeded)
+			},
+			wantedErr: errors.New(`get scan findings of the image "frontend": context deadline exceeded`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockimageRepositoryConfigurer(ctrl)
+			tc.mock(m)
+			spinner := mocks.NewMockspinner(ctrl)
+			spinner.EXPECT().Start(gomock.Any()).AnyTimes()
+			spinner.EXPECT().Stop(gomock.Any()).AnyTimes()
+			d := &workloadDeployer{
+				name:     "frontend",
+				app:      &config.Application{Name: "phonetool"},
+				registry: m,
+				spinner:  spinner,
+			}
+
+			err := d.checkImageScanFindings(tc.in, images)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				if tc.wantedActions != "" {
+					var scanErr *errImageScanFindings
+					require.ErrorAs(t, err, &scanErr)
+					require.Equal(t, tc.wantedActions, scanErr.RecommendActions())
+				}
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWorkloadDeployer_signContainerImages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	signer := mocks.NewMockimageSigner(ctrl)
+	signer.EXPECT().Sign(gomock.Any(), signature.ToolCosign, "awskms:///alias/signing", "uri@sha256:frontend").Return(nil)
+	signer.EXPECT().Sign(gomock.Any(), signature.ToolCosign, "awskms:///alias/signing", "uri@sha256:nginx").Return(errors.New("some error"))
+	spinner := mocks.NewMockspinner(ctrl)
+	spinner.EXPECT().Start(gomock.Any())
+	spinner.EXPECT().Stop(gomock.Any())
+	d := &workloadDeployer{
+		name: "frontend",
+		resources: &stack.AppRegionalResources{
+			RepositoryURLs: map[string]string{"frontend": "uri"},
+		},
+		signer:  signer,
+		spinner: spinner,
+	}
+
+	err := d.signContainerImages(manifest.ImageSigning{
+		Tool: aws.String("cosign"),
+		Key:  aws.String("awskms:///alias/signing"),
+	}, map[string]ContainerImageIdentifier{
+		"frontend": {Digest: "sha256:frontend"},
+		"nginx":    {Digest: "sha256:nginx"},
+	})
+
+	require.EqualError(t, err, `sign the image "nginx": some error`)
+}
+
+func TestWorkloadDeployer_verifyImageSignatures(t *testing.T) {
+	type verifyImageSignaturesMocks struct {
+		signer         *mocks.MockimageSigner
+		digestResolver *mocks.MockimageDigestResolver
+		registry       *mocks.MockimageTagDigester
+	}
+	notation := manifest.EnvironmentConfig{
+		ImageVerification: manifest.EnvironmentImageVerification{
+			Tool: aws.String("notation"),
+		},
+	}
+	backendWithImage := func(location string, sidecars map[string]*manifest.SidecarConfig) *manifest.BackendService {
+		return &manifest.BackendService{
+			BackendServiceConfig: manifest.BackendServiceConfig{
+				ImageConfig: manifest.ImageWithHealthcheckAndOptionalPort{
+					ImageWithOptionalPort: manifest.ImageWithOptionalPort{
+						Image: manifest.Image{
+							ImageLocationOrBuild: manifest.ImageLocationOrBuild{
+								Location: aws.String(location),
+							},
+						},
+					},
+				},
+				Sidecars: sidecars,
+			},
+		}
+	}
+	testCases := map[string]struct {
+		envConfig manifest.EnvironmentConfig
+		mft       interface{}
+		images    map[string]ContainerImageIdentifier
+		mock      func(m *verifyImageSignaturesMocks)
+
+		wantedPinned map[string]string
+		wantedErr    error
+	}{
+		"does not verify images if the environment does not require it": {
+			mft: backendWithImage("public.ecr.aws/frontend:v1", nil),
+			images: map[string]ContainerImageIdentifier{
+				"nginx": {Digest: "sha256:nginx"},
+			},
+			mock: func(m *verifyImageSignaturesMocks) {},
+		},
+		"verifies the pushed digests and the image locations pinned to their digest": {
+			envConfig: notation,
+			mft: backendWithImage("public.ecr.aws/frontend:v1", map[string]*manifest.SidecarConfig{
+				"logrouter": {
+					Image: manifest.Union[*string, manifest.ImageLocationOrBuild]{
+						Basic: aws.String("localhost:5000/logrouter:latest"),
+					},
+				},
+			}),
+			images: map[string]ContainerImageIdentifier{
+				"nginx": {Digest: "sha256:nginx"},
+			},
+			mock: func(m *verifyImageSignaturesMocks) {
+				m.digestResolver.EXPECT().ImageDigest(gomock.Any(), "public.ecr.aws/frontend:v1").Return("sha256:frontend", nil)
+				m.digestResolver.EXPECT().ImageDigest(gomock.Any(), "localhost:5000/logrouter:latest").Return("sha256:logrouter", nil)
+				m.signer.EXPECT().Verify(gomock.Any(), signature.ToolNotation, "", "public.ecr.aws/frontend@sha256:frontend").Return(nil)
+				m.signer.EXPECT().Verify(gomock.Any(), signature.ToolNotation, "", "localhost:5000/logrouter@sha256:logrouter").Return(nil)
+				m.signer.EXPECT().Verify(gomock.Any(), signature.ToolNotation, "", "uri@sha256:nginx").Return(nil)
+			},
+			wantedPinned: map[string]string{
+				"frontend":  "public.ecr.aws/frontend@sha256:frontend",
+				"logrouter": "localhost:5000/logrouter@sha256:logrouter",
+				"nginx":     "uri@sha256:nginx",
+			},
+		},
+		"resolves images in ecr with ecr and images pinned to a digest without the container engine": {
+			envConfig: notation,
+			mft: backendWithImage("012345678910.dkr.ecr.eu-west-1.amazonaws.com/frontend:v1", map[string]*manifest.SidecarConfig{
+				"logrouter": {
+					Image: manifest.Union[*string, manifest.ImageLocationOrBuild]{
+						Basic: aws.String("public.ecr.aws/logrouter@sha256:logrouter"),
+					},
+				},
+			}),
+			mock: func(m *verifyImageSignaturesMocks) {
+				m.registry.EXPECT().TagDigest("012345678910", "frontend", "v1").Return("sha256:frontend", nil)
+				m.signer.EXPECT().Verify(gomock.Any(), signature.ToolNotation, "", "012345678910.dkr.ecr.eu-west-1.amazonaws.com/frontend@sha256:frontend").Return(nil)
+				m.signer.EXPECT().Verify(gomock.Any(), signature.ToolNotation, "", "public.ecr.aws/logrouter@sha256:logrouter").Return(nil)
+			},
+			wantedPinned: map[string]string{
+				"frontend":  "012345678910.dkr.ecr.eu-west-1.amazonaws.com/frontend@sha256:frontend",
+				"logrouter": "public.ecr.aws/logrouter@sha256:logrouter",
+			},
+		},
+		"returns a wrapped error if the digest of an image location cannot be resolved": {
+			envConfig: notation,
+			mft:       backendWithImage("public.ecr.aws/frontend:v1", nil),
+			mock: func(m *verifyImageSignaturesMocks) {
+				m.digestResolver.EXPECT().ImageDigest(gomock.Any(), "public.ecr.aws/frontend:v1").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New(`resolve the digest of the image "frontend": some error`),
+		},
+		"refuses unsigned images": {
+			envConfig: manifest.EnvironmentConfig{
+				ImageVerification: manifest.EnvironmentImageVerification{
+					Tool: aws.String("cosign"),
+					Key:  aws.String("awskms:///alias/signing"),
+				},
+			},
+			images: map[string]ContainerImageIdentifier{
+				"frontend": {Digest: "sha256:frontend"},
+			},
+			mock: func(m *verifyImageSignaturesMocks) {
+				m.signer.EXPECT().Verify(gomock.Any(), signature.ToolCosign, "awskms:///alias/signing", "uri@sha256:frontend").Return(errors.New("no signatures found"))
+			},
+			wantedErr: errors.New("environment test only accepts signed images: no signatures found"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &verifyImageSignaturesMocks{
+				signer:         mocks.NewMockimageSigner(ctrl),
+				digestResolver: mocks.NewMockimageDigestResolver(ctrl),
+				registry:       mocks.NewMockimageTagDigester(ctrl),
+			}
+			tc.mock(m)
+			spinner := mocks.NewMockspinner(ctrl)
+			spinner.EXPECT().Start(gomock.Any()).AnyTimes()
+			spinner.EXPECT().Stop(gomock.Any()).AnyTimes()
+			d := &workloadDeployer{
+				name: "frontend",
+				env:  &config.Environment{Name: "test"},
+				mft:  tc.mft,
+				resources: &stack.AppRegionalResources{
+					RepositoryURLs: map[string]string{"frontend": "uri"},
+				},
+				envConfig: &manifest.Environment{
+					EnvironmentConfig: tc.envConfig,
+				},
+				signer:         m.signer,
+				digestResolver: m.digestResolver,
+				registryInRegion: func(region string) (imageTagDigester, error) {
+					require.Equal(t, "eu-west-1", region)
+					return m.registry, nil
+				},
+				spinner: spinner,
+			}
+
+			pinned, err := d.verifyImageSignatures(tc.images)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPinned, pinned)
+		})
+	}
+}
+
+func TestPinImageDigest(t *testing.T) {
+	testCases := map[string]struct {
+		ref    string
+		wanted string
+	}{
+		"replaces the tag": {
+			ref:    "public.ecr.aws/nginx/nginx:1.25",
+			wanted: "public.ecr.aws/nginx/nginx@sha256:abc",
+		},
+		"keeps the port of the registry": {
+			ref:    "localhost:5000/nginx",
+			wanted: "localhost:5000/nginx@sha256:abc",
+		},
+		"replaces an existing digest": {
+			ref:    "nginx:1.25@sha256:def",
+			wanted: "nginx@sha256:abc",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, pinImageDigest(tc.ref, "sha256:abc"))
+		})
+	}
+}
+
 func TestUploadArtifacts(t *testing.T) {
 	d := &workloadDeployer{}
 	errFunc := func(out *UploadArtifactsOutput) error {
@@ -1482,6 +2003,63 @@ func TestUploadArtifacts(t *testing.T) {
 
 }
 
+func TestReadAppConfigContents(t *testing.T) {
+	testCases := map[string]struct {
+		inConfig manifest.AppConfig
+		setupFS  func(fs afero.Fs)
+
+		wanted    map[string]string
+		wantedErr error
+	}{
+		"return nil if there are no configuration profiles": {
+			setupFS: func(fs afero.Fs) {},
+		},
+		"error if a configuration file does not exist": {
+			inConfig: manifest.AppConfig{
+				Profiles: map[string]*manifest.AppConfigProfile{
+					"flags": {Path: aws.String("config/flags.json")},
+				},
+			},
+			setupFS:   func(fs afero.Fs) {},
+			wantedErr: errors.New(`read configuration file config/flags.json of profile "flags": open /ws/config/flags.json: file does not exist`),
+		},
+		"read the content of each profile": {
+			inConfig: manifest.AppConfig{
+				Profiles: map[string]*manifest.AppConfigProfile{
+					"flags": {
+						Path: aws.String("config/flags.yml"),
+						Type: aws.String(manifest.AppConfigProfileTypeFeatureFlags),
+					},
+					"settings": {Path: aws.String("config/settings.yml")},
+				},
+			},
+			setupFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "/ws/config/flags.yml", []byte("version: \"1\"\n"), 0644)
+				_ = afero.WriteFile(fs, "/ws/config/settings.yml", []byte("timeout: 30\n"), 0644)
+			},
+			wanted: map[string]string{
+				"flags":    `{"version":"1"}`,
+				"settings": "timeout: 30\n",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			tc.setupFS(fs)
+
+			got, err := ReadAppConfigContents(fs, "/ws", tc.inConfig)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 type deployDiffMocks struct {
 	mockDeployedTmplGetter *mocks.MockdeployedTemplateGetter
 }
@@ -1564,3 +2142,104 @@ func TestWorkloadDeployer_DeployDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkloadDeployer_recordDeployment(t *testing.T) {
+	mockResources := &stack.AppRegionalResources{
+		S3Bucket: "mockBucket",
+		RepositoryURLs: map[string]string{
+			"api": "mockRepoURL",
+		},
+	}
+	testCases := map[string]struct {
+		inDetach bool
+		mock     func(m *deployMocks)
+	}{
+		"skip recording a detached deployment": {
+			inDetach: true,
+			mock:     func(m *deployMocks) {},
+		},
+		"skip recording if the template can't be uploaded": {
+			mock: func(m *deployMocks) {
+				m.mockUploader.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+		},
+		"record the deployment": {
+			mock: func(m *deployMocks) {
+				m.mockUploader.EXPECT().Upload("mockBucket", "manual/templates/demo/30e8a778c8f0a49854adf79501d6944d6edc3074a76374446832cdcb9b6cd37a.yml", gomock.Any()).Return("mockTemplateURL", nil)
+				m.mockDeploymentRecorder.EXPECT().Record(gomock.Any()).DoAndReturn(func(deployment *deploy.WorkloadDeployment) error {
+					require.False(t, deployment.DeployedAt.IsZero())
+					deployment.DeployedAt = time.Time{}
+					require.Equal(t, &deploy.WorkloadDeployment{
+						App:            "phonetool",
+						Env:            "test",
+						Name:           "api",
+						DeployedBy:     "arn:aws:iam::123456789012:user/alice",
+						GitCommit:      "a1b2c3d",
+						ManifestSHA256: "b5bb9d8014a0f9b1d61e21e796d78dccdf1352f23cd32812f4850b878ae4944c",
+						TemplateURL:    "mockTemplateURL",
+						Parameters:     map[string]string{},
+						Tags:           map[string]string{},
+						Images: map[string]deploy.DeployedImage{
+							"api": {
+								Repository: "mockRepoURL",
+								URI:        "mockRepoURL:a1b2c3d",
+								Digest:     "sha256:main",
+							},
+							"logs": {
+								Repository: "mockRepoURL",
+								URI:        "mockRepoURL:logs-a1b2c3d",
+								Digest:     "sha256:sidecar",
+							},
+						},
+					}, deployment)
+					return nil
+				})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &deployMocks{
+				mockUploader:           mocks.NewMockuploader(ctrl),
+				mockDeploymentRecorder: mocks.NewMockdeploymentRecorder(ctrl),
+			}
+			tc.mock(m)
+			deployer := &workloadDeployer{
+				name:      "api",
+				app:       &config.Application{Name: "phonetool"},
+				env:       &config.Environment{Name: "test"},
+				resources: mockResources,
+				rawMft:    []byte("foo\n"),
+				s3Client:  m.mockUploader,
+				history:   m.mockDeploymentRecorder,
+			}
+
+			// WHEN
+			deployer.recordDeployment(&DeployWorkloadInput{
+				StackRuntimeConfiguration: StackRuntimeConfiguration{
+					ImageDigests: map[string]ContainerImageIdentifier{
+						"api": {
+							Digest:            "sha256:main",
+							GitShortCommitTag: "a1b2c3d",
+						},
+						"logs": {
+							Digest:            "sha256:sidecar",
+							GitShortCommitTag: "a1b2c3d",
+						},
+					},
+				},
+				Options: Options{
+					Detach: tc.inDetach,
+				},
+				Provenance: Provenance{
+					GitCommit:  "a1b2c3d",
+					DeployedBy: "arn:aws:iam::123456789012:user/alice",
+				},
+			}, new(stubCloudFormationStack))
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const (
+	fmtDetectDriftStart    = "Detecting drift of %s."
+	fmtDetectDriftFailed   = "Failed to detect drift of %s.\n"
+	fmtDetectDriftComplete = "Detected drift of %s.\n"
+)
+
+// driftVars holds the flags shared by the commands that detect the drift of a stack.
+type driftVars struct {
+	shouldOutputJSON bool
+	failOnDrift      bool
+}
+
+// stackDriftOpts detects and renders the drift of the stack of an environment, a workload or a pipeline.
+type stackDriftOpts struct {
+	w         io.Writer
+	prog      progress
+	describer stackDriftDescriber
+}
+
+// detectDrift writes the drifted resources of the stack of the component, such as `service "api" in environment "test"`.
+// If the fail-on-drift flag is set and resources drifted, returns errStackDrifted.
+func (o *stackDriftOpts) detectDrift(vars driftVars, component string) error {
+	o.prog.Start(fmt.Sprintf(fmtDetectDriftStart, component))
+	drift, err := o.describer.Describe()
+	if err != nil {
+		o.prog.Stop(log.Serrorf(fmtDetectDriftFailed, component))
+		return fmt.Errorf("detect drift of %s: %w", component, err)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtDetectDriftComplete, component))
+	if vars.shouldOutputJSON {
+		data, err := drift.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		fmt.Fprint(o.w, drift.HumanString())
+	}
+	if vars.failOnDrift && drift.HasDrift() {
+		return &errStackDrifted{
+			component: component,
+		}
+	}
+	return nil
+}
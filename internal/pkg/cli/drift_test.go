@@ -0,0 +1,100 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStackDriftOpts_DetectDrift(t *testing.T) {
+	inSync := &describe.StackDrift{
+		Stacks: []*describe.DriftedStack{{Name: "phonetool-test", Status: "IN_SYNC"}},
+	}
+	drifted := &describe.StackDrift{
+		Stacks: []*describe.DriftedStack{
+			{
+				Name:   "phonetool-test",
+				Status: "DRIFTED",
+				Resources: []*describe.DriftedResource{
+					{LogicalID: "EnvironmentSecurityGroup", Type: "AWS::EC2::SecurityGroup", Status: "DELETED"},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		vars      driftVars
+		setupMock func(m *mocks.MockstackDriftDescriber)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"error if the drift can't be detected": {
+			setupMock: func(m *mocks.MockstackDriftDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New(`detect drift of environment "test": some error`),
+		},
+		"writes the drift in human-readable format": {
+			setupMock: func(m *mocks.MockstackDriftDescriber) {
+				m.EXPECT().Describe().Return(inSync, nil)
+			},
+			wantedContent: inSync.HumanString(),
+		},
+		"writes the drift in JSON": {
+			vars: driftVars{shouldOutputJSON: true},
+			setupMock: func(m *mocks.MockstackDriftDescriber) {
+				m.EXPECT().Describe().Return(drifted, nil)
+			},
+			wantedContent: `{"stacks":[{"name":"phonetool-test","status":"DRIFTED","resources":[{"logicalID":"EnvironmentSecurityGroup","physicalID":"","type":"AWS::EC2::SecurityGroup","status":"DELETED"}]}]}` + "\n",
+		},
+		"succeeds without drift if fail-on-drift is set": {
+			vars: driftVars{failOnDrift: true},
+			setupMock: func(m *mocks.MockstackDriftDescriber) {
+				m.EXPECT().Describe().Return(inSync, nil)
+			},
+			wantedContent: inSync.HumanString(),
+		},
+		"error after writing the drift if fail-on-drift is set": {
+			vars: driftVars{failOnDrift: true},
+			setupMock: func(m *mocks.MockstackDriftDescriber) {
+				m.EXPECT().Describe().Return(drifted, nil)
+			},
+			wantedContent: drifted.HumanString(),
+			wantedErr:     errors.New(`resources of environment "test" drifted from their template`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockstackDriftDescriber(ctrl)
+			tc.setupMock(m)
+			prog := mocks.NewMockprogress(ctrl)
+			prog.EXPECT().Start(gomock.Any())
+			prog.EXPECT().Stop(gomock.Any())
+			b := &bytes.Buffer{}
+			opts := &stackDriftOpts{
+				w:         b,
+				prog:      prog,
+				describer: m,
+			}
+
+			err := opts.detectDrift(tc.vars, `environment "test"`)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
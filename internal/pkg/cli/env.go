@@ -41,6 +41,7 @@ Environments are deployment stages shared between services.`,
 	cmd.AddCommand(buildEnvInitCmd())
 	cmd.AddCommand(buildEnvListCmd())
 	cmd.AddCommand(buildEnvShowCmd())
+	cmd.AddCommand(buildEnvDriftCmd())
 	cmd.AddCommand(buildEnvUpgradeCmd())
 	cmd.AddCommand(buildEnvPkgCmd())
 	cmd.AddCommand(buildEnvOverrideCmd())
@@ -11,9 +11,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
-	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 
@@ -102,7 +100,7 @@ func newDeleteEnvOpts(vars deleteEnvVars) (*deleteEnvOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("default session: %v", err)
 	}
-	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	store := config.NewStore(defaultSess)
 
 	prompter := prompt.New()
 	return &deleteEnvOpts{
@@ -313,7 +311,7 @@ func (o *deleteEnvOpts) validateNoDependencyPipelines() error {
 			return fmt.Errorf("get pipeline %s: %w", pipeline.ResourceName, err)
 		}
 		for _, stage := range info.Stages {
-			if strings.TrimPrefix(stage.Name, deploy.StageFullNamePrefix) == o.name {
+			if deploy.StageDeploysToEnvironment(stage.Name, stage.Actions, o.name) {
 				return &errPipelineDependsOnEnv{
 					pipeline: pipeline.Name,
 					env:      o.name,
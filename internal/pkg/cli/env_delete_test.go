@@ -265,6 +265,48 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 
 			wantedError: errors.New(`environment "test" cannot be deleted because pipeline "mockName" depends on it`),
 		},
+		"returns error when a wave of a pipeline deploys to the env": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{},
+				}, nil)
+
+				lister := mocks.NewMockdeployedPipelineLister(ctrl)
+				lister.EXPECT().ListDeployedPipelines("phonetool").Return([]deploy.Pipeline{
+					{
+						ResourceName: "mockResourceName",
+						Name:         "mockName",
+					},
+				}, nil)
+
+				getter := mocks.NewMockpipelineGetter(ctrl)
+				getter.EXPECT().GetPipeline("mockResourceName").Return(&codepipeline.Pipeline{
+					Stages: []*codepipeline.Stage{
+						{
+							Name: "DeployTo-test",
+						},
+						{
+							Name:    "DeployTo-Wave-2",
+							Actions: []string{"CreateOrUpdate-api-prod-us", "CreateOrUpdate-api-prod-eu"},
+						},
+					},
+				}, nil)
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName: "phonetool",
+						name:    "prod-eu",
+					},
+					rg:                     rg,
+					deployedPipelineLister: lister,
+					pipelineGetter:         getter,
+					initRuntimeClients:     noopInitRuntimeClients,
+				}
+			},
+
+			wantedError: errors.New(`environment "prod-eu" cannot be deleted because pipeline "mockName" depends on it`),
+		},
 		"returns wrapped error when environment stack cannot be updated to retain roles": {
 			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
 				rg := mocks.NewMockresourceGetter(ctrl)
@@ -0,0 +1,151 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envDriftNamePrompt = "Which environment of %s would you like to detect the drift of?"
+	envDriftHelpPrompt = "Detects the resources of the environment and of its addons that were changed outside of Copilot."
+)
+
+type envDriftVars struct {
+	driftVars
+	appName string
+	name    string
+}
+
+type envDriftOpts struct {
+	envDriftVars
+	stackDriftOpts
+
+	store         store
+	sel           configSelector
+	initDescriber func() error
+}
+
+func newEnvDriftOpts(vars envDriftVars) (*envDriftOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env drift"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewStore(defaultSess)
+	opts := &envDriftOpts{
+		envDriftVars: vars,
+		stackDriftOpts: stackDriftOpts{
+			w:    log.OutputWriter,
+			prog: termprogress.NewSpinner(log.DiagnosticWriter),
+		},
+		store: store,
+		sel:   selector.NewConfigSelector(prompt.New(), store),
+	}
+	opts.initDescriber = func() error {
+		env, err := store.GetEnvironment(opts.appName, opts.name)
+		if err != nil {
+			return fmt.Errorf("get environment %s: %w", opts.name, err)
+		}
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return err
+		}
+		opts.describer = describe.NewStackDriftDescriber(stack.NameForEnv(opts.appName, opts.name), sess)
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if any optional flags are invalid.
+func (o *envDriftOpts) Validate() error {
+	return nil
+}
+
+// Ask validates required fields that users passed in, otherwise it prompts for them.
+func (o *envDriftOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateOrAskEnv()
+}
+
+// Execute detects and displays the resources of the environment that drifted from its template.
+func (o *envDriftOpts) Execute() error {
+	if err := o.initDescriber(); err != nil {
+		return err
+	}
+	return o.detectDrift(o.driftVars, fmt.Sprintf("environment %q", o.name))
+}
+
+func (o *envDriftOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name %q: %v", o.appName, err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(envShowAppNamePrompt, envShowAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *envDriftOpts) validateOrAskEnv() error {
+	if o.name != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.name); err != nil {
+			return fmt.Errorf("validate environment name %q in application %q: %v", o.name, o.appName, err)
+		}
+		return nil
+	}
+	env, err := o.sel.Environment(fmt.Sprintf(envDriftNamePrompt, color.HighlightUserInput(o.appName)), envDriftHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+	}
+	o.name = env
+	return nil
+}
+
+// buildEnvDriftCmd builds the command for detecting the drift of an environment.
+func buildEnvDriftCmd() *cobra.Command {
+	vars := envDriftVars{}
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Detects changes made to a deployed environment outside of Copilot.",
+		Long: `Detects changes made to a deployed environment outside of Copilot.
+Shows the resources of the environment and of its addons that were modified or deleted,
+such as security group rules added in the console, with the difference between their template and actual properties.`,
+
+		Example: `
+  Shows the drift of the "prod" environment.
+  /code $ copilot env drift -n prod
+  Fails a CI job if any resource of the environment drifted.
+  /code $ copilot env drift -n prod --fail-on-drift --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newEnvDriftOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.failOnDrift, failOnDriftFlag, false, failOnDriftFlagDescription)
+	return cmd
+}
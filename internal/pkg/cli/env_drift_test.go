@@ -0,0 +1,89 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvDrift_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp   string
+		inputEnv   string
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockconfigSelector)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"validate app and env with all flags passed in": {
+			inputApp: "phonetool",
+			inputEnv: "test",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockconfigSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+		},
+		"prompt for app and env": {
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockconfigSelector) {
+				sel.EXPECT().Application(envShowAppNamePrompt, envShowAppNameHelpPrompt).Return("phonetool", nil)
+				sel.EXPECT().Environment(gomock.Any(), envDriftHelpPrompt, "phonetool").Return("prod", nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "prod",
+		},
+		"errors if the environment doesn't exist": {
+			inputApp: "phonetool",
+			inputEnv: "test",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockconfigSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`validate environment name "test" in application "phonetool": some error`),
+		},
+		"errors if failed to select environment": {
+			inputApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockconfigSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				sel.EXPECT().Environment(gomock.Any(), gomock.Any(), "phonetool").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select environment for application phonetool: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockconfigSelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := &envDriftOpts{
+				envDriftVars: envDriftVars{
+					appName: tc.inputApp,
+					name:    tc.inputEnv,
+				},
+				store: store,
+				sel:   sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.name)
+		})
+	}
+}
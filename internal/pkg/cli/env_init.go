@@ -21,8 +21,6 @@ import (
 	"github.com/spf13/afero"
 	"golang.org/x/mod/semver"
 
-	"github.com/aws/aws-sdk-go/service/ssm"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
@@ -195,9 +193,9 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 	if err != nil {
 		return nil, err
 	}
-	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	store := config.NewStore(defaultSession)
 	prompter := prompt.New()
-	ws, err := workspace.Use(afero.NewOsFs())
+	ws, err := workspace.UseApp(vars.appName, afero.NewOsFs())
 	if err != nil {
 		return nil, err
 	}
@@ -228,7 +226,7 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 		manifestWriter: ws,
 		envLister:      ws,
 
-		wsAppName:       tryReadingAppName(),
+		wsAppName:       tryReadingWorkspaceAppName(vars.appName),
 		templateVersion: version.LatestTemplateVersion(),
 	}, nil
 }
@@ -10,9 +10,6 @@ import (
 	"os"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -46,7 +43,7 @@ func newListEnvOpts(vars listEnvVars) (*listEnvOpts, error) {
 	if err != nil {
 		return nil, err
 	}
-	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	store := config.NewStore(defaultSess)
 	prompter := prompt.New()
 	return &listEnvOpts{
 		listEnvVars: vars,
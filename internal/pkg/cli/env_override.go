@@ -8,9 +8,6 @@ import (
 	"fmt"
 	"slices"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -31,7 +28,7 @@ type overrideEnvOpts struct {
 
 func newOverrideEnvOpts(vars overrideVars) (*overrideEnvOpts, error) {
 	fs := afero.NewOsFs()
-	ws, err := workspace.Use(fs)
+	ws, err := workspace.UseApp(vars.appName, fs)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +38,7 @@ func newOverrideEnvOpts(vars overrideVars) (*overrideEnvOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("default session: %v", err)
 	}
-	cfgStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	cfgStore := config.NewStore(defaultSess)
 	vars.requiresEnv = true
 	prompt := prompt.New()
 	cmd := &overrideEnvOpts{
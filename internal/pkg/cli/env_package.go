@@ -24,8 +24,6 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -92,11 +90,11 @@ func newPackageEnvOpts(vars packageEnvVars) (*packageEnvOpts, error) {
 	}
 
 	fs := afero.NewOsFs()
-	ws, err := workspace.Use(fs)
+	ws, err := workspace.UseApp(vars.appName, fs)
 	if err != nil {
 		return nil, err
 	}
-	cfgStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	cfgStore := config.NewStore(defaultSess)
 
 	opts := &packageEnvOpts{
 		packageEnvVars: vars,
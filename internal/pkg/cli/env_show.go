@@ -7,9 +7,6 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -53,7 +50,7 @@ func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
 	if err != nil {
 		return nil, err
 	}
-	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	store := config.NewStore(defaultSess)
 
 	deployStore, err := deploy.NewStore(sessProvider, store)
 	if err != nil {
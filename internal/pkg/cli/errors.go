@@ -163,3 +163,17 @@ func (e *errPipelineDependsOnEnv) RecommendActions() string {
 or run %s to delete the pipeline before running %s to delete the environment`,
 		e.pipeline, e.env, color.HighlightCode(fmt.Sprintf("copilot pipeline delete -n %s", e.pipeline)), color.HighlightCode(fmt.Sprintf("copilot env delete -n %s", e.env)))
 }
+
+type errStackDrifted struct {
+	component string
+}
+
+func (e *errStackDrifted) Error() string {
+	return fmt.Sprintf("resources of %s drifted from their template", e.component)
+}
+
+func (e *errStackDrifted) RecommendActions() string {
+	return `A deployment that updates the drifted resources overwrites the changes made outside of Copilot.
+- To keep the changes, add them to your manifest or addons templates.
+- Otherwise, revert the changes in the console.`
+}
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/dustin/go-humanize/english"
@@ -48,6 +49,7 @@ const (
 	diffFlag              = "diff"
 	diffAutoApproveFlag   = "diff-yes"
 	sourcesFlag           = "sources"
+	exportFormatFlag      = "format"
 
 	// Flags for operational commands.
 	limitFlag                   = "limit"
@@ -55,6 +57,11 @@ const (
 	followFlag                  = "follow"
 	previousFlag                = "previous"
 	sinceFlag                   = "since"
+	pullRequestFlag             = "pr"
+	executionIDFlag             = "execution-id"
+	commitFlag                  = "commit"
+	waitFlag                    = "wait"
+	deleteFlag                  = "delete"
 	startTimeFlag               = "start-time"
 	endTimeFlag                 = "end-time"
 	tasksFlag                   = "tasks"
@@ -64,6 +71,8 @@ const (
 	resourcesFlag               = "resources"
 	taskIDFlag                  = "task-id"
 	containerFlag               = "container"
+	failOnDriftFlag             = "fail-on-drift"
+	rollbackToFlag              = "to"
 
 	// Run local flags
 	portOverrideFlag   = "port-override"
@@ -76,10 +85,33 @@ const (
 	gitBranchFlag         = "git-branch"
 	envsFlag              = "environments"
 	pipelineTypeFlag      = "pipeline-type"
+	pipelinePlatformFlag  = "platform"
 
 	// Flags for ls.
 	localFlag = "local"
 
+	// Flags for routes.
+	routeSvcFlag           = "svc"
+	routeHostnameFlag      = "hostname"
+	routeRoutingPolicyFlag = "routing"
+	routeWeightsFlag       = "weights"
+	routePrimaryEnvFlag    = "primary"
+
+	// Flags for worker service queues.
+	queueFlag       = "queue"
+	redriveRateFlag = "rate"
+
+	// Flags for configuration profiles.
+	configProfilesFlag = "profiles"
+
+	// Flags for change-aware pipelines.
+	reuseDeployedFlag = "reuse-deployed"
+
+	// Flags for config store migration.
+	storeBackendFlag = "to"
+	storeTableFlag   = "table"
+	storeDirFlag     = "dir"
+
 	// Flags for storage.
 	storageTypeFlag                    = "storage-type"
 	storageLifecycleFlag               = "lifecycle"
@@ -253,6 +285,9 @@ Supported providers are: %s.`, strings.Join(manifest.PipelineProviders, ", "))
 
 	ingressTypeFlagDescription = fmt.Sprintf(`Required for a Request-Driven Web Service. Allowed source of traffic to your service.
 Must be one of %s.`, english.OxfordWordSeries(rdwsIngressOptions, "or"))
+
+	routeRoutingPolicyFlagDescription = fmt.Sprintf(`Route 53 routing policy used to pick an environment.
+Must be one of %s.`, english.OxfordWordSeries(applyAll(config.RoutingPolicies, strconv.Quote), "or"))
 )
 
 const (
@@ -311,6 +346,10 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	localJobFlagDescription          = "Only show jobs in the workspace."
 	localPipelineFlagDescription     = "Only show pipelines in the workspace."
 
+	failOnDriftFlagDescription = `Optional. Exit with an error if resources drifted from their template.
+Use in CI to detect changes made outside of Copilot before they are reverted by a deployment.`
+	rollbackToFlagDescription = `The number of the deployment to roll back to, as listed by "copilot svc history".`
+
 	// Run local
 	envVarOverrideFlagDescription = `Optional. Override environment variables passed to containers.
 Format: [container]:KEY=VALUE. Omit container name to apply to all containers.`
@@ -331,6 +370,9 @@ output the manifest file used for that deployment.`
 	uploadAssetsFlagDescription = `Optional. Whether to upload assets (container images, Lambda functions, etc.).
 Uploaded asset locations are filled in the template configuration.`
 	stackOutputDirFlagDescription = "Optional. Writes the stack template and template configuration to a directory."
+	exportFormatFlagDescription   = `Format of the exported project.
+Must be one of "cloudformation" or "cdk".`
+	exportOutputDirFlagDescription = "Empty directory to write the exported project to."
 
 	// CI/CD.
 	pipelineFlagDescription          = "Name of the pipeline."
@@ -339,6 +381,54 @@ Uploaded asset locations are filled in the template configuration.`
 	gitBranchFlagDescription         = "Branch used to trigger your pipeline."
 	pipelineEnvsFlagDescription      = "Environments to add to the pipeline."
 	pipelineTypeFlagDescription      = `The type of pipeline. Must be either "Workloads" or "Environments".`
+	pipelinePlatformFlagDescription  = `Optional. The platform that runs the pipeline.
+Must be one of "CodePipeline", "GitHubActions" or "GitLabCI". Defaults to "CodePipeline".`
+
+	// Routes.
+	routeFlagDescription         = "Name of the route."
+	routeSvcFlagDescription      = "Name of the Load Balanced Web Service that receives the traffic."
+	routeEnvsFlagDescription     = "Environments that serve the hostname."
+	routeHostnameFlagDescription = "Fully qualified domain name shared by the environments. Must be within the application's domain."
+	routeWeightsFlagDescription  = `Optional. Relative weight of each environment for weighted routing.
+Specified by env=weight separated by commas. (default 1 for each environment)`
+	routePrimaryEnvFlagDescription = "Environment that receives all traffic while it is healthy. Required for failover routing."
+
+	// Worker service queues.
+	queueFlagDescription = `Optional. Logical ID of the queue in the service stack.
+For example, "EventsQueue" or "DeadLetterQueue".`
+	peekCountFlagDescription   = "Optional. The maximum number of messages to peek at."
+	redriveRateFlagDescription = `Optional. The maximum number of messages to move per second, between 1 and 500.
+Defaults to a rate optimized by SQS.`
+
+	// Change-aware pipelines.
+	sinceRevisionFlagDescription = "Optional. Git revision to compare HEAD with. Lists all the workloads if empty."
+	reuseDeployedFlagDescription = `Optional. Write the template and parameters of the stack deployed to the environment
+instead of generating them, so that deploying the stack is a no-op.
+The template is generated as usual if the stack is not deployed yet.`
+
+	// Pipeline executions.
+	commitFlagDescription = `Optional. ID of the commit to run the pipeline at.
+Defaults to the latest commit of the source branch.`
+	waitFlagDescription              = "Optional. Wait for the execution to complete."
+	executionIDFlagDescription       = "Optional. ID of the pipeline execution. Defaults to the latest execution."
+	pipelineFollowFlagDescription    = "Optional. Stream the logs of the builds until the execution completes."
+	pipelineLogsLimitFlagDescription = "Optional. The maximum number of log events returned per build. Defaults to all the events."
+
+	// Preview environments.
+	pullRequestFlagDescription   = "ID of the pull request."
+	deletePreviewFlagDescription = "Optional. Delete the preview environment of the pull request and its workloads."
+
+	// Configuration profiles.
+	configProfilesFlagDescription = `Optional. Names of the configuration profiles to deploy.
+Defaults to all the profiles in the manifest.`
+	configDetachFlagDescription = "Optional. Return once the deployments have started instead of waiting for them to complete."
+
+	// Config store.
+	storeBackendFlagDescription = `Backend to copy the configuration of the applications to.
+Must be one of "ssm", "dynamodb" or "file".`
+	storeTableFlagDescription = `Name of an existing DynamoDB table with a string partition key "path" and a string sort key "name".
+Required for the "dynamodb" backend.`
+	storeDirFlagDescription = `Local directory to copy the configuration to. Required for the "file" backend.`
 
 	// Storage.
 	storageFlagDescription             = "Name of the storage resource to create."
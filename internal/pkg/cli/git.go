@@ -43,3 +43,17 @@ func imageTagFromGit(r execRunner) string {
 	}
 	return commit
 }
+
+// commitFromGit returns the git commit to record in the deployment history in case the user is in a git repository.
+// The commit is suffixed with "-dirty" if there are local changes.
+// Otherwise, returns the empty string.
+func commitFromGit(r execRunner) string {
+	commit, err := describeGitChanges(r)
+	if err != nil {
+		return ""
+	}
+	if isRepoDirty, _ := hasUncommitedGitChanges(r); isRepoDirty {
+		return commit + "-dirty"
+	}
+	return commit
+}
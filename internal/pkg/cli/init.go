@@ -20,7 +20,6 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
 	cmdtemplate "github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
@@ -108,7 +107,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 	if err != nil {
 		return nil, err
 	}
-	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	configStore := config.NewStore(defaultSess)
 	prompt := prompt.New()
 	sel := selector.NewConfigSelector(prompt, configStore)
 	deployStore, err := deploy.NewStore(sessProvider, configStore)
@@ -133,7 +132,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 			return sessions.AreCredsFromEnvVars(defaultSess)
 		},
 		existingWorkspace: func() (wsAppManager, error) {
-			return workspace.Use(fs)
+			return workspace.UseApp(vars.appName, fs)
 		},
 		newWorkspace: func(appName string) (wsAppManager, error) {
 			return workspace.Create(appName, fs)
@@ -204,7 +203,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 	cmd := exec.NewCmd()
 
 	useExistingWorkspaceClient := func(o *initOpts) error {
-		ws, err := workspace.Use(fs)
+		ws, err := workspace.UseApp(*o.appName, fs)
 		if err != nil {
 			return err
 		}
@@ -227,9 +226,10 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		}
 		return nil
 	}
-	ws, err := workspace.Use(fs)
+	ws, err := workspace.UseApp(vars.appName, fs)
 	var errWorkspaceNotFound *workspace.ErrWorkspaceNotFound
-	if err != nil && !errors.As(err, &errWorkspaceNotFound) {
+	var errAppNotInIndex *workspace.ErrAppNotInIndex
+	if err != nil && !errors.As(err, &errWorkspaceNotFound) && !errors.As(err, &errAppNotInIndex) {
 		return nil, err
 	}
 	return &initOpts{
@@ -11,11 +11,14 @@ import (
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/appconfig"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
 	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -26,8 +29,10 @@ import (
 	stackdescr "github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
+	"github.com/aws/copilot-cli/internal/pkg/docker/signature"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/export"
 	"github.com/aws/copilot-cli/internal/pkg/initialize"
 	"github.com/aws/copilot-cli/internal/pkg/logging"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
@@ -137,12 +142,20 @@ type environmentDeleter interface {
 	DeleteEnvironment(appName, environmentName string) error
 }
 
+type routeStore interface {
+	CreateRoute(route *config.Route) error
+	GetRoute(appName, routeName string) (*config.Route, error)
+	ListRoutes(appName string) ([]*config.Route, error)
+	DeleteRoute(appName, routeName string) error
+}
+
 type store interface {
 	applicationStore
 	environmentStore
 	serviceStore
 	jobStore
 	wlStore
+	routeStore
 }
 
 type deployedEnvironmentLister interface {
@@ -342,6 +355,18 @@ type wsPipelineReader interface {
 	PipelineOverridesPath(string) string
 }
 
+type wsPipelineChangesReader interface {
+	wsPipelineGetter
+	manifestReader
+	workspacePathGetter
+}
+
+type wsPipelinePreviewReadWriter interface {
+	wsPipelineChangesReader
+	environmentManifestWriter
+	ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error)
+}
+
 type wsPipelineGetter interface {
 	wsPipelineManifestReader
 	wlLister
@@ -369,6 +394,11 @@ type bucketEmptier interface {
 	EmptyBucket(bucket string) error
 }
 
+type deployedStackGetter interface {
+	Describe(name string) (*awscloudformation.StackDescription, error)
+	TemplateBody(name string) (string, error)
+}
+
 type stackDescriber interface {
 	Resources() ([]*stackdescr.Resource, error)
 }
@@ -428,6 +458,49 @@ type envDeleterFromApp interface {
 	RemoveEnvFromApp(opts *cloudformation.RemoveEnvFromAppOpts) error
 }
 
+type wsExportReader interface {
+	wsEnvironmentsLister
+	wlLister
+	ListPipelines() ([]workspace.PipelineManifest, error)
+}
+
+type exportProject interface {
+	Add(stack *export.Stack) error
+	Write() error
+}
+
+type configStoreCopier interface {
+	CopyTo(dst config.Backend, copied map[string]string) (map[string]string, error)
+}
+
+type routeDeployer interface {
+	DeployRoute(input *deploy.CreateRouteInput, opts ...awscloudformation.StackOption) error
+	DeleteRoute(appName, routeName string) error
+}
+
+type workerQueuesDescriber interface {
+	Queues() ([]*describe.WorkerQueue, error)
+	Describe() (describe.HumanJSONStringer, error)
+}
+
+type queuePeeker interface {
+	PeekMessages(url string, count int) ([]*sqs.Message, error)
+}
+
+type queuePurger interface {
+	Purge(url string) error
+}
+
+type queueRedriver interface {
+	StartRedrive(deadLetterQueueARN string, maxPerSecond int) (string, error)
+	RedriveTask(deadLetterQueueARN, handle string) (*sqs.MessageMoveTask, error)
+}
+
+type appConfigDeployer interface {
+	DeployConfig(in *appconfig.DeployConfigInput) (*appconfig.Deployment, error)
+	Deployment(appID, envID string, number int64) (*appconfig.Deployment, error)
+}
+
 type taskDeployer interface {
 	DeployTask(input *deploy.CreateTaskResourcesInput, opts ...awscloudformation.StackOption) error
 	GetTaskStack(taskName string) (*deploy.TaskStackInfo, error)
@@ -468,6 +541,38 @@ type statusDescriber interface {
 	Describe() (describe.HumanJSONStringer, error)
 }
 
+type stackDriftDescriber interface {
+	Describe() (*describe.StackDrift, error)
+}
+
+type deploymentHistoryLister interface {
+	List(env, name string) ([]*deploy.WorkloadDeployment, error)
+}
+
+type deploymentHistory interface {
+	deploymentHistoryLister
+	Get(env, name string, number int) (*deploy.WorkloadDeployment, error)
+	Template(deployment *deploy.WorkloadDeployment) (string, error)
+	Record(deployment *deploy.WorkloadDeployment) error
+}
+
+type imageExistenceChecker interface {
+	ImageExists(repoName, digest string) (bool, error)
+}
+
+type imageVerifier interface {
+	Verify(ctx context.Context, tool signature.Tool, key, ref string) error
+}
+
+type workloadStackDeployer interface {
+	DeployService(conf cloudformation.StackConfiguration, bucketName string, detach bool, opts ...awscloudformation.StackOption) error
+}
+
+type routeEnvDescriber interface {
+	Params() (map[string]string, error)
+	Outputs() (map[string]string, error)
+}
+
 type envDescriber interface {
 	Describe() (*describe.EnvDescription, error)
 	PublicCIDRBlocks() ([]string, error)
@@ -492,6 +597,19 @@ type pipelineGetter interface {
 	GetPipeline(pipelineName string) (*codepipeline.Pipeline, error)
 }
 
+type pipelineExecutor interface {
+	StartExecution(pipelineName, commitID string) (string, error)
+	Execution(pipelineName, executionID string) (*codepipeline.Execution, error)
+}
+
+type latestExecutionGetter interface {
+	LatestExecutionID(pipelineName string) (string, error)
+}
+
+type pipelineLogWriter interface {
+	WriteLogEvents(opts logging.WritePipelineLogEventsOpts) error
+}
+
 type deployedPipelineLister interface {
 	ListDeployedPipelines(appName string) ([]deploy.Pipeline, error)
 }
@@ -668,6 +786,20 @@ type codestar interface {
 	GetConnectionARN(string) (string, error)
 }
 
+type oidcProviderGetter interface {
+	OIDCProvider(url string) (*iam.OIDCProvider, error)
+}
+
+type workflowParser interface {
+	ParseWorkflow(path string, data interface{}) (*template.Content, error)
+}
+
+type wsWorkflowReadWriter interface {
+	ProjectRoot() string
+	ReadFile(fPath string) ([]byte, error)
+	WritePipelineWorkflow(marshaler encoding.BinaryMarshaler, path string) (string, error)
+}
+
 type publicIPGetter interface {
 	PublicIP(ENI string) (string, error)
 }
@@ -746,6 +878,23 @@ type stackConfiguration interface {
 	SerializedParameters() (string, error)
 }
 
+type pullRequestCommenter interface {
+	CommentOnPullRequest(id, content string) error
+}
+
+type pullRequestForkChecker interface {
+	IsFromFork(id string) (bool, error)
+}
+
+type pullRequestClient interface {
+	pullRequestCommenter
+	pullRequestForkChecker
+}
+
+type uriGetter interface {
+	URI(env string) (describe.URI, error)
+}
+
 type secretGetter interface {
 	GetSecretValue(context.Context, string) (string, error)
 }
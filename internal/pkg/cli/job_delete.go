@@ -9,10 +9,6 @@ import (
 	"os"
 	"slices"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
-
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -79,7 +75,7 @@ func newDeleteJobOpts(vars deleteJobVars) (*deleteJobOpts, error) {
 	if err != nil {
 		return nil, err
 	}
-	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	store := config.NewStore(defaultSession)
 	prompter := prompt.New()
 	return &deleteJobOpts{
 		deleteJobVars: vars,
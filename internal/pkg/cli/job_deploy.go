@@ -9,9 +9,7 @@ import (
 	"io"
 	"os"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/spf13/afero"
@@ -51,6 +49,7 @@ type deployJobOpts struct {
 	sel                  wsSelector
 	prompt               prompter
 	gitShortCommit       string
+	gitCommit            string
 	diffWriter           io.Writer
 
 	// cached variables
@@ -59,6 +58,7 @@ type deployJobOpts struct {
 	envSess           *session.Session
 	appliedDynamicMft manifest.DynamicWorkload
 	rootUserARN       string
+	callerARN         string
 
 	// Overridden in tests.
 	templateVersion string
@@ -70,8 +70,8 @@ func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
 	if err != nil {
 		return nil, err
 	}
-	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
-	ws, err := workspace.Use(afero.NewOsFs())
+	store := config.NewStore(defaultSess)
+	ws, err := workspace.UseApp(vars.appName, afero.NewOsFs())
 	if err != nil {
 		return nil, err
 	}
@@ -252,6 +252,10 @@ func (o *deployJobOpts) Execute() error {
 			DisableRollback: o.disableRollback,
 			Detach:          o.detach,
 		},
+		Provenance: deploy.Provenance{
+			GitCommit:  o.gitCommit,
+			DeployedBy: o.callerARN,
+		},
 	}); err != nil {
 		var errStackDeletedOnInterrupt *deploycfn.ErrStackDeletedOnInterrupt
 		var errStackUpdateCanceledOnInterrupt *deploycfn.ErrStackUpdateCanceledOnInterrupt
@@ -286,6 +290,7 @@ After fixing the deployment, you can:
 
 func (o *deployJobOpts) configureClients() error {
 	o.gitShortCommit = imageTagFromGit(o.cmd) // Best effort assign git tag.
+	o.gitCommit = commitFromGit(o.cmd)
 	env, err := o.store.GetEnvironment(o.appName, o.envName)
 	if err != nil {
 		return err
@@ -314,6 +319,7 @@ func (o *deployJobOpts) configureClients() error {
 		return fmt.Errorf("get identity: %w", err)
 	}
 	o.rootUserARN = caller.RootUserARN
+	o.callerARN = caller.ARN
 
 	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         o.appName,
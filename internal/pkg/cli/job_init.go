@@ -8,9 +8,6 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/version"
@@ -93,7 +90,7 @@ type initJobOpts struct {
 
 func newInitJobOpts(vars initJobVars) (*initJobOpts, error) {
 	fs := afero.NewOsFs()
-	ws, err := workspace.Use(fs)
+	ws, err := workspace.UseApp(vars.appName, fs)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +100,7 @@ func newInitJobOpts(vars initJobVars) (*initJobOpts, error) {
 	if err != nil {
 		return nil, err
 	}
-	store := config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
+	store := config.NewStore(sess)
 	jobInitter := &initialize.WorkloadInitializer{
 		Store:    store,
 		Ws:       ws,
@@ -144,7 +141,7 @@ func newInitJobOpts(vars initJobVars) (*initJobOpts, error) {
 		newAppVersionGetter: func(appName string) (versionGetter, error) {
 			return describe.NewAppDescriber(appName)
 		},
-		wsAppName:       tryReadingAppName(),
+		wsAppName:       tryReadingWorkspaceAppName(vars.appName),
 		templateVersion: version.LatestTemplateVersion(),
 	}, nil
 }
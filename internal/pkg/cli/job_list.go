@@ -7,9 +7,6 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/spf13/afero"
 
@@ -38,12 +35,12 @@ func newListJobOpts(vars listWkldVars) (*listJobOpts, error) {
 	if err != nil {
 		return nil, err
 	}
-	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	store := config.NewStore(defaultSession)
 
 	if err != nil {
 		return nil, err
 	}
-	ws, err := workspace.Use(afero.NewOsFs())
+	ws, err := workspace.UseApp(vars.appName, afero.NewOsFs())
 	if err != nil {
 		return nil, err
 	}
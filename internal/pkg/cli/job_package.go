@@ -8,9 +8,6 @@ import (
 	"os"
 	"slices"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/version"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
@@ -38,6 +35,7 @@ type packageJobVars struct {
 	uploadAssets       bool
 	showDiff           bool
 	allowWkldDowngrade bool
+	reuseDeployed      bool
 }
 
 type packageJobOpts struct {
@@ -61,9 +59,9 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 	if err != nil {
 		return nil, err
 	}
-	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	store := config.NewStore(defaultSess)
 	fs := afero.NewOsFs()
-	ws, err := workspace.Use(fs)
+	ws, err := workspace.UseApp(vars.appName, fs)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +85,7 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 				outputDir:          o.outputDir,
 				uploadAssets:       o.uploadAssets,
 				allowWkldDowngrade: o.allowWkldDowngrade,
+				reuseDeployed:      o.reuseDeployed,
 			},
 			runner:            o.runner,
 			ws:                ws,
@@ -209,8 +208,10 @@ func buildJobPackageCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.uploadAssets, uploadAssetsFlag, false, uploadAssetsFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
+	cmd.Flags().BoolVar(&vars.reuseDeployed, reuseDeployedFlag, false, reuseDeployedFlagDescription)
 
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, stackOutputDirFlag)
+	cmd.MarkFlagsMutuallyExclusive(diffFlag, reuseDeployedFlag)
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, uploadAssetsFlag)
 	return cmd
 }
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./internal/pkg/cli/interfaces.go
+// Source: ./interfaces.go
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -12,11 +12,14 @@ import (
 
 	session "github.com/aws/aws-sdk-go/aws/session"
 	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	appconfig "github.com/aws/copilot-cli/internal/pkg/aws/appconfig"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	iam "github.com/aws/copilot-cli/internal/pkg/aws/iam"
 	secretsmanager "github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	sqs "github.com/aws/copilot-cli/internal/pkg/aws/sqs"
 	ssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	deploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
@@ -27,8 +30,10 @@ import (
 	stack0 "github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	dockerengine "github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	dockerfile "github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
+	signature "github.com/aws/copilot-cli/internal/pkg/docker/signature"
 	ecs0 "github.com/aws/copilot-cli/internal/pkg/ecs"
 	exec "github.com/aws/copilot-cli/internal/pkg/exec"
+	export "github.com/aws/copilot-cli/internal/pkg/export"
 	initialize "github.com/aws/copilot-cli/internal/pkg/initialize"
 	logging "github.com/aws/copilot-cli/internal/pkg/logging"
 	manifest "github.com/aws/copilot-cli/internal/pkg/manifest"
@@ -1002,6 +1007,87 @@ func (mr *MockenvironmentDeleterMockRecorder) DeleteEnvironment(appName, environ
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*MockenvironmentDeleter)(nil).DeleteEnvironment), appName, environmentName)
 }
 
+// MockrouteStore is a mock of routeStore interface.
+type MockrouteStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockrouteStoreMockRecorder
+}
+
+// MockrouteStoreMockRecorder is the mock recorder for MockrouteStore.
+type MockrouteStoreMockRecorder struct {
+	mock *MockrouteStore
+}
+
+// NewMockrouteStore creates a new mock instance.
+func NewMockrouteStore(ctrl *gomock.Controller) *MockrouteStore {
+	mock := &MockrouteStore{ctrl: ctrl}
+	mock.recorder = &MockrouteStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrouteStore) EXPECT() *MockrouteStoreMockRecorder {
+	return m.recorder
+}
+
+// CreateRoute mocks base method.
+func (m *MockrouteStore) CreateRoute(route *config.Route) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoute", route)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRoute indicates an expected call of CreateRoute.
+func (mr *MockrouteStoreMockRecorder) CreateRoute(route interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoute", reflect.TypeOf((*MockrouteStore)(nil).CreateRoute), route)
+}
+
+// DeleteRoute mocks base method.
+func (m *MockrouteStore) DeleteRoute(appName, routeName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoute", appName, routeName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoute indicates an expected call of DeleteRoute.
+func (mr *MockrouteStoreMockRecorder) DeleteRoute(appName, routeName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoute", reflect.TypeOf((*MockrouteStore)(nil).DeleteRoute), appName, routeName)
+}
+
+// GetRoute mocks base method.
+func (m *MockrouteStore) GetRoute(appName, routeName string) (*config.Route, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoute", appName, routeName)
+	ret0, _ := ret[0].(*config.Route)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoute indicates an expected call of GetRoute.
+func (mr *MockrouteStoreMockRecorder) GetRoute(appName, routeName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoute", reflect.TypeOf((*MockrouteStore)(nil).GetRoute), appName, routeName)
+}
+
+// ListRoutes mocks base method.
+func (m *MockrouteStore) ListRoutes(appName string) ([]*config.Route, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoutes", appName)
+	ret0, _ := ret[0].([]*config.Route)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoutes indicates an expected call of ListRoutes.
+func (mr *MockrouteStoreMockRecorder) ListRoutes(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutes", reflect.TypeOf((*MockrouteStore)(nil).ListRoutes), appName)
+}
+
 // Mockstore is a mock of store interface.
 type Mockstore struct {
 	ctrl     *gomock.Controller
@@ -1067,6 +1153,20 @@ func (mr *MockstoreMockRecorder) CreateJob(job interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*Mockstore)(nil).CreateJob), job)
 }
 
+// CreateRoute mocks base method.
+func (m *Mockstore) CreateRoute(route *config.Route) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoute", route)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRoute indicates an expected call of CreateRoute.
+func (mr *MockstoreMockRecorder) CreateRoute(route interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoute", reflect.TypeOf((*Mockstore)(nil).CreateRoute), route)
+}
+
 // CreateService mocks base method.
 func (m *Mockstore) CreateService(svc *config.Workload) error {
 	m.ctrl.T.Helper()
@@ -1123,6 +1223,20 @@ func (mr *MockstoreMockRecorder) DeleteJob(appName, jobName interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJob", reflect.TypeOf((*Mockstore)(nil).DeleteJob), appName, jobName)
 }
 
+// DeleteRoute mocks base method.
+func (m *Mockstore) DeleteRoute(appName, routeName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoute", appName, routeName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoute indicates an expected call of DeleteRoute.
+func (mr *MockstoreMockRecorder) DeleteRoute(appName, routeName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoute", reflect.TypeOf((*Mockstore)(nil).DeleteRoute), appName, routeName)
+}
+
 // DeleteService mocks base method.
 func (m *Mockstore) DeleteService(appName, svcName string) error {
 	m.ctrl.T.Helper()
@@ -1182,6 +1296,21 @@ func (mr *MockstoreMockRecorder) GetJob(appName, jobName interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*Mockstore)(nil).GetJob), appName, jobName)
 }
 
+// GetRoute mocks base method.
+func (m *Mockstore) GetRoute(appName, routeName string) (*config.Route, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoute", appName, routeName)
+	ret0, _ := ret[0].(*config.Route)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoute indicates an expected call of GetRoute.
+func (mr *MockstoreMockRecorder) GetRoute(appName, routeName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoute", reflect.TypeOf((*Mockstore)(nil).GetRoute), appName, routeName)
+}
+
 // GetService mocks base method.
 func (m *Mockstore) GetService(appName, svcName string) (*config.Workload, error) {
 	m.ctrl.T.Helper()
@@ -1257,6 +1386,21 @@ func (mr *MockstoreMockRecorder) ListJobs(appName interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*Mockstore)(nil).ListJobs), appName)
 }
 
+// ListRoutes mocks base method.
+func (m *Mockstore) ListRoutes(appName string) ([]*config.Route, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoutes", appName)
+	ret0, _ := ret[0].([]*config.Route)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoutes indicates an expected call of ListRoutes.
+func (mr *MockstoreMockRecorder) ListRoutes(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutes", reflect.TypeOf((*Mockstore)(nil).ListRoutes), appName)
+}
+
 // ListServices mocks base method.
 func (m *Mockstore) ListServices(appName string) ([]*config.Workload, error) {
 	m.ctrl.T.Helper()
@@ -3597,6 +3741,230 @@ func (mr *MockwsPipelineReaderMockRecorder) Rel(path interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rel", reflect.TypeOf((*MockwsPipelineReader)(nil).Rel), path)
 }
 
+// MockwsPipelineChangesReader is a mock of wsPipelineChangesReader interface.
+type MockwsPipelineChangesReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsPipelineChangesReaderMockRecorder
+}
+
+// MockwsPipelineChangesReaderMockRecorder is the mock recorder for MockwsPipelineChangesReader.
+type MockwsPipelineChangesReaderMockRecorder struct {
+	mock *MockwsPipelineChangesReader
+}
+
+// NewMockwsPipelineChangesReader creates a new mock instance.
+func NewMockwsPipelineChangesReader(ctrl *gomock.Controller) *MockwsPipelineChangesReader {
+	mock := &MockwsPipelineChangesReader{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineChangesReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsPipelineChangesReader) EXPECT() *MockwsPipelineChangesReaderMockRecorder {
+	return m.recorder
+}
+
+// ListPipelines mocks base method.
+func (m *MockwsPipelineChangesReader) ListPipelines() ([]workspace.PipelineManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelines")
+	ret0, _ := ret[0].([]workspace.PipelineManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelines indicates an expected call of ListPipelines.
+func (mr *MockwsPipelineChangesReaderMockRecorder) ListPipelines() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsPipelineChangesReader)(nil).ListPipelines))
+}
+
+// ListWorkloads mocks base method.
+func (m *MockwsPipelineChangesReader) ListWorkloads() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsPipelineChangesReaderMockRecorder) ListWorkloads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsPipelineChangesReader)(nil).ListWorkloads))
+}
+
+// Path mocks base method.
+func (m *MockwsPipelineChangesReader) Path() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Path")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Path indicates an expected call of Path.
+func (mr *MockwsPipelineChangesReaderMockRecorder) Path() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Path", reflect.TypeOf((*MockwsPipelineChangesReader)(nil).Path))
+}
+
+// ReadPipelineManifest mocks base method.
+func (m *MockwsPipelineChangesReader) ReadPipelineManifest(path string) (*manifest.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPipelineManifest", path)
+	ret0, _ := ret[0].(*manifest.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPipelineManifest indicates an expected call of ReadPipelineManifest.
+func (mr *MockwsPipelineChangesReaderMockRecorder) ReadPipelineManifest(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineChangesReader)(nil).ReadPipelineManifest), path)
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsPipelineChangesReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsPipelineChangesReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsPipelineChangesReader)(nil).ReadWorkloadManifest), name)
+}
+
+// MockwsPipelinePreviewReadWriter is a mock of wsPipelinePreviewReadWriter interface.
+type MockwsPipelinePreviewReadWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsPipelinePreviewReadWriterMockRecorder
+}
+
+// MockwsPipelinePreviewReadWriterMockRecorder is the mock recorder for MockwsPipelinePreviewReadWriter.
+type MockwsPipelinePreviewReadWriterMockRecorder struct {
+	mock *MockwsPipelinePreviewReadWriter
+}
+
+// NewMockwsPipelinePreviewReadWriter creates a new mock instance.
+func NewMockwsPipelinePreviewReadWriter(ctrl *gomock.Controller) *MockwsPipelinePreviewReadWriter {
+	mock := &MockwsPipelinePreviewReadWriter{ctrl: ctrl}
+	mock.recorder = &MockwsPipelinePreviewReadWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsPipelinePreviewReadWriter) EXPECT() *MockwsPipelinePreviewReadWriterMockRecorder {
+	return m.recorder
+}
+
+// ListPipelines mocks base method.
+func (m *MockwsPipelinePreviewReadWriter) ListPipelines() ([]workspace.PipelineManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelines")
+	ret0, _ := ret[0].([]workspace.PipelineManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelines indicates an expected call of ListPipelines.
+func (mr *MockwsPipelinePreviewReadWriterMockRecorder) ListPipelines() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsPipelinePreviewReadWriter)(nil).ListPipelines))
+}
+
+// ListWorkloads mocks base method.
+func (m *MockwsPipelinePreviewReadWriter) ListWorkloads() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsPipelinePreviewReadWriterMockRecorder) ListWorkloads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsPipelinePreviewReadWriter)(nil).ListWorkloads))
+}
+
+// Path mocks base method.
+func (m *MockwsPipelinePreviewReadWriter) Path() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Path")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Path indicates an expected call of Path.
+func (mr *MockwsPipelinePreviewReadWriterMockRecorder) Path() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Path", reflect.TypeOf((*MockwsPipelinePreviewReadWriter)(nil).Path))
+}
+
+// ReadEnvironmentManifest mocks base method.
+func (m *MockwsPipelinePreviewReadWriter) ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", mftDirName)
+	ret0, _ := ret[0].(workspace.EnvironmentManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest.
+func (mr *MockwsPipelinePreviewReadWriterMockRecorder) ReadEnvironmentManifest(mftDirName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsPipelinePreviewReadWriter)(nil).ReadEnvironmentManifest), mftDirName)
+}
+
+// ReadPipelineManifest mocks base method.
+func (m *MockwsPipelinePreviewReadWriter) ReadPipelineManifest(path string) (*manifest.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPipelineManifest", path)
+	ret0, _ := ret[0].(*manifest.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPipelineManifest indicates an expected call of ReadPipelineManifest.
+func (mr *MockwsPipelinePreviewReadWriterMockRecorder) ReadPipelineManifest(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelinePreviewReadWriter)(nil).ReadPipelineManifest), path)
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsPipelinePreviewReadWriter) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsPipelinePreviewReadWriterMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsPipelinePreviewReadWriter)(nil).ReadWorkloadManifest), name)
+}
+
+// WriteEnvironmentManifest mocks base method.
+func (m *MockwsPipelinePreviewReadWriter) WriteEnvironmentManifest(arg0 encoding.BinaryMarshaler, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteEnvironmentManifest", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteEnvironmentManifest indicates an expected call of WriteEnvironmentManifest.
+func (mr *MockwsPipelinePreviewReadWriterMockRecorder) WriteEnvironmentManifest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteEnvironmentManifest", reflect.TypeOf((*MockwsPipelinePreviewReadWriter)(nil).WriteEnvironmentManifest), arg0, arg1)
+}
+
 // MockwsPipelineGetter is a mock of wsPipelineGetter interface.
 type MockwsPipelineGetter struct {
 	ctrl     *gomock.Controller
@@ -3868,31 +4236,84 @@ func (mr *MockbucketEmptierMockRecorder) EmptyBucket(bucket interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmptyBucket", reflect.TypeOf((*MockbucketEmptier)(nil).EmptyBucket), bucket)
 }
 
-// MockstackDescriber is a mock of stackDescriber interface.
-type MockstackDescriber struct {
+// MockdeployedStackGetter is a mock of deployedStackGetter interface.
+type MockdeployedStackGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockstackDescriberMockRecorder
+	recorder *MockdeployedStackGetterMockRecorder
 }
 
-// MockstackDescriberMockRecorder is the mock recorder for MockstackDescriber.
-type MockstackDescriberMockRecorder struct {
-	mock *MockstackDescriber
+// MockdeployedStackGetterMockRecorder is the mock recorder for MockdeployedStackGetter.
+type MockdeployedStackGetterMockRecorder struct {
+	mock *MockdeployedStackGetter
 }
 
-// NewMockstackDescriber creates a new mock instance.
-func NewMockstackDescriber(ctrl *gomock.Controller) *MockstackDescriber {
-	mock := &MockstackDescriber{ctrl: ctrl}
-	mock.recorder = &MockstackDescriberMockRecorder{mock}
+// NewMockdeployedStackGetter creates a new mock instance.
+func NewMockdeployedStackGetter(ctrl *gomock.Controller) *MockdeployedStackGetter {
+	mock := &MockdeployedStackGetter{ctrl: ctrl}
+	mock.recorder = &MockdeployedStackGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockstackDescriber) EXPECT() *MockstackDescriberMockRecorder {
+func (m *MockdeployedStackGetter) EXPECT() *MockdeployedStackGetterMockRecorder {
 	return m.recorder
 }
 
-// Resources mocks base method.
-func (m *MockstackDescriber) Resources() ([]*stack0.Resource, error) {
+// Describe mocks base method.
+func (m *MockdeployedStackGetter) Describe(name string) (*cloudformation0.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe", name)
+	ret0, _ := ret[0].(*cloudformation0.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockdeployedStackGetterMockRecorder) Describe(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockdeployedStackGetter)(nil).Describe), name)
+}
+
+// TemplateBody mocks base method.
+func (m *MockdeployedStackGetter) TemplateBody(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TemplateBody", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TemplateBody indicates an expected call of TemplateBody.
+func (mr *MockdeployedStackGetterMockRecorder) TemplateBody(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TemplateBody", reflect.TypeOf((*MockdeployedStackGetter)(nil).TemplateBody), name)
+}
+
+// MockstackDescriber is a mock of stackDescriber interface.
+type MockstackDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackDescriberMockRecorder
+}
+
+// MockstackDescriberMockRecorder is the mock recorder for MockstackDescriber.
+type MockstackDescriberMockRecorder struct {
+	mock *MockstackDescriber
+}
+
+// NewMockstackDescriber creates a new mock instance.
+func NewMockstackDescriber(ctrl *gomock.Controller) *MockstackDescriber {
+	mock := &MockstackDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackDescriber) EXPECT() *MockstackDescriberMockRecorder {
+	return m.recorder
+}
+
+// Resources mocks base method.
+func (m *MockstackDescriber) Resources() ([]*stack0.Resource, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Resources")
 	ret0, _ := ret[0].([]*stack0.Resource)
@@ -4525,6 +4946,453 @@ func (mr *MockenvDeleterFromAppMockRecorder) RemoveEnvFromApp(opts interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEnvFromApp", reflect.TypeOf((*MockenvDeleterFromApp)(nil).RemoveEnvFromApp), opts)
 }
 
+// MockwsExportReader is a mock of wsExportReader interface.
+type MockwsExportReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsExportReaderMockRecorder
+}
+
+// MockwsExportReaderMockRecorder is the mock recorder for MockwsExportReader.
+type MockwsExportReaderMockRecorder struct {
+	mock *MockwsExportReader
+}
+
+// NewMockwsExportReader creates a new mock instance.
+func NewMockwsExportReader(ctrl *gomock.Controller) *MockwsExportReader {
+	mock := &MockwsExportReader{ctrl: ctrl}
+	mock.recorder = &MockwsExportReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsExportReader) EXPECT() *MockwsExportReaderMockRecorder {
+	return m.recorder
+}
+
+// ListEnvironments mocks base method.
+func (m *MockwsExportReader) ListEnvironments() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockwsExportReaderMockRecorder) ListEnvironments() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockwsExportReader)(nil).ListEnvironments))
+}
+
+// ListPipelines mocks base method.
+func (m *MockwsExportReader) ListPipelines() ([]workspace.PipelineManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelines")
+	ret0, _ := ret[0].([]workspace.PipelineManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelines indicates an expected call of ListPipelines.
+func (mr *MockwsExportReaderMockRecorder) ListPipelines() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsExportReader)(nil).ListPipelines))
+}
+
+// ListWorkloads mocks base method.
+func (m *MockwsExportReader) ListWorkloads() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsExportReaderMockRecorder) ListWorkloads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsExportReader)(nil).ListWorkloads))
+}
+
+// MockexportProject is a mock of exportProject interface.
+type MockexportProject struct {
+	ctrl     *gomock.Controller
+	recorder *MockexportProjectMockRecorder
+}
+
+// MockexportProjectMockRecorder is the mock recorder for MockexportProject.
+type MockexportProjectMockRecorder struct {
+	mock *MockexportProject
+}
+
+// NewMockexportProject creates a new mock instance.
+func NewMockexportProject(ctrl *gomock.Controller) *MockexportProject {
+	mock := &MockexportProject{ctrl: ctrl}
+	mock.recorder = &MockexportProjectMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockexportProject) EXPECT() *MockexportProjectMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockexportProject) Add(stack *export.Stack) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", stack)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockexportProjectMockRecorder) Add(stack interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockexportProject)(nil).Add), stack)
+}
+
+// Write mocks base method.
+func (m *MockexportProject) Write() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockexportProjectMockRecorder) Write() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockexportProject)(nil).Write))
+}
+
+// MockconfigStoreCopier is a mock of configStoreCopier interface.
+type MockconfigStoreCopier struct {
+	ctrl     *gomock.Controller
+	recorder *MockconfigStoreCopierMockRecorder
+}
+
+// MockconfigStoreCopierMockRecorder is the mock recorder for MockconfigStoreCopier.
+type MockconfigStoreCopierMockRecorder struct {
+	mock *MockconfigStoreCopier
+}
+
+// NewMockconfigStoreCopier creates a new mock instance.
+func NewMockconfigStoreCopier(ctrl *gomock.Controller) *MockconfigStoreCopier {
+	mock := &MockconfigStoreCopier{ctrl: ctrl}
+	mock.recorder = &MockconfigStoreCopierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockconfigStoreCopier) EXPECT() *MockconfigStoreCopierMockRecorder {
+	return m.recorder
+}
+
+// CopyTo mocks base method.
+func (m *MockconfigStoreCopier) CopyTo(dst config.Backend, copied map[string]string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyTo", dst, copied)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyTo indicates an expected call of CopyTo.
+func (mr *MockconfigStoreCopierMockRecorder) CopyTo(dst, copied interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyTo", reflect.TypeOf((*MockconfigStoreCopier)(nil).CopyTo), dst, copied)
+}
+
+// MockrouteDeployer is a mock of routeDeployer interface.
+type MockrouteDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockrouteDeployerMockRecorder
+}
+
+// MockrouteDeployerMockRecorder is the mock recorder for MockrouteDeployer.
+type MockrouteDeployerMockRecorder struct {
+	mock *MockrouteDeployer
+}
+
+// NewMockrouteDeployer creates a new mock instance.
+func NewMockrouteDeployer(ctrl *gomock.Controller) *MockrouteDeployer {
+	mock := &MockrouteDeployer{ctrl: ctrl}
+	mock.recorder = &MockrouteDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrouteDeployer) EXPECT() *MockrouteDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeleteRoute mocks base method.
+func (m *MockrouteDeployer) DeleteRoute(appName, routeName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoute", appName, routeName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoute indicates an expected call of DeleteRoute.
+func (mr *MockrouteDeployerMockRecorder) DeleteRoute(appName, routeName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoute", reflect.TypeOf((*MockrouteDeployer)(nil).DeleteRoute), appName, routeName)
+}
+
+// DeployRoute mocks base method.
+func (m *MockrouteDeployer) DeployRoute(input *deploy0.CreateRouteInput, opts ...cloudformation0.StackOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployRoute", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployRoute indicates an expected call of DeployRoute.
+func (mr *MockrouteDeployerMockRecorder) DeployRoute(input interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployRoute", reflect.TypeOf((*MockrouteDeployer)(nil).DeployRoute), varargs...)
+}
+
+// MockworkerQueuesDescriber is a mock of workerQueuesDescriber interface.
+type MockworkerQueuesDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkerQueuesDescriberMockRecorder
+}
+
+// MockworkerQueuesDescriberMockRecorder is the mock recorder for MockworkerQueuesDescriber.
+type MockworkerQueuesDescriberMockRecorder struct {
+	mock *MockworkerQueuesDescriber
+}
+
+// NewMockworkerQueuesDescriber creates a new mock instance.
+func NewMockworkerQueuesDescriber(ctrl *gomock.Controller) *MockworkerQueuesDescriber {
+	mock := &MockworkerQueuesDescriber{ctrl: ctrl}
+	mock.recorder = &MockworkerQueuesDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkerQueuesDescriber) EXPECT() *MockworkerQueuesDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockworkerQueuesDescriber) Describe() (describe.HumanJSONStringer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(describe.HumanJSONStringer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockworkerQueuesDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockworkerQueuesDescriber)(nil).Describe))
+}
+
+// Queues mocks base method.
+func (m *MockworkerQueuesDescriber) Queues() ([]*describe.WorkerQueue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Queues")
+	ret0, _ := ret[0].([]*describe.WorkerQueue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Queues indicates an expected call of Queues.
+func (mr *MockworkerQueuesDescriberMockRecorder) Queues() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Queues", reflect.TypeOf((*MockworkerQueuesDescriber)(nil).Queues))
+}
+
+// MockqueuePeeker is a mock of queuePeeker interface.
+type MockqueuePeeker struct {
+	ctrl     *gomock.Controller
+	recorder *MockqueuePeekerMockRecorder
+}
+
+// MockqueuePeekerMockRecorder is the mock recorder for MockqueuePeeker.
+type MockqueuePeekerMockRecorder struct {
+	mock *MockqueuePeeker
+}
+
+// NewMockqueuePeeker creates a new mock instance.
+func NewMockqueuePeeker(ctrl *gomock.Controller) *MockqueuePeeker {
+	mock := &MockqueuePeeker{ctrl: ctrl}
+	mock.recorder = &MockqueuePeekerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockqueuePeeker) EXPECT() *MockqueuePeekerMockRecorder {
+	return m.recorder
+}
+
+// PeekMessages mocks base method.
+func (m *MockqueuePeeker) PeekMessages(url string, count int) ([]*sqs.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PeekMessages", url, count)
+	ret0, _ := ret[0].([]*sqs.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PeekMessages indicates an expected call of PeekMessages.
+func (mr *MockqueuePeekerMockRecorder) PeekMessages(url, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeekMessages", reflect.TypeOf((*MockqueuePeeker)(nil).PeekMessages), url, count)
+}
+
+// MockqueuePurger is a mock of queuePurger interface.
+type MockqueuePurger struct {
+	ctrl     *gomock.Controller
+	recorder *MockqueuePurgerMockRecorder
+}
+
+// MockqueuePurgerMockRecorder is the mock recorder for MockqueuePurger.
+type MockqueuePurgerMockRecorder struct {
+	mock *MockqueuePurger
+}
+
+// NewMockqueuePurger creates a new mock instance.
+func NewMockqueuePurger(ctrl *gomock.Controller) *MockqueuePurger {
+	mock := &MockqueuePurger{ctrl: ctrl}
+	mock.recorder = &MockqueuePurgerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockqueuePurger) EXPECT() *MockqueuePurgerMockRecorder {
+	return m.recorder
+}
+
+// Purge mocks base method.
+func (m *MockqueuePurger) Purge(url string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", url)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockqueuePurgerMockRecorder) Purge(url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockqueuePurger)(nil).Purge), url)
+}
+
+// MockqueueRedriver is a mock of queueRedriver interface.
+type MockqueueRedriver struct {
+	ctrl     *gomock.Controller
+	recorder *MockqueueRedriverMockRecorder
+}
+
+// MockqueueRedriverMockRecorder is the mock recorder for MockqueueRedriver.
+type MockqueueRedriverMockRecorder struct {
+	mock *MockqueueRedriver
+}
+
+// NewMockqueueRedriver creates a new mock instance.
+func NewMockqueueRedriver(ctrl *gomock.Controller) *MockqueueRedriver {
+	mock := &MockqueueRedriver{ctrl: ctrl}
+	mock.recorder = &MockqueueRedriverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockqueueRedriver) EXPECT() *MockqueueRedriverMockRecorder {
+	return m.recorder
+}
+
+// RedriveTask mocks base method.
+func (m *MockqueueRedriver) RedriveTask(deadLetterQueueARN, handle string) (*sqs.MessageMoveTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedriveTask", deadLetterQueueARN, handle)
+	ret0, _ := ret[0].(*sqs.MessageMoveTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedriveTask indicates an expected call of RedriveTask.
+func (mr *MockqueueRedriverMockRecorder) RedriveTask(deadLetterQueueARN, handle interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedriveTask", reflect.TypeOf((*MockqueueRedriver)(nil).RedriveTask), deadLetterQueueARN, handle)
+}
+
+// StartRedrive mocks base method.
+func (m *MockqueueRedriver) StartRedrive(deadLetterQueueARN string, maxPerSecond int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRedrive", deadLetterQueueARN, maxPerSecond)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRedrive indicates an expected call of StartRedrive.
+func (mr *MockqueueRedriverMockRecorder) StartRedrive(deadLetterQueueARN, maxPerSecond interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRedrive", reflect.TypeOf((*MockqueueRedriver)(nil).StartRedrive), deadLetterQueueARN, maxPerSecond)
+}
+
+// MockappConfigDeployer is a mock of appConfigDeployer interface.
+type MockappConfigDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockappConfigDeployerMockRecorder
+}
+
+// MockappConfigDeployerMockRecorder is the mock recorder for MockappConfigDeployer.
+type MockappConfigDeployerMockRecorder struct {
+	mock *MockappConfigDeployer
+}
+
+// NewMockappConfigDeployer creates a new mock instance.
+func NewMockappConfigDeployer(ctrl *gomock.Controller) *MockappConfigDeployer {
+	mock := &MockappConfigDeployer{ctrl: ctrl}
+	mock.recorder = &MockappConfigDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockappConfigDeployer) EXPECT() *MockappConfigDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployConfig mocks base method.
+func (m *MockappConfigDeployer) DeployConfig(in *appconfig.DeployConfigInput) (*appconfig.Deployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployConfig", in)
+	ret0, _ := ret[0].(*appconfig.Deployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployConfig indicates an expected call of DeployConfig.
+func (mr *MockappConfigDeployerMockRecorder) DeployConfig(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployConfig", reflect.TypeOf((*MockappConfigDeployer)(nil).DeployConfig), in)
+}
+
+// Deployment mocks base method.
+func (m *MockappConfigDeployer) Deployment(appID, envID string, number int64) (*appconfig.Deployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deployment", appID, envID, number)
+	ret0, _ := ret[0].(*appconfig.Deployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deployment indicates an expected call of Deployment.
+func (mr *MockappConfigDeployerMockRecorder) Deployment(appID, envID, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deployment", reflect.TypeOf((*MockappConfigDeployer)(nil).Deployment), appID, envID, number)
+}
+
 // MocktaskDeployer is a mock of taskDeployer interface.
 type MocktaskDeployer struct {
 	ctrl     *gomock.Controller
@@ -5152,24 +6020,352 @@ func NewMockstatusDescriber(ctrl *gomock.Controller) *MockstatusDescriber {
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockstatusDescriber) EXPECT() *MockstatusDescriberMockRecorder {
-	return m.recorder
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstatusDescriber) EXPECT() *MockstatusDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockstatusDescriber) Describe() (describe.HumanJSONStringer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(describe.HumanJSONStringer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockstatusDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstatusDescriber)(nil).Describe))
+}
+
+// MockstackDriftDescriber is a mock of stackDriftDescriber interface.
+type MockstackDriftDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackDriftDescriberMockRecorder
+}
+
+// MockstackDriftDescriberMockRecorder is the mock recorder for MockstackDriftDescriber.
+type MockstackDriftDescriberMockRecorder struct {
+	mock *MockstackDriftDescriber
+}
+
+// NewMockstackDriftDescriber creates a new mock instance.
+func NewMockstackDriftDescriber(ctrl *gomock.Controller) *MockstackDriftDescriber {
+	mock := &MockstackDriftDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackDriftDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackDriftDescriber) EXPECT() *MockstackDriftDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockstackDriftDescriber) Describe() (*describe.StackDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.StackDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockstackDriftDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstackDriftDescriber)(nil).Describe))
+}
+
+// MockdeploymentHistoryLister is a mock of deploymentHistoryLister interface.
+type MockdeploymentHistoryLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeploymentHistoryListerMockRecorder
+}
+
+// MockdeploymentHistoryListerMockRecorder is the mock recorder for MockdeploymentHistoryLister.
+type MockdeploymentHistoryListerMockRecorder struct {
+	mock *MockdeploymentHistoryLister
+}
+
+// NewMockdeploymentHistoryLister creates a new mock instance.
+func NewMockdeploymentHistoryLister(ctrl *gomock.Controller) *MockdeploymentHistoryLister {
+	mock := &MockdeploymentHistoryLister{ctrl: ctrl}
+	mock.recorder = &MockdeploymentHistoryListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeploymentHistoryLister) EXPECT() *MockdeploymentHistoryListerMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockdeploymentHistoryLister) List(env, name string) ([]*deploy0.WorkloadDeployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", env, name)
+	ret0, _ := ret[0].([]*deploy0.WorkloadDeployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockdeploymentHistoryListerMockRecorder) List(env, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockdeploymentHistoryLister)(nil).List), env, name)
+}
+
+// MockdeploymentHistory is a mock of deploymentHistory interface.
+type MockdeploymentHistory struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeploymentHistoryMockRecorder
+}
+
+// MockdeploymentHistoryMockRecorder is the mock recorder for MockdeploymentHistory.
+type MockdeploymentHistoryMockRecorder struct {
+	mock *MockdeploymentHistory
+}
+
+// NewMockdeploymentHistory creates a new mock instance.
+func NewMockdeploymentHistory(ctrl *gomock.Controller) *MockdeploymentHistory {
+	mock := &MockdeploymentHistory{ctrl: ctrl}
+	mock.recorder = &MockdeploymentHistoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeploymentHistory) EXPECT() *MockdeploymentHistoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockdeploymentHistory) Get(env, name string, number int) (*deploy0.WorkloadDeployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", env, name, number)
+	ret0, _ := ret[0].(*deploy0.WorkloadDeployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockdeploymentHistoryMockRecorder) Get(env, name, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockdeploymentHistory)(nil).Get), env, name, number)
+}
+
+// List mocks base method.
+func (m *MockdeploymentHistory) List(env, name string) ([]*deploy0.WorkloadDeployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", env, name)
+	ret0, _ := ret[0].([]*deploy0.WorkloadDeployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockdeploymentHistoryMockRecorder) List(env, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockdeploymentHistory)(nil).List), env, name)
+}
+
+// Record mocks base method.
+func (m *MockdeploymentHistory) Record(deployment *deploy0.WorkloadDeployment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", deployment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockdeploymentHistoryMockRecorder) Record(deployment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockdeploymentHistory)(nil).Record), deployment)
+}
+
+// Template mocks base method.
+func (m *MockdeploymentHistory) Template(deployment *deploy0.WorkloadDeployment) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Template", deployment)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Template indicates an expected call of Template.
+func (mr *MockdeploymentHistoryMockRecorder) Template(deployment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockdeploymentHistory)(nil).Template), deployment)
+}
+
+// MockimageExistenceChecker is a mock of imageExistenceChecker interface.
+type MockimageExistenceChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageExistenceCheckerMockRecorder
+}
+
+// MockimageExistenceCheckerMockRecorder is the mock recorder for MockimageExistenceChecker.
+type MockimageExistenceCheckerMockRecorder struct {
+	mock *MockimageExistenceChecker
+}
+
+// NewMockimageExistenceChecker creates a new mock instance.
+func NewMockimageExistenceChecker(ctrl *gomock.Controller) *MockimageExistenceChecker {
+	mock := &MockimageExistenceChecker{ctrl: ctrl}
+	mock.recorder = &MockimageExistenceCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageExistenceChecker) EXPECT() *MockimageExistenceCheckerMockRecorder {
+	return m.recorder
+}
+
+// ImageExists mocks base method.
+func (m *MockimageExistenceChecker) ImageExists(repoName, digest string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageExists", repoName, digest)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageExists indicates an expected call of ImageExists.
+func (mr *MockimageExistenceCheckerMockRecorder) ImageExists(repoName, digest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageExists", reflect.TypeOf((*MockimageExistenceChecker)(nil).ImageExists), repoName, digest)
+}
+
+// MockimageVerifier is a mock of imageVerifier interface.
+type MockimageVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageVerifierMockRecorder
+}
+
+// MockimageVerifierMockRecorder is the mock recorder for MockimageVerifier.
+type MockimageVerifierMockRecorder struct {
+	mock *MockimageVerifier
+}
+
+// NewMockimageVerifier creates a new mock instance.
+func NewMockimageVerifier(ctrl *gomock.Controller) *MockimageVerifier {
+	mock := &MockimageVerifier{ctrl: ctrl}
+	mock.recorder = &MockimageVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageVerifier) EXPECT() *MockimageVerifierMockRecorder {
+	return m.recorder
+}
+
+// Verify mocks base method.
+func (m *MockimageVerifier) Verify(ctx context.Context, tool signature.Tool, key, ref string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, tool, key, ref)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockimageVerifierMockRecorder) Verify(ctx, tool, key, ref interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockimageVerifier)(nil).Verify), ctx, tool, key, ref)
+}
+
+// MockworkloadStackDeployer is a mock of workloadStackDeployer interface.
+type MockworkloadStackDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkloadStackDeployerMockRecorder
+}
+
+// MockworkloadStackDeployerMockRecorder is the mock recorder for MockworkloadStackDeployer.
+type MockworkloadStackDeployerMockRecorder struct {
+	mock *MockworkloadStackDeployer
+}
+
+// NewMockworkloadStackDeployer creates a new mock instance.
+func NewMockworkloadStackDeployer(ctrl *gomock.Controller) *MockworkloadStackDeployer {
+	mock := &MockworkloadStackDeployer{ctrl: ctrl}
+	mock.recorder = &MockworkloadStackDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkloadStackDeployer) EXPECT() *MockworkloadStackDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployService mocks base method.
+func (m *MockworkloadStackDeployer) DeployService(conf cloudformation1.StackConfiguration, bucketName string, detach bool, opts ...cloudformation0.StackOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf, bucketName, detach}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployService", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployService indicates an expected call of DeployService.
+func (mr *MockworkloadStackDeployerMockRecorder) DeployService(conf, bucketName, detach interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf, bucketName, detach}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MockworkloadStackDeployer)(nil).DeployService), varargs...)
+}
+
+// MockrouteEnvDescriber is a mock of routeEnvDescriber interface.
+type MockrouteEnvDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockrouteEnvDescriberMockRecorder
+}
+
+// MockrouteEnvDescriberMockRecorder is the mock recorder for MockrouteEnvDescriber.
+type MockrouteEnvDescriberMockRecorder struct {
+	mock *MockrouteEnvDescriber
+}
+
+// NewMockrouteEnvDescriber creates a new mock instance.
+func NewMockrouteEnvDescriber(ctrl *gomock.Controller) *MockrouteEnvDescriber {
+	mock := &MockrouteEnvDescriber{ctrl: ctrl}
+	mock.recorder = &MockrouteEnvDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrouteEnvDescriber) EXPECT() *MockrouteEnvDescriberMockRecorder {
+	return m.recorder
+}
+
+// Outputs mocks base method.
+func (m *MockrouteEnvDescriber) Outputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outputs indicates an expected call of Outputs.
+func (mr *MockrouteEnvDescriberMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockrouteEnvDescriber)(nil).Outputs))
 }
 
-// Describe mocks base method.
-func (m *MockstatusDescriber) Describe() (describe.HumanJSONStringer, error) {
+// Params mocks base method.
+func (m *MockrouteEnvDescriber) Params() (map[string]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Describe")
-	ret0, _ := ret[0].(describe.HumanJSONStringer)
+	ret := m.ctrl.Call(m, "Params")
+	ret0, _ := ret[0].(map[string]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Describe indicates an expected call of Describe.
-func (mr *MockstatusDescriberMockRecorder) Describe() *gomock.Call {
+// Params indicates an expected call of Params.
+func (mr *MockrouteEnvDescriberMockRecorder) Params() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstatusDescriber)(nil).Describe))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockrouteEnvDescriber)(nil).Params))
 }
 
 // MockenvDescriber is a mock of envDescriber interface.
@@ -5420,6 +6616,134 @@ func (mr *MockpipelineGetterMockRecorder) GetPipeline(pipelineName interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipeline), pipelineName)
 }
 
+// MockpipelineExecutor is a mock of pipelineExecutor interface.
+type MockpipelineExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineExecutorMockRecorder
+}
+
+// MockpipelineExecutorMockRecorder is the mock recorder for MockpipelineExecutor.
+type MockpipelineExecutorMockRecorder struct {
+	mock *MockpipelineExecutor
+}
+
+// NewMockpipelineExecutor creates a new mock instance.
+func NewMockpipelineExecutor(ctrl *gomock.Controller) *MockpipelineExecutor {
+	mock := &MockpipelineExecutor{ctrl: ctrl}
+	mock.recorder = &MockpipelineExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineExecutor) EXPECT() *MockpipelineExecutorMockRecorder {
+	return m.recorder
+}
+
+// Execution mocks base method.
+func (m *MockpipelineExecutor) Execution(pipelineName, executionID string) (*codepipeline.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execution", pipelineName, executionID)
+	ret0, _ := ret[0].(*codepipeline.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execution indicates an expected call of Execution.
+func (mr *MockpipelineExecutorMockRecorder) Execution(pipelineName, executionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execution", reflect.TypeOf((*MockpipelineExecutor)(nil).Execution), pipelineName, executionID)
+}
+
+// StartExecution mocks base method.
+func (m *MockpipelineExecutor) StartExecution(pipelineName, commitID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartExecution", pipelineName, commitID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartExecution indicates an expected call of StartExecution.
+func (mr *MockpipelineExecutorMockRecorder) StartExecution(pipelineName, commitID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartExecution", reflect.TypeOf((*MockpipelineExecutor)(nil).StartExecution), pipelineName, commitID)
+}
+
+// MocklatestExecutionGetter is a mock of latestExecutionGetter interface.
+type MocklatestExecutionGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocklatestExecutionGetterMockRecorder
+}
+
+// MocklatestExecutionGetterMockRecorder is the mock recorder for MocklatestExecutionGetter.
+type MocklatestExecutionGetterMockRecorder struct {
+	mock *MocklatestExecutionGetter
+}
+
+// NewMocklatestExecutionGetter creates a new mock instance.
+func NewMocklatestExecutionGetter(ctrl *gomock.Controller) *MocklatestExecutionGetter {
+	mock := &MocklatestExecutionGetter{ctrl: ctrl}
+	mock.recorder = &MocklatestExecutionGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklatestExecutionGetter) EXPECT() *MocklatestExecutionGetterMockRecorder {
+	return m.recorder
+}
+
+// LatestExecutionID mocks base method.
+func (m *MocklatestExecutionGetter) LatestExecutionID(pipelineName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestExecutionID", pipelineName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestExecutionID indicates an expected call of LatestExecutionID.
+func (mr *MocklatestExecutionGetterMockRecorder) LatestExecutionID(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestExecutionID", reflect.TypeOf((*MocklatestExecutionGetter)(nil).LatestExecutionID), pipelineName)
+}
+
+// MockpipelineLogWriter is a mock of pipelineLogWriter interface.
+type MockpipelineLogWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineLogWriterMockRecorder
+}
+
+// MockpipelineLogWriterMockRecorder is the mock recorder for MockpipelineLogWriter.
+type MockpipelineLogWriterMockRecorder struct {
+	mock *MockpipelineLogWriter
+}
+
+// NewMockpipelineLogWriter creates a new mock instance.
+func NewMockpipelineLogWriter(ctrl *gomock.Controller) *MockpipelineLogWriter {
+	mock := &MockpipelineLogWriter{ctrl: ctrl}
+	mock.recorder = &MockpipelineLogWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineLogWriter) EXPECT() *MockpipelineLogWriterMockRecorder {
+	return m.recorder
+}
+
+// WriteLogEvents mocks base method.
+func (m *MockpipelineLogWriter) WriteLogEvents(opts logging.WritePipelineLogEventsOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteLogEvents", opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteLogEvents indicates an expected call of WriteLogEvents.
+func (mr *MockpipelineLogWriterMockRecorder) WriteLogEvents(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteLogEvents", reflect.TypeOf((*MockpipelineLogWriter)(nil).WriteLogEvents), opts)
+}
+
 // MockdeployedPipelineLister is a mock of deployedPipelineLister interface.
 type MockdeployedPipelineLister struct {
 	ctrl     *gomock.Controller
@@ -7287,6 +8611,149 @@ func (mr *MockcodestarMockRecorder) GetConnectionARN(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectionARN", reflect.TypeOf((*Mockcodestar)(nil).GetConnectionARN), arg0)
 }
 
+// MockoidcProviderGetter is a mock of oidcProviderGetter interface.
+type MockoidcProviderGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockoidcProviderGetterMockRecorder
+}
+
+// MockoidcProviderGetterMockRecorder is the mock recorder for MockoidcProviderGetter.
+type MockoidcProviderGetterMockRecorder struct {
+	mock *MockoidcProviderGetter
+}
+
+// NewMockoidcProviderGetter creates a new mock instance.
+func NewMockoidcProviderGetter(ctrl *gomock.Controller) *MockoidcProviderGetter {
+	mock := &MockoidcProviderGetter{ctrl: ctrl}
+	mock.recorder = &MockoidcProviderGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoidcProviderGetter) EXPECT() *MockoidcProviderGetterMockRecorder {
+	return m.recorder
+}
+
+// OIDCProvider mocks base method.
+func (m *MockoidcProviderGetter) OIDCProvider(url string) (*iam.OIDCProvider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OIDCProvider", url)
+	ret0, _ := ret[0].(*iam.OIDCProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OIDCProvider indicates an expected call of OIDCProvider.
+func (mr *MockoidcProviderGetterMockRecorder) OIDCProvider(url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OIDCProvider", reflect.TypeOf((*MockoidcProviderGetter)(nil).OIDCProvider), url)
+}
+
+// MockworkflowParser is a mock of workflowParser interface.
+type MockworkflowParser struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkflowParserMockRecorder
+}
+
+// MockworkflowParserMockRecorder is the mock recorder for MockworkflowParser.
+type MockworkflowParserMockRecorder struct {
+	mock *MockworkflowParser
+}
+
+// NewMockworkflowParser creates a new mock instance.
+func NewMockworkflowParser(ctrl *gomock.Controller) *MockworkflowParser {
+	mock := &MockworkflowParser{ctrl: ctrl}
+	mock.recorder = &MockworkflowParserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkflowParser) EXPECT() *MockworkflowParserMockRecorder {
+	return m.recorder
+}
+
+// ParseWorkflow mocks base method.
+func (m *MockworkflowParser) ParseWorkflow(path string, data interface{}) (*template.Content, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseWorkflow", path, data)
+	ret0, _ := ret[0].(*template.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseWorkflow indicates an expected call of ParseWorkflow.
+func (mr *MockworkflowParserMockRecorder) ParseWorkflow(path, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseWorkflow", reflect.TypeOf((*MockworkflowParser)(nil).ParseWorkflow), path, data)
+}
+
+// MockwsWorkflowReadWriter is a mock of wsWorkflowReadWriter interface.
+type MockwsWorkflowReadWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsWorkflowReadWriterMockRecorder
+}
+
+// MockwsWorkflowReadWriterMockRecorder is the mock recorder for MockwsWorkflowReadWriter.
+type MockwsWorkflowReadWriterMockRecorder struct {
+	mock *MockwsWorkflowReadWriter
+}
+
+// NewMockwsWorkflowReadWriter creates a new mock instance.
+func NewMockwsWorkflowReadWriter(ctrl *gomock.Controller) *MockwsWorkflowReadWriter {
+	mock := &MockwsWorkflowReadWriter{ctrl: ctrl}
+	mock.recorder = &MockwsWorkflowReadWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsWorkflowReadWriter) EXPECT() *MockwsWorkflowReadWriterMockRecorder {
+	return m.recorder
+}
+
+// ProjectRoot mocks base method.
+func (m *MockwsWorkflowReadWriter) ProjectRoot() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectRoot")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ProjectRoot indicates an expected call of ProjectRoot.
+func (mr *MockwsWorkflowReadWriterMockRecorder) ProjectRoot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectRoot", reflect.TypeOf((*MockwsWorkflowReadWriter)(nil).ProjectRoot))
+}
+
+// ReadFile mocks base method.
+func (m *MockwsWorkflowReadWriter) ReadFile(fPath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadFile", fPath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadFile indicates an expected call of ReadFile.
+func (mr *MockwsWorkflowReadWriterMockRecorder) ReadFile(fPath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFile", reflect.TypeOf((*MockwsWorkflowReadWriter)(nil).ReadFile), fPath)
+}
+
+// WritePipelineWorkflow mocks base method.
+func (m *MockwsWorkflowReadWriter) WritePipelineWorkflow(marshaler encoding.BinaryMarshaler, path string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WritePipelineWorkflow", marshaler, path)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WritePipelineWorkflow indicates an expected call of WritePipelineWorkflow.
+func (mr *MockwsWorkflowReadWriterMockRecorder) WritePipelineWorkflow(marshaler, path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineWorkflow", reflect.TypeOf((*MockwsWorkflowReadWriter)(nil).WritePipelineWorkflow), marshaler, path)
+}
+
 // MockpublicIPGetter is a mock of publicIPGetter interface.
 type MockpublicIPGetter struct {
 	ctrl     *gomock.Controller
@@ -8115,6 +9582,171 @@ func (mr *MockstackConfigurationMockRecorder) Template() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockstackConfiguration)(nil).Template))
 }
 
+// MockpullRequestCommenter is a mock of pullRequestCommenter interface.
+type MockpullRequestCommenter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpullRequestCommenterMockRecorder
+}
+
+// MockpullRequestCommenterMockRecorder is the mock recorder for MockpullRequestCommenter.
+type MockpullRequestCommenterMockRecorder struct {
+	mock *MockpullRequestCommenter
+}
+
+// NewMockpullRequestCommenter creates a new mock instance.
+func NewMockpullRequestCommenter(ctrl *gomock.Controller) *MockpullRequestCommenter {
+	mock := &MockpullRequestCommenter{ctrl: ctrl}
+	mock.recorder = &MockpullRequestCommenterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpullRequestCommenter) EXPECT() *MockpullRequestCommenterMockRecorder {
+	return m.recorder
+}
+
+// CommentOnPullRequest mocks base method.
+func (m *MockpullRequestCommenter) CommentOnPullRequest(id, content string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommentOnPullRequest", id, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommentOnPullRequest indicates an expected call of CommentOnPullRequest.
+func (mr *MockpullRequestCommenterMockRecorder) CommentOnPullRequest(id, content interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommentOnPullRequest", reflect.TypeOf((*MockpullRequestCommenter)(nil).CommentOnPullRequest), id, content)
+}
+
+// MockpullRequestForkChecker is a mock of pullRequestForkChecker interface.
+type MockpullRequestForkChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockpullRequestForkCheckerMockRecorder
+}
+
+// MockpullRequestForkCheckerMockRecorder is the mock recorder for MockpullRequestForkChecker.
+type MockpullRequestForkCheckerMockRecorder struct {
+	mock *MockpullRequestForkChecker
+}
+
+// NewMockpullRequestForkChecker creates a new mock instance.
+func NewMockpullRequestForkChecker(ctrl *gomock.Controller) *MockpullRequestForkChecker {
+	mock := &MockpullRequestForkChecker{ctrl: ctrl}
+	mock.recorder = &MockpullRequestForkCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpullRequestForkChecker) EXPECT() *MockpullRequestForkCheckerMockRecorder {
+	return m.recorder
+}
+
+// IsFromFork mocks base method.
+func (m *MockpullRequestForkChecker) IsFromFork(id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFromFork", id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFromFork indicates an expected call of IsFromFork.
+func (mr *MockpullRequestForkCheckerMockRecorder) IsFromFork(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFromFork", reflect.TypeOf((*MockpullRequestForkChecker)(nil).IsFromFork), id)
+}
+
+// MockpullRequestClient is a mock of pullRequestClient interface.
+type MockpullRequestClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockpullRequestClientMockRecorder
+}
+
+// MockpullRequestClientMockRecorder is the mock recorder for MockpullRequestClient.
+type MockpullRequestClientMockRecorder struct {
+	mock *MockpullRequestClient
+}
+
+// NewMockpullRequestClient creates a new mock instance.
+func NewMockpullRequestClient(ctrl *gomock.Controller) *MockpullRequestClient {
+	mock := &MockpullRequestClient{ctrl: ctrl}
+	mock.recorder = &MockpullRequestClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpullRequestClient) EXPECT() *MockpullRequestClientMockRecorder {
+	return m.recorder
+}
+
+// CommentOnPullRequest mocks base method.
+func (m *MockpullRequestClient) CommentOnPullRequest(id, content string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommentOnPullRequest", id, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommentOnPullRequest indicates an expected call of CommentOnPullRequest.
+func (mr *MockpullRequestClientMockRecorder) CommentOnPullRequest(id, content interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommentOnPullRequest", reflect.TypeOf((*MockpullRequestClient)(nil).CommentOnPullRequest), id, content)
+}
+
+// IsFromFork mocks base method.
+func (m *MockpullRequestClient) IsFromFork(id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFromFork", id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFromFork indicates an expected call of IsFromFork.
+func (mr *MockpullRequestClientMockRecorder) IsFromFork(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFromFork", reflect.TypeOf((*MockpullRequestClient)(nil).IsFromFork), id)
+}
+
+// MockuriGetter is a mock of uriGetter interface.
+type MockuriGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockuriGetterMockRecorder
+}
+
+// MockuriGetterMockRecorder is the mock recorder for MockuriGetter.
+type MockuriGetterMockRecorder struct {
+	mock *MockuriGetter
+}
+
+// NewMockuriGetter creates a new mock instance.
+func NewMockuriGetter(ctrl *gomock.Controller) *MockuriGetter {
+	mock := &MockuriGetter{ctrl: ctrl}
+	mock.recorder = &MockuriGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuriGetter) EXPECT() *MockuriGetterMockRecorder {
+	return m.recorder
+}
+
+// URI mocks base method.
+func (m *MockuriGetter) URI(env string) (describe.URI, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "URI", env)
+	ret0, _ := ret[0].(describe.URI)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// URI indicates an expected call of URI.
+func (mr *MockuriGetterMockRecorder) URI(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URI", reflect.TypeOf((*MockuriGetter)(nil).URI), env)
+}
+
 // MocksecretGetter is a mock of secretGetter interface.
 type MocksecretGetter struct {
 	ctrl     *gomock.Controller
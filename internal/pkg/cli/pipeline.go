@@ -25,7 +25,12 @@ Continuous delivery pipelines to release services.`,
 	cmd.AddCommand(buildPipelineDeleteCmd())
 	cmd.AddCommand(buildPipelineShowCmd())
 	cmd.AddCommand(buildPipelineStatusCmd())
+	cmd.AddCommand(buildPipelineDriftCmd())
 	cmd.AddCommand(buildPipelineListCmd())
+	cmd.AddCommand(buildPipelineRunCmd())
+	cmd.AddCommand(buildPipelineLogsCmd())
+	cmd.AddCommand(buildPipelineChangesCmd())
+	cmd.AddCommand(buildPipelinePreviewCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
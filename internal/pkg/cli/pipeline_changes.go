@@ -0,0 +1,240 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type pipelineChangesVars struct {
+	appName string
+	name    string
+	since   string
+}
+
+type pipelineChangesOpts struct {
+	pipelineChangesVars
+
+	ws        wsPipelineChangesReader
+	runner    execRunner
+	unmarshal func([]byte) (manifest.DynamicWorkload, error)
+	w         io.Writer
+}
+
+func newPipelineChangesOpts(vars pipelineChangesVars) (*pipelineChangesOpts, error) {
+	ws, err := workspace.UseApp(vars.appName, afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	return &pipelineChangesOpts{
+		pipelineChangesVars: vars,
+		ws:                  ws,
+		runner:              exec.NewCmd(),
+		unmarshal:           manifest.UnmarshalWorkload,
+		w:                   os.Stdout,
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *pipelineChangesOpts) Validate() error {
+	if o.name == "" {
+		return fmt.Errorf("--%s is required", nameFlag)
+	}
+	return nil
+}
+
+// Ask is a no-op: the command runs in the build stage of a pipeline.
+func (o *pipelineChangesOpts) Ask() error {
+	return nil
+}
+
+// Execute writes the names of the workloads affected by the changes since the revision, one per line.
+// If the pipeline doesn't only build changed workloads, or the changes can't be computed, writes all the workloads.
+func (o *pipelineChangesOpts) Execute() error {
+	mft, mftPath, err := o.pipelineManifest()
+	if err != nil {
+		return err
+	}
+	if mft.Build == nil || !mft.Build.OnlyChanged {
+		workloads, err := o.workloads()
+		if err != nil {
+			return err
+		}
+		return o.write(workloads)
+	}
+	changed, err := o.changedWorkloads(mft.Build, mftPath)
+	if err != nil {
+		return err
+	}
+	return o.write(changed)
+}
+
+// changedWorkloads returns the sorted workloads affected by the changes since the revision.
+// If there is no revision, or the changes can't be computed, returns all the workloads.
+func (o *pipelineChangesOpts) changedWorkloads(build *manifest.Build, mftPath string) ([]string, error) {
+	workloads, err := o.workloads()
+	if err != nil {
+		return nil, err
+	}
+	if o.since == "" {
+		return workloads, nil
+	}
+	files, err := o.changedFiles()
+	if err != nil {
+		log.Warningf("Consider all workloads changed: list changes since %s: %v\n", o.since, err)
+		return workloads, nil
+	}
+	pipelineDir, err := filepath.Rel(o.ws.Path(), filepath.Dir(mftPath))
+	if err != nil {
+		return nil, fmt.Errorf("get directory of pipeline %s relative to the workspace: %w", o.name, err)
+	}
+	if matchAny([]string{filepath.ToSlash(pipelineDir)}, files) {
+		// A change to the pipeline manifest or buildspec can affect how every workload is built.
+		return workloads, nil
+	}
+	var changed []string
+	for _, name := range workloads {
+		paths, err := o.defaultPaths(name)
+		if err != nil {
+			return nil, err
+		}
+		if matchAny(build.WorkloadPaths(name, path.Join(workspace.CopilotDirName, name), paths), files) {
+			changed = append(changed, name)
+		}
+	}
+	return changed, nil
+}
+
+func (o *pipelineChangesOpts) workloads() ([]string, error) {
+	workloads, err := o.ws.ListWorkloads()
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	sort.Strings(workloads)
+	return workloads, nil
+}
+
+// pipelineManifest returns the manifest of the pipeline and its path.
+func (o *pipelineChangesOpts) pipelineManifest() (*manifest.Pipeline, string, error) {
+	pipelines, err := o.ws.ListPipelines()
+	if err != nil {
+		return nil, "", fmt.Errorf("list pipelines in the workspace: %w", err)
+	}
+	for _, pipeline := range pipelines {
+		if pipeline.Name != o.name {
+			continue
+		}
+		mft, err := o.ws.ReadPipelineManifest(pipeline.Path)
+		if err != nil {
+			return nil, "", fmt.Errorf("read manifest of pipeline %s: %w", o.name, err)
+		}
+		return mft, pipeline.Path, nil
+	}
+	return nil, "", fmt.Errorf("pipeline %s not found in the workspace", o.name)
+}
+
+// changedFiles returns the slash-separated paths, relative to the workspace, of the files that changed since the revision.
+func (o *pipelineChangesOpts) changedFiles() ([]string, error) {
+	var stdout, stderr bytes.Buffer
+	err := o.runner.Run("git", []string{"-C", o.ws.Path(), "diff", "--name-only", "--relative", o.since, "HEAD"},
+		exec.Stdout(&stdout), exec.Stderr(&stderr))
+	if err != nil {
+		return nil, fmt.Errorf("git diff: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.Fields(stdout.String()), nil
+}
+
+// defaultPaths returns the build contexts and Dockerfiles of the images of the workload.
+func (o *pipelineChangesOpts) defaultPaths(name string) ([]string, error) {
+	var paths []string
+	raw, err := o.ws.ReadWorkloadManifest(name)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest file for %s: %w", name, err)
+	}
+	mft, err := o.unmarshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest for %s: %w", name, err)
+	}
+	type buildArgsGetter interface {
+		BuildArgs(rootDirectory string) (map[string]*manifest.DockerBuildArgs, error)
+	}
+	wkld, ok := mft.Manifest().(buildArgsGetter)
+	if !ok {
+		return paths, nil
+	}
+	args, err := wkld.BuildArgs(o.ws.Path())
+	if err != nil {
+		return nil, fmt.Errorf("get build arguments of %s: %w", name, err)
+	}
+	for _, arg := range args {
+		for _, file := range []*string{arg.Context, arg.Dockerfile} {
+			if file == nil {
+				continue
+			}
+			rel, err := filepath.Rel(o.ws.Path(), *file)
+			if err != nil {
+				return nil, fmt.Errorf("get path of %s relative to the workspace: %w", *file, err)
+			}
+			paths = append(paths, filepath.ToSlash(rel))
+		}
+	}
+	return paths, nil
+}
+
+func (o *pipelineChangesOpts) write(workloads []string) error {
+	for _, name := range workloads {
+		if _, err := fmt.Fprintln(o.w, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func matchAny(patterns, files []string) bool {
+	for _, file := range files {
+		for _, pattern := range patterns {
+			if manifest.MatchPath(pattern, file) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// buildPipelineChangesCmd builds the command to list the workloads affected by the changes since a revision.
+func buildPipelineChangesCmd() *cobra.Command {
+	vars := pipelineChangesVars{}
+	cmd := &cobra.Command{
+		Use:   "changes",
+		Short: "Lists the workloads affected by the changes since a revision.",
+		Long: `Lists the workloads affected by the changes since a revision.
+Used in the build stage of pipelines with "only_changed" enabled.`,
+		Hidden: true,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineChangesOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVar(&vars.since, sinceFlag, "", sinceRevisionFlagDescription)
+	return cmd
+}
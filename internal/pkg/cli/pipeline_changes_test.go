@@ -0,0 +1,194 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	osexec "os/exec"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+)
+
+func TestPipelineChangesOpts_Execute(t *testing.T) {
+	const (
+		apiMft = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+`
+		frontendMft = `name: frontend
+type: Load Balanced Web Service
+image:
+  build:
+    dockerfile: web/frontend/Dockerfile
+    context: web
+`
+	)
+	pipelines := []workspace.PipelineManifest{
+		{Name: "release", Path: "/ws/copilot/pipelines/release/manifest.yml"},
+	}
+	gitDiff := func(files string) func(string, []string, ...exec.CmdOption) error {
+		return func(_ string, _ []string, opts ...exec.CmdOption) error {
+			cmd := &osexec.Cmd{}
+			for _, opt := range opts {
+				opt(cmd)
+			}
+			_, err := cmd.Stdout.Write([]byte(files))
+			return err
+		}
+	}
+	testCases := map[string]struct {
+		inSince    string
+		setupMocks func(ws *mocks.MockwsPipelineChangesReader, runner *mocks.MockexecRunner)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"error if the pipeline is not in the workspace": {
+			setupMocks: func(ws *mocks.MockwsPipelineChangesReader, runner *mocks.MockexecRunner) {
+				ws.EXPECT().ListPipelines().Return(nil, nil)
+			},
+			wantedErr: errors.New("pipeline release not found in the workspace"),
+		},
+		"write all workloads if the pipeline builds every workload": {
+			inSince: "abc123",
+			setupMocks: func(ws *mocks.MockwsPipelineChangesReader, runner *mocks.MockexecRunner) {
+				ws.EXPECT().ListPipelines().Return(pipelines, nil)
+				ws.EXPECT().ReadPipelineManifest(pipelines[0].Path).Return(&manifest.Pipeline{}, nil)
+				ws.EXPECT().ListWorkloads().Return([]string{"frontend", "api"}, nil)
+			},
+			wantedOutput: "api\nfrontend\n",
+		},
+		"write all workloads if there is no previous revision": {
+			setupMocks: func(ws *mocks.MockwsPipelineChangesReader, runner *mocks.MockexecRunner) {
+				ws.EXPECT().ListPipelines().Return(pipelines, nil)
+				ws.EXPECT().ReadPipelineManifest(pipelines[0].Path).Return(&manifest.Pipeline{
+					Build: &manifest.Build{OnlyChanged: true},
+				}, nil)
+				ws.EXPECT().ListWorkloads().Return([]string{"frontend", "api"}, nil)
+			},
+			wantedOutput: "api\nfrontend\n",
+		},
+		"write all workloads if the changes cannot be listed": {
+			inSince: "abc123",
+			setupMocks: func(ws *mocks.MockwsPipelineChangesReader, runner *mocks.MockexecRunner) {
+				ws.EXPECT().ListPipelines().Return(pipelines, nil)
+				ws.EXPECT().ReadPipelineManifest(pipelines[0].Path).Return(&manifest.Pipeline{
+					Build: &manifest.Build{OnlyChanged: true},
+				}, nil)
+				ws.EXPECT().ListWorkloads().Return([]string{"frontend", "api"}, nil)
+				runner.EXPECT().Run("git", []string{"-C", "/ws", "diff", "--name-only", "--relative", "abc123", "HEAD"}, gomock.Any()).
+					Return(errors.New("exit status 128"))
+			},
+			wantedOutput: "api\nfrontend\n",
+		},
+		"write all workloads if the pipeline changed": {
+			inSince: "abc123",
+			setupMocks: func(ws *mocks.MockwsPipelineChangesReader, runner *mocks.MockexecRunner) {
+				ws.EXPECT().ListPipelines().Return(pipelines, nil)
+				ws.EXPECT().ReadPipelineManifest(pipelines[0].Path).Return(&manifest.Pipeline{
+					Build: &manifest.Build{OnlyChanged: true},
+				}, nil)
+				ws.EXPECT().ListWorkloads().Return([]string{"frontend", "api"}, nil)
+				runner.EXPECT().Run("git", gomock.Any(), gomock.Any()).
+					DoAndReturn(gitDiff("copilot/pipelines/release/buildspec.yml\n"))
+			},
+			wantedOutput: "api\nfrontend\n",
+		},
+		"write the workloads whose build context or manifest changed": {
+			inSince: "abc123",
+			setupMocks: func(ws *mocks.MockwsPipelineChangesReader, runner *mocks.MockexecRunner) {
+				ws.EXPECT().ListPipelines().Return(pipelines, nil)
+				ws.EXPECT().ReadPipelineManifest(pipelines[0].Path).Return(&manifest.Pipeline{
+					Build: &manifest.Build{OnlyChanged: true},
+				}, nil)
+				ws.EXPECT().ListWorkloads().Return([]string{"frontend", "api", "worker"}, nil)
+				runner.EXPECT().Run("git", gomock.Any(), gomock.Any()).
+					DoAndReturn(gitDiff("web/index.html\ncopilot/worker/manifest.yml\nREADME.md\n"))
+				ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(apiMft), nil)
+				ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(frontendMft), nil)
+				ws.EXPECT().ReadWorkloadManifest("worker").Return([]byte(apiMft), nil)
+			},
+			wantedOutput: "frontend\nworker\n",
+		},
+		"match the paths of the workload in the pipeline manifest": {
+			inSince: "abc123",
+			setupMocks: func(ws *mocks.MockwsPipelineChangesReader, runner *mocks.MockexecRunner) {
+				ws.EXPECT().ListPipelines().Return(pipelines, nil)
+				ws.EXPECT().ReadPipelineManifest(pipelines[0].Path).Return(&manifest.Pipeline{
+					Build: &manifest.Build{
+						OnlyChanged: true,
+						Paths: map[string][]string{
+							"api": {"api/**", "libs/**/*.go"},
+						},
+					},
+				}, nil)
+				ws.EXPECT().ListWorkloads().Return([]string{"frontend", "api"}, nil)
+				runner.EXPECT().Run("git", gomock.Any(), gomock.Any()).
+					DoAndReturn(gitDiff("libs/auth/token.go\n"))
+				ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(apiMft), nil)
+				ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(frontendMft), nil)
+			},
+			wantedOutput: "api\n",
+		},
+		"match the manifest directory of a workload with paths in the pipeline manifest": {
+			inSince: "abc123",
+			setupMocks: func(ws *mocks.MockwsPipelineChangesReader, runner *mocks.MockexecRunner) {
+				ws.EXPECT().ListPipelines().Return(pipelines, nil)
+				ws.EXPECT().ReadPipelineManifest(pipelines[0].Path).Return(&manifest.Pipeline{
+					Build: &manifest.Build{
+						OnlyChanged: true,
+						Paths: map[string][]string{
+							"api": {"api/**"},
+						},
+					},
+				}, nil)
+				ws.EXPECT().ListWorkloads().Return([]string{"frontend", "api"}, nil)
+				runner.EXPECT().Run("git", gomock.Any(), gomock.Any()).
+					DoAndReturn(gitDiff("copilot/api/manifest.yml\n"))
+				ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(apiMft), nil)
+				ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(frontendMft), nil)
+			},
+			wantedOutput: "api\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsPipelineChangesReader(ctrl)
+			runner := mocks.NewMockexecRunner(ctrl)
+			ws.EXPECT().Path().Return("/ws").AnyTimes()
+			tc.setupMocks(ws, runner)
+			b := &bytes.Buffer{}
+			opts := &pipelineChangesOpts{
+				pipelineChangesVars: pipelineChangesVars{
+					name:  "release",
+					since: tc.inSince,
+				},
+				ws:        ws,
+				runner:    runner,
+				unmarshal: manifest.UnmarshalWorkload,
+				w:         b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
@@ -13,14 +13,14 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/spf13/afero"
 	"golang.org/x/mod/semver"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	cs "github.com/aws/copilot-cli/internal/pkg/aws/codestar"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
@@ -31,6 +31,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -40,6 +41,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
 
 	"github.com/spf13/cobra"
 
@@ -66,6 +68,10 @@ const (
 
 const connectionsURL = "https://console.aws.amazon.com/codesuite/settings/connections"
 
+const fmtWorkflowFileName = "copilot-%s.yml" // Ex: "copilot-release.yml"
+
+const fmtBuildCacheLocation = "%s/build-cache/%s" // Ex: "stackset-bucket/build-cache/release"
+
 type deployPipelineVars struct {
 	appName          string
 	name             string
@@ -99,8 +105,13 @@ type deployPipelineOpts struct {
 	newJobListCmd         func(io.Writer, string) cmd
 	pipelineVersionGetter func(string, string, bool) (versionGetter, error)
 	pipelineStackConfig   func(in *deploy.CreatePipelineInput) stackConfiguration
+	workflowStackConfig   func(in *deploy.CreateWorkflowInput) stackConfiguration
+	oidcProviderGetter    oidcProviderGetter
+	workflowParser        workflowParser
+	workflowWs            wsWorkflowReadWriter
 
 	configureDeployedPipelineLister func() deployedPipelineLister
+	configStoreBackend              func() (*config.BackendConfig, error)
 
 	// cached variables
 	wsAppName                    string
@@ -111,6 +122,7 @@ type deployPipelineOpts struct {
 	pipelineMft                  *manifest.Pipeline
 	svcBuffer                    *bytes.Buffer
 	jobBuffer                    *bytes.Buffer
+	workflowPath                 string // Absolute path of the workflow file written for pipelines that don't run on CodePipeline.
 
 	// Overridden in tests.
 	templateVersion string
@@ -122,15 +134,15 @@ func newDeployPipelineOpts(vars deployPipelineVars) (*deployPipelineOpts, error)
 	if err != nil {
 		return nil, fmt.Errorf("default session: %w", err)
 	}
-	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	store := config.NewStore(defaultSession)
 
 	prompter := prompt.New()
-	ws, err := workspace.Use(afero.NewOsFs())
+	ws, err := workspace.UseApp(vars.appName, afero.NewOsFs())
 	if err != nil {
 		return nil, err
 	}
 
-	wsAppName := tryReadingAppName()
+	wsAppName := tryReadingWorkspaceAppName(vars.appName)
 	if vars.appName == "" {
 		vars.appName = wsAppName
 	}
@@ -151,6 +163,12 @@ func newDeployPipelineOpts(vars deployPipelineVars) (*deployPipelineOpts, error)
 		pipelineStackConfig: func(in *deploy.CreatePipelineInput) stackConfiguration {
 			return stack.NewPipelineStackConfig(in)
 		},
+		workflowStackConfig: func(in *deploy.CreateWorkflowInput) stackConfiguration {
+			return stack.NewWorkflowStackConfig(in)
+		},
+		oidcProviderGetter: iam.New(defaultSession),
+		workflowParser:     template.New(),
+		workflowWs:         ws,
 		newSvcListCmd: func(w io.Writer, appName string) cmd {
 			return &listSvcOpts{
 				listWkldVars: listWkldVars{
@@ -191,6 +209,9 @@ func newDeployPipelineOpts(vars deployPipelineVars) (*deployPipelineOpts, error)
 		// Initialize the client only after the appName is asked.
 		return deploy.NewPipelineStore(rg.New(defaultSession))
 	}
+	opts.configStoreBackend = func() (*config.BackendConfig, error) {
+		return config.GetBackendConfig(ssm.New(defaultSession))
+	}
 	opts.pipelineVersionGetter = func(appName, name string, isLegacy bool) (versionGetter, error) {
 		return describe.NewPipelineStackDescriber(appName, name, isLegacy)
 	}
@@ -264,6 +285,9 @@ func (o *deployPipelineOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	if !pipeline.IsCodePipeline() {
+		return o.deployWorkflow(pipeline)
+	}
 
 	// If the source has an existing connection, get the correlating ConnectionARN.
 	connection, ok := pipeline.Source.Properties["connection_name"]
@@ -303,8 +327,13 @@ func (o *deployPipelineOpts) Execute() error {
 	if err != nil {
 		return err
 	}
-	var build deploy.Build
-	if err = build.Init(pipeline.Build, filepath.Dir(relPath)); err != nil {
+	build, err := pipelineBuild(pipeline, filepath.Dir(relPath), o.region, o.app, o.store, o.getBucketName)
+	if err != nil {
+		return err
+	}
+	// The build runs "copilot svc package", which reads the configuration of the application.
+	backend, err := o.configStoreBackend()
+	if err != nil {
 		return err
 	}
 
@@ -313,14 +342,162 @@ func (o *deployPipelineOpts) Execute() error {
 		Name:                pipeline.Name,
 		IsLegacy:            isLegacy,
 		Source:              source,
-		Build:               &build,
+		Build:               build,
 		Stages:              stages,
+		Waves:               pipeline.Waves,
+		Previews:            pipelinePreviews(pipeline),
+		Notifications:       deploy.PipelineNotificationsFromManifest(pipeline.Notifications),
 		ArtifactBuckets:     artifactBuckets,
 		AdditionalTags:      o.app.Tags,
 		Version:             o.templateVersion,
 		PermissionsBoundary: o.app.PermissionsBoundary,
 	}
+	if backend.Type == config.BackendTypeDynamoDB {
+		deployPipelineInput.ConfigStoreTable = backend.Table
+	}
+
+	stackConfig, err := o.overrideStackConfig(o.pipelineStackConfig(deployPipelineInput))
+	if err != nil {
+		return err
+	}
+	contd, err := o.confirmDiff(stackConfig)
+	if err != nil {
+		return err
+	}
+	if !contd {
+		return nil
+	}
+	if err := o.addPipelineResourcesToApp(); err != nil {
+		return err
+	}
+	if err := o.deployPipeline(source, stackConfig); err != nil {
+		return err
+	}
+	return nil
+}
+
+// deployWorkflow deploys the OIDC identity provider and the role assumed by a pipeline that runs on
+// GitHub Actions or GitLab CI, and writes the workflow file run by the platform to the project.
+func (o *deployPipelineOpts) deployWorkflow(mft *manifest.Pipeline) error {
+	source, _, err := deploy.PipelineSourceFromManifest(mft.Source)
+	if err != nil {
+		return fmt.Errorf("read source from manifest: %w", err)
+	}
+	stages, err := o.convertStages(mft.Stages)
+	if err != nil {
+		return fmt.Errorf("convert environments to deployment stage: %w", err)
+	}
+	artifactBuckets, err := o.getArtifactBuckets()
+	if err != nil {
+		return fmt.Errorf("get cross-regional resources: %w", err)
+	}
+	providerARN, err := o.workflowOIDCProviderARN(mft)
+	if err != nil {
+		return err
+	}
+	stackConfig, err := o.overrideStackConfig(o.workflowStackConfig(&deploy.CreateWorkflowInput{
+		AppName:             o.appName,
+		Name:                mft.Name,
+		Platform:            mft.Platform,
+		Source:              source,
+		OIDCProviderARN:     providerARN,
+		Stages:              stages,
+		ArtifactBuckets:     artifactBuckets,
+		AdditionalTags:      o.app.Tags,
+		PermissionsBoundary: o.app.PermissionsBoundary,
+		Version:             o.templateVersion,
+	}))
+	if err != nil {
+		return err
+	}
+	contd, err := o.confirmDiff(stackConfig)
+	if err != nil {
+		return err
+	}
+	if !contd {
+		return nil
+	}
+	if err := o.addPipelineResourcesToApp(); err != nil {
+		return err
+	}
+	if err := o.deployPipeline(source, stackConfig); err != nil {
+		return err
+	}
+	return o.writeWorkflow(mft, source, stages)
+}
+
+// workflowOIDCProviderARN returns the ARN of the existing OIDC identity provider of the platform of the pipeline.
+// The identity provider is unique per account: it's created by the stack of the workflow unless another stack,
+// or the user, already created it.
+// The stacks retain the identity provider when they no longer create it, so that it can be reused by every workflow,
+// including the one whose stack created it.
+func (o *deployPipelineOpts) workflowOIDCProviderARN(mft *manifest.Pipeline) (string, error) {
+	provider, err := o.oidcProviderGetter.OIDCProvider(deploy.WorkflowOIDCProviderURL(mft.Platform))
+	if err != nil {
+		return "", fmt.Errorf("get OIDC identity provider of %s: %w", mft.Platform, err)
+	}
+	if provider == nil {
+		return "", nil
+	}
+	return provider.ARN, nil
+}
 
+func (o *deployPipelineOpts) writeWorkflow(mft *manifest.Pipeline, source interface{}, stages []deploy.PipelineStage) error {
+	partition, err := partitions.Region(o.region).Partition()
+	if err != nil {
+		return err
+	}
+	workflow := deploy.Workflow{
+		AppName:   o.appName,
+		Name:      mft.Name,
+		Branch:    workflowBranch(source),
+		RoleARN:   fmt.Sprintf("arn:%s:iam::%s:role/%s", partition.ID(), o.app.AccountID, stack.NameForWorkflowRole(o.appName, mft.Name)),
+		Region:    o.region,
+		BinaryURL: copilotBinaryURL(),
+	}
+	readBuildspec := func(path string) ([]byte, error) {
+		return o.workflowWs.ReadFile(filepath.Join(o.workflowWs.ProjectRoot(), path))
+	}
+	for i := range stages {
+		stage, err := deploy.NewWorkflowStage(&stages[i], readBuildspec)
+		if err != nil {
+			return fmt.Errorf("convert stage %s to a workflow job: %w", stages[i].Name(), err)
+		}
+		workflow.Stages = append(workflow.Stages, *stage)
+	}
+
+	tplPath, path := template.GitHubActionsWorkflowTemplatePath, filepath.Join(".github", "workflows", fmt.Sprintf(fmtWorkflowFileName, mft.Name))
+	if mft.Platform == manifest.PipelinePlatformGitLabCI {
+		tplPath, path = template.GitLabCIWorkflowTemplatePath, filepath.Join(".gitlab", fmt.Sprintf(fmtWorkflowFileName, mft.Name))
+	}
+	content, err := o.workflowParser.ParseWorkflow(tplPath, workflow)
+	if err != nil {
+		return fmt.Errorf("generate %s workflow: %w", mft.Platform, err)
+	}
+	path, err = o.workflowWs.WritePipelineWorkflow(content, path)
+	if err != nil {
+		return fmt.Errorf("write %s workflow: %w", mft.Platform, err)
+	}
+	o.workflowPath = path
+	log.Successf("Wrote the %s workflow of pipeline %s at '%s'\n", mft.Platform, color.HighlightUserInput(mft.Name), color.HighlightResource(displayPath(path)))
+	return nil
+}
+
+// workflowBranch returns the branch whose pushes trigger the workflow.
+func workflowBranch(source interface{}) string {
+	switch src := source.(type) {
+	case *deploy.GitHubSource:
+		return src.Branch
+	case *deploy.GitHubV1Source:
+		return src.Branch
+	case *deploy.GitLabSource:
+		return src.Branch
+	}
+	return deploy.DefaultPipelineBranch
+}
+
+// overrideStackConfig applies the overrides of the pipeline, if any, to the template of the stack.
+func (o *deployPipelineOpts) overrideStackConfig(stackConfig stackConfiguration) (stackConfiguration, error) {
 	overrideOpts := newOverrideOpts{
 		path:       o.ws.PipelineOverridesPath(o.pipeline.Name),
 		appName:    o.appName,
@@ -330,44 +507,46 @@ func (o *deployPipelineOpts) Execute() error {
 
 	overrider, err := clideploy.NewOverrider(overrideOpts.path, overrideOpts.appName, overrideOpts.envName, overrideOpts.fileSystem, overrideOpts.sess)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	stackConfig := deploycfn.WrapWithTemplateOverrider(o.pipelineStackConfig(deployPipelineInput), overrider)
+	return deploycfn.WrapWithTemplateOverrider(stackConfig, overrider), nil
+}
 
-	if o.showDiff {
-		tpl, err := stackConfig.Template()
-		if err != nil {
-			return fmt.Errorf("generate the new template for diff: %w", err)
-		}
-		if err = diff(o, tpl, o.diffWriter); err != nil {
-			var errHasDiff *errHasDiff
-			if !errors.As(err, &errHasDiff) {
-				return err
-			}
-		}
-		if !o.skipConfirmation {
-			contd, err := o.prompt.Confirm(continueDeploymentPrompt, "")
-			if err != nil {
-				return fmt.Errorf("ask whether to continue with the deployment: %w", err)
-			}
-			if !contd {
-				return nil
-			}
+// confirmDiff writes the diff of the template against the deployed stack if the --diff flag is set,
+// and returns false if the user doesn't want to continue with the deployment.
+func (o *deployPipelineOpts) confirmDiff(stackConfig stackConfiguration) (bool, error) {
+	if !o.showDiff {
+		return true, nil
+	}
+	tpl, err := stackConfig.Template()
+	if err != nil {
+		return false, fmt.Errorf("generate the new template for diff: %w", err)
+	}
+	if err = diff(o, tpl, o.diffWriter); err != nil {
+		var errHasDiff *errHasDiff
+		if !errors.As(err, &errHasDiff) {
+			return false, err
 		}
 	}
+	if o.skipConfirmation {
+		return true, nil
+	}
+	contd, err := o.prompt.Confirm(continueDeploymentPrompt, "")
+	if err != nil {
+		return false, fmt.Errorf("ask whether to continue with the deployment: %w", err)
+	}
+	return contd, nil
+}
 
-	// bootstrap pipeline resources
+// addPipelineResourcesToApp bootstraps the pipeline resources of the application.
+func (o *deployPipelineOpts) addPipelineResourcesToApp() error {
 	o.prog.Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, color.HighlightUserInput(o.appName)))
-	err = o.pipelineDeployer.AddPipelineResourcesToApp(o.app, o.region)
+	err := o.pipelineDeployer.AddPipelineResourcesToApp(o.app, o.region)
 	if err != nil {
 		o.prog.Stop(log.Serrorf(fmtPipelineDeployResourcesFailed, color.HighlightUserInput(o.appName)))
 		return fmt.Errorf("add pipeline resources to application %s in %s: %w", o.appName, o.region, err)
 	}
 	o.prog.Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, color.HighlightUserInput(o.appName)))
-
-	if err := o.deployPipeline(deployPipelineInput, stackConfig); err != nil {
-		return err
-	}
 	return nil
 }
 
@@ -543,7 +722,7 @@ func (o *deployPipelineOpts) shouldUpdate() (bool, error) {
 	return shouldUpdate, nil
 }
 
-func (o *deployPipelineOpts) deployPipeline(in *deploy.CreatePipelineInput, stackConfig deploycfn.StackConfiguration) error {
+func (o *deployPipelineOpts) deployPipeline(source interface{}, stackConfig deploycfn.StackConfiguration) error {
 	exist, err := o.pipelineDeployer.PipelineExists(stackConfig)
 	if err != nil {
 		return fmt.Errorf("check if pipeline exists: %w", err)
@@ -559,13 +738,13 @@ func (o *deployPipelineOpts) deployPipeline(in *deploy.CreatePipelineInput, stac
 
 		// If the source requires CodeStar Connections, the user is prompted to update the connection status.
 		if o.shouldPromptUpdateConnection {
-			source, ok := in.Source.(interface {
+			src, ok := source.(interface {
 				ConnectionName() (string, error)
 			})
 			if !ok {
-				return fmt.Errorf("source %v does not have a connection name", in.Source)
+				return fmt.Errorf("source %v does not have a connection name", source)
 			}
-			connectionName, err := source.ConnectionName()
+			connectionName, err := src.ConnectionName()
 			if err != nil {
 				return fmt.Errorf("parse connection name: %w", err)
 			}
@@ -606,12 +785,67 @@ func (o *deployPipelineOpts) deployPipeline(in *deploy.CreatePipelineInput, stac
 
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
 func (o *deployPipelineOpts) RecommendedActions() []string {
+	if o.workflowPath != "" {
+		path := displayPath(o.workflowPath)
+		actions := []string{
+			fmt.Sprintf("Commit and push the workflow file %s to your repository to run your pipeline.", color.HighlightResource(path)),
+		}
+		if o.pipelineMft.Platform == manifest.PipelinePlatformGitLabCI {
+			actions = append(actions, fmt.Sprintf("Include %s from the %s file of your project.", color.HighlightResource(path), color.HighlightResource(".gitlab-ci.yml")))
+		}
+		return actions
+	}
 	return []string{
 		fmt.Sprintf("Run %s to see the state of your pipeline.", color.HighlightCode("copilot pipeline status")),
 		fmt.Sprintf("Run %s for info about your pipeline.", color.HighlightCode("copilot pipeline show")),
 	}
 }
 
+// pipelineBuild converts the "build" section of the pipeline manifest to the build project of the pipeline.
+// The S3 cache of the build is stored in the artifact bucket of the region of the pipeline, and the build
+// can only run in the VPC of an environment in the same account and region as the pipeline.
+func pipelineBuild(mft *manifest.Pipeline, mftDirPath, region string, app *config.Application, envGetter environmentGetter, bucketName func() (string, error)) (*deploy.Build, error) {
+	var build deploy.Build
+	if err := build.Init(mft.Build, mftDirPath); err != nil {
+		return nil, err
+	}
+	if build.VPCEnvironment != "" {
+		env, err := envGetter.GetEnvironment(app.Name, build.VPCEnvironment)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s of the build in application %s: %w", build.VPCEnvironment, app.Name, err)
+		}
+		if env.Region != region || env.AccountID != app.AccountID {
+			return nil, fmt.Errorf("environment %s of the build must be in the region %s and the account %s of the pipeline", env.Name, region, app.AccountID)
+		}
+	}
+	if build.S3Cache() {
+		bucket, err := bucketName()
+		if err != nil {
+			return nil, fmt.Errorf("get bucket of the build cache: %w", err)
+		}
+		build.CacheLocation = fmt.Sprintf(fmtBuildCacheLocation, bucket, mft.Name)
+	}
+	return &build, nil
+}
+
+// pipelinePreviews returns the project that deploys preview environments if the pipeline manifest configures them.
+func pipelinePreviews(mft *manifest.Pipeline) *deploy.Previews {
+	if mft.Previews == nil {
+		return nil
+	}
+	return &deploy.Previews{
+		BinaryURL:         copilotBinaryURL(),
+		Environment:       mft.Previews.Environment,
+		AccessTokenSecret: mft.Previews.AccessTokenSecret,
+		TrustedActors:     mft.Previews.TrustedActors,
+	}
+}
+
+// copilotBinaryURL returns the URL of the Linux binary of the running version of Copilot.
+func copilotBinaryURL() string {
+	return fmt.Sprintf("%s/copilot-linux-%s", binaryS3BucketPath, template.URLSafeVersion(version.Version))
+}
+
 // BuildPipelineDeployCmd build the command for deploying a new pipeline or updating an existing pipeline.
 func buildPipelineDeployCmd() *cobra.Command {
 	vars := deployPipelineVars{}
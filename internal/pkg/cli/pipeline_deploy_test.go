@@ -8,14 +8,17 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
@@ -959,6 +962,9 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 				configureDeployedPipelineLister: func() deployedPipelineLister {
 					return mocks.deployedPipelineLister
 				},
+				configStoreBackend: func() (*config.BackendConfig, error) {
+					return &config.BackendConfig{Type: config.BackendTypeSSM}, nil
+				},
 				pipeline: &workspace.PipelineManifest{
 					Name: "pipepiper",
 					Path: pipelineManifestPath,
@@ -1033,3 +1039,328 @@ func TestDeployPipelineOpts_getArtifactBuckets(t *testing.T) {
 		})
 	}
 }
+
+func TestPipelineBuild(t *testing.T) {
+	const (
+		mockRegion    = "us-west-2"
+		mockAccountID = "123456789012"
+	)
+	mockApp := &config.Application{Name: "phonetool", AccountID: mockAccountID}
+	testCases := map[string]struct {
+		inBuild        *manifest.Build
+		mockEnvGetter  func(m *mocks.MockenvironmentGetter)
+		mockBucketName func() (string, error)
+
+		wantedBuild *deploy.Build
+		wantedErr   error
+	}{
+		"stores the S3 cache in the artifact bucket": {
+			inBuild: &manifest.Build{Cache: manifest.BuildCacheS3},
+			mockBucketName: func() (string, error) {
+				return "stackset-bucket", nil
+			},
+			wantedBuild: &deploy.Build{
+				Image:           "aws/codebuild/amazonlinux2-x86_64-standard:4.0",
+				EnvironmentType: "LINUX_CONTAINER",
+				BuildspecPath:   "copilot/pipelines/release/buildspec.yml",
+				CacheType:       "s3",
+				CacheLocation:   "stackset-bucket/build-cache/release",
+			},
+		},
+		"error if the artifact bucket can't be found": {
+			inBuild: &manifest.Build{Cache: manifest.BuildCacheS3},
+			mockBucketName: func() (string, error) {
+				return "", errors.New("some error")
+			},
+			wantedErr: errors.New("get bucket of the build cache: some error"),
+		},
+		"runs the build in the VPC of an environment": {
+			inBuild: &manifest.Build{VPC: manifest.BuildVPC{Environment: "test"}},
+			mockEnvGetter: func(m *mocks.MockenvironmentGetter) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					Name:      "test",
+					Region:    mockRegion,
+					AccountID: mockAccountID,
+				}, nil)
+			},
+			wantedBuild: &deploy.Build{
+				Image:           "aws/codebuild/amazonlinux2-x86_64-standard:4.0",
+				EnvironmentType: "LINUX_CONTAINER",
+				BuildspecPath:   "copilot/pipelines/release/buildspec.yml",
+				VPCEnvironment:  "test",
+			},
+		},
+		"error if the environment of the VPC can't be found": {
+			inBuild: &manifest.Build{VPC: manifest.BuildVPC{Environment: "test"}},
+			mockEnvGetter: func(m *mocks.MockenvironmentGetter) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get environment test of the build in application phonetool: some error"),
+		},
+		"error if the environment of the VPC is in another region": {
+			inBuild: &manifest.Build{VPC: manifest.BuildVPC{Environment: "prod"}},
+			mockEnvGetter: func(m *mocks.MockenvironmentGetter) {
+				m.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{
+					Name:      "prod",
+					Region:    "us-east-1",
+					AccountID: mockAccountID,
+				}, nil)
+			},
+			wantedErr: errors.New("environment prod of the build must be in the region us-west-2 and the account 123456789012 of the pipeline"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEnvGetter := mocks.NewMockenvironmentGetter(ctrl)
+			if tc.mockEnvGetter != nil {
+				tc.mockEnvGetter(mockEnvGetter)
+			}
+			mft := &manifest.Pipeline{
+				Name:  "release",
+				Build: tc.inBuild,
+			}
+
+			build, err := pipelineBuild(mft, "copilot/pipelines/release", mockRegion, mockApp, mockEnvGetter, tc.mockBucketName)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedBuild, build)
+		})
+	}
+}
+
+func TestDeployPipelineOpts_deployWorkflow(t *testing.T) {
+	const (
+		appName      = "badgoose"
+		region       = "us-west-2"
+		accountID    = "123456789012"
+		pipelineName = "pipepiper"
+	)
+	app := &config.Application{
+		AccountID: accountID,
+		Name:      appName,
+	}
+	mockEnv := &config.Environment{
+		Name:      "test",
+		App:       appName,
+		Region:    region,
+		AccountID: accountID,
+	}
+	mockResources := []*stack.AppRegionalResources{
+		{
+			S3Bucket:  "someBucket",
+			KMSKeyARN: "someKey",
+		},
+	}
+	githubMft := &manifest.Pipeline{
+		Name:     pipelineName,
+		Platform: manifest.PipelinePlatformGitHubActions,
+		Source: &manifest.Source{
+			ProviderName: manifest.GithubProviderName,
+			Properties: map[string]interface{}{
+				"repository": "https://github.com/badgoose/goose",
+				"branch":     "release",
+			},
+		},
+		Stages: []manifest.PipelineStage{{Name: "test"}},
+	}
+	gitlabMft := &manifest.Pipeline{
+		Name:     pipelineName,
+		Platform: manifest.PipelinePlatformGitLabCI,
+		Source: &manifest.Source{
+			ProviderName: manifest.GitLabProviderName,
+			Properties: map[string]interface{}{
+				"repository": "https://gitlab.com/badgoose/flock/goose",
+			},
+		},
+		Stages: []manifest.PipelineStage{{Name: "test"}},
+	}
+	type workflowMocks struct {
+		deployPipelineMocks
+		oidc   *mocks.MockoidcProviderGetter
+		parser *mocks.MockworkflowParser
+		wfWs   *mocks.MockwsWorkflowReadWriter
+	}
+	testCases := map[string]struct {
+		inMft     *manifest.Pipeline
+		callMocks func(m workflowMocks)
+
+		wantedProviderARN  string
+		wantedWorkflowPath string
+		wantedError        error
+	}{
+		"creates the identity provider and writes the GitHub Actions workflow": {
+			inMft: githubMft,
+			callMocks: func(m workflowMocks) {
+				m.oidc.EXPECT().OIDCProvider("https://token.actions.githubusercontent.com").Return(nil, nil)
+				m.prog.EXPECT().Start(gomock.Any()).AnyTimes()
+				m.prog.EXPECT().Stop(gomock.Any()).AnyTimes()
+				m.deployer.EXPECT().AddPipelineResourcesToApp(app, region).Return(nil)
+				m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, nil)
+				m.deployer.EXPECT().GetAppResourcesByRegion(app, region).Return(mockResources[0], nil)
+				m.deployer.EXPECT().CreatePipeline("someBucket", gomock.Any()).Return(nil)
+				m.parser.EXPECT().ParseWorkflow(template.GitHubActionsWorkflowTemplatePath, gomock.Any()).DoAndReturn(func(_ string, data interface{}) (*template.Content, error) {
+					wf, ok := data.(deploy.Workflow)
+					require.True(t, ok)
+					require.Equal(t, "release", wf.Branch)
+					require.Equal(t, "arn:aws:iam::123456789012:role/pipeline-badgoose-pipepiper-WorkflowRole", wf.RoleARN)
+					require.Len(t, wf.Stages, 1)
+					require.Equal(t, []deploy.WorkflowStep{
+						{Name: "CreateOrUpdate-backend-test", Commands: []string{"copilot deploy --name backend --env test --deploy-env=false"}},
+						{Name: "CreateOrUpdate-frontend-test", Commands: []string{"copilot deploy --name frontend --env test --deploy-env=false"}},
+					}, wf.Stages[0].Steps)
+					return &template.Content{Buffer: bytes.NewBufferString("jobs:")}, nil
+				})
+				m.wfWs.EXPECT().WritePipelineWorkflow(gomock.Any(), filepath.Join(".github", "workflows", "copilot-pipepiper.yml")).
+					Return("/project/.github/workflows/copilot-pipepiper.yml", nil)
+			},
+			wantedWorkflowPath: "/project/.github/workflows/copilot-pipepiper.yml",
+		},
+		"reuses the identity provider of another workflow and writes the GitLab CI workflow": {
+			inMft: gitlabMft,
+			callMocks: func(m workflowMocks) {
+				m.oidc.EXPECT().OIDCProvider("https://gitlab.com").Return(&iam.OIDCProvider{
+					ARN: "arn:aws:iam::123456789012:oidc-provider/gitlab.com",
+					Tags: map[string]string{
+						deploy.AppTagKey:      appName,
+						deploy.PipelineTagKey: "other",
+					},
+				}, nil)
+				m.prog.EXPECT().Start(gomock.Any()).AnyTimes()
+				m.prog.EXPECT().Stop(gomock.Any()).AnyTimes()
+				m.deployer.EXPECT().AddPipelineResourcesToApp(app, region).Return(nil)
+				m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(true, nil)
+				m.deployer.EXPECT().GetAppResourcesByRegion(app, region).Return(mockResources[0], nil)
+				m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtPipelineDeployExistPrompt, pipelineName), "").Return(true, nil)
+				m.deployer.EXPECT().UpdatePipeline("someBucket", gomock.Any()).Return(nil)
+				m.parser.EXPECT().ParseWorkflow(template.GitLabCIWorkflowTemplatePath, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("stages:")}, nil)
+				m.wfWs.EXPECT().WritePipelineWorkflow(gomock.Any(), filepath.Join(".gitlab", "copilot-pipepiper.yml")).
+					Return("/project/.gitlab/copilot-pipepiper.yml", nil)
+			},
+			wantedProviderARN:  "arn:aws:iam::123456789012:oidc-provider/gitlab.com",
+			wantedWorkflowPath: "/project/.gitlab/copilot-pipepiper.yml",
+		},
+		"reuses the identity provider retained by the stack of this workflow": {
+			inMft: gitlabMft,
+			callMocks: func(m workflowMocks) {
+				m.oidc.EXPECT().OIDCProvider("https://gitlab.com").Return(&iam.OIDCProvider{
+					ARN: "arn:aws:iam::123456789012:oidc-provider/gitlab.com",
+					Tags: map[string]string{
+						deploy.AppTagKey:      appName,
+						deploy.PipelineTagKey: pipelineName,
+					},
+				}, nil)
+				m.prog.EXPECT().Start(gomock.Any()).AnyTimes()
+				m.prog.EXPECT().Stop(gomock.Any()).AnyTimes()
+				m.deployer.EXPECT().AddPipelineResourcesToApp(app, region).Return(nil)
+				m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(true, nil)
+				m.deployer.EXPECT().GetAppResourcesByRegion(app, region).Return(mockResources[0], nil)
+				m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtPipelineDeployExistPrompt, pipelineName), "").Return(true, nil)
+				m.deployer.EXPECT().UpdatePipeline("someBucket", gomock.Any()).Return(nil)
+				m.parser.EXPECT().ParseWorkflow(template.GitLabCIWorkflowTemplatePath, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("stages:")}, nil)
+				m.wfWs.EXPECT().WritePipelineWorkflow(gomock.Any(), filepath.Join(".gitlab", "copilot-pipepiper.yml")).
+					Return("/project/.gitlab/copilot-pipepiper.yml", nil)
+			},
+			wantedProviderARN:  "arn:aws:iam::123456789012:oidc-provider/gitlab.com",
+			wantedWorkflowPath: "/project/.gitlab/copilot-pipepiper.yml",
+		},
+		"wraps error if the identity provider can't be retrieved": {
+			inMft: githubMft,
+			callMocks: func(m workflowMocks) {
+				m.oidc.EXPECT().OIDCProvider(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get OIDC identity provider of GitHubActions: some error"),
+		},
+		"wraps error if the workflow can't be written": {
+			inMft: githubMft,
+			callMocks: func(m workflowMocks) {
+				m.oidc.EXPECT().OIDCProvider(gomock.Any()).Return(nil, nil)
+				m.prog.EXPECT().Start(gomock.Any()).AnyTimes()
+				m.prog.EXPECT().Stop(gomock.Any()).AnyTimes()
+				m.deployer.EXPECT().AddPipelineResourcesToApp(app, region).Return(nil)
+				m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, nil)
+				m.deployer.EXPECT().GetAppResourcesByRegion(app, region).Return(mockResources[0], nil)
+				m.deployer.EXPECT().CreatePipeline(gomock.Any(), gomock.Any()).Return(nil)
+				m.parser.EXPECT().ParseWorkflow(gomock.Any(), gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("jobs:")}, nil)
+				m.wfWs.EXPECT().WritePipelineWorkflow(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("write GitHubActions workflow: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := workflowMocks{
+				deployPipelineMocks: deployPipelineMocks{
+					store:     mocks.NewMockstore(ctrl),
+					prompt:    mocks.NewMockprompter(ctrl),
+					prog:      mocks.NewMockprogress(ctrl),
+					deployer:  mocks.NewMockpipelineDeployer(ctrl),
+					ws:        mocks.NewMockwsPipelineReader(ctrl),
+					actionCmd: mocks.NewMockactionCommand(ctrl),
+				},
+				oidc:   mocks.NewMockoidcProviderGetter(ctrl),
+				parser: mocks.NewMockworkflowParser(ctrl),
+				wfWs:   mocks.NewMockwsWorkflowReadWriter(ctrl),
+			}
+			m.actionCmd.EXPECT().Execute().Times(2)
+			m.store.EXPECT().GetEnvironment(appName, "test").Return(mockEnv, nil)
+			m.deployer.EXPECT().GetRegionalAppResources(app).Return(mockResources, nil)
+			m.ws.EXPECT().PipelineOverridesPath(pipelineName).Return("path").AnyTimes()
+			tc.callMocks(m)
+
+			var gotIn *deploy.CreateWorkflowInput
+			opts := &deployPipelineOpts{
+				deployPipelineVars: deployPipelineVars{
+					appName: appName,
+					name:    pipelineName,
+				},
+				pipelineDeployer: m.deployer,
+				workflowStackConfig: func(in *deploy.CreateWorkflowInput) stackConfiguration {
+					gotIn = in
+					return stack.NewWorkflowStackConfig(in)
+				},
+				oidcProviderGetter: m.oidc,
+				workflowParser:     m.parser,
+				workflowWs:         m.wfWs,
+				ws:                 m.ws,
+				app:                app,
+				region:             region,
+				store:              m.store,
+				prog:               m.prog,
+				prompt:             m.prompt,
+				newSvcListCmd: func(w io.Writer, app string) cmd {
+					return m.actionCmd
+				},
+				newJobListCmd: func(w io.Writer, app string) cmd {
+					return m.actionCmd
+				},
+				pipeline: &workspace.PipelineManifest{
+					Name: pipelineName,
+				},
+				svcBuffer: bytes.NewBufferString(`{"services":[{"app":"badgoose","name":"frontend","type":""}]}`),
+				jobBuffer: bytes.NewBufferString(`{"jobs":[{"app":"badgoose","name":"backend","type":""}]}`),
+			}
+
+			// WHEN
+			err := opts.deployWorkflow(tc.inMft)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedProviderARN, gotIn.OIDCProviderARN)
+			require.Equal(t, tc.wantedWorkflowPath, opts.workflowPath)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineDriftAppNamePrompt     = "Which application's pipeline would you like to detect the drift of?"
+	pipelineDriftAppNameHelpPrompt = "An application is a collection of related services."
+
+	fmtPipelineDriftPrompt = "Which pipeline of %s would you like to detect the drift of?"
+)
+
+type pipelineDriftVars struct {
+	driftVars
+	appName string
+	name    string
+}
+
+type pipelineDriftOpts struct {
+	pipelineDriftVars
+	stackDriftOpts
+
+	store                  store
+	sel                    codePipelineSelector
+	deployedPipelineLister deployedPipelineLister
+	initDescriber          func() error
+
+	// Cached variables.
+	targetPipeline *deploy.Pipeline
+}
+
+func newPipelineDriftOpts(vars pipelineDriftVars) (*pipelineDriftOpts, error) {
+	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("pipeline drift")).Default()
+	if err != nil {
+		return nil, fmt.Errorf("session: %w", err)
+	}
+	pipelineLister := deploy.NewPipelineStore(rg.New(sess))
+	store := config.NewStore(sess)
+	opts := &pipelineDriftOpts{
+		pipelineDriftVars: vars,
+		stackDriftOpts: stackDriftOpts{
+			w:    log.OutputWriter,
+			prog: termprogress.NewSpinner(log.DiagnosticWriter),
+		},
+		store:                  store,
+		sel:                    selector.NewAppPipelineSelector(prompt.New(), store, pipelineLister),
+		deployedPipelineLister: pipelineLister,
+	}
+	opts.initDescriber = func() error {
+		pipeline, err := opts.getTargetPipeline()
+		if err != nil {
+			return err
+		}
+		opts.describer = describe.NewStackDriftDescriber(stack.NameForPipeline(pipeline.AppName, pipeline.Name, pipeline.IsLegacy), sess)
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the optional flag values provided by the user are invalid.
+func (o *pipelineDriftOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in, and validates those that are.
+func (o *pipelineDriftOpts) Ask() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name: %w", err)
+		}
+	} else {
+		app, err := o.sel.Application(pipelineDriftAppNamePrompt, pipelineDriftAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.name != "" {
+		if _, err := o.getTargetPipeline(); err != nil {
+			return fmt.Errorf("validate pipeline name %s: %w", o.name, err)
+		}
+		return nil
+	}
+	pipeline, err := askDeployedPipelineName(o.sel, fmt.Sprintf(fmtPipelineDriftPrompt, color.HighlightUserInput(o.appName)), o.appName)
+	if err != nil {
+		return err
+	}
+	o.name = pipeline.Name
+	o.targetPipeline = &pipeline
+	return nil
+}
+
+// Execute detects and displays the resources of the pipeline that drifted from its template.
+func (o *pipelineDriftOpts) Execute() error {
+	if err := o.initDescriber(); err != nil {
+		return err
+	}
+	return o.detectDrift(o.driftVars, fmt.Sprintf("pipeline %q", o.name))
+}
+
+func (o *pipelineDriftOpts) getTargetPipeline() (deploy.Pipeline, error) {
+	if o.targetPipeline != nil {
+		return *o.targetPipeline, nil
+	}
+	pipeline, err := getDeployedPipelineInfo(o.deployedPipelineLister, o.appName, o.name)
+	if err != nil {
+		return deploy.Pipeline{}, err
+	}
+	o.targetPipeline = &pipeline
+	return pipeline, nil
+}
+
+// buildPipelineDriftCmd builds the command for detecting the drift of a deployed pipeline.
+func buildPipelineDriftCmd() *cobra.Command {
+	vars := pipelineDriftVars{}
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Detects changes made to a deployed pipeline outside of Copilot.",
+		Long: `Detects changes made to a deployed pipeline outside of Copilot.
+Shows the resources of the pipeline stack that were modified or deleted,
+with the difference between their template and actual properties.`,
+
+		Example: `
+  Shows the drift of the pipeline "my-pipeline".
+  /code $ copilot pipeline drift -n my-pipeline`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineDriftOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.failOnDrift, failOnDriftFlag, false, failOnDriftFlagDescription)
+	return cmd
+}
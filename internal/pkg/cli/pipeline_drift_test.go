@@ -0,0 +1,93 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type pipelineDriftAskMocks struct {
+	store  *mocks.Mockstore
+	sel    *mocks.MockcodePipelineSelector
+	lister *mocks.MockdeployedPipelineLister
+}
+
+func TestPipelineDrift_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp   string
+		inputName  string
+		setupMocks func(m pipelineDriftAskMocks)
+
+		wantedPipeline *deploy.Pipeline
+		wantedError    error
+	}{
+		"validate the deployed pipeline passed by flag": {
+			inputApp:  "phonetool",
+			inputName: "release",
+			setupMocks: func(m pipelineDriftAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.lister.EXPECT().ListDeployedPipelines("phonetool").Return([]deploy.Pipeline{
+					{AppName: "phonetool", Name: "release", ResourceName: "pipeline-phonetool-release"},
+				}, nil)
+			},
+			wantedPipeline: &deploy.Pipeline{AppName: "phonetool", Name: "release", ResourceName: "pipeline-phonetool-release"},
+		},
+		"errors if the pipeline isn't deployed": {
+			inputApp:  "phonetool",
+			inputName: "release",
+			setupMocks: func(m pipelineDriftAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.lister.EXPECT().ListDeployedPipelines("phonetool").Return(nil, nil)
+			},
+			wantedError: errors.New("validate pipeline name release: cannot find pipeline named release"),
+		},
+		"prompt for app and pipeline": {
+			setupMocks: func(m pipelineDriftAskMocks) {
+				m.sel.EXPECT().Application(pipelineDriftAppNamePrompt, pipelineDriftAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().DeployedPipeline(gomock.Any(), gomock.Any(), "phonetool").Return(deploy.Pipeline{
+					AppName: "phonetool", Name: "release", ResourceName: "pipeline-phonetool-release",
+				}, nil)
+			},
+			wantedPipeline: &deploy.Pipeline{AppName: "phonetool", Name: "release", ResourceName: "pipeline-phonetool-release"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := pipelineDriftAskMocks{
+				store:  mocks.NewMockstore(ctrl),
+				sel:    mocks.NewMockcodePipelineSelector(ctrl),
+				lister: mocks.NewMockdeployedPipelineLister(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &pipelineDriftOpts{
+				pipelineDriftVars: pipelineDriftVars{
+					appName: tc.inputApp,
+					name:    tc.inputName,
+				},
+				store:                  m.store,
+				sel:                    m.sel,
+				deployedPipelineLister: m.lister,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPipeline, opts.targetPipeline)
+			require.Equal(t, tc.wantedPipeline.Name, opts.name)
+		})
+	}
+}
@@ -11,13 +11,12 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/dustin/go-humanize/english"
 
@@ -77,6 +76,9 @@ const (
 	// For a Bitbucket repository.
 	bbURL        = "bitbucket.org"
 	fmtBBRepoURL = "https://%s/%s/%s" // Ex: "https://bitbucket.org/repoOwner/repoName"
+	// For a GitLab repository.
+	gitlabURL    = "gitlab.com"
+	fmtGLRepoURL = "https://%s/%s" // Ex: "https://gitlab.com/group/subgroup/project"
 )
 
 const (
@@ -181,6 +183,7 @@ type initPipelineVars struct {
 	repoBranch        string
 	githubAccessToken string
 	pipelineType      string
+	platform          string
 }
 
 type initPipelineOpts struct {
@@ -203,6 +206,7 @@ type initPipelineOpts struct {
 	repoName  string
 	repoOwner string
 	ccRegion  string
+	glProject string
 
 	// Cached variables
 	wsAppName    string
@@ -218,7 +222,7 @@ type artifactBucket struct {
 }
 
 func newInitPipelineOpts(vars initPipelineVars) (*initPipelineOpts, error) {
-	ws, err := workspace.Use(afero.NewOsFs())
+	ws, err := workspace.UseApp(vars.appName, afero.NewOsFs())
 	if err != nil {
 		return nil, err
 	}
@@ -229,10 +233,10 @@ func newInitPipelineOpts(vars initPipelineVars) (*initPipelineOpts, error) {
 		return nil, err
 	}
 
-	ssmStore := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	ssmStore := config.NewStore(defaultSession)
 	prompter := prompt.New()
 
-	wsAppName := tryReadingAppName()
+	wsAppName := tryReadingWorkspaceAppName(vars.appName)
 	if vars.appName == "" {
 		vars.appName = wsAppName
 	}
@@ -255,6 +259,9 @@ func newInitPipelineOpts(vars initPipelineVars) (*initPipelineOpts, error) {
 
 // Validate returns an error if the optional flag values passed by the user are invalid.
 func (o *initPipelineOpts) Validate() error {
+	if o.platform != "" && !slices.Contains(manifest.PipelinePlatforms, o.platform) {
+		return fmt.Errorf("invalid platform %q; must be one of %s", o.platform, english.WordSeries(applyAll(manifest.PipelinePlatforms, strconv.Quote), "or"))
+	}
 	return nil
 }
 
@@ -314,6 +321,10 @@ func (o *initPipelineOpts) Execute() error {
 	if err := ini.writeManifest(); err != nil {
 		return err
 	}
+	if !o.isCodePipeline() {
+		log.Debugf("The %s workflow is generated from the manifest by %s.\n", o.platform, color.HighlightCode("copilot pipeline deploy"))
+		return nil
+	}
 	if err := ini.writeBuildspec(); err != nil {
 		return err
 	}
@@ -322,6 +333,12 @@ func (o *initPipelineOpts) Execute() error {
 
 // RequiredActions returns follow-up actions the user must take after successfully executing the command.
 func (o *initPipelineOpts) RequiredActions() []string {
+	if !o.isCodePipeline() {
+		return []string{
+			fmt.Sprintf("Commit and push the %s directory to your repository.", color.HighlightResource("copilot/")),
+			fmt.Sprintf("Run %s to create the role of your workflow and generate the %s workflow file.", color.HighlightCode("copilot pipeline deploy"), o.platform),
+		}
+	}
 	return []string{
 		fmt.Sprintf("Commit and push the %s directory to your repository.", color.HighlightResource("copilot/")),
 		fmt.Sprintf("Run %s to create your pipeline.", color.HighlightCode("copilot pipeline deploy")),
@@ -439,12 +456,39 @@ func (o *initPipelineOpts) askOrValidatePipelineType() error {
 func (o *initPipelineOpts) validateURL(url string) error {
 	// Note: no longer calling `validateDomainName` because if users use git-remote-codecommit
 	// (the HTTPS (GRC) protocol) to connect to CodeCommit, the url does not have any periods.
-	if !strings.Contains(url, githubURL) && !strings.Contains(url, ccIdentifier) && !strings.Contains(url, bbURL) {
-		return fmt.Errorf(fmtErrInvalidPipelineProvider, url, english.WordSeries(manifest.PipelineProviders, "or"))
+	if !o.isSupportedURL(url) {
+		return fmt.Errorf(fmtErrInvalidPipelineProvider, url, english.WordSeries(o.supportedProviders(), "or"))
 	}
 	return nil
 }
 
+// isSupportedURL returns true if the repository can be tracked by a pipeline running on the platform.
+func (o *initPipelineOpts) isSupportedURL(url string) bool {
+	switch o.platform {
+	case manifest.PipelinePlatformGitHubActions:
+		return strings.Contains(url, githubURL)
+	case manifest.PipelinePlatformGitLabCI:
+		return strings.Contains(url, gitlabURL)
+	default:
+		return strings.Contains(url, githubURL) || strings.Contains(url, ccIdentifier) || strings.Contains(url, bbURL)
+	}
+}
+
+func (o *initPipelineOpts) supportedProviders() []string {
+	switch o.platform {
+	case manifest.PipelinePlatformGitHubActions:
+		return []string{manifest.GithubProviderName}
+	case manifest.PipelinePlatformGitLabCI:
+		return []string{manifest.GitLabProviderName}
+	default:
+		return manifest.PipelineProviders
+	}
+}
+
+func (o *initPipelineOpts) isCodePipeline() bool {
+	return o.platform == "" || o.platform == manifest.PipelinePlatformCodePipeline
+}
+
 // To avoid duplicating calls to GetEnvironment, validate and get config in the same step.
 func (o *initPipelineOpts) validateEnvs() error {
 	var envConfigs []*config.Environment
@@ -479,8 +523,10 @@ func (o *initPipelineOpts) parseRepoDetails() error {
 		return o.parseCodeCommitRepoDetails()
 	case strings.Contains(o.repoURL, bbURL):
 		return o.parseBitbucketRepoDetails()
+	case strings.Contains(o.repoURL, gitlabURL) && o.platform == manifest.PipelinePlatformGitLabCI:
+		return o.parseGitLabRepoDetails()
 	default:
-		return fmt.Errorf(fmtErrInvalidPipelineProvider, o.repoURL, english.WordSeries(manifest.PipelineProviders, "or"))
+		return fmt.Errorf(fmtErrInvalidPipelineProvider, o.repoURL, english.WordSeries(o.supportedProviders(), "or"))
 	}
 }
 
@@ -555,6 +601,18 @@ func (o *initPipelineOpts) parseBitbucketRepoDetails() error {
 	return nil
 }
 
+func (o *initPipelineOpts) parseGitLabRepoDetails() error {
+	o.provider = manifest.GitLabProviderName
+	repoDetails, err := glRepoURL(o.repoURL).parse()
+	if err != nil {
+		return err
+	}
+	o.repoName = repoDetails.name
+	o.glProject = repoDetails.project
+
+	return nil
+}
+
 func (o *initPipelineOpts) selectURL() error {
 	// Fetches and parses all remote repositories.
 	err := o.runner.Run("git", []string{"remote", "-v"}, exec.Stdout(&o.buffer))
@@ -609,7 +667,7 @@ func (o *initPipelineOpts) parseGitRemoteResult(s string) ([]string, error) {
 	urlSet := make(map[string]bool)
 	items := strings.Split(s, "\n")
 	for _, item := range items {
-		if !strings.Contains(item, githubURL) && !strings.Contains(item, ccIdentifier) && !strings.Contains(item, bbURL) {
+		if !o.isSupportedURL(item) {
 			continue
 		}
 		cols := strings.Split(item, "\t")
@@ -639,6 +697,12 @@ type bbRepoDetails struct {
 	owner string
 }
 
+type glRepoURL string
+type glRepoDetails struct {
+	name    string
+	project string // Full path of the project, including its groups.
+}
+
 func (url ghRepoURL) parse() (ghRepoDetails, error) {
 	urlString := string(url)
 	regexPattern := regexp.MustCompile(`.*(github.com)(:|\/)`)
@@ -711,6 +775,24 @@ func (url bbRepoURL) parse() (bbRepoDetails, error) {
 	}, nil
 }
 
+// GitLab URLs, post-parseGitRemoteResults(), may look like:
+// https://gitlab.com/group/subgroup/project
+// git@gitlab.com:group/project
+func (url glRepoURL) parse() (glRepoDetails, error) {
+	urlString := string(url)
+	regexPattern := regexp.MustCompile(`.*(gitlab.com)(:|\/)`)
+	project := strings.TrimPrefix(urlString, regexPattern.FindString(urlString))
+	project = strings.TrimSuffix(project, ".git")
+	splitProject := strings.Split(project, "/")
+	if len(splitProject) < 2 {
+		return glRepoDetails{}, fmt.Errorf("unable to parse the GitLab project path from %s: please pass the repository URL with the format `--url https://gitlab.com/{group}/{project}`", url)
+	}
+	return glRepoDetails{
+		name:    splitProject[len(splitProject)-1],
+		project: project,
+	}, nil
+}
+
 func (o *initPipelineOpts) storeGitHubAccessToken() error {
 	secretName := o.secretName()
 	_, err := o.secretsmanager.CreateSecret(secretName, o.githubAccessToken)
@@ -737,6 +819,9 @@ func (o *initPipelineOpts) createPipelineManifest(stages []manifest.PipelineStag
 	if err != nil {
 		return fmt.Errorf("generate a pipeline manifest: %w", err)
 	}
+	if !o.isCodePipeline() {
+		manifest.Platform = o.platform
+	}
 
 	var manifestExists bool
 	o.manifestPath, err = o.workspace.WritePipelineManifest(manifest, o.name)
@@ -775,11 +860,13 @@ func (o *initPipelineOpts) createBuildspec(buildSpecTemplatePath string) error {
 		return err
 	}
 	content, err := o.parser.Parse(buildSpecTemplatePath, struct {
+		AppName            string
 		BinaryS3BucketPath string
 		Version            string
 		ManifestPath       string
 		ArtifactBuckets    []artifactBucket
 	}{
+		AppName:            o.appName,
 		BinaryS3BucketPath: binaryS3BucketPath,
 		Version:            version.Version,
 		ManifestPath:       filepath.ToSlash(o.manifestPath), // The manifest path must be rendered in the buildspec with '/' instead of os-specific separator.
@@ -837,6 +924,11 @@ func (o *initPipelineOpts) pipelineProvider() (manifest.Provider, error) {
 			RepositoryURL: fmt.Sprintf(fmtBBRepoURL, bbURL, o.repoOwner, o.repoName),
 			Branch:        o.repoBranch,
 		}
+	case manifest.GitLabProviderName:
+		config = &manifest.GitLabProperties{
+			RepositoryURL: fmt.Sprintf(fmtGLRepoURL, gitlabURL, o.glProject),
+			Branch:        o.repoBranch,
+		}
 	default:
 		return nil, fmt.Errorf("unable to create pipeline source provider for %s", o.repoName)
 	}
@@ -884,7 +976,14 @@ func buildPipelineInitCmd() *cobra.Command {
   /code  --name frontend-main \
   /code  --url https://github.com/gitHubUserName/frontend.git \
   /code  --git-branch main \
-  /code  --environments "stage,prod"`,
+  /code  --environments "stage,prod"
+
+  Create a pipeline that runs as a GitLab CI workflow.
+  /code $ copilot pipeline init \
+  /code  --name frontend-main \
+  /code  --url https://gitlab.com/groupName/frontend.git \
+  /code  --environments "stage,prod" \
+  /code  --platform GitLabCI`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitPipelineOpts(vars)
 			if err != nil {
@@ -917,5 +1016,6 @@ func buildPipelineInitCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.repoBranch, gitBranchFlag, gitBranchFlagShort, "", gitBranchFlagDescription)
 	cmd.Flags().StringSliceVarP(&vars.environments, envsFlag, envsFlagShort, []string{}, pipelineEnvsFlagDescription)
 	cmd.Flags().StringVarP(&vars.pipelineType, pipelineTypeFlag, pipelineTypeShort, "", pipelineTypeFlagDescription)
+	cmd.Flags().StringVar(&vars.platform, pipelinePlatformFlag, "", pipelinePlatformFlagDescription)
 	return cmd
 }
@@ -5,6 +5,7 @@ package cli
 
 import (
 	"bytes"
+	"encoding"
 	"errors"
 	"fmt"
 	"testing"
@@ -16,6 +17,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	templatemocks "github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -36,6 +38,43 @@ type pipelineInitMocks struct {
 	pipelineLister *mocks.MockdeployedPipelineLister
 }
 
+func TestInitPipelineOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inPlatform string
+
+		expectedError error
+	}{
+		"valid without a platform": {},
+		"valid with a workflow platform": {
+			inPlatform: manifest.PipelinePlatformGitLabCI,
+		},
+		"invalid platform": {
+			inPlatform:    "Jenkins",
+			expectedError: errors.New(`invalid platform "Jenkins"; must be one of "CodePipeline", "GitHubActions" or "GitLabCI"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &initPipelineOpts{
+				initPipelineVars: initPipelineVars{
+					platform: tc.inPlatform,
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestInitPipelineOpts_Ask(t *testing.T) {
 	const (
 		mockAppName = "my-app"
@@ -57,6 +96,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 		inGitHubAccessToken string
 		inGitBranch         string
 		inType              string
+		inPlatform          string
 
 		setupMocks func(m pipelineInitMocks)
 		buffer     bytes.Buffer
@@ -91,6 +131,15 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 			},
 			expectedError: errors.New("repository https://gitlab.company.com/group/project.git must be from a supported provider: GitHub, CodeCommit or Bitbucket"),
 		},
+		"returns error when repository URL is not from GitHub for a GitHub Actions workflow": {
+			inWsAppName: mockAppName,
+			inRepoURL:   "https://bitbucket.org/badGoose/chaOS",
+			inPlatform:  manifest.PipelinePlatformGitHubActions,
+			setupMocks: func(m pipelineInitMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil)
+			},
+			expectedError: errors.New("repository https://bitbucket.org/badGoose/chaOS must be from a supported provider: GitHub"),
+		},
 		"returns error when GitHub repository URL is of unknown format": {
 			inWsAppName: mockAppName,
 			inRepoURL:   "thisisnotevenagithub.comrepository",
@@ -288,6 +337,24 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				m.workspace.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{}, nil)
 			},
 		},
+		"success with GitLab repo for a GitLab CI workflow": {
+			inWsAppName:    mockAppName,
+			inName:         wantedName,
+			inEnvironments: []string{"test"},
+			inRepoURL:      "https://gitlab.com/badGoose/devs/chaOS.git",
+			inGitBranch:    "main",
+			inPlatform:     manifest.PipelinePlatformGitLabCI,
+			setupMocks: func(m pipelineInitMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil)
+				m.prompt.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(
+					&config.Environment{
+						Name: "test",
+					}, nil)
+				m.pipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{}, nil)
+				m.workspace.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{}, nil)
+			},
+		},
 		"success with CC repo with env and repoURL flags": {
 			inWsAppName:    mockAppName,
 			inName:         wantedName,
@@ -440,6 +507,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 					githubAccessToken: tc.inGitHubAccessToken,
 					repoBranch:        tc.inGitBranch,
 					pipelineType:      tc.inType,
+					platform:          tc.inPlatform,
 				},
 				wsAppName:      tc.inWsAppName,
 				prompt:         mocks.prompt,
@@ -488,6 +556,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 		inBranch       string
 		inAppName      string
 		inType         string
+		inPlatform     string
 
 		setupMocks func(m pipelineInitMocks)
 		buffer     bytes.Buffer
@@ -625,6 +694,51 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		"writes GitHub Actions pipeline manifest without a buildspec": {
+			inName:     wantedName,
+			inType:     pipelineTypeWorkloads,
+			inPlatform: manifest.PipelinePlatformGitHubActions,
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+				},
+			},
+			inRepoURL: "git@github.com:badgoose/goose.git",
+			inAppName: "badgoose",
+			setupMocks: func(m pipelineInitMocks) {
+				m.workspace.EXPECT().WritePipelineManifest(gomock.Any(), wantedName).DoAndReturn(func(marshaler encoding.BinaryMarshaler, _ string) (string, error) {
+					mft, ok := marshaler.(*manifest.Pipeline)
+					require.True(t, ok)
+					require.Equal(t, manifest.PipelinePlatformGitHubActions, mft.Platform)
+					return wantedManifestFile, nil
+				})
+				m.workspace.EXPECT().Rel(wantedManifestFile).Return(wantedManifestRelPath, nil)
+				m.workspace.EXPECT().WritePipelineBuildspec(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"writes GitLab CI pipeline manifest for GitLab provider": {
+			inName:     wantedName,
+			inType:     pipelineTypeEnvironments,
+			inPlatform: manifest.PipelinePlatformGitLabCI,
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+				},
+			},
+			inRepoURL: "git@gitlab.com:badgoose/flock/goose.git",
+			inAppName: "badgoose",
+			setupMocks: func(m pipelineInitMocks) {
+				m.workspace.EXPECT().WritePipelineManifest(gomock.Any(), wantedName).DoAndReturn(func(marshaler encoding.BinaryMarshaler, _ string) (string, error) {
+					mft, ok := marshaler.(*manifest.Pipeline)
+					require.True(t, ok)
+					require.Equal(t, manifest.PipelinePlatformGitLabCI, mft.Platform)
+					require.Equal(t, manifest.GitLabProviderName, mft.Source.ProviderName)
+					require.Equal(t, "https://gitlab.com/badgoose/flock/goose", mft.Source.Properties["repository"])
+					return wantedManifestFile, nil
+				})
+				m.workspace.EXPECT().Rel(wantedManifestFile).Return(wantedManifestRelPath, nil)
+			},
+		},
 		"writes environments pipeline manifest for GH(v2) provider": {
 			inName: wantedName,
 			inType: pipelineTypeEnvironments,
@@ -874,6 +988,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 					repoBranch:        tc.inBranch,
 					repoURL:           tc.inRepoURL,
 					pipelineType:      tc.inType,
+					platform:          tc.inPlatform,
 				},
 				workspace:      mocks.workspace,
 				secretsmanager: mocks.secretsmanager,
@@ -902,6 +1017,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 func TestInitPipelineOpts_parseGitRemoteResult(t *testing.T) {
 	testCases := map[string]struct {
 		inRemoteResult string
+		inPlatform     string
 
 		expectedURLs  []string
 		expectedError error
@@ -923,12 +1039,23 @@ bb	https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service.git (push)
 
 			expectedURLs: []string{},
 		},
+		"only add GitLab URLs for a GitLab CI workflow": {
+			inRemoteResult: `origin	git@gitlab.com:badgoose/grit.git (fetch)
+badgoose	https://github.com/badgoose/cli.git (fetch)`,
+			inPlatform: manifest.PipelinePlatformGitLabCI,
+
+			expectedURLs: []string{"git@gitlab.com:badgoose/grit"},
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
-			opts := &initPipelineOpts{}
+			opts := &initPipelineOpts{
+				initPipelineVars: initPipelineVars{
+					platform: tc.inPlatform,
+				},
+			}
 
 			// WHEN
 			urls, err := opts.parseGitRemoteResult(tc.inRemoteResult)
@@ -1074,3 +1201,48 @@ func TestInitPipelineBBRepoURL_parse(t *testing.T) {
 		})
 	}
 }
+
+func TestInitPipelineGLRepoURL_parse(t *testing.T) {
+	testCases := map[string]struct {
+		inRepoURL glRepoURL
+
+		expectedDetails glRepoDetails
+		expectedError   error
+	}{
+		"successfully parses https url of a project in a subgroup": {
+			inRepoURL: "https://gitlab.com/badgoose/flock/goose.git",
+
+			expectedDetails: glRepoDetails{
+				name:    "goose",
+				project: "badgoose/flock/goose",
+			},
+		},
+		"successfully parses ssh url": {
+			inRepoURL: "git@gitlab.com:badgoose/goose",
+
+			expectedDetails: glRepoDetails{
+				name:    "goose",
+				project: "badgoose/goose",
+			},
+		},
+		"returns an error if the url is missing the group": {
+			inRepoURL: "https://gitlab.com/goose",
+
+			expectedError: errors.New("unable to parse the GitLab project path from https://gitlab.com/goose: please pass the repository URL with the format `--url https://gitlab.com/{group}/{project}`"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			details, err := glRepoURL.parse(tc.inRepoURL)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.Equal(t, tc.expectedDetails, details)
+			}
+		})
+	}
+}
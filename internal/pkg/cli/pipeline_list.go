@@ -13,9 +13,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
@@ -60,7 +57,7 @@ type listPipelineOpts struct {
 type newPipelineDescriberFunc func(pipeline deploy.Pipeline) (describer, error)
 
 func newListPipelinesOpts(vars listPipelineVars) (*listPipelineOpts, error) {
-	ws, err := workspace.Use(afero.NewOsFs())
+	ws, err := workspace.UseApp(vars.appName, afero.NewOsFs())
 	if err != nil {
 		return nil, err
 	}
@@ -72,10 +69,10 @@ func newListPipelinesOpts(vars listPipelineVars) (*listPipelineOpts, error) {
 
 	var wsAppName string
 	if vars.shouldShowLocalPipelines {
-		wsAppName = tryReadingAppName()
+		wsAppName = tryReadingWorkspaceAppName(vars.appName)
 	}
 
-	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	store := config.NewStore(defaultSession)
 	prompter := prompt.New()
 	return &listPipelineOpts{
 		listPipelineVars: vars,
@@ -0,0 +1,167 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineLogsAppNamePrompt     = "Which application's pipeline logs would you like to show?"
+	pipelineLogsAppNameHelpPrompt = "An application is a collection of related services."
+
+	fmtPipelineLogsPrompt = "Which pipeline of %s would you like to show the logs of?"
+)
+
+type pipelineLogsVars struct {
+	appName          string
+	name             string
+	executionID      string
+	follow           bool
+	limit            int
+	shouldOutputJSON bool
+}
+
+type pipelineLogsOpts struct {
+	pipelineLogsVars
+
+	store                  store
+	sel                    codePipelineSelector
+	deployedPipelineLister deployedPipelineLister
+	executions             latestExecutionGetter
+	newLogger              func(pipelineName string) pipelineLogWriter
+
+	// Cached variables.
+	targetPipeline *deploy.Pipeline
+}
+
+func newPipelineLogsOpts(vars pipelineLogsVars) (*pipelineLogsOpts, error) {
+	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("pipeline logs")).Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	store := config.NewStore(sess)
+	pipelineLister := deploy.NewPipelineStore(rg.New(sess))
+	return &pipelineLogsOpts{
+		pipelineLogsVars:       vars,
+		store:                  store,
+		sel:                    selector.NewAppPipelineSelector(prompt.New(), store, pipelineLister),
+		deployedPipelineLister: pipelineLister,
+		executions:             codepipeline.New(sess),
+		newLogger: func(pipelineName string) pipelineLogWriter {
+			return logging.NewPipelineLogger(pipelineName, sess)
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by flags are invalid.
+func (o *pipelineLogsOpts) Validate() error {
+	if o.limit != 0 && (o.limit < cwGetLogEventsLimitMin || o.limit > cwGetLogEventsLimitMax) {
+		return fmt.Errorf("--limit %d is out-of-bounds, value must be between %d and %d", o.limit, cwGetLogEventsLimitMin, cwGetLogEventsLimitMax)
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in, and validates those that are.
+func (o *pipelineLogsOpts) Ask() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name: %w", err)
+		}
+	} else {
+		app, err := o.sel.Application(pipelineLogsAppNamePrompt, pipelineLogsAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.name != "" {
+		pipeline, err := getDeployedPipelineInfo(o.deployedPipelineLister, o.appName, o.name)
+		if err != nil {
+			return fmt.Errorf("validate pipeline name %s: %w", o.name, err)
+		}
+		o.targetPipeline = &pipeline
+		return nil
+	}
+	pipeline, err := askDeployedPipelineName(o.sel, fmt.Sprintf(fmtPipelineLogsPrompt, color.HighlightUserInput(o.appName)), o.appName)
+	if err != nil {
+		return err
+	}
+	o.name = pipeline.Name
+	o.targetPipeline = &pipeline
+	return nil
+}
+
+// Execute writes the logs of the CodeBuild actions of the latest or the given execution of the pipeline.
+func (o *pipelineLogsOpts) Execute() error {
+	id := o.executionID
+	if id == "" {
+		latest, err := o.executions.LatestExecutionID(o.targetPipeline.ResourceName)
+		if err != nil {
+			return fmt.Errorf("get latest execution of pipeline %s: %w", o.name, err)
+		}
+		id = latest
+	}
+	eventsWriter := logging.WriteHumanLogs
+	if o.shouldOutputJSON {
+		eventsWriter = logging.WriteJSONLogs
+	}
+	var limit *int64
+	if o.limit != 0 {
+		limit = aws.Int64(int64(o.limit))
+	}
+	err := o.newLogger(o.targetPipeline.ResourceName).WriteLogEvents(logging.WritePipelineLogEventsOpts{
+		ExecutionID: id,
+		Follow:      o.follow,
+		Limit:       limit,
+		OnEvents:    eventsWriter,
+	})
+	if err != nil {
+		return fmt.Errorf("write logs of execution %s of pipeline %s: %w", id, o.name, err)
+	}
+	return nil
+}
+
+// buildPipelineLogsCmd builds the command to show the build logs of a pipeline execution.
+func buildPipelineLogsCmd() *cobra.Command {
+	vars := pipelineLogsVars{}
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Displays logs of the builds in a pipeline execution.",
+		Long: `Displays logs of the build, test commands, pre-deployments and post-deployments actions
+in the latest or a given execution of a pipeline.`,
+
+		Example: `
+  Displays the build logs of the latest execution of the pipeline "my-repo-my-branch".
+  /code $ copilot pipeline logs -n my-repo-my-branch
+  Follows the build logs of an execution until it completes.
+  /code $ copilot pipeline logs -n my-repo-my-branch --execution-id 1b2c3d4e-aaaa-bbbb-cccc-1234567890ab --follow`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineLogsOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.executionID, executionIDFlag, "", executionIDFlagDescription)
+	cmd.Flags().BoolVar(&vars.follow, followFlag, false, pipelineFollowFlagDescription)
+	cmd.Flags().IntVar(&vars.limit, limitFlag, 0, pipelineLogsLimitFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
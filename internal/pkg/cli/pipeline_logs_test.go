@@ -0,0 +1,119 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+)
+
+func TestPipelineLogsOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inLimit int
+
+		wantedErr error
+	}{
+		"valid without limit": {},
+		"invalid limit": {
+			inLimit:   10001,
+			wantedErr: errors.New("--limit 10001 is out-of-bounds, value must be between 1 and 10000"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &pipelineLogsOpts{
+				pipelineLogsVars: pipelineLogsVars{
+					limit: tc.inLimit,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPipelineLogsOpts_Execute(t *testing.T) {
+	const mockResourceName = "pipeline-dinder-badgoose-repo-RANDOMSTRING"
+	testCases := map[string]struct {
+		inExecutionID string
+		inLimit       int
+		setupMocks    func(executions *mocks.MocklatestExecutionGetter, logger *mocks.MockpipelineLogWriter)
+
+		wantedErr error
+	}{
+		"error if the latest execution cannot be retrieved": {
+			setupMocks: func(executions *mocks.MocklatestExecutionGetter, logger *mocks.MockpipelineLogWriter) {
+				executions.EXPECT().LatestExecutionID(mockResourceName).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("get latest execution of pipeline badgoose-repo: some error"),
+		},
+		"write the logs of the latest execution": {
+			setupMocks: func(executions *mocks.MocklatestExecutionGetter, logger *mocks.MockpipelineLogWriter) {
+				executions.EXPECT().LatestExecutionID(mockResourceName).Return("exec-2", nil)
+				logger.EXPECT().WriteLogEvents(gomock.Any()).Do(func(opts logging.WritePipelineLogEventsOpts) {
+					require.Equal(t, "exec-2", opts.ExecutionID)
+					require.Nil(t, opts.Limit)
+				}).Return(nil)
+			},
+		},
+		"follow the logs of the given execution": {
+			inExecutionID: "exec-1",
+			inLimit:       50,
+			setupMocks: func(executions *mocks.MocklatestExecutionGetter, logger *mocks.MockpipelineLogWriter) {
+				logger.EXPECT().WriteLogEvents(gomock.Any()).Do(func(opts logging.WritePipelineLogEventsOpts) {
+					require.Equal(t, "exec-1", opts.ExecutionID)
+					require.Equal(t, aws.Int64(50), opts.Limit)
+				}).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("write logs of execution exec-1 of pipeline badgoose-repo: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			executions := mocks.NewMocklatestExecutionGetter(ctrl)
+			logger := mocks.NewMockpipelineLogWriter(ctrl)
+			tc.setupMocks(executions, logger)
+			opts := &pipelineLogsOpts{
+				pipelineLogsVars: pipelineLogsVars{
+					name:        "badgoose-repo",
+					executionID: tc.inExecutionID,
+					limit:       tc.inLimit,
+				},
+				executions: executions,
+				newLogger: func(pipelineName string) pipelineLogWriter {
+					require.Equal(t, mockResourceName, pipelineName)
+					return logger
+				},
+				targetPipeline: &deploy.Pipeline{
+					ResourceName: mockResourceName,
+					Name:         "badgoose-repo",
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
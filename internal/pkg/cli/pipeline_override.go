@@ -7,9 +7,6 @@ import (
 	"fmt"
 	"slices"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -31,7 +28,7 @@ type overridePipelineOpts struct {
 
 func newOverridePipelineOpts(vars overrideVars) (*overridePipelineOpts, error) {
 	fs := afero.NewOsFs()
-	ws, err := workspace.Use(fs)
+	ws, err := workspace.UseApp(vars.appName, fs)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +45,7 @@ func newOverridePipelineOpts(vars overrideVars) (*overridePipelineOpts, error) {
 		overrideOpts: &overrideOpts{
 			overrideVars: vars,
 			fs:           fs,
-			cfgStore:     config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region)),
+			cfgStore:     config.NewStore(defaultSess),
 			prompt:       prompt,
 			cfnPrompt:    selector.NewCFNSelector(prompt),
 			spinner:      termprogress.NewSpinner(log.DiagnosticWriter),
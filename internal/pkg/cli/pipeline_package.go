@@ -12,8 +12,7 @@ import (
 	"path/filepath"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	cs "github.com/aws/copilot-cli/internal/pkg/aws/codestar"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
@@ -48,6 +47,7 @@ type packagePipelineOpts struct {
 	newSvcListCmd                   func(io.Writer, string) cmd
 	newJobListCmd                   func(io.Writer, string) cmd
 	sessProvider                    *sessions.Provider
+	region                          string
 
 	//catched variables
 	pipelineMft *manifest.Pipeline
@@ -62,9 +62,9 @@ func newPackagePipelineOpts(vars packagePipelineVars) (*packagePipelineOpts, err
 	if err != nil {
 		return nil, fmt.Errorf("default session: %w", err)
 	}
-	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	store := config.NewStore(defaultSession)
 
-	ws, err := workspace.Use(afero.NewOsFs())
+	ws, err := workspace.UseApp(vars.appName, afero.NewOsFs())
 	if err != nil {
 		return nil, err
 	}
@@ -73,8 +73,10 @@ func newPackagePipelineOpts(vars packagePipelineVars) (*packagePipelineOpts, err
 		pipelineDeployer:    deploycfn.New(defaultSession, deploycfn.WithProgressTracker(os.Stderr)),
 		tmplWriter:          os.Stdout,
 		ws:                  ws,
+		codestar:            cs.New(defaultSession),
 		store:               store,
 		sessProvider:        sessProvider,
+		region:              aws.StringValue(defaultSession.Config.Region),
 		pipelineStackConfig: func(in *deploy.CreatePipelineInput) deploycfn.StackConfiguration {
 			return stack.NewPipelineStackConfig(in)
 		},
@@ -181,8 +183,8 @@ func (o *packagePipelineOpts) Execute() error {
 		return err
 	}
 
-	var build deploy.Build
-	if err = build.Init(pipelineMft.Build, filepath.Dir(relPath)); err != nil {
+	build, err := pipelineBuild(pipelineMft, filepath.Dir(relPath), o.region, o.app, o.store, o.getBucketName)
+	if err != nil {
 		return err
 	}
 
@@ -196,8 +198,11 @@ func (o *packagePipelineOpts) Execute() error {
 		Name:                o.name,
 		IsLegacy:            isLegacy,
 		Source:              source,
-		Build:               &build,
+		Build:               build,
 		Stages:              stages,
+		Waves:               pipelineMft.Waves,
+		Previews:            pipelinePreviews(pipelineMft),
+		Notifications:       deploy.PipelineNotificationsFromManifest(pipelineMft.Notifications),
 		ArtifactBuckets:     artifactBuckets,
 		AdditionalTags:      o.app.Tags,
 		Version:             version.LatestTemplateVersion(),
@@ -307,3 +312,11 @@ func (o *packagePipelineOpts) getArtifactBuckets() ([]deploy.ArtifactBucket, err
 
 	return buckets, nil
 }
+
+func (o *packagePipelineOpts) getBucketName() (string, error) {
+	resources, err := o.pipelineDeployer.GetAppResourcesByRegion(o.app, o.region)
+	if err != nil {
+		return "", fmt.Errorf("get app resources: %w", err)
+	}
+	return resources.S3Bucket, nil
+}
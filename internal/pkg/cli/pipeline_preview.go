@@ -0,0 +1,574 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codecommit"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/pullrequest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const fmtPreviewEnvName = "pr-%s"
+
+type pipelinePreviewVars struct {
+	appName     string
+	name        string
+	pullRequest string
+	since       string
+	delete      bool
+}
+
+type pipelinePreviewOpts struct {
+	pipelinePreviewVars
+
+	ws          wsPipelinePreviewReadWriter
+	store       store
+	deployStore deployedEnvironmentLister
+	changes     *pipelineChangesOpts
+
+	newEnvDescriber func(envName string) (envDescriber, error)
+	newURIGetter    func(svcName string) (uriGetter, error)
+	newCommenter    func(mft *manifest.Pipeline) (pullRequestCommenter, error)
+	newForkChecker  func(mft *manifest.Pipeline) (pullRequestForkChecker, error)
+	newInitEnvCmd   func(envName, region string) (cmd, error)
+	newDeployEnvCmd func(envName string) (cmd, error)
+	newDeleteEnvCmd func(envName string) (executeAsker, error)
+	newDeployCmd    func(name, wkldType, envName string) (cmd, error)
+	newDeleteCmd    func(name, wkldType, envName string) (executor, error)
+}
+
+func newPipelinePreviewOpts(vars pipelinePreviewVars) (*pipelinePreviewOpts, error) {
+	ws, err := workspace.UseApp(vars.appName, afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	summary, err := ws.Summary()
+	if err != nil {
+		return nil, fmt.Errorf("read workspace summary: %w", err)
+	}
+	vars.appName = summary.Application
+	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("pipeline preview")).Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	store := config.NewStore(sess)
+	deployStore, err := deploy.NewStore(sessions.ImmutableProvider(), store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &pipelinePreviewOpts{
+		pipelinePreviewVars: vars,
+		ws:                  ws,
+		store:               store,
+		deployStore:         deployStore,
+		changes: &pipelineChangesOpts{
+			pipelineChangesVars: pipelineChangesVars{
+				appName: vars.appName,
+				name:    vars.name,
+				since:   vars.since,
+			},
+			ws:        ws,
+			runner:    exec.NewCmd(),
+			unmarshal: manifest.UnmarshalWorkload,
+		},
+		newCommenter: func(mft *manifest.Pipeline) (pullRequestCommenter, error) {
+			return newPullRequestCommenter(mft, secretsmanager.New(sess), codecommit.New(sess))
+		},
+		newForkChecker: func(mft *manifest.Pipeline) (pullRequestForkChecker, error) {
+			return newPullRequestForkChecker(mft, secretsmanager.New(sess))
+		},
+		newInitEnvCmd: func(envName, region string) (cmd, error) {
+			// The manifest of the preview environment is written before initializing it, so "default config" only skips the prompts.
+			return newInitEnvOpts(initEnvVars{
+				appName:       summary.Application,
+				name:          envName,
+				defaultConfig: true,
+				region:        region,
+			})
+		},
+		newDeployEnvCmd: func(envName string) (cmd, error) {
+			return newEnvDeployOpts(deployEnvVars{
+				appName: summary.Application,
+				name:    envName,
+			})
+		},
+		newDeleteEnvCmd: func(envName string) (executeAsker, error) {
+			return newDeleteEnvOpts(deleteEnvVars{
+				appName:          summary.Application,
+				name:             envName,
+				skipConfirmation: true,
+			})
+		},
+		newDeployCmd: func(name, wkldType, envName string) (cmd, error) {
+			vars := deployWkldVars{
+				appName: summary.Application,
+				name:    name,
+				envName: envName,
+			}
+			if slices.Contains(manifestinfo.JobTypes(), wkldType) {
+				return newJobDeployOpts(vars)
+			}
+			return newSvcDeployOpts(vars)
+		},
+		newDeleteCmd: func(name, wkldType, envName string) (executor, error) {
+			if slices.Contains(manifestinfo.JobTypes(), wkldType) {
+				return newDeleteJobOpts(deleteJobVars{
+					appName:          summary.Application,
+					name:             name,
+					envName:          envName,
+					skipConfirmation: true,
+				})
+			}
+			return newDeleteSvcOpts(deleteSvcVars{
+				appName:          summary.Application,
+				name:             name,
+				envName:          envName,
+				skipConfirmation: true,
+			})
+		},
+	}
+	opts.newEnvDescriber = func(envName string) (envDescriber, error) {
+		return describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         opts.appName,
+			Env:         envName,
+			ConfigStore: store,
+			DeployStore: deployStore,
+		})
+	}
+	opts.newURIGetter = func(svcName string) (uriGetter, error) {
+		return describe.NewReachableService(opts.appName, svcName, store)
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *pipelinePreviewOpts) Validate() error {
+	if o.name == "" {
+		return fmt.Errorf("--%s is required", nameFlag)
+	}
+	if o.pullRequest == "" {
+		return fmt.Errorf("--%s is required", pullRequestFlag)
+	}
+	return nil
+}
+
+// Ask is a no-op: the command runs in the preview project of a pipeline.
+func (o *pipelinePreviewOpts) Ask() error {
+	return nil
+}
+
+// Execute deploys the preview environment of the pull request and the workloads it changes,
+// or deletes the preview environment and its workloads if the pull request is closed.
+func (o *pipelinePreviewOpts) Execute() error {
+	mft, mftPath, err := o.changes.pipelineManifest()
+	if err != nil {
+		return err
+	}
+	if mft.Previews == nil {
+		return fmt.Errorf("pipeline %s does not deploy previews", o.name)
+	}
+	envName := fmt.Sprintf(fmtPreviewEnvName, o.pullRequest)
+	if o.delete {
+		return o.deletePreview(envName)
+	}
+	return o.deployPreview(mft, mftPath, envName)
+}
+
+func (o *pipelinePreviewOpts) deployPreview(mft *manifest.Pipeline, mftPath, envName string) error {
+	if err := o.checkNotFromFork(mft); err != nil {
+		return err
+	}
+	parent, err := o.store.GetEnvironment(o.appName, mft.Previews.Environment)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", mft.Previews.Environment, err)
+	}
+	if err := o.writeEnvManifest(parent.Name, envName, mft.Previews.ShareVPC); err != nil {
+		return err
+	}
+	exists, err := o.envExists(envName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		initEnv, err := o.newInitEnvCmd(envName, parent.Region)
+		if err != nil {
+			return fmt.Errorf("set up env init command: %w", err)
+		}
+		if err := run(initEnv); err != nil {
+			return fmt.Errorf("initialize environment %s: %w", envName, err)
+		}
+	}
+	deployEnv, err := o.newDeployEnvCmd(envName)
+	if err != nil {
+		return fmt.Errorf("set up env deploy command: %w", err)
+	}
+	if err := run(deployEnv); err != nil {
+		return fmt.Errorf("deploy environment %s: %w", envName, err)
+	}
+
+	workloads, err := o.changes.changedWorkloads(mft.Build, mftPath)
+	if err != nil {
+		return err
+	}
+	var urls []previewURL
+	for _, name := range workloads {
+		wkld, err := o.store.GetWorkload(o.appName, name)
+		if err != nil {
+			return fmt.Errorf("get workload %s: %w", name, err)
+		}
+		deployWkld, err := o.newDeployCmd(name, wkld.Type, envName)
+		if err != nil {
+			return fmt.Errorf("set up deploy command for %s: %w", name, err)
+		}
+		if err := run(deployWkld); err != nil {
+			return fmt.Errorf("deploy %s to environment %s: %w", name, envName, err)
+		}
+		url, err := o.url(name, envName)
+		if err != nil {
+			return err
+		}
+		if url != "" {
+			urls = append(urls, previewURL{name: name, url: url})
+		}
+	}
+	return o.comment(mft, previewComment(envName, workloads, urls))
+}
+
+func (o *pipelinePreviewOpts) deletePreview(envName string) error {
+	exists, err := o.envExists(envName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		log.Infof("Preview environment %s does not exist, nothing to delete.\n", envName)
+		return nil
+	}
+	svcs, err := o.deployStore.ListDeployedServices(o.appName, envName)
+	if err != nil {
+		return fmt.Errorf("list services deployed to environment %s: %w", envName, err)
+	}
+	jobs, err := o.deployStore.ListDeployedJobs(o.appName, envName)
+	if err != nil {
+		return fmt.Errorf("list jobs deployed to environment %s: %w", envName, err)
+	}
+	for _, wkld := range append(svcs, jobs...) {
+		cfg, err := o.store.GetWorkload(o.appName, wkld)
+		if err != nil {
+			return fmt.Errorf("get workload %s: %w", wkld, err)
+		}
+		deleteWkld, err := o.newDeleteCmd(wkld, cfg.Type, envName)
+		if err != nil {
+			return fmt.Errorf("set up delete command for %s: %w", wkld, err)
+		}
+		if err := deleteWkld.Execute(); err != nil {
+			return fmt.Errorf("delete %s from environment %s: %w", wkld, envName, err)
+		}
+	}
+	deleteEnv, err := o.newDeleteEnvCmd(envName)
+	if err != nil {
+		return fmt.Errorf("set up env delete command: %w", err)
+	}
+	if err := deleteEnv.Ask(); err != nil {
+		return err
+	}
+	if err := deleteEnv.Execute(); err != nil {
+		return fmt.Errorf("delete environment %s: %w", envName, err)
+	}
+	return nil
+}
+
+// checkNotFromFork returns an error if the pull request comes from a fork of the repository,
+// since the code of a fork is not reviewed before it's built with the permissions of the preview project.
+func (o *pipelinePreviewOpts) checkNotFromFork(mft *manifest.Pipeline) error {
+	checker, err := o.newForkChecker(mft)
+	if err != nil {
+		return err
+	}
+	if checker == nil {
+		return nil
+	}
+	fromFork, err := checker.IsFromFork(o.pullRequest)
+	if err != nil {
+		return fmt.Errorf("check if pull request %s is from a fork: %w", o.pullRequest, err)
+	}
+	if fromFork {
+		return fmt.Errorf("pull request %s is from a fork: previews are only deployed for branches of the repository", o.pullRequest)
+	}
+	return nil
+}
+
+func (o *pipelinePreviewOpts) envExists(envName string) (bool, error) {
+	_, err := o.store.GetEnvironment(o.appName, envName)
+	if err == nil {
+		return true, nil
+	}
+	var errNotFound *config.ErrNoSuchEnvironment
+	if errors.As(err, &errNotFound) {
+		return false, nil
+	}
+	return false, fmt.Errorf("get environment %s: %w", envName, err)
+}
+
+// writeEnvManifest writes the manifest of the preview environment from the manifest of its parent environment.
+// If the preview shares the VPC of the parent, the manifest imports the VPC and subnets of the parent instead of creating new ones.
+// A preview manifest that already exists in the workspace is left as is.
+func (o *pipelinePreviewOpts) writeEnvManifest(parent, envName string, shareVPC bool) error {
+	raw, err := o.ws.ReadEnvironmentManifest(parent)
+	if err != nil {
+		return fmt.Errorf("read manifest for environment %s: %w", parent, err)
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return fmt.Errorf("unmarshal manifest for environment %s: %w", parent, err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return fmt.Errorf("manifest for environment %s is not a map", parent)
+	}
+	root := doc.Content[0]
+	setMapValue(root, "name", &yaml.Node{Kind: yaml.ScalarNode, Value: envName})
+	if shareVPC {
+		vpc, err := o.parentVPC(parent)
+		if err != nil {
+			return err
+		}
+		network := mapValue(root, "network")
+		if network == nil || network.Kind != yaml.MappingNode {
+			network = &yaml.Node{Kind: yaml.MappingNode}
+			setMapValue(root, "network", network)
+		}
+		setMapValue(network, "vpc", vpc)
+	}
+	var out bytes.Buffer
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return fmt.Errorf("marshal manifest for environment %s: %w", envName, err)
+	}
+	if _, err := o.ws.WriteEnvironmentManifest(rawEnvManifest(out.Bytes()), envName); err != nil {
+		var errFileExists *workspace.ErrFileExists
+		if errors.As(err, &errFileExists) {
+			return nil
+		}
+		return fmt.Errorf("write manifest for environment %s: %w", envName, err)
+	}
+	return nil
+}
+
+// parentVPC returns the "network.vpc" manifest node that imports the VPC of the environment.
+func (o *pipelinePreviewOpts) parentVPC(envName string) (*yaml.Node, error) {
+	d, err := o.newEnvDescriber(envName)
+	if err != nil {
+		return nil, fmt.Errorf("create describer for environment %s: %w", envName, err)
+	}
+	desc, err := d.Describe()
+	if err != nil {
+		return nil, fmt.Errorf("describe environment %s: %w", envName, err)
+	}
+	subnets := func(ids []string) []map[string]string {
+		out := make([]map[string]string, len(ids))
+		for i, id := range ids {
+			out[i] = map[string]string{"id": id}
+		}
+		return out
+	}
+	vpc := map[string]interface{}{
+		"id": desc.EnvironmentVPC.ID,
+		"subnets": map[string]interface{}{
+			"public":  subnets(desc.EnvironmentVPC.PublicSubnetIDs),
+			"private": subnets(desc.EnvironmentVPC.PrivateSubnetIDs),
+		},
+	}
+	var node yaml.Node
+	if err := node.Encode(vpc); err != nil {
+		return nil, fmt.Errorf("encode vpc of environment %s: %w", envName, err)
+	}
+	return &node, nil
+}
+
+// url returns the URL of the service in the environment if it's reachable over the internet.
+func (o *pipelinePreviewOpts) url(svcName, envName string) (string, error) {
+	getter, err := o.newURIGetter(svcName)
+	if err != nil {
+		var errNotAccessible *describe.ErrNonAccessibleServiceType
+		if errors.As(err, &errNotAccessible) {
+			return "", nil
+		}
+		return "", fmt.Errorf("create describer for %s: %w", svcName, err)
+	}
+	uri, err := getter.URI(envName)
+	if err != nil {
+		return "", fmt.Errorf("get uri of %s in environment %s: %w", svcName, envName, err)
+	}
+	if uri.AccessType != describe.URIAccessTypeInternet {
+		return "", nil
+	}
+	return uri.URI, nil
+}
+
+func (o *pipelinePreviewOpts) comment(mft *manifest.Pipeline, content string) error {
+	commenter, err := o.newCommenter(mft)
+	if err != nil {
+		return err
+	}
+	if commenter == nil {
+		log.Infoln(content)
+		return nil
+	}
+	if err := commenter.CommentOnPullRequest(o.pullRequest, content); err != nil {
+		return fmt.Errorf("comment on pull request %s: %w", o.pullRequest, err)
+	}
+	return nil
+}
+
+// rawEnvManifest is the content of an environment manifest written as is.
+type rawEnvManifest []byte
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (m rawEnvManifest) MarshalBinary() ([]byte, error) {
+	return m, nil
+}
+
+type previewURL struct {
+	name string
+	url  string
+}
+
+func previewComment(envName string, workloads []string, urls []previewURL) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Preview environment `%s` is deployed.\n", envName)
+	if len(workloads) == 0 {
+		b.WriteString("\nNo workloads changed.\n")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "\nDeployed workloads: %s.\n", strings.Join(workloads, ", "))
+	if len(urls) == 0 {
+		return b.String()
+	}
+	b.WriteString("\n| Service | URL |\n| --- | --- |\n")
+	for _, u := range urls {
+		fmt.Fprintf(&b, "| %s | %s |\n", u.name, u.url)
+	}
+	return b.String()
+}
+
+// newPullRequestCommenter returns the client that comments on the pull requests of the pipeline's source.
+// GitHub and Bitbucket pull requests are commented only if the previews have an access token secret,
+// otherwise the returned commenter is nil.
+func newPullRequestCommenter(mft *manifest.Pipeline, secrets secretGetter, cc pullRequestCommenter) (pullRequestCommenter, error) {
+	source, _, err := deploy.PipelineSourceFromManifest(mft.Source)
+	if err != nil {
+		return nil, fmt.Errorf("read source from manifest: %w", err)
+	}
+	if _, ok := source.(*deploy.CodeCommitSource); ok {
+		return cc, nil
+	}
+	if mft.Previews.AccessTokenSecret == "" {
+		return nil, nil
+	}
+	return newPullRequestClient(mft, source, secrets)
+}
+
+// newPullRequestForkChecker returns the client that checks if the pull requests of the pipeline's source come from forks.
+// CodeCommit repositories can't be forked, so the returned checker is nil for CodeCommit sources.
+func newPullRequestForkChecker(mft *manifest.Pipeline, secrets secretGetter) (pullRequestForkChecker, error) {
+	source, _, err := deploy.PipelineSourceFromManifest(mft.Source)
+	if err != nil {
+		return nil, fmt.Errorf("read source from manifest: %w", err)
+	}
+	if _, ok := source.(*deploy.CodeCommitSource); ok {
+		return nil, nil
+	}
+	return newPullRequestClient(mft, source, secrets)
+}
+
+// newPullRequestClient returns the client of the GitHub or Bitbucket pull requests of the source.
+// The client is anonymous if the previews don't have an access token secret.
+func newPullRequestClient(mft *manifest.Pipeline, source interface{}, secrets secretGetter) (pullRequestClient, error) {
+	var token string
+	if mft.Previews.AccessTokenSecret != "" {
+		secret, err := secrets.GetSecretValue(context.Background(), mft.Previews.AccessTokenSecret)
+		if err != nil {
+			return nil, fmt.Errorf("get access token secret %s: %w", mft.Previews.AccessTokenSecret, err)
+		}
+		token = secret
+	}
+	switch src := source.(type) {
+	case *deploy.GitHubSource:
+		repo, err := src.Repository()
+		if err != nil {
+			return nil, err
+		}
+		return pullrequest.NewGitHub(repo, token), nil
+	case *deploy.BitbucketSource:
+		repo, err := src.Repository()
+		if err != nil {
+			return nil, err
+		}
+		return pullrequest.NewBitbucket(repo, token), nil
+	}
+	return nil, fmt.Errorf("previews are not supported for source provider %s", mft.Source.ProviderName)
+}
+
+func mapValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func setMapValue(node *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content[i+1] = value
+			return
+		}
+	}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+}
+
+// buildPipelinePreviewCmd builds the command to deploy or delete the preview environment of a pull request.
+func buildPipelinePreviewCmd() *cobra.Command {
+	vars := pipelinePreviewVars{}
+	cmd := &cobra.Command{
+		Use:   "preview",
+		Short: "Deploys or deletes the preview environment of a pull request.",
+		Long: `Deploys or deletes the preview environment of a pull request.
+Used by the preview project of pipelines with "previews" configured.`,
+		Hidden: true,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelinePreviewOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVar(&vars.pullRequest, pullRequestFlag, "", pullRequestFlagDescription)
+	cmd.Flags().StringVar(&vars.since, sinceFlag, "", sinceRevisionFlagDescription)
+	cmd.Flags().BoolVar(&vars.delete, deleteFlag, false, deletePreviewFlagDescription)
+	return cmd
+}
@@ -0,0 +1,287 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+)
+
+type pipelinePreviewMocks struct {
+	ws          *mocks.MockwsPipelinePreviewReadWriter
+	store       *mocks.Mockstore
+	deployStore *mocks.MockdeployedEnvironmentLister
+	envDescr    *mocks.MockenvDescriber
+	uri         *mocks.MockuriGetter
+	commenter   *mocks.MockpullRequestCommenter
+	forkChecker *mocks.MockpullRequestForkChecker
+	initEnv     *mocks.Mockcmd
+	deployEnv   *mocks.Mockcmd
+	deployWkld  *mocks.Mockcmd
+	deleteEnv   *mocks.MockexecuteAsker
+	deleteWkld  *mocks.Mockexecutor
+}
+
+func TestPipelinePreviewOpts_Execute(t *testing.T) {
+	const envMft = `# The manifest for the "test" environment.
+name: test
+type: Environment
+observability:
+  container_insights: false
+`
+	pipelines := []workspace.PipelineManifest{
+		{Name: "release", Path: "/ws/copilot/pipelines/release/manifest.yml"},
+	}
+	previewMft := &manifest.Pipeline{
+		Source: &manifest.Source{
+			ProviderName: "CodeCommit",
+			Properties: map[string]interface{}{
+				"repository": "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/api",
+			},
+		},
+		Previews: &manifest.Previews{
+			Environment: "test",
+			ShareVPC:    true,
+		},
+	}
+	expectManifest := func(m pipelinePreviewMocks) {
+		m.ws.EXPECT().ListPipelines().Return(pipelines, nil)
+		m.ws.EXPECT().ReadPipelineManifest(pipelines[0].Path).Return(previewMft, nil)
+	}
+	errNoEnv := &config.ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "pr-7"}
+	testCases := map[string]struct {
+		inDelete   bool
+		noComment  bool
+		checkForks bool
+		setupMocks func(m pipelinePreviewMocks)
+
+		wantedManifest string
+		wantedErr      error
+	}{
+		"error if the pipeline doesn't deploy previews": {
+			setupMocks: func(m pipelinePreviewMocks) {
+				m.ws.EXPECT().ListPipelines().Return(pipelines, nil)
+				m.ws.EXPECT().ReadPipelineManifest(pipelines[0].Path).Return(&manifest.Pipeline{}, nil)
+			},
+			wantedErr: errors.New("pipeline release does not deploy previews"),
+		},
+		"error if the pull request is from a fork": {
+			checkForks: true,
+			setupMocks: func(m pipelinePreviewMocks) {
+				expectManifest(m)
+				m.forkChecker.EXPECT().IsFromFork("7").Return(true, nil)
+			},
+			wantedErr: errors.New("pull request 7 is from a fork: previews are only deployed for branches of the repository"),
+		},
+		"error if it can't be checked whether the pull request is from a fork": {
+			checkForks: true,
+			setupMocks: func(m pipelinePreviewMocks) {
+				expectManifest(m)
+				m.forkChecker.EXPECT().IsFromFork("7").Return(false, errors.New("some error"))
+			},
+			wantedErr: errors.New("check if pull request 7 is from a fork: some error"),
+		},
+		"initialize the preview environment in the VPC of its parent and deploy the workloads": {
+			setupMocks: func(m pipelinePreviewMocks) {
+				expectManifest(m)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test", Region: "us-west-2"}, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return(workspace.EnvironmentManifest(envMft), nil)
+				m.envDescr.EXPECT().Describe().Return(&describe.EnvDescription{
+					EnvironmentVPC: describe.EnvironmentVPC{
+						ID:               "vpc-1",
+						PublicSubnetIDs:  []string{"subnet-1"},
+						PrivateSubnetIDs: []string{"subnet-2"},
+					},
+				}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "pr-7").Return(nil, errNoEnv)
+				m.initEnv.EXPECT().Validate().Return(nil)
+				m.initEnv.EXPECT().Ask().Return(nil)
+				m.initEnv.EXPECT().Execute().Return(nil)
+				m.deployEnv.EXPECT().Validate().Return(nil)
+				m.deployEnv.EXPECT().Ask().Return(nil)
+				m.deployEnv.EXPECT().Execute().Return(nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"frontend", "report"}, nil)
+				m.store.EXPECT().GetWorkload("phonetool", "frontend").Return(&config.Workload{Type: manifestinfo.LoadBalancedWebServiceType}, nil)
+				m.store.EXPECT().GetWorkload("phonetool", "report").Return(&config.Workload{Type: manifestinfo.ScheduledJobType}, nil)
+				m.deployWkld.EXPECT().Validate().Return(nil).Times(2)
+				m.deployWkld.EXPECT().Ask().Return(nil).Times(2)
+				m.deployWkld.EXPECT().Execute().Return(nil).Times(2)
+				m.uri.EXPECT().URI("pr-7").Return(describe.URI{URI: "http://pr-7.example.com", AccessType: describe.URIAccessTypeInternet}, nil)
+				m.commenter.EXPECT().CommentOnPullRequest("7", "Preview environment `pr-7` is deployed.\n"+
+					"\nDeployed workloads: frontend, report.\n"+
+					"\n| Service | URL |\n| --- | --- |\n"+
+					"| frontend | http://pr-7.example.com |\n").Return(nil)
+			},
+			wantedManifest: `# The manifest for the "test" environment.
+name: pr-7
+type: Environment
+observability:
+  container_insights: false
+network:
+  vpc:
+    id: vpc-1
+    subnets:
+      private:
+        - id: subnet-2
+      public:
+        - id: subnet-1
+`,
+		},
+		"redeploy an existing preview environment without commenting": {
+			noComment: true,
+			setupMocks: func(m pipelinePreviewMocks) {
+				expectManifest(m)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test", Region: "us-west-2"}, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return(workspace.EnvironmentManifest(envMft), nil)
+				m.envDescr.EXPECT().Describe().Return(&describe.EnvDescription{}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "pr-7").Return(&config.Environment{Name: "pr-7"}, nil)
+				m.deployEnv.EXPECT().Validate().Return(nil)
+				m.deployEnv.EXPECT().Ask().Return(nil)
+				m.deployEnv.EXPECT().Execute().Return(nil)
+				m.ws.EXPECT().ListWorkloads().Return(nil, nil)
+			},
+		},
+		"error if a workload fails to deploy": {
+			setupMocks: func(m pipelinePreviewMocks) {
+				expectManifest(m)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test", Region: "us-west-2"}, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return(workspace.EnvironmentManifest(envMft), nil)
+				m.envDescr.EXPECT().Describe().Return(&describe.EnvDescription{}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "pr-7").Return(&config.Environment{Name: "pr-7"}, nil)
+				m.deployEnv.EXPECT().Validate().Return(nil)
+				m.deployEnv.EXPECT().Ask().Return(nil)
+				m.deployEnv.EXPECT().Execute().Return(nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"frontend"}, nil)
+				m.store.EXPECT().GetWorkload("phonetool", "frontend").Return(&config.Workload{Type: manifestinfo.LoadBalancedWebServiceType}, nil)
+				m.deployWkld.EXPECT().Validate().Return(nil)
+				m.deployWkld.EXPECT().Ask().Return(nil)
+				m.deployWkld.EXPECT().Execute().Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("deploy frontend to environment pr-7: some error"),
+		},
+		"do nothing on delete if the preview environment doesn't exist": {
+			inDelete: true,
+			setupMocks: func(m pipelinePreviewMocks) {
+				expectManifest(m)
+				m.store.EXPECT().GetEnvironment("phonetool", "pr-7").Return(nil, errNoEnv)
+			},
+		},
+		"delete the workloads and the preview environment": {
+			inDelete: true,
+			setupMocks: func(m pipelinePreviewMocks) {
+				expectManifest(m)
+				m.store.EXPECT().GetEnvironment("phonetool", "pr-7").Return(&config.Environment{Name: "pr-7"}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "pr-7").Return([]string{"frontend"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("phonetool", "pr-7").Return([]string{"report"}, nil)
+				m.store.EXPECT().GetWorkload("phonetool", "frontend").Return(&config.Workload{Type: manifestinfo.LoadBalancedWebServiceType}, nil)
+				m.store.EXPECT().GetWorkload("phonetool", "report").Return(&config.Workload{Type: manifestinfo.ScheduledJobType}, nil)
+				m.deleteWkld.EXPECT().Execute().Return(nil).Times(2)
+				m.deleteEnv.EXPECT().Ask().Return(nil)
+				m.deleteEnv.EXPECT().Execute().Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := pipelinePreviewMocks{
+				ws:          mocks.NewMockwsPipelinePreviewReadWriter(ctrl),
+				store:       mocks.NewMockstore(ctrl),
+				deployStore: mocks.NewMockdeployedEnvironmentLister(ctrl),
+				envDescr:    mocks.NewMockenvDescriber(ctrl),
+				uri:         mocks.NewMockuriGetter(ctrl),
+				commenter:   mocks.NewMockpullRequestCommenter(ctrl),
+				forkChecker: mocks.NewMockpullRequestForkChecker(ctrl),
+				initEnv:     mocks.NewMockcmd(ctrl),
+				deployEnv:   mocks.NewMockcmd(ctrl),
+				deployWkld:  mocks.NewMockcmd(ctrl),
+				deleteEnv:   mocks.NewMockexecuteAsker(ctrl),
+				deleteWkld:  mocks.NewMockexecutor(ctrl),
+			}
+			m.ws.EXPECT().Path().Return("/ws").AnyTimes()
+			var gotManifest string
+			m.ws.EXPECT().WriteEnvironmentManifest(gomock.Any(), "pr-7").DoAndReturn(func(mft encoding.BinaryMarshaler, _ string) (string, error) {
+				raw, err := mft.MarshalBinary()
+				gotManifest = string(raw)
+				return "/ws/copilot/environments/pr-7/manifest.yml", err
+			}).AnyTimes()
+			tc.setupMocks(m)
+			opts := &pipelinePreviewOpts{
+				pipelinePreviewVars: pipelinePreviewVars{
+					name:        "release",
+					pullRequest: "7",
+					delete:      tc.inDelete,
+					appName:     "phonetool",
+				},
+				ws:          m.ws,
+				store:       m.store,
+				deployStore: m.deployStore,
+				changes: &pipelineChangesOpts{
+					pipelineChangesVars: pipelineChangesVars{name: "release"},
+					ws:                  m.ws,
+					unmarshal:           manifest.UnmarshalWorkload,
+				},
+				newEnvDescriber: func(string) (envDescriber, error) {
+					return m.envDescr, nil
+				},
+				newURIGetter: func(svcName string) (uriGetter, error) {
+					if svcName == "report" {
+						return nil, &describe.ErrNonAccessibleServiceType{}
+					}
+					return m.uri, nil
+				},
+				newCommenter: func(*manifest.Pipeline) (pullRequestCommenter, error) {
+					if tc.noComment {
+						return nil, nil
+					}
+					return m.commenter, nil
+				},
+				newForkChecker: func(*manifest.Pipeline) (pullRequestForkChecker, error) {
+					if !tc.checkForks {
+						return nil, nil
+					}
+					return m.forkChecker, nil
+				},
+				newInitEnvCmd: func(string, string) (cmd, error) {
+					return m.initEnv, nil
+				},
+				newDeployEnvCmd: func(string) (cmd, error) {
+					return m.deployEnv, nil
+				},
+				newDeleteEnvCmd: func(string) (executeAsker, error) {
+					return m.deleteEnv, nil
+				},
+				newDeployCmd: func(string, string, string) (cmd, error) {
+					return m.deployWkld, nil
+				},
+				newDeleteCmd: func(string, string, string) (executor, error) {
+					return m.deleteWkld, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantedManifest != "" {
+				require.Equal(t, tc.wantedManifest, gotManifest)
+			}
+		})
+	}
+}
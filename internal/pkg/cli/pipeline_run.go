@@ -0,0 +1,179 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineRunAppNamePrompt     = "Which application's pipeline would you like to run?"
+	pipelineRunAppNameHelpPrompt = "An application is a collection of related services."
+
+	fmtPipelineRunPrompt = "Which pipeline of %s would you like to run?"
+
+	pipelineRunPollInterval = 10 * time.Second
+)
+
+type runPipelineVars struct {
+	appName  string
+	name     string
+	commitID string
+	wait     bool
+}
+
+type runPipelineOpts struct {
+	runPipelineVars
+
+	store                  store
+	sel                    codePipelineSelector
+	deployedPipelineLister deployedPipelineLister
+	executor               pipelineExecutor
+	prog                   progress
+	pollInterval           time.Duration
+
+	// Cached variables.
+	targetPipeline *deploy.Pipeline
+	executionID    string
+}
+
+func newRunPipelineOpts(vars runPipelineVars) (*runPipelineOpts, error) {
+	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("pipeline run")).Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	store := config.NewStore(sess)
+	pipelineLister := deploy.NewPipelineStore(rg.New(sess))
+	return &runPipelineOpts{
+		runPipelineVars:        vars,
+		store:                  store,
+		sel:                    selector.NewAppPipelineSelector(prompt.New(), store, pipelineLister),
+		deployedPipelineLister: pipelineLister,
+		executor:               codepipeline.New(sess),
+		prog:                   termprogress.NewSpinner(log.DiagnosticWriter),
+		pollInterval:           pipelineRunPollInterval,
+	}, nil
+}
+
+// Validate is a no-op for this command.
+func (o *runPipelineOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in, and validates those that are.
+func (o *runPipelineOpts) Ask() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name: %w", err)
+		}
+	} else {
+		app, err := o.sel.Application(pipelineRunAppNamePrompt, pipelineRunAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.name != "" {
+		pipeline, err := getDeployedPipelineInfo(o.deployedPipelineLister, o.appName, o.name)
+		if err != nil {
+			return fmt.Errorf("validate pipeline name %s: %w", o.name, err)
+		}
+		o.targetPipeline = &pipeline
+		return nil
+	}
+	pipeline, err := askDeployedPipelineName(o.sel, fmt.Sprintf(fmtPipelineRunPrompt, color.HighlightUserInput(o.appName)), o.appName)
+	if err != nil {
+		return err
+	}
+	o.name = pipeline.Name
+	o.targetPipeline = &pipeline
+	return nil
+}
+
+// Execute starts an execution of the pipeline and, if requested, waits for it to complete.
+func (o *runPipelineOpts) Execute() error {
+	id, err := o.executor.StartExecution(o.targetPipeline.ResourceName, o.commitID)
+	if err != nil {
+		return err
+	}
+	o.executionID = id
+	log.Successf("Started execution %s of pipeline %s.\n", color.HighlightResource(id), color.HighlightUserInput(o.name))
+	if !o.wait {
+		return nil
+	}
+	o.prog.Start(fmt.Sprintf("Waiting for execution %s to complete.", id))
+	for {
+		execution, err := o.executor.Execution(o.targetPipeline.ResourceName, id)
+		if err != nil {
+			o.prog.Stop("")
+			return err
+		}
+		if execution.IsInProgress() {
+			time.Sleep(o.pollInterval)
+			continue
+		}
+		status := strings.ToLower(execution.Status)
+		if execution.Status == "Succeeded" {
+			o.prog.Stop(log.Ssuccessf("Execution %s %s.\n", id, status))
+			return nil
+		}
+		o.prog.Stop(log.Serrorf("Execution %s %s.\n", id, status))
+		return fmt.Errorf("execution %s of pipeline %s %s", id, o.name, status)
+	}
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *runPipelineOpts) RecommendActions() error {
+	logs := fmt.Sprintf("copilot pipeline logs -n %s --execution-id %s", o.name, o.executionID)
+	if !o.wait {
+		logs += " --follow"
+	}
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to see the logs of the builds in the execution.", color.HighlightCode(logs)),
+		fmt.Sprintf("Run %s to see the status of each stage.", color.HighlightCode(fmt.Sprintf("copilot pipeline status -n %s", o.name))),
+	})
+	return nil
+}
+
+// buildPipelineRunCmd builds the command to start an execution of a deployed pipeline.
+func buildPipelineRunCmd() *cobra.Command {
+	vars := runPipelineVars{}
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Starts an execution of a pipeline.",
+		Long:  "Starts an execution of a deployed pipeline, optionally at a given commit of its source.",
+
+		Example: `
+  Runs the pipeline "my-repo-my-branch" and waits for it to complete.
+  /code $ copilot pipeline run -n my-repo-my-branch --wait
+  Runs the pipeline at commit "4e9a6b1".
+  /code $ copilot pipeline run -n my-repo-my-branch --commit 4e9a6b1`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRunPipelineOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.commitID, commitFlag, "", commitFlagDescription)
+	cmd.Flags().BoolVar(&vars.wait, waitFlag, false, waitFlagDescription)
+	return cmd
+}
@@ -0,0 +1,183 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+)
+
+type runPipelineMocks struct {
+	store                  *mocks.Mockstore
+	sel                    *mocks.MockcodePipelineSelector
+	deployedPipelineLister *mocks.MockdeployedPipelineLister
+	executor               *mocks.MockpipelineExecutor
+	prog                   *mocks.Mockprogress
+}
+
+func TestRunPipelineOpts_Ask(t *testing.T) {
+	const mockAppName = "dinder"
+	mockPipeline := deploy.Pipeline{
+		AppName:      mockAppName,
+		ResourceName: "pipeline-dinder-badgoose-repo-RANDOMSTRING",
+		Name:         "badgoose-repo",
+	}
+	testCases := map[string]struct {
+		inAppName      string
+		inPipelineName string
+		setupMocks     func(m runPipelineMocks)
+
+		wantedApp      string
+		wantedPipeline *deploy.Pipeline
+		wantedErr      error
+	}{
+		"error if the application doesn't exist": {
+			inAppName: mockAppName,
+			setupMocks: func(m runPipelineMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("validate application name: some error"),
+		},
+		"error if the pipeline isn't deployed": {
+			inAppName:      mockAppName,
+			inPipelineName: "unknown",
+			setupMocks: func(m runPipelineMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{}, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline}, nil)
+			},
+			wantedErr: errors.New("validate pipeline name unknown: cannot find pipeline named unknown"),
+		},
+		"prompt for the application and the pipeline": {
+			setupMocks: func(m runPipelineMocks) {
+				m.sel.EXPECT().Application(pipelineRunAppNamePrompt, pipelineRunAppNameHelpPrompt).Return(mockAppName, nil)
+				m.sel.EXPECT().DeployedPipeline(gomock.Any(), gomock.Any(), mockAppName).Return(mockPipeline, nil)
+			},
+			wantedApp:      mockAppName,
+			wantedPipeline: &mockPipeline,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := runPipelineMocks{
+				store:                  mocks.NewMockstore(ctrl),
+				sel:                    mocks.NewMockcodePipelineSelector(ctrl),
+				deployedPipelineLister: mocks.NewMockdeployedPipelineLister(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &runPipelineOpts{
+				runPipelineVars: runPipelineVars{
+					appName: tc.inAppName,
+					name:    tc.inPipelineName,
+				},
+				store:                  m.store,
+				sel:                    m.sel,
+				deployedPipelineLister: m.deployedPipelineLister,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedPipeline, opts.targetPipeline)
+		})
+	}
+}
+
+func TestRunPipelineOpts_Execute(t *testing.T) {
+	const (
+		mockResourceName = "pipeline-dinder-badgoose-repo-RANDOMSTRING"
+		mockExecutionID  = "exec-1"
+	)
+	testCases := map[string]struct {
+		inCommitID string
+		inWait     bool
+		setupMocks func(m runPipelineMocks)
+
+		wantedErr error
+	}{
+		"error if the execution cannot start": {
+			setupMocks: func(m runPipelineMocks) {
+				m.executor.EXPECT().StartExecution(mockResourceName, "").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"start an execution at a commit without waiting": {
+			inCommitID: "4e9a6b1",
+			setupMocks: func(m runPipelineMocks) {
+				m.executor.EXPECT().StartExecution(mockResourceName, "4e9a6b1").Return(mockExecutionID, nil)
+			},
+		},
+		"wait for the execution to succeed": {
+			inWait: true,
+			setupMocks: func(m runPipelineMocks) {
+				gomock.InOrder(
+					m.executor.EXPECT().StartExecution(mockResourceName, "").Return(mockExecutionID, nil),
+					m.prog.EXPECT().Start("Waiting for execution exec-1 to complete."),
+					m.executor.EXPECT().Execution(mockResourceName, mockExecutionID).Return(&codepipeline.Execution{Status: "InProgress"}, nil),
+					m.executor.EXPECT().Execution(mockResourceName, mockExecutionID).Return(&codepipeline.Execution{Status: "Succeeded"}, nil),
+					m.prog.EXPECT().Stop(gomock.Any()),
+				)
+			},
+		},
+		"error if the execution fails": {
+			inWait: true,
+			setupMocks: func(m runPipelineMocks) {
+				m.executor.EXPECT().StartExecution(mockResourceName, "").Return(mockExecutionID, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.executor.EXPECT().Execution(mockResourceName, mockExecutionID).Return(&codepipeline.Execution{Status: "Failed"}, nil)
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: fmt.Errorf("execution exec-1 of pipeline badgoose-repo failed"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := runPipelineMocks{
+				executor: mocks.NewMockpipelineExecutor(ctrl),
+				prog:     mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &runPipelineOpts{
+				runPipelineVars: runPipelineVars{
+					appName:  "dinder",
+					name:     "badgoose-repo",
+					commitID: tc.inCommitID,
+					wait:     tc.inWait,
+				},
+				executor: m.executor,
+				prog:     m.prog,
+				targetPipeline: &deploy.Pipeline{
+					ResourceName: mockResourceName,
+					Name:         "badgoose-repo",
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, mockExecutionID, opts.executionID)
+		})
+	}
+}
@@ -11,10 +11,6 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/spf13/afero"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
-
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
@@ -60,7 +56,7 @@ type showPipelineOpts struct {
 }
 
 func newShowPipelineOpts(vars showPipelineVars) (*showPipelineOpts, error) {
-	ws, err := workspace.Use(afero.NewOsFs())
+	ws, err := workspace.UseApp(vars.appName, afero.NewOsFs())
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +67,7 @@ func newShowPipelineOpts(vars showPipelineVars) (*showPipelineOpts, error) {
 	}
 	codepipeline := codepipeline.New(defaultSession)
 	pipelineLister := deploy.NewPipelineStore(rg.New(defaultSession))
-	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	store := config.NewStore(defaultSession)
 	prompter := prompt.New()
 	opts := &showPipelineOpts{
 		showPipelineVars:       vars,
@@ -16,14 +16,11 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
-
 	"github.com/dustin/go-humanize/english"
 
 	"gopkg.in/yaml.v3"
 
 	"github.com/aws/aws-sdk-go/aws"
-	awsssm "github.com/aws/aws-sdk-go/service/ssm"
 
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
@@ -87,12 +84,12 @@ func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
 		return nil, err
 	}
 	fs := afero.NewOsFs()
-	ws, err := workspace.Use(afero.NewOsFs())
+	ws, err := workspace.UseApp(vars.appName, afero.NewOsFs())
 	if err != nil {
 		return nil, err
 	}
 
-	store := config.NewSSMStore(identity.New(defaultSession), awsssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	store := config.NewStore(defaultSession)
 	prompter := prompt.New()
 	opts := secretInitOpts{
 		secretInitVars: vars,
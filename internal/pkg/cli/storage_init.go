@@ -9,9 +9,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/dustin/go-humanize/english"
@@ -152,6 +149,7 @@ const (
 )
 
 type initStorageVars struct {
+	appName        string
 	storageType    string
 	storageName    string
 	workloadName   string
@@ -174,7 +172,6 @@ type initStorageVars struct {
 
 type initStorageOpts struct {
 	initStorageVars
-	appName string
 
 	fs    afero.Fs
 	ws    wsReadWriter
@@ -197,16 +194,15 @@ func newStorageInitOpts(vars initStorageVars) (*initStorageOpts, error) {
 	}
 
 	fs := afero.NewOsFs()
-	ws, err := workspace.Use(fs)
+	ws, err := workspace.UseApp(vars.appName, fs)
 	if err != nil {
 		return nil, err
 	}
 
-	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	store := config.NewStore(defaultSession)
 	prompter := prompt.New()
 	return &initStorageOpts{
 		initStorageVars: vars,
-		appName:         tryReadingAppName(),
 
 		fs:        fs,
 		store:     store,
@@ -1231,6 +1227,7 @@ Storage resources are addons, either for a workload or the environments.`,
 			return run(opts)
 		}),
 	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.storageName, nameFlag, nameFlagShort, "", storageFlagDescription)
 	cmd.Flags().StringVarP(&vars.storageType, storageTypeFlag, typeFlagShort, "", storageTypeFlagDescription)
 	cmd.Flags().StringVarP(&vars.workloadName, workloadFlag, workloadFlagShort, "", storageWorkloadFlagDescription)
@@ -1269,6 +1266,7 @@ Storage resources are addons, either for a workload or the environments.`,
 	}
 
 	optionalFlagSet := pflag.NewFlagSet("Optional", pflag.ContinueOnError)
+	optionalFlagSet.AddFlag(cmd.Flags().Lookup(appFlag))
 	optionalFlagSet.AddFlag(cmd.Flags().Lookup(storageAddIngressFromFlag))
 
 	cmd.Annotations = map[string]string{
@@ -151,10 +151,10 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 					noSort:                  tc.inNoSort,
 					auroraServerlessVersion: tc.inServerlessVersion,
 					rdsEngine:               tc.inEngine,
+					appName:                 tc.inAppName,
 				},
-				appName: tc.inAppName,
-				ws:      m.ws,
-				store:   m.store,
+				ws:    m.ws,
+				store: m.store,
 			}
 
 			// WHEN
@@ -500,8 +500,8 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 					workloadName:   tc.inSvcName,
 					lifecycle:      tc.inLifecycle,
 					addIngressFrom: tc.inAddIngressFrom,
+					appName:        wantedAppName,
 				},
-				appName:   wantedAppName,
 				sel:       m.sel,
 				configSel: m.configSel,
 				prompt:    m.prompt,
@@ -518,7 +518,9 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 				require.NoError(t, err)
 			}
 			if tc.wantedVars != nil {
-				require.Equal(t, *tc.wantedVars, opts.initStorageVars)
+				wantedVars := *tc.wantedVars
+				wantedVars.appName = wantedAppName
+				require.Equal(t, wantedVars, opts.initStorageVars)
 			}
 		})
 	}
@@ -922,10 +924,10 @@ func TestStorageInitOpts_AskDDB(t *testing.T) {
 					noLSI:        tc.inNoLSI,
 					noSort:       tc.inNoSort,
 					lifecycle:    lifecycleWorkloadLevel,
+					appName:      "ddos",
 				},
-				appName: "ddos",
-				prompt:  m.prompt,
-				ws:      m.ws,
+				prompt: m.prompt,
+				ws:     m.ws,
 			}
 			// WHEN
 			err := opts.Ask()
@@ -937,7 +939,9 @@ func TestStorageInitOpts_AskDDB(t *testing.T) {
 				require.NoError(t, err)
 			}
 			if tc.wantedVars != nil {
-				require.Equal(t, *tc.wantedVars, opts.initStorageVars)
+				wantedVars := *tc.wantedVars
+				wantedVars.appName = "ddos"
+				require.Equal(t, wantedVars, opts.initStorageVars)
 			}
 		})
 	}
@@ -1137,10 +1141,10 @@ func TestStorageInitOpts_AskRDS(t *testing.T) {
 					auroraServerlessVersion: wantedServerlessVersion,
 					rdsEngine:               tc.inDBEngine,
 					rdsInitialDBName:        tc.inInitialDBName,
+					appName:                 "ddos",
 				},
-				appName: "ddos",
-				prompt:  m.prompt,
-				ws:      m.ws,
+				prompt: m.prompt,
+				ws:     m.ws,
 			}
 			tc.mock(&m)
 			// WHEN
@@ -1153,7 +1157,9 @@ func TestStorageInitOpts_AskRDS(t *testing.T) {
 				require.NoError(t, err)
 			}
 			if tc.wantedVars != nil {
-				require.Equal(t, *tc.wantedVars, opts.initStorageVars)
+				wantedVars := *tc.wantedVars
+				wantedVars.appName = "ddos"
+				require.Equal(t, wantedVars, opts.initStorageVars)
 			}
 		})
 	}
@@ -1501,8 +1507,8 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 					auroraServerlessVersion: tc.inServerlessVersion,
 					rdsEngine:               tc.inEngine,
 					rdsParameterGroup:       tc.inParameterGroup,
+					appName:                 wantedAppName,
 				},
-				appName:        wantedAppName,
 				ws:             mockWS,
 				store:          mockStore,
 				workloadExists: !tc.mockWkldAbsent,
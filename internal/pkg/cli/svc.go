@@ -28,13 +28,17 @@ Services are long-running ECS or App Runner services.`,
 	cmd.AddCommand(buildSvcPackageCmd())
 	cmd.AddCommand(buildSvcOverrideCmd())
 	cmd.AddCommand(buildSvcDeployCmd())
+	cmd.AddCommand(buildSvcHistoryCmd())
+	cmd.AddCommand(buildSvcRollbackCmd())
 	cmd.AddCommand(buildSvcDeleteCmd())
 	cmd.AddCommand(buildSvcShowCmd())
 	cmd.AddCommand(buildSvcStatusCmd())
+	cmd.AddCommand(buildSvcDriftCmd())
 	cmd.AddCommand(buildSvcLogsCmd())
 	cmd.AddCommand(buildSvcExecCmd())
 	cmd.AddCommand(buildSvcPauseCmd())
 	cmd.AddCommand(buildSvcResumeCmd())
+	cmd.AddCommand(buildSvcQueueCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
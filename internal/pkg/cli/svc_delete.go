@@ -13,9 +13,6 @@ import (
 
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	awss3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/cli/clean"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
@@ -81,7 +78,7 @@ func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
 		return nil, err
 	}
 
-	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	store := config.NewStore(defaultSession)
 	prompter := prompt.New()
 	opts := &deleteSvcOpts{
 		deleteSvcVars: vars,
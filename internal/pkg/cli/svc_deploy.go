@@ -11,9 +11,7 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
@@ -76,6 +74,7 @@ type deploySvcOpts struct {
 	spinner        progress
 	sel            wsSelector
 	prompt         prompter
+	gitCommit      string
 	gitShortCommit string
 
 	// cached variables
@@ -85,6 +84,7 @@ type deploySvcOpts struct {
 	svcType           string
 	appliedDynamicMft manifest.DynamicWorkload
 	rootUserARN       string
+	callerARN         string
 	deployRecs        clideploy.ActionRecommender
 	noDeploy          bool
 
@@ -93,7 +93,7 @@ type deploySvcOpts struct {
 }
 
 func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
-	ws, err := workspace.Use(afero.NewOsFs())
+	ws, err := workspace.UseApp(vars.appName, afero.NewOsFs())
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +104,7 @@ func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
 		return nil, err
 	}
 
-	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	store := config.NewStore(defaultSession)
 	prompter := prompt.New()
 
 	opts := &deploySvcOpts{
@@ -310,6 +310,10 @@ func (o *deploySvcOpts) Execute() error {
 			DisableRollback: o.disableRollback,
 			Detach:          o.detach,
 		},
+		Provenance: clideploy.Provenance{
+			GitCommit:  o.gitCommit,
+			DeployedBy: o.callerARN,
+		},
 	})
 	if err != nil {
 		var errStackDeletedOnInterrupt *deploycfn.ErrStackDeletedOnInterrupt
@@ -420,6 +424,7 @@ func (o *deploySvcOpts) validateOrAskEnvName() error {
 
 func (o *deploySvcOpts) configureClients() error {
 	o.gitShortCommit = imageTagFromGit(o.cmd) // Best effort assign git tag.
+	o.gitCommit = commitFromGit(o.cmd)
 	env, err := o.store.GetEnvironment(o.appName, o.envName)
 	if err != nil {
 		return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
@@ -443,6 +448,7 @@ func (o *deploySvcOpts) configureClients() error {
 		return fmt.Errorf("get identity: %w", err)
 	}
 	o.rootUserARN = caller.RootUserARN
+	o.callerARN = caller.ARN
 
 	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         o.appName,
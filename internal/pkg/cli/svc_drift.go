@@ -0,0 +1,160 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcDriftNamePrompt     = "Which service's drift would you like to detect?"
+	svcDriftNameHelpPrompt = "Detects the resources of the service and of its addons that were changed outside of Copilot."
+)
+
+type svcDriftVars struct {
+	driftVars
+	svcName string
+	envName string
+	appName string
+}
+
+type svcDriftOpts struct {
+	svcDriftVars
+	stackDriftOpts
+
+	store         store
+	sel           deploySelector
+	initDescriber func() error
+}
+
+func newSvcDriftOpts(vars svcDriftVars) (*svcDriftOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc drift"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewStore(defaultSess)
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &svcDriftOpts{
+		svcDriftVars: vars,
+		stackDriftOpts: stackDriftOpts{
+			w:    log.OutputWriter,
+			prog: termprogress.NewSpinner(log.DiagnosticWriter),
+		},
+		store: configStore,
+		sel:   selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+	}
+	opts.initDescriber = func() error {
+		env, err := configStore.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s: %w", opts.envName, err)
+		}
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return err
+		}
+		opts.describer = describe.NewStackDriftDescriber(stack.NameForWorkload(opts.appName, opts.envName, opts.svcName), sess)
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcDriftOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcDriftOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+// Execute detects and displays the resources of the service that drifted from its template.
+func (o *svcDriftOpts) Execute() error {
+	if err := o.initDescriber(); err != nil {
+		return err
+	}
+	return o.detectDrift(o.driftVars, fmt.Sprintf("service %q in environment %q", o.svcName, o.envName))
+}
+
+func (o *svcDriftOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcDriftOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	deployedService, err := o.sel.DeployedService(svcDriftNamePrompt, svcDriftNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithName(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcDriftCmd builds the command for detecting the drift of a deployed service.
+func buildSvcDriftCmd() *cobra.Command {
+	vars := svcDriftVars{}
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Detects changes made to a deployed service outside of Copilot.",
+		Long: `Detects changes made to a deployed service outside of Copilot.
+Shows the resources of the service and of its addons that were modified or deleted,
+with the difference between their template and actual properties.`,
+
+		Example: `
+  Shows the drift of the service "my-svc" in the "prod" environment.
+  /code $ copilot svc drift -n my-svc -e prod
+  Fails a CI job if any resource of the service drifted.
+  /code $ copilot svc drift -n my-svc -e prod --fail-on-drift`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcDriftOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.failOnDrift, failOnDriftFlag, false, failOnDriftFlagDescription)
+	return cmd
+}
@@ -0,0 +1,136 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcDriftAskMock struct {
+	store *mocks.Mockstore
+	sel   *mocks.MockdeploySelector
+}
+
+func TestSvcDrift_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp   string
+		inputSvc   string
+		inputEnv   string
+		setupMocks func(m svcDriftAskMock)
+
+		wantedSvc   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"validate app env and svc with all flags passed in": {
+			inputApp: "phonetool",
+			inputSvc: "api",
+			inputEnv: "test",
+			setupMocks: func(m svcDriftAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{}, nil)
+				m.sel.EXPECT().DeployedService(svcDriftNamePrompt, svcDriftNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "test", Name: "api"}, nil)
+			},
+			wantedSvc: "api",
+			wantedEnv: "test",
+		},
+		"prompt for app, service and env": {
+			setupMocks: func(m svcDriftAskMock) {
+				m.sel.EXPECT().Application(svcAppNamePrompt, wkldAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().DeployedService(svcDriftNamePrompt, svcDriftNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "prod", Name: "api"}, nil)
+			},
+			wantedSvc: "api",
+			wantedEnv: "prod",
+		},
+		"errors if failed to select deployed service": {
+			inputApp: "phonetool",
+			setupMocks: func(m svcDriftAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed services for application phonetool: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcDriftAskMock{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockdeploySelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcDriftOpts{
+				svcDriftVars: svcDriftVars{
+					appName: tc.inputApp,
+					svcName: tc.inputSvc,
+					envName: tc.inputEnv,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+func TestSvcDrift_Execute(t *testing.T) {
+	t.Run("errors if the describer can't be initialized", func(t *testing.T) {
+		opts := &svcDriftOpts{
+			initDescriber: func() error { return errors.New("some error") },
+		}
+
+		require.EqualError(t, opts.Execute(), "some error")
+	})
+	t.Run("fails on drift of the service", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		describer := mocks.NewMockstackDriftDescriber(ctrl)
+		describer.EXPECT().Describe().Return(&describe.StackDrift{
+			Stacks: []*describe.DriftedStack{{Name: "phonetool-test-api", Status: "DRIFTED"}},
+		}, nil)
+		prog := mocks.NewMockprogress(ctrl)
+		prog.EXPECT().Start(`Detecting drift of service "api" in environment "test".`)
+		prog.EXPECT().Stop(gomock.Any())
+		opts := &svcDriftOpts{
+			svcDriftVars: svcDriftVars{
+				driftVars: driftVars{failOnDrift: true},
+				appName:   "phonetool",
+				svcName:   "api",
+				envName:   "test",
+			},
+			stackDriftOpts: stackDriftOpts{
+				w:    &bytes.Buffer{},
+				prog: prog,
+			},
+		}
+		opts.initDescriber = func() error {
+			opts.describer = describer
+			return nil
+		}
+
+		require.EqualError(t, opts.Execute(), `resources of service "api" in environment "test" drifted from their template`)
+	})
+}
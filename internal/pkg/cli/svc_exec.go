@@ -9,8 +9,6 @@ import (
 	"math/rand"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -65,7 +63,7 @@ func newSvcExecOpts(vars execVars) (*svcExecOpts, error) {
 	if err != nil {
 		return nil, err
 	}
-	ssmStore := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	ssmStore := config.NewStore(defaultSession)
 	deployStore, err := deploy.NewStore(sessProvider, ssmStore)
 	if err != nil {
 		return nil, fmt.Errorf("connect to deploy store: %w", err)
@@ -0,0 +1,199 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcHistoryNamePrompt     = "Which service's deployments would you like to list?"
+	svcHistoryNameHelpPrompt = "Lists the deployments recorded by Copilot for the service in an environment."
+)
+
+type svcHistoryVars struct {
+	appName          string
+	svcName          string
+	envName          string
+	shouldOutputJSON bool
+}
+
+type svcHistoryOpts struct {
+	svcHistoryVars
+
+	w           io.Writer
+	store       store
+	sel         deploySelector
+	history     deploymentHistoryLister
+	initHistory func() error
+}
+
+func newSvcHistoryOpts(vars svcHistoryVars) (*svcHistoryOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc history"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewStore(defaultSess)
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &svcHistoryOpts{
+		svcHistoryVars: vars,
+		w:              log.OutputWriter,
+		store:          configStore,
+		sel:            selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+	}
+	opts.initHistory = func() error {
+		app, err := configStore.GetApplication(opts.appName)
+		if err != nil {
+			return fmt.Errorf("get application %s: %w", opts.appName, err)
+		}
+		env, err := configStore.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s: %w", opts.envName, err)
+		}
+		envSess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return err
+		}
+		history, _, err := newDeploymentHistory(app, env, defaultSess, envSess)
+		if err != nil {
+			return err
+		}
+		opts.history = history
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcHistoryOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcHistoryOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+// Execute lists the recorded deployments of the service, the most recent first.
+func (o *svcHistoryOpts) Execute() error {
+	if err := o.initHistory(); err != nil {
+		return err
+	}
+	deployments, err := o.history.List(o.envName, o.svcName)
+	if err != nil {
+		return fmt.Errorf("list deployments of service %s in environment %s: %w", o.svcName, o.envName, err)
+	}
+	slices.Reverse(deployments)
+	history := &describe.DeploymentHistory{
+		Deployments: deployments,
+	}
+	if o.shouldOutputJSON {
+		data, err := history.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	if len(deployments) == 0 {
+		log.Infof("No deployments of service %s in environment %s are recorded. Deployments are recorded by %s.\n",
+			color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName), color.HighlightCode("copilot svc deploy"))
+		return nil
+	}
+	fmt.Fprint(o.w, history.HumanString())
+	return nil
+}
+
+func (o *svcHistoryOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcHistoryOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	deployedService, err := o.sel.DeployedService(svcHistoryNamePrompt, svcHistoryNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithName(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// newDeploymentHistory returns the deployment history stored in the artifact bucket of the region of the environment,
+// along with the name of the bucket.
+func newDeploymentHistory(app *config.Application, env *config.Environment, defaultSess, envSess *session.Session) (*deploy.DeploymentHistory, string, error) {
+	resources, err := deploycfn.New(defaultSess).GetAppResourcesByRegion(app, env.Region)
+	if err != nil {
+		return nil, "", fmt.Errorf("get application %s resources from region %s: %w", app.Name, env.Region, err)
+	}
+	return deploy.NewDeploymentHistory(resources.S3Bucket, s3.New(envSess)), resources.S3Bucket, nil
+}
+
+// buildSvcHistoryCmd builds the command for listing the deployments of a service.
+func buildSvcHistoryCmd() *cobra.Command {
+	vars := svcHistoryVars{}
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Lists the deployments of a service.",
+		Long: `Lists the deployments of a service in an environment, the most recent first.
+Each deployment records the digests of the pushed images, the git commit, the hash of the manifest,
+who deployed the service and when. Roll back to a deployment with "copilot svc rollback".`,
+
+		Example: `
+  Lists the deployments of the service "my-svc" in the "prod" environment.
+  /code $ copilot svc history -n my-svc -e prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcHistoryOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
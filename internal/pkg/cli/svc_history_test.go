@@ -0,0 +1,162 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcHistoryAskMock struct {
+	store *mocks.Mockstore
+	sel   *mocks.MockdeploySelector
+}
+
+func TestSvcHistory_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp   string
+		inputSvc   string
+		inputEnv   string
+		setupMocks func(m svcHistoryAskMock)
+
+		wantedSvc   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"validate app env and svc with all flags passed in": {
+			inputApp: "phonetool",
+			inputSvc: "api",
+			inputEnv: "test",
+			setupMocks: func(m svcHistoryAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{}, nil)
+				m.sel.EXPECT().DeployedService(svcHistoryNamePrompt, svcHistoryNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "test", Name: "api"}, nil)
+			},
+			wantedSvc: "api",
+			wantedEnv: "test",
+		},
+		"prompt for app, service and env": {
+			setupMocks: func(m svcHistoryAskMock) {
+				m.sel.EXPECT().Application(svcAppNamePrompt, wkldAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().DeployedService(svcHistoryNamePrompt, svcHistoryNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "prod", Name: "api"}, nil)
+			},
+			wantedSvc: "api",
+			wantedEnv: "prod",
+		},
+		"errors if failed to select deployed service": {
+			inputApp: "phonetool",
+			setupMocks: func(m svcHistoryAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed services for application phonetool: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcHistoryAskMock{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockdeploySelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcHistoryOpts{
+				svcHistoryVars: svcHistoryVars{
+					appName: tc.inputApp,
+					svcName: tc.inputSvc,
+					envName: tc.inputEnv,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+func TestSvcHistory_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inJSON     bool
+		setupMocks func(m *mocks.MockdeploymentHistoryLister)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if the deployments can't be listed": {
+			setupMocks: func(m *mocks.MockdeploymentHistoryLister) {
+				m.EXPECT().List("test", "api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list deployments of service api in environment test: some error"),
+		},
+		"writes nothing if no deployment is recorded": {
+			setupMocks: func(m *mocks.MockdeploymentHistoryLister) {
+				m.EXPECT().List("test", "api").Return(nil, nil)
+			},
+		},
+		"writes the deployments in JSON from the most recent": {
+			inJSON: true,
+			setupMocks: func(m *mocks.MockdeploymentHistoryLister) {
+				m.EXPECT().List("test", "api").Return([]*deploy.WorkloadDeployment{
+					{Number: 1, GitCommit: "a1b2c3d"},
+					{Number: 2, RollbackOf: 1},
+				}, nil)
+			},
+			wantedContent: `{"deployments":[` +
+				`{"number":2,"app":"","env":"","name":"","deployedAt":"0001-01-01T00:00:00Z","deployedBy":"","templateURL":"","parameters":null,"rollbackOf":1},` +
+				`{"number":1,"app":"","env":"","name":"","deployedAt":"0001-01-01T00:00:00Z","deployedBy":"","gitCommit":"a1b2c3d","templateURL":"","parameters":null}]}` + "\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			history := mocks.NewMockdeploymentHistoryLister(ctrl)
+			tc.setupMocks(history)
+			b := &bytes.Buffer{}
+			opts := &svcHistoryOpts{
+				svcHistoryVars: svcHistoryVars{
+					appName:          "phonetool",
+					svcName:          "api",
+					envName:          "test",
+					shouldOutputJSON: tc.inJSON,
+				},
+				w: b,
+			}
+			opts.initHistory = func() error {
+				opts.history = history
+				return nil
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -12,8 +12,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/version"
@@ -185,7 +183,7 @@ type initSvcOpts struct {
 
 func newInitSvcOpts(vars initSvcVars) (*initSvcOpts, error) {
 	fs := afero.NewOsFs()
-	ws, err := workspace.Use(fs)
+	ws, err := workspace.UseApp(vars.appName, fs)
 	if err != nil {
 		return nil, err
 	}
@@ -194,7 +192,7 @@ func newInitSvcOpts(vars initSvcVars) (*initSvcOpts, error) {
 	if err != nil {
 		return nil, err
 	}
-	store := config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
+	store := config.NewStore(sess)
 	prompter := prompt.New()
 	deployStore, err := deploy.NewStore(sessProvider, store)
 	if err != nil {
@@ -242,7 +240,7 @@ func newInitSvcOpts(vars initSvcVars) (*initSvcOpts, error) {
 			return envDescriber, nil
 		},
 		dockerEngine:    dockerengine.New(exec.NewCmd()),
-		wsAppName:       tryReadingAppName(),
+		wsAppName:       tryReadingWorkspaceAppName(vars.appName),
 		wsRoot:          ws.ProjectRoot(),
 		templateVersion: version.LatestTemplateVersion(),
 	}
@@ -7,9 +7,6 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/spf13/afero"
 
@@ -36,7 +33,7 @@ type listSvcOpts struct {
 }
 
 func newListSvcOpts(vars listWkldVars) (*listSvcOpts, error) {
-	ws, err := workspace.Use(afero.NewOsFs())
+	ws, err := workspace.UseApp(vars.appName, afero.NewOsFs())
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +43,7 @@ func newListSvcOpts(vars listWkldVars) (*listSvcOpts, error) {
 		return nil, fmt.Errorf("default session: %v", err)
 	}
 
-	store := config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
+	store := config.NewStore(sess)
 	svcLister := &list.SvcListWriter{
 		Ws:    ws,
 		Store: store,
@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -13,9 +14,10 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/ssm"
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
@@ -26,6 +28,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -45,6 +48,7 @@ type packageSvcVars struct {
 	uploadAssets       bool
 	showDiff           bool
 	allowWkldDowngrade bool
+	reuseDeployed      bool
 
 	// To facilitate unit tests.
 	clientConfigured bool
@@ -69,6 +73,7 @@ type packageSvcOpts struct {
 	newInterpolator      func(app, env string) interpolator
 	newStackGenerator    func(*packageSvcOpts) (workloadStackGenerator, error)
 	envFeaturesDescriber versionCompatibilityChecker
+	deployedStack        deployedStackGetter
 	gitShortCommit       string
 
 	// cached variables
@@ -84,7 +89,7 @@ type packageSvcOpts struct {
 
 func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 	fs := afero.NewOsFs()
-	ws, err := workspace.Use(fs)
+	ws, err := workspace.UseApp(vars.appName, fs)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +100,7 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 		return nil, fmt.Errorf("default session: %v", err)
 	}
 
-	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	store := config.NewStore(defaultSess)
 	prompter := prompt.New()
 	opts := &packageSvcOpts{
 		packageSvcVars:    vars,
@@ -214,6 +219,21 @@ func (o *packageSvcOpts) Execute() error {
 			return err
 		}
 	}
+	if o.reuseDeployed {
+		deployed, err := o.getDeployedWorkloadStack()
+		var errNotFound *awscfn.ErrStackNotFound
+		switch {
+		case errors.As(err, &errNotFound):
+			log.Infof("Stack of %s is not deployed to environment %s yet, generating its template.\n", o.name, o.envName)
+		case err != nil:
+			return err
+		default:
+			if err := o.writeAndClose(o.templateWriter, deployed.template); err != nil {
+				return err
+			}
+			return o.writeAndClose(o.paramsWriter, deployed.parameters)
+		}
+	}
 	targetEnv, err := o.getTargetEnv()
 	if err != nil {
 		return nil
@@ -309,6 +329,7 @@ func (o *packageSvcOpts) configureClients() error {
 		return err
 	}
 	o.envSess = envSess
+	o.deployedStack = awscfn.New(envSess)
 	// client to retrieve caller identity.
 	caller, err := identity.New(defaultSess).Get()
 	if err != nil {
@@ -398,6 +419,41 @@ func (o *packageSvcOpts) getWorkloadStack(generator workloadStackGenerator) (*cf
 		parameters: output.Parameters}, nil
 }
 
+// getDeployedWorkloadStack returns the template and parameters of the stack of the workload deployed to the environment.
+// If the stack does not exist, returns ErrStackNotFound.
+func (o *packageSvcOpts) getDeployedWorkloadStack() (*cfnStackConfig, error) {
+	stackName := stack.NameForWorkload(o.appName, o.envName, o.name)
+	descr, err := o.deployedStack.Describe(stackName)
+	if err != nil {
+		return nil, err
+	}
+	tpl, err := o.deployedStack.TemplateBody(stackName)
+	if err != nil {
+		return nil, err
+	}
+	config := struct {
+		Parameters map[string]*string `json:"Parameters"`
+		Tags       map[string]*string `json:"Tags,omitempty"`
+	}{
+		Parameters: make(map[string]*string, len(descr.Parameters)),
+		Tags:       make(map[string]*string, len(descr.Tags)),
+	}
+	for _, param := range descr.Parameters {
+		config.Parameters[aws.StringValue(param.ParameterKey)] = param.ParameterValue
+	}
+	for _, tag := range descr.Tags {
+		config.Tags[aws.StringValue(tag.Key)] = tag.Value
+	}
+	params, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal parameters of stack %s: %w", stackName, err)
+	}
+	return &cfnStackConfig{
+		template:   tpl,
+		parameters: string(params),
+	}, nil
+}
+
 // setOutputFileWriters creates the output directory, and updates the template and param writers to file writers in the directory.
 func (o *packageSvcOpts) setOutputFileWriters() error {
 	if err := o.fs.MkdirAll(o.outputDir, 0755); err != nil {
@@ -522,8 +578,10 @@ func buildSvcPackageCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.uploadAssets, uploadAssetsFlag, false, uploadAssetsFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
+	cmd.Flags().BoolVar(&vars.reuseDeployed, reuseDeployedFlag, false, reuseDeployedFlagDescription)
 
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, stackOutputDirFlag)
+	cmd.MarkFlagsMutuallyExclusive(diffFlag, reuseDeployedFlag)
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, uploadAssetsFlag)
 	return cmd
 }
@@ -10,9 +10,12 @@ import (
 	"io"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -135,6 +138,7 @@ type svcPackageExecuteMock struct {
 	interpolator         *mocks.Mockinterpolator
 	envFeaturesDescriber *mocks.MockversionCompatibilityChecker
 	mockVersionGetter    *mocks.MockversionGetter
+	deployedStack        *mocks.MockdeployedStackGetter
 	mft                  *mockWorkloadMft
 }
 
@@ -326,6 +330,66 @@ count: 1`
 			wantedStack:  "mystack",
 			wantedParams: "myparams",
 		},
+		"writes the template and parameters of the deployed stack": {
+			inVars: packageSvcVars{
+				appName:            "ecs-kudos",
+				name:               "api",
+				envName:            "test",
+				allowWkldDowngrade: true,
+				clientConfigured:   true,
+				reuseDeployed:      true,
+			},
+			setupMocks: func(m *svcPackageExecuteMock) {
+				m.deployedStack.EXPECT().Describe("ecs-kudos-test-api").Return(&cloudformation.StackDescription{
+					Parameters: []*sdkcloudformation.Parameter{
+						{ParameterKey: aws.String("ContainerImage"), ParameterValue: aws.String("1234")},
+					},
+					Tags: []*sdkcloudformation.Tag{
+						{Key: aws.String("copilot-service"), Value: aws.String("api")},
+					},
+				}, nil)
+				m.deployedStack.EXPECT().TemplateBody("ecs-kudos-test-api").Return("deployedstack", nil)
+			},
+			wantedStack: "deployedstack",
+			wantedParams: `{
+  "Parameters": {
+    "ContainerImage": "1234"
+  },
+  "Tags": {
+    "copilot-service": "api"
+  }
+}`,
+		},
+		"generates the template if the stack is not deployed yet": {
+			inVars: packageSvcVars{
+				appName:            "ecs-kudos",
+				name:               "api",
+				envName:            "test",
+				tag:                "1234",
+				allowWkldDowngrade: true,
+				clientConfigured:   true,
+				reuseDeployed:      true,
+			},
+			setupMocks: func(m *svcPackageExecuteMock) {
+				m.deployedStack.EXPECT().Describe("ecs-kudos-test-api").Return(nil, &cloudformation.ErrStackNotFound{})
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(rdwsMft), nil)
+				m.interpolator.EXPECT().Interpolate(rdwsMft).Return(rdwsMft, nil)
+				m.generator.EXPECT().AddonsTemplate().Return("", nil)
+				m.envFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{}
+					},
+				}
+				m.envFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{}, nil)
+				m.generator.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template:   "mystack",
+					Parameters: "myparams",
+				}, nil)
+			},
+			wantedStack:  "mystack",
+			wantedParams: "myparams",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -345,6 +409,7 @@ count: 1`
 				interpolator:         mocks.NewMockinterpolator(ctrl),
 				envFeaturesDescriber: mocks.NewMockversionCompatibilityChecker(ctrl),
 				mockVersionGetter:    mocks.NewMockversionGetter(ctrl),
+				deployedStack:        mocks.NewMockdeployedStackGetter(ctrl),
 			}
 			tc.setupMocks(m)
 			opts := &packageSvcOpts{
@@ -368,6 +433,7 @@ count: 1`
 					return m.generator, nil
 				},
 				envFeaturesDescriber: m.envFeaturesDescriber,
+				deployedStack:        m.deployedStack,
 				targetApp:            &config.Application{},
 				targetEnv:            &config.Environment{},
 			}
@@ -0,0 +1,211 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+)
+
+const (
+	svcQueueAppNamePrompt     = "Which application is the worker service in?"
+	svcQueueSvcNameHelpPrompt = "Only worker services have queues."
+	svcQueueQueueHelpPrompt   = "The queues of the worker service, including the dead-letter queues."
+)
+
+// svcQueueVars holds the flags shared by the commands that operate on the queues of a worker service.
+type svcQueueVars struct {
+	appName   string
+	envName   string
+	svcName   string
+	queueName string
+}
+
+// svcQueueOpts resolves the deployed worker service and the queue that a command operates on.
+type svcQueueOpts struct {
+	svcQueueVars
+
+	store              store
+	sel                deploySelector
+	prompt             prompter
+	newQueuesDescriber func() (workerQueuesDescriber, error)
+	envSession         func() (*session.Session, error)
+	queues             workerQueuesDescriber
+	queue              *describe.WorkerQueue // cached after the queue is selected.
+	targetEnv          *config.Environment
+	svcNamePrompt      string
+}
+
+func newSvcQueueOpts(vars svcQueueVars, cmd, svcNamePrompt string) (*svcQueueOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras(cmd))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewStore(defaultSess)
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	opts := &svcQueueOpts{
+		svcQueueVars:  vars,
+		store:         configStore,
+		sel:           selector.NewDeploySelect(prompter, configStore, deployStore),
+		prompt:        prompter,
+		svcNamePrompt: svcNamePrompt,
+	}
+	opts.newQueuesDescriber = func() (workerQueuesDescriber, error) {
+		return describe.NewWorkerQueuesDescriber(describe.NewServiceConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			Svc:         opts.svcName,
+			ConfigStore: configStore,
+		})
+	}
+	opts.envSession = func() (*session.Session, error) {
+		env, err := opts.getTargetEnv()
+		if err != nil {
+			return nil, err
+		}
+		return sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+	}
+	return opts, nil
+}
+
+// askWorkerService prompts for the application and the deployed worker service.
+func (o *svcQueueOpts) askWorkerService() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	} else {
+		app, err := o.sel.Application(svcQueueAppNamePrompt, wkldAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.envName != "" {
+		if _, err := o.getTargetEnv(); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	deployed, err := o.sel.DeployedService(
+		fmt.Sprintf(o.svcNamePrompt, color.HighlightUserInput(o.appName)),
+		svcQueueSvcNameHelpPrompt,
+		o.appName,
+		selector.WithEnv(o.envName),
+		selector.WithName(o.svcName),
+		selector.WithServiceTypesFilter([]string{manifestinfo.WorkerServiceType}),
+	)
+	if err != nil {
+		return fmt.Errorf("select deployed worker service for application %s: %w", o.appName, err)
+	}
+	o.svcName, o.envName = deployed.Name, deployed.Env
+	return nil
+}
+
+// askQueue selects the queue named by the flag, or prompts for one of the queues that satisfy the filter.
+func (o *svcQueueOpts) askQueue(msg string, filter func(*describe.WorkerQueue) bool) error {
+	if o.queues == nil {
+		queues, err := o.newQueuesDescriber()
+		if err != nil {
+			return err
+		}
+		o.queues = queues
+	}
+	queues, err := o.queues.Queues()
+	if err != nil {
+		return fmt.Errorf("list queues of service %s in environment %s: %w", o.svcName, o.envName, err)
+	}
+	var names []string
+	byName := make(map[string]*describe.WorkerQueue)
+	for _, queue := range queues {
+		if !filter(queue) {
+			continue
+		}
+		names = append(names, queue.Name)
+		byName[queue.Name] = queue
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("no eligible queues found for service %s in environment %s", o.svcName, o.envName)
+	}
+	if o.queueName != "" {
+		queue, ok := byName[o.queueName]
+		if !ok {
+			return fmt.Errorf("queue %s is not one of the eligible queues of service %s: %s", o.queueName, o.svcName, strings.Join(names, ", "))
+		}
+		o.queue = queue
+		return nil
+	}
+	name, err := o.prompt.SelectOne(msg, svcQueueQueueHelpPrompt, names, prompt.WithFinalMessage("Queue:"))
+	if err != nil {
+		return fmt.Errorf("select queue: %w", err)
+	}
+	o.queueName, o.queue = name, byName[name]
+	return nil
+}
+
+func (o *svcQueueOpts) getTargetEnv() (*config.Environment, error) {
+	if o.targetEnv != nil {
+		return o.targetEnv, nil
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment: %w", err)
+	}
+	o.targetEnv = env
+	return o.targetEnv, nil
+}
+
+func anyQueue(*describe.WorkerQueue) bool {
+	return true
+}
+
+func isDeadLetterQueue(queue *describe.WorkerQueue) bool {
+	return queue.IsDeadLetterQueue()
+}
+
+// buildSvcQueueCmd builds the command for operating on the queues of a worker service.
+func buildSvcQueueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queue",
+		Short: "Commands for the queues of worker services.",
+		Long: `Commands for the queues of worker services.
+Show the depth of the queues, peek at and purge their messages, and redrive dead-letter queues.`,
+	}
+
+	cmd.AddCommand(buildSvcQueueStatusCmd())
+	cmd.AddCommand(buildSvcQueuePeekCmd())
+	cmd.AddCommand(buildSvcQueuePurgeCmd())
+	cmd.AddCommand(buildSvcQueueRedriveCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
+
+func addSvcQueueFlags(cmd *cobra.Command, vars *svcQueueVars) {
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+}
@@ -0,0 +1,142 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const (
+	svcQueuePeekNamePrompt  = "Which worker service of %s would you like to peek at the messages of?"
+	svcQueuePeekQueuePrompt = "Which queue would you like to peek at?"
+
+	defaultPeekCount = 10
+	maxPeekCount     = 100
+)
+
+type svcQueuePeekVars struct {
+	svcQueueVars
+	count            int
+	shouldOutputJSON bool
+}
+
+type svcQueuePeekOpts struct {
+	*svcQueueOpts
+	count            int
+	shouldOutputJSON bool
+
+	w         io.Writer
+	newPeeker func() (queuePeeker, error)
+}
+
+func newSvcQueuePeekOpts(vars svcQueuePeekVars) (*svcQueuePeekOpts, error) {
+	opts, err := newSvcQueueOpts(vars.svcQueueVars, "svc queue peek", svcQueuePeekNamePrompt)
+	if err != nil {
+		return nil, err
+	}
+	return &svcQueuePeekOpts{
+		svcQueueOpts:     opts,
+		count:            vars.count,
+		shouldOutputJSON: vars.shouldOutputJSON,
+		w:                log.OutputWriter,
+		newPeeker: func() (queuePeeker, error) {
+			sess, err := opts.envSession()
+			if err != nil {
+				return nil, err
+			}
+			return sqs.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcQueuePeekOpts) Validate() error {
+	if o.count < 1 || o.count > maxPeekCount {
+		return fmt.Errorf("--%s must be between 1 and %d", countFlag, maxPeekCount)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcQueuePeekOpts) Ask() error {
+	if err := o.askWorkerService(); err != nil {
+		return err
+	}
+	return o.askQueue(svcQueuePeekQueuePrompt, anyQueue)
+}
+
+// Execute receives messages from the queue without deleting them, and writes them out.
+func (o *svcQueuePeekOpts) Execute() error {
+	if o.queue.DeadLetterQueue != "" {
+		log.Warningf("Peeking increments the receive count of the messages, which can move them to the dead-letter queue %s.\n", o.queue.DeadLetterQueue)
+	}
+	peeker, err := o.newPeeker()
+	if err != nil {
+		return err
+	}
+	messages, err := peeker.PeekMessages(o.queue.URL, o.count)
+	if err != nil {
+		return fmt.Errorf("peek at messages of queue %s: %w", o.queueName, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := json.Marshal(struct {
+			Messages []*sqs.Message `json:"messages"`
+		}{Messages: messages})
+		if err != nil {
+			return fmt.Errorf("marshal messages: %w", err)
+		}
+		fmt.Fprintf(o.w, "%s\n", data)
+		return nil
+	}
+	if len(messages) == 0 {
+		log.Infof("No visible messages in queue %s.\n", o.queueName)
+		return nil
+	}
+	for _, msg := range messages {
+		fmt.Fprintf(o.w, "%s %s\n", color.Bold.Sprint("Message"), msg.ID)
+		fmt.Fprintf(o.w, "  Sent           %s\n", msg.SentAt.Format(time.RFC3339))
+		fmt.Fprintf(o.w, "  Receive count  %d\n", msg.ReceiveCount)
+		if msg.MessageGroupID != "" {
+			fmt.Fprintf(o.w, "  Group ID       %s\n", msg.MessageGroupID)
+		}
+		fmt.Fprintf(o.w, "  Body\n    %s\n\n", strings.ReplaceAll(msg.Body, "\n", "\n    "))
+	}
+	return nil
+}
+
+// buildSvcQueuePeekCmd builds the command for peeking at the messages of a queue.
+func buildSvcQueuePeekCmd() *cobra.Command {
+	vars := svcQueuePeekVars{}
+	cmd := &cobra.Command{
+		Use:   "peek",
+		Short: "Shows messages of a queue without deleting them.",
+		Long: `Shows messages of a queue of a worker service without deleting them.
+The messages stay visible to the service, but their receive count is incremented.`,
+		Example: `
+  Peek at up to 5 messages of the dead-letter queue of the worker service "orders".
+  /code $ copilot svc queue peek -n orders -e test --queue DeadLetterQueue --count 5`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcQueuePeekOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	addSvcQueueFlags(cmd, &vars.svcQueueVars)
+	cmd.Flags().StringVar(&vars.queueName, queueFlag, "", queueFlagDescription)
+	cmd.Flags().IntVar(&vars.count, countFlag, defaultPeekCount, peekCountFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
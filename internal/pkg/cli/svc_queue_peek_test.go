@@ -0,0 +1,123 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+)
+
+func TestSvcQueuePeekOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inCount   int
+		wantedErr error
+	}{
+		"error if the count is zero": {
+			inCount:   0,
+			wantedErr: errors.New("--count must be between 1 and 100"),
+		},
+		"error if the count is too large": {
+			inCount:   101,
+			wantedErr: errors.New("--count must be between 1 and 100"),
+		},
+		"valid count": {
+			inCount: 10,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcQueuePeekOpts{count: tc.inCount}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcQueuePeekOpts_Execute(t *testing.T) {
+	mockMessages := []*sqs.Message{
+		{
+			ID:           "1",
+			Body:         `{"order": 1}`,
+			SentAt:       time.Date(2023, time.November, 1, 12, 0, 0, 0, time.UTC),
+			ReceiveCount: 3,
+		},
+	}
+	testCases := map[string]struct {
+		inJSON     bool
+		setupMocks func(m *mocks.MockqueuePeeker)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"error if messages cannot be peeked at": {
+			setupMocks: func(m *mocks.MockqueuePeeker) {
+				m.EXPECT().PeekMessages(mockWorkerQueues[1].URL, 5).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("peek at messages of queue DeadLetterQueue: some error"),
+		},
+		"write the messages": {
+			setupMocks: func(m *mocks.MockqueuePeeker) {
+				m.EXPECT().PeekMessages(mockWorkerQueues[1].URL, 5).Return(mockMessages, nil)
+			},
+			wantedOutput: `Message 1
+  Sent           2023-11-01T12:00:00Z
+  Receive count  3
+  Body
+    {"order": 1}
+
+`,
+		},
+		"write the messages in JSON": {
+			inJSON: true,
+			setupMocks: func(m *mocks.MockqueuePeeker) {
+				m.EXPECT().PeekMessages(mockWorkerQueues[1].URL, 5).Return(mockMessages, nil)
+			},
+			wantedOutput: `{"messages":[{"id":"1","body":"{\"order\": 1}","sentAt":"2023-11-01T12:00:00Z","receiveCount":3}]}` + "\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockqueuePeeker(ctrl)
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &svcQueuePeekOpts{
+				svcQueueOpts: &svcQueueOpts{
+					svcQueueVars: svcQueueVars{queueName: "DeadLetterQueue"},
+					queue:        mockWorkerQueues[1],
+				},
+				count:            5,
+				shouldOutputJSON: tc.inJSON,
+				w:                b,
+				newPeeker: func() (queuePeeker, error) {
+					return m, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+)
+
+const (
+	svcQueuePurgeNamePrompt  = "Which worker service of %s would you like to purge a queue of?"
+	svcQueuePurgeQueuePrompt = "Which queue would you like to purge?"
+	fmtSvcQueuePurgeConfirm  = "Are you sure you want to delete all %d messages of queue %s?"
+	svcQueuePurgeConfirmHelp = "Purged messages cannot be recovered."
+	fmtSvcQueuePurgeStart    = "Purging queue %s of service %s in environment %s."
+	fmtSvcQueuePurgeFailed   = "Failed to purge queue %s of service %s in environment %s.\n"
+	fmtSvcQueuePurgeSucceed  = "Purged queue %s of service %s in environment %s.\n"
+)
+
+type svcQueuePurgeVars struct {
+	svcQueueVars
+	skipConfirmation bool
+}
+
+type svcQueuePurgeOpts struct {
+	*svcQueueOpts
+	skipConfirmation bool
+
+	spinner   progress
+	newPurger func() (queuePurger, error)
+}
+
+func newSvcQueuePurgeOpts(vars svcQueuePurgeVars) (*svcQueuePurgeOpts, error) {
+	opts, err := newSvcQueueOpts(vars.svcQueueVars, "svc queue purge", svcQueuePurgeNamePrompt)
+	if err != nil {
+		return nil, err
+	}
+	return &svcQueuePurgeOpts{
+		svcQueueOpts:     opts,
+		skipConfirmation: vars.skipConfirmation,
+		spinner:          termprogress.NewSpinner(log.DiagnosticWriter),
+		newPurger: func() (queuePurger, error) {
+			sess, err := opts.envSession()
+			if err != nil {
+				return nil, err
+			}
+			return sqs.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcQueuePurgeOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcQueuePurgeOpts) Ask() error {
+	if err := o.askWorkerService(); err != nil {
+		return err
+	}
+	if err := o.askQueue(svcQueuePurgeQueuePrompt, anyQueue); err != nil {
+		return err
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	total := o.queue.VisibleMessages + o.queue.InFlightMessages + o.queue.DelayedMessages
+	confirmed, err := o.prompt.Confirm(
+		fmt.Sprintf(fmtSvcQueuePurgeConfirm, total, color.HighlightUserInput(o.queueName)),
+		svcQueuePurgeConfirmHelp,
+		prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("svc queue purge confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errOperationCancelled
+	}
+	return nil
+}
+
+// Execute deletes all the messages of the queue.
+func (o *svcQueuePurgeOpts) Execute() error {
+	purger, err := o.newPurger()
+	if err != nil {
+		return err
+	}
+	o.spinner.Start(fmt.Sprintf(fmtSvcQueuePurgeStart, o.queueName, o.svcName, o.envName))
+	if err := purger.Purge(o.queue.URL); err != nil {
+		o.spinner.Stop(log.Serrorf(fmtSvcQueuePurgeFailed, o.queueName, o.svcName, o.envName))
+		return err
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtSvcQueuePurgeSucceed, o.queueName, o.svcName, o.envName))
+	log.Infoln("It can take up to 60 seconds for SQS to delete the messages.")
+	return nil
+}
+
+// buildSvcQueuePurgeCmd builds the command for deleting all the messages of a queue.
+func buildSvcQueuePurgeCmd() *cobra.Command {
+	vars := svcQueuePurgeVars{}
+	cmd := &cobra.Command{
+		Use:   "purge",
+		Short: "Deletes all the messages of a queue.",
+		Long:  "Deletes all the messages of a queue of a worker service.",
+		Example: `
+  Purge the dead-letter queue of the worker service "orders" without confirmation.
+  /code $ copilot svc queue purge -n orders -e test --queue DeadLetterQueue --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcQueuePurgeOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	addSvcQueueFlags(cmd, &vars.svcQueueVars)
+	cmd.Flags().StringVar(&vars.queueName, queueFlag, "", queueFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
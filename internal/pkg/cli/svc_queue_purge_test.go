@@ -0,0 +1,130 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+)
+
+func TestSvcQueuePurgeOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		skipConfirmation bool
+		setupMocks       func(m svcQueueAskMocks)
+
+		wantedErr error
+	}{
+		"skip the confirmation": {
+			skipConfirmation: true,
+			setupMocks: func(m svcQueueAskMocks) {
+				m.queues.EXPECT().Queues().Return(mockWorkerQueues, nil)
+			},
+		},
+		"error if the purge is cancelled": {
+			setupMocks: func(m svcQueueAskMocks) {
+				m.queues.EXPECT().Queues().Return(mockWorkerQueues, nil)
+				m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtSvcQueuePurgeConfirm, 7, "DeadLetterQueue"), svcQueuePurgeConfirmHelp, gomock.Any()).Return(false, nil)
+			},
+			wantedErr: errOperationCancelled,
+		},
+		"confirm the purge": {
+			setupMocks: func(m svcQueueAskMocks) {
+				m.queues.EXPECT().Queues().Return(mockWorkerQueues, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcQueueAskMocks{
+				store:  mocks.NewMockstore(ctrl),
+				sel:    mocks.NewMockdeploySelector(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+				queues: mocks.NewMockworkerQueuesDescriber(ctrl),
+			}
+			m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+			m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, nil)
+			m.store.EXPECT().GetService("phonetool", "orders").Return(nil, nil)
+			m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(mockDeployedWorker, nil)
+			tc.setupMocks(m)
+			opts := &svcQueuePurgeOpts{
+				svcQueueOpts: newSvcQueueOptsForTest(m, svcQueueVars{
+					appName:   "phonetool",
+					envName:   "test",
+					svcName:   "orders",
+					queueName: "DeadLetterQueue",
+				}, svcQueuePurgeNamePrompt),
+				skipConfirmation: tc.skipConfirmation,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcQueuePurgeOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockqueuePurger, spinner *mocks.Mockprogress)
+
+		wantedErr error
+	}{
+		"error if the queue cannot be purged": {
+			setupMocks: func(m *mocks.MockqueuePurger, spinner *mocks.Mockprogress) {
+				spinner.EXPECT().Start(fmt.Sprintf(fmtSvcQueuePurgeStart, "DeadLetterQueue", "orders", "test"))
+				m.EXPECT().Purge(mockWorkerQueues[1].URL).Return(errors.New("some error"))
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"purge the queue": {
+			setupMocks: func(m *mocks.MockqueuePurger, spinner *mocks.Mockprogress) {
+				spinner.EXPECT().Start(gomock.Any())
+				m.EXPECT().Purge(mockWorkerQueues[1].URL).Return(nil)
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockqueuePurger(ctrl)
+			spinner := mocks.NewMockprogress(ctrl)
+			tc.setupMocks(m, spinner)
+			opts := &svcQueuePurgeOpts{
+				svcQueueOpts: &svcQueueOpts{
+					svcQueueVars: svcQueueVars{envName: "test", svcName: "orders", queueName: "DeadLetterQueue"},
+					queue:        mockWorkerQueues[1],
+				},
+				spinner: spinner,
+				newPurger: func() (queuePurger, error) {
+					return m, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+)
+
+const (
+	svcQueueRedriveNamePrompt  = "Which worker service of %s would you like to redrive a dead-letter queue of?"
+	svcQueueRedriveQueuePrompt = "Which dead-letter queue would you like to redrive?"
+	fmtSvcQueueRedriveConfirm  = "Are you sure you want to move %d messages from %s back to %s?"
+	svcQueueRedriveConfirmHelp = "The messages are sent to the source queue and processed by the service again."
+	fmtSvcQueueRedriveProgress = "Moving messages from %s to %s (%d/%d)."
+	fmtSvcQueueRedriveFailed   = "Failed to redrive queue %s: %s.\n"
+	fmtSvcQueueRedriveSucceed  = "Moved %d messages from %s to %s.\n"
+)
+
+// redrivePollInterval is the time to wait between two checks of the progress of a redrive.
+var redrivePollInterval = 3 * time.Second
+
+type svcQueueRedriveVars struct {
+	svcQueueVars
+	rate             int
+	skipConfirmation bool
+}
+
+type svcQueueRedriveOpts struct {
+	*svcQueueOpts
+	rate             *int // Nil if SQS optimizes the rate.
+	skipConfirmation bool
+
+	spinner     progress
+	newRedriver func() (queueRedriver, error)
+}
+
+func newSvcQueueRedriveOpts(vars svcQueueRedriveVars) (*svcQueueRedriveOpts, error) {
+	opts, err := newSvcQueueOpts(vars.svcQueueVars, "svc queue redrive", svcQueueRedriveNamePrompt)
+	if err != nil {
+		return nil, err
+	}
+	return &svcQueueRedriveOpts{
+		svcQueueOpts:     opts,
+		skipConfirmation: vars.skipConfirmation,
+		spinner:          termprogress.NewSpinner(log.DiagnosticWriter),
+		newRedriver: func() (queueRedriver, error) {
+			sess, err := opts.envSession()
+			if err != nil {
+				return nil, err
+			}
+			return sqs.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcQueueRedriveOpts) Validate() error {
+	if o.rate != nil && (aws.IntValue(o.rate) < 1 || aws.IntValue(o.rate) > sqs.MaxRedriveRate) {
+		return fmt.Errorf("--%s must be between 1 and %d", redriveRateFlag, sqs.MaxRedriveRate)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcQueueRedriveOpts) Ask() error {
+	if err := o.askWorkerService(); err != nil {
+		return err
+	}
+	if err := o.askQueue(svcQueueRedriveQueuePrompt, isDeadLetterQueue); err != nil {
+		return err
+	}
+	if o.skipConfirmation || o.queue.VisibleMessages == 0 {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(
+		fmt.Sprintf(fmtSvcQueueRedriveConfirm, o.queue.VisibleMessages, color.HighlightUserInput(o.queueName), o.sourceQueues()),
+		svcQueueRedriveConfirmHelp,
+		prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("svc queue redrive confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errOperationCancelled
+	}
+	return nil
+}
+
+// Execute moves the messages of the dead-letter queue back to their source queue and waits until the move completes.
+func (o *svcQueueRedriveOpts) Execute() error {
+	if o.queue.VisibleMessages == 0 {
+		log.Infof("There are no messages to redrive in queue %s.\n", o.queueName)
+		return nil
+	}
+	redriver, err := o.newRedriver()
+	if err != nil {
+		return err
+	}
+	handle, err := redriver.StartRedrive(o.queue.ARN, aws.IntValue(o.rate))
+	if err != nil {
+		return fmt.Errorf("redrive queue %s: %w", o.queueName, err)
+	}
+	sources := o.sourceQueues()
+	o.spinner.Start(fmt.Sprintf(fmtSvcQueueRedriveProgress, o.queueName, sources, 0, o.queue.VisibleMessages))
+	for {
+		task, err := redriver.RedriveTask(o.queue.ARN, handle)
+		if err != nil {
+			o.spinner.Stop(log.Serrorf(fmtSvcQueueRedriveFailed, o.queueName, err))
+			return fmt.Errorf("get progress of the redrive of queue %s: %w", o.queueName, err)
+		}
+		if task.IsDone() {
+			if task.Status != sqs.MessageMoveTaskStatusCompleted {
+				reason := strings.ToLower(task.Status)
+				if task.FailureReason != "" {
+					reason = task.FailureReason
+				}
+				o.spinner.Stop(log.Serrorf(fmtSvcQueueRedriveFailed, o.queueName, reason))
+				return fmt.Errorf("redrive of queue %s %s after moving %d messages", o.queueName, strings.ToLower(task.Status), task.MovedMessages)
+			}
+			o.spinner.Stop(log.Ssuccessf(fmtSvcQueueRedriveSucceed, task.MovedMessages, o.queueName, sources))
+			return nil
+		}
+		o.spinner.Start(fmt.Sprintf(fmtSvcQueueRedriveProgress, o.queueName, sources, task.MovedMessages, task.TotalMessages))
+		time.Sleep(redrivePollInterval)
+	}
+}
+
+func (o *svcQueueRedriveOpts) sourceQueues() string {
+	return strings.Join(o.queue.SourceQueues, ", ")
+}
+
+// buildSvcQueueRedriveCmd builds the command for moving the messages of a dead-letter queue back to their source queue.
+func buildSvcQueueRedriveCmd() *cobra.Command {
+	vars := svcQueueRedriveVars{}
+	cmd := &cobra.Command{
+		Use:   "redrive",
+		Short: "Moves the messages of a dead-letter queue back to their source queue.",
+		Long: `Moves the messages of a dead-letter queue of a worker service back to their source queue,
+so that the service processes them again.`,
+		Example: `
+  Redrive the dead-letter queue of the worker service "orders" at 10 messages per second.
+  /code $ copilot svc queue redrive -n orders -e test --queue DeadLetterQueue --rate 10`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcQueueRedriveOpts(vars)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(redriveRateFlag) {
+				opts.rate = aws.Int(vars.rate)
+			}
+			return run(opts)
+		}),
+	}
+	addSvcQueueFlags(cmd, &vars.svcQueueVars)
+	cmd.Flags().StringVar(&vars.queueName, queueFlag, "", queueFlagDescription)
+	cmd.Flags().IntVar(&vars.rate, redriveRateFlag, 0, redriveRateFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,169 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+)
+
+var mockDeployedWorker = &selector.DeployedService{Name: "orders", Env: "test"}
+
+func TestSvcQueueRedriveOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inRate    *int
+		wantedErr error
+	}{
+		"error if the rate is negative": {
+			inRate:    aws.Int(-1),
+			wantedErr: errors.New("--rate must be between 1 and 500"),
+		},
+		"error if the rate is zero": {
+			inRate:    aws.Int(0),
+			wantedErr: errors.New("--rate must be between 1 and 500"),
+		},
+		"error if the rate is too large": {
+			inRate:    aws.Int(501),
+			wantedErr: errors.New("--rate must be between 1 and 500"),
+		},
+		"let SQS optimize the rate by default": {},
+		"valid rate": {
+			inRate: aws.Int(10),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcQueueRedriveOpts{rate: tc.inRate}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcQueueRedriveOpts_Ask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := svcQueueAskMocks{
+		store:  mocks.NewMockstore(ctrl),
+		sel:    mocks.NewMockdeploySelector(ctrl),
+		prompt: mocks.NewMockprompter(ctrl),
+		queues: mocks.NewMockworkerQueuesDescriber(ctrl),
+	}
+	m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+	m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(mockDeployedWorker, nil)
+	m.queues.EXPECT().Queues().Return(mockWorkerQueues, nil)
+	m.prompt.EXPECT().SelectOne(svcQueueRedriveQueuePrompt, gomock.Any(), []string{"DeadLetterQueue"}, gomock.Any()).Return("DeadLetterQueue", nil)
+	m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtSvcQueueRedriveConfirm, 7, "DeadLetterQueue", "EventsQueue"), svcQueueRedriveConfirmHelp, gomock.Any()).Return(false, nil)
+	opts := &svcQueueRedriveOpts{
+		svcQueueOpts: newSvcQueueOptsForTest(m, svcQueueVars{appName: "phonetool"}, svcQueueRedriveNamePrompt),
+	}
+
+	err := opts.Ask()
+
+	require.ErrorIs(t, err, errOperationCancelled)
+}
+
+func TestSvcQueueRedriveOpts_Execute(t *testing.T) {
+	const mockHandle = "mockHandle"
+	dlq := mockWorkerQueues[1]
+	testCases := map[string]struct {
+		inQueue    *describe.WorkerQueue
+		setupMocks func(m *mocks.MockqueueRedriver, spinner *mocks.Mockprogress)
+
+		wantedErr error
+	}{
+		"do nothing if the dead-letter queue is empty": {
+			inQueue:    &describe.WorkerQueue{Name: "DeadLetterQueue", SourceQueues: []string{"EventsQueue"}},
+			setupMocks: func(m *mocks.MockqueueRedriver, spinner *mocks.Mockprogress) {},
+		},
+		"error if the redrive cannot be started": {
+			inQueue: dlq,
+			setupMocks: func(m *mocks.MockqueueRedriver, spinner *mocks.Mockprogress) {
+				m.EXPECT().StartRedrive(dlq.ARN, 10).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("redrive queue DeadLetterQueue: some error"),
+		},
+		"error if the redrive fails": {
+			inQueue: dlq,
+			setupMocks: func(m *mocks.MockqueueRedriver, spinner *mocks.Mockprogress) {
+				m.EXPECT().StartRedrive(dlq.ARN, 10).Return(mockHandle, nil)
+				spinner.EXPECT().Start(gomock.Any())
+				m.EXPECT().RedriveTask(dlq.ARN, mockHandle).Return(&sqs.MessageMoveTask{
+					Status:        sqs.MessageMoveTaskStatusFailed,
+					MovedMessages: 2,
+					FailureReason: "AccessDenied",
+				}, nil)
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: errors.New("redrive of queue DeadLetterQueue failed after moving 2 messages"),
+		},
+		"wait until the redrive completes": {
+			inQueue: dlq,
+			setupMocks: func(m *mocks.MockqueueRedriver, spinner *mocks.Mockprogress) {
+				m.EXPECT().StartRedrive(dlq.ARN, 10).Return(mockHandle, nil)
+				spinner.EXPECT().Start(fmt.Sprintf(fmtSvcQueueRedriveProgress, "DeadLetterQueue", "EventsQueue", 0, 7))
+				gomock.InOrder(
+					m.EXPECT().RedriveTask(dlq.ARN, mockHandle).Return(&sqs.MessageMoveTask{
+						Status:        sqs.MessageMoveTaskStatusRunning,
+						MovedMessages: 3,
+						TotalMessages: 7,
+					}, nil),
+					m.EXPECT().RedriveTask(dlq.ARN, mockHandle).Return(&sqs.MessageMoveTask{
+						Status:        sqs.MessageMoveTaskStatusCompleted,
+						MovedMessages: 7,
+						TotalMessages: 7,
+					}, nil),
+				)
+				spinner.EXPECT().Start(fmt.Sprintf(fmtSvcQueueRedriveProgress, "DeadLetterQueue", "EventsQueue", 3, 7))
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockqueueRedriver(ctrl)
+			spinner := mocks.NewMockprogress(ctrl)
+			tc.setupMocks(m, spinner)
+			redrivePollInterval = 0
+			opts := &svcQueueRedriveOpts{
+				svcQueueOpts: &svcQueueOpts{
+					svcQueueVars: svcQueueVars{envName: "test", svcName: "orders", queueName: "DeadLetterQueue"},
+					queue:        tc.inQueue,
+				},
+				rate:    aws.Int(10),
+				spinner: spinner,
+				newRedriver: func() (queueRedriver, error) {
+					return m, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const svcQueueStatusNamePrompt = "Which worker service of %s would you like to show the queues of?"
+
+type svcQueueStatusVars struct {
+	svcQueueVars
+	shouldOutputJSON bool
+}
+
+type svcQueueStatusOpts struct {
+	*svcQueueOpts
+	shouldOutputJSON bool
+
+	w io.Writer
+}
+
+func newSvcQueueStatusOpts(vars svcQueueStatusVars) (*svcQueueStatusOpts, error) {
+	opts, err := newSvcQueueOpts(vars.svcQueueVars, "svc queue status", svcQueueStatusNamePrompt)
+	if err != nil {
+		return nil, err
+	}
+	return &svcQueueStatusOpts{
+		svcQueueOpts:     opts,
+		shouldOutputJSON: vars.shouldOutputJSON,
+		w:                log.OutputWriter,
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcQueueStatusOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcQueueStatusOpts) Ask() error {
+	return o.askWorkerService()
+}
+
+// Execute displays the depth and the age of the oldest message of each queue of the worker service.
+func (o *svcQueueStatusOpts) Execute() error {
+	if o.queues == nil {
+		queues, err := o.newQueuesDescriber()
+		if err != nil {
+			return err
+		}
+		o.queues = queues
+	}
+	status, err := o.queues.Describe()
+	if err != nil {
+		return fmt.Errorf("describe queues of service %s in environment %s: %w", o.svcName, o.envName, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := status.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	fmt.Fprint(o.w, status.HumanString())
+	return nil
+}
+
+// buildSvcQueueStatusCmd builds the command for showing the queues of a worker service.
+func buildSvcQueueStatusCmd() *cobra.Command {
+	vars := svcQueueStatusVars{}
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Shows the depth and age of the queues of a worker service.",
+		Long: `Shows the number of visible, in-flight and delayed messages, and the age of the oldest message,
+of the queues of a worker service.`,
+		Example: `
+  Shows the queues of the worker service "orders" in the "test" environment.
+  /code $ copilot svc queue status -n orders -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcQueueStatusOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	addSvcQueueFlags(cmd, &vars.svcQueueVars)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
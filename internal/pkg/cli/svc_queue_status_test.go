@@ -0,0 +1,71 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+)
+
+func TestSvcQueueStatusOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inJSON     bool
+		setupMocks func(m *mocks.MockworkerQueuesDescriber)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"error if the queues cannot be described": {
+			setupMocks: func(m *mocks.MockworkerQueuesDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe queues of service orders in environment test: some error"),
+		},
+		"write the queues in human-readable format": {
+			setupMocks: func(m *mocks.MockworkerQueuesDescriber) {
+				m.EXPECT().Describe().Return(&mockDescribeData{data: "human"}, nil)
+			},
+			wantedOutput: "human",
+		},
+		"write the queues in JSON": {
+			inJSON: true,
+			setupMocks: func(m *mocks.MockworkerQueuesDescriber) {
+				m.EXPECT().Describe().Return(&mockDescribeData{data: "json"}, nil)
+			},
+			wantedOutput: "json",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockworkerQueuesDescriber(ctrl)
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &svcQueueStatusOpts{
+				svcQueueOpts: &svcQueueOpts{
+					svcQueueVars: svcQueueVars{envName: "test", svcName: "orders"},
+					queues:       m,
+				},
+				shouldOutputJSON: tc.inJSON,
+				w:                b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+)
+
+type svcQueueAskMocks struct {
+	store  *mocks.Mockstore
+	sel    *mocks.MockdeploySelector
+	prompt *mocks.Mockprompter
+	queues *mocks.MockworkerQueuesDescriber
+}
+
+var mockWorkerQueues = []*describe.WorkerQueue{
+	{
+		Name:            "EventsQueue",
+		URL:             "https://sqs.us-west-2.amazonaws.com/123456789012/EventsQueue",
+		DeadLetterQueue: "DeadLetterQueue",
+	},
+	{
+		Name:            "DeadLetterQueue",
+		URL:             "https://sqs.us-west-2.amazonaws.com/123456789012/DeadLetterQueue",
+		ARN:             "arn:aws:sqs:us-west-2:123456789012:DeadLetterQueue",
+		VisibleMessages: 7,
+		SourceQueues:    []string{"EventsQueue"},
+	},
+}
+
+func newSvcQueueOptsForTest(m svcQueueAskMocks, vars svcQueueVars, svcNamePrompt string) *svcQueueOpts {
+	return &svcQueueOpts{
+		svcQueueVars:  vars,
+		store:         m.store,
+		sel:           m.sel,
+		prompt:        m.prompt,
+		svcNamePrompt: svcNamePrompt,
+		newQueuesDescriber: func() (workerQueuesDescriber, error) {
+			return m.queues, nil
+		},
+	}
+}
+
+func TestSvcQueueOpts_askWorkerService(t *testing.T) {
+	testCases := map[string]struct {
+		inVars     svcQueueVars
+		setupMocks func(m svcQueueAskMocks)
+
+		wantedSvc string
+		wantedEnv string
+		wantedErr error
+	}{
+		"error if the application does not exist": {
+			inVars: svcQueueVars{appName: "phonetool"},
+			setupMocks: func(m svcQueueAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"select a deployed worker service": {
+			setupMocks: func(m svcQueueAskMocks) {
+				m.sel.EXPECT().Application(svcQueueAppNamePrompt, wkldAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().DeployedService(fmt.Sprintf(svcQueuePeekNamePrompt, "phonetool"), svcQueueSvcNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Name: "orders", Env: "test"}, nil)
+			},
+			wantedSvc: "orders",
+			wantedEnv: "test",
+		},
+		"validate the flags before selecting the service": {
+			inVars: svcQueueVars{appName: "phonetool", envName: "test", svcName: "orders"},
+			setupMocks: func(m svcQueueAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				m.store.EXPECT().GetService("phonetool", "orders").Return(&config.Workload{}, nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Name: "orders", Env: "test"}, nil)
+			},
+			wantedSvc: "orders",
+			wantedEnv: "test",
+		},
+		"error if no deployed worker service can be selected": {
+			inVars: svcQueueVars{appName: "phonetool"},
+			setupMocks: func(m svcQueueAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("select deployed worker service for application phonetool: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcQueueAskMocks{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockdeploySelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := newSvcQueueOptsForTest(m, tc.inVars, svcQueuePeekNamePrompt)
+
+			err := opts.askWorkerService()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+func TestSvcQueueOpts_askQueue(t *testing.T) {
+	testCases := map[string]struct {
+		inQueue    string
+		inFilter   func(*describe.WorkerQueue) bool
+		setupMocks func(m svcQueueAskMocks)
+
+		wantedQueue string
+		wantedErr   error
+	}{
+		"error if the queues cannot be listed": {
+			inFilter: anyQueue,
+			setupMocks: func(m svcQueueAskMocks) {
+				m.queues.EXPECT().Queues().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list queues of service orders in environment test: some error"),
+		},
+		"error if there are no eligible queues": {
+			inFilter: isDeadLetterQueue,
+			setupMocks: func(m svcQueueAskMocks) {
+				m.queues.EXPECT().Queues().Return(mockWorkerQueues[:1], nil)
+			},
+			wantedErr: errors.New("no eligible queues found for service orders in environment test"),
+		},
+		"error if the queue flag does not name an eligible queue": {
+			inQueue:  "EventsQueue",
+			inFilter: isDeadLetterQueue,
+			setupMocks: func(m svcQueueAskMocks) {
+				m.queues.EXPECT().Queues().Return(mockWorkerQueues, nil)
+			},
+			wantedErr: errors.New("queue EventsQueue is not one of the eligible queues of service orders: DeadLetterQueue"),
+		},
+		"use the queue flag": {
+			inQueue:  "EventsQueue",
+			inFilter: anyQueue,
+			setupMocks: func(m svcQueueAskMocks) {
+				m.queues.EXPECT().Queues().Return(mockWorkerQueues, nil)
+			},
+			wantedQueue: "EventsQueue",
+		},
+		"prompt for one of the eligible queues": {
+			inFilter: anyQueue,
+			setupMocks: func(m svcQueueAskMocks) {
+				m.queues.EXPECT().Queues().Return(mockWorkerQueues, nil)
+				m.prompt.EXPECT().SelectOne(svcQueuePeekQueuePrompt, svcQueueQueueHelpPrompt, []string{"EventsQueue", "DeadLetterQueue"}, gomock.Any()).
+					Return("DeadLetterQueue", nil)
+			},
+			wantedQueue: "DeadLetterQueue",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcQueueAskMocks{
+				prompt: mocks.NewMockprompter(ctrl),
+				queues: mocks.NewMockworkerQueuesDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := newSvcQueueOptsForTest(m, svcQueueVars{
+				appName:   "phonetool",
+				envName:   "test",
+				svcName:   "orders",
+				queueName: tc.inQueue,
+			}, svcQueuePeekNamePrompt)
+
+			err := opts.askQueue(svcQueuePeekQueuePrompt, tc.inFilter)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedQueue, opts.queueName)
+			require.Equal(t, tc.wantedQueue, opts.queue.Name)
+		})
+	}
+}
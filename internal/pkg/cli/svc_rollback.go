@@ -0,0 +1,325 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/docker/signature"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcRollbackNamePrompt     = "Which service would you like to roll back?"
+	svcRollbackNameHelpPrompt = "Redeploys a previous deployment of the service without rebuilding its images."
+
+	fmtSvcRollbackConfirmPrompt = "Are you sure you want to roll back %s in environment %s to deployment #%d of %s?"
+	svcRollbackConfirmHelp      = "The service is redeployed with the template, parameters and image digests of the deployment."
+)
+
+var errSvcRollbackCancelled = errors.New("svc rollback cancelled - no changes made")
+
+type svcRollbackVars struct {
+	appName          string
+	svcName          string
+	envName          string
+	to               int
+	skipConfirmation bool
+}
+
+type svcRollbackOpts struct {
+	svcRollbackVars
+
+	store   store
+	sel     deploySelector
+	prompt  prompter
+	history deploymentHistory
+	caller  identityService
+
+	deployer     workloadStackDeployer
+	images       imageExistenceChecker
+	signer       imageVerifier
+	envDescriber envDescriber
+	initClient   func() error
+
+	// Cached variables.
+	targetEnv  *config.Environment
+	bucketName string
+	deployment *deploy.WorkloadDeployment
+	template   string
+}
+
+func newSvcRollbackOpts(vars svcRollbackVars) (*svcRollbackOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc rollback"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewStore(defaultSess)
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	opts := &svcRollbackOpts{
+		svcRollbackVars: vars,
+		store:           configStore,
+		sel:             selector.NewDeploySelect(prompter, configStore, deployStore),
+		prompt:          prompter,
+		caller:          identity.New(defaultSess),
+		signer:          signature.New(exec.NewCmd()),
+	}
+	opts.initClient = func() error {
+		app, err := configStore.GetApplication(opts.appName)
+		if err != nil {
+			return fmt.Errorf("get application %s: %w", opts.appName, err)
+		}
+		env, err := configStore.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s: %w", opts.envName, err)
+		}
+		envSess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return err
+		}
+		defaultSessEnvRegion, err := sessProvider.DefaultWithRegion(env.Region)
+		if err != nil {
+			return fmt.Errorf("create default session with region %s: %w", env.Region, err)
+		}
+		history, bucket, err := newDeploymentHistory(app, env, defaultSess, envSess)
+		if err != nil {
+			return err
+		}
+		envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("initiate environment describer: %w", err)
+		}
+		opts.targetEnv = env
+		opts.bucketName = bucket
+		opts.history = history
+		opts.deployer = deploycfn.New(envSess, deploycfn.WithProgressTracker(os.Stderr))
+		opts.images = ecr.New(defaultSessEnvRegion)
+		opts.envDescriber = envDescriber
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcRollbackOpts) Validate() error {
+	if o.to < 1 {
+		return fmt.Errorf(`--%s must be the number of a deployment listed by "copilot svc history"`, rollbackToFlag)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags, and confirms the rollback.
+func (o *svcRollbackOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	if err := o.validateAndAskSvcEnvName(); err != nil {
+		return err
+	}
+	if err := o.initClient(); err != nil {
+		return err
+	}
+	if err := o.retrieveDeployment(); err != nil {
+		return err
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(
+		fmt.Sprintf(fmtSvcRollbackConfirmPrompt, o.svcName, o.envName, o.to, o.deployment.DeployedAt.Local().Format(time.RFC1123)),
+		svcRollbackConfirmHelp,
+		prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("svc rollback confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errSvcRollbackCancelled
+	}
+	return nil
+}
+
+// Execute redeploys the template and images of the deployment, and records the rollback as a new deployment.
+func (o *svcRollbackOpts) Execute() error {
+	if err := o.validateImages(); err != nil {
+		return err
+	}
+	rollback := stack.NewWorkloadRollback(o.deployment, o.template)
+	if err := o.deployer.DeployService(rollback, o.bucketName, false, awscfn.WithRoleARN(o.targetEnv.ExecutionRoleARN)); err != nil {
+		var errEmptyChangeSet *awscfn.ErrChangeSetEmpty
+		if errors.As(err, &errEmptyChangeSet) {
+			log.Infof("Service %s is already deployed as in deployment #%d.\n", color.HighlightUserInput(o.svcName), o.to)
+			return &errNoInfrastructureChanges{parentErr: err}
+		}
+		return fmt.Errorf("roll back service %s in environment %s to deployment #%d: %w", o.svcName, o.envName, o.to, err)
+	}
+	log.Successf("Rolled back service %s in environment %s to deployment #%d.\n",
+		color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName), o.to)
+	if err := o.recordRollback(); err != nil {
+		log.Warningf("Failed to record the rollback in the deployment history of %s: %v\n", o.svcName, err)
+	}
+	return nil
+}
+
+// retrieveDeployment retrieves the deployment to roll back to and its template.
+func (o *svcRollbackOpts) retrieveDeployment() error {
+	deployment, err := o.history.Get(o.envName, o.svcName, o.to)
+	if err != nil {
+		return fmt.Errorf("get deployment #%d of service %s in environment %s: %w", o.to, o.svcName, o.envName, err)
+	}
+	template, err := o.history.Template(deployment)
+	if err != nil {
+		return fmt.Errorf("get template of deployment #%d: %w", o.to, err)
+	}
+	o.deployment = deployment
+	o.template = template
+	return nil
+}
+
+// validateImages returns an error if an image of the deployment is no longer in its repository, for example because
+// the lifecycle policy of the repository expired it, or if the environment requires signed images and an image isn't signed.
+func (o *svcRollbackOpts) validateImages() error {
+	containers := make([]string, 0, len(o.deployment.Images))
+	for container, img := range o.deployment.Images {
+		if img.Digest == "" {
+			continue
+		}
+		containers = append(containers, container)
+	}
+	sort.Strings(containers)
+	repoName := clideploy.RepoName(o.appName, o.svcName)
+	for _, container := range containers {
+		img := o.deployment.Images[container]
+		exists, err := o.images.ImageExists(repoName, img.Digest)
+		if err != nil {
+			return fmt.Errorf("check if image %s of container %s exists: %w", img.Digest, container, err)
+		}
+		if !exists {
+			return fmt.Errorf(`image %s of container %s is no longer in repository %s: deploy the service with "copilot svc deploy" instead`, img.Digest, container, repoName)
+		}
+	}
+	raw, err := o.envDescriber.Manifest()
+	if err != nil {
+		return fmt.Errorf("read the manifest used to deploy environment %s: %w", o.envName, err)
+	}
+	envConfig, err := manifest.UnmarshalEnvironment(raw)
+	if err != nil {
+		return fmt.Errorf("unmarshal the manifest used to deploy environment %s: %w", o.envName, err)
+	}
+	verification := envConfig.ImageVerification
+	if verification.IsEmpty() {
+		return nil
+	}
+	tool := signature.Tool(aws.StringValue(verification.Tool))
+	for _, container := range containers {
+		if err := o.signer.Verify(context.Background(), tool, aws.StringValue(verification.Key), o.deployment.Images[container].PinnedURI()); err != nil {
+			return fmt.Errorf("environment %s only accepts signed images: image of container %s: %w", o.envName, container, err)
+		}
+	}
+	return nil
+}
+
+func (o *svcRollbackOpts) recordRollback() error {
+	caller, err := o.caller.Get()
+	if err != nil {
+		return fmt.Errorf("get identity: %w", err)
+	}
+	rollback := *o.deployment
+	rollback.DeployedAt = time.Now().UTC()
+	rollback.DeployedBy = caller.ARN
+	rollback.RollbackOf = o.deployment.Number
+	return o.history.Record(&rollback)
+}
+
+func (o *svcRollbackOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcRollbackOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	deployedService, err := o.sel.DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithName(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcRollbackCmd builds the command for rolling back a service to a previous deployment.
+func buildSvcRollbackCmd() *cobra.Command {
+	vars := svcRollbackVars{}
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rolls back a service to a previous deployment.",
+		Long: `Rolls back a service to a previous deployment listed by "copilot svc history".
+The service is redeployed with the template and parameters of the deployment,
+and with its images referenced by digest, without rebuilding them.`,
+
+		Example: `
+  Rolls back the service "my-svc" in the "prod" environment to deployment #12.
+  /code $ copilot svc rollback -n my-svc -e prod --to 12`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcRollbackOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.to, rollbackToFlag, 0, rollbackToFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
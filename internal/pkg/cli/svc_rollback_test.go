@@ -0,0 +1,332 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/docker/signature"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcRollbackMocks struct {
+	store        *mocks.Mockstore
+	sel          *mocks.MockdeploySelector
+	prompt       *mocks.Mockprompter
+	history      *mocks.MockdeploymentHistory
+	caller       *mocks.MockidentityService
+	deployer     *mocks.MockworkloadStackDeployer
+	images       *mocks.MockimageExistenceChecker
+	signer       *mocks.MockimageVerifier
+	envDescriber *mocks.MockenvDescriber
+}
+
+func TestSvcRollback_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inTo        int
+		wantedError error
+	}{
+		"errors if --to is not a deployment number": {
+			inTo:        0,
+			wantedError: errors.New(`--to must be the number of a deployment listed by "copilot svc history"`),
+		},
+		"success": {
+			inTo: 3,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					to: tc.inTo,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcRollback_Ask(t *testing.T) {
+	deployment := &deploy.WorkloadDeployment{
+		Number:     3,
+		DeployedAt: time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
+	}
+	testCases := map[string]struct {
+		inApp              string
+		inSvc              string
+		inEnv              string
+		inSkipConfirmation bool
+		setupMocks         func(m svcRollbackMocks)
+
+		wantedSvc      string
+		wantedEnv      string
+		wantedTemplate string
+		wantedError    error
+	}{
+		"errors if failed to select deployed service": {
+			inApp: "phonetool",
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.sel.EXPECT().DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed services for application phonetool: some error"),
+		},
+		"errors if the deployment can't be retrieved": {
+			inApp:              "phonetool",
+			inSkipConfirmation: true,
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "test", Name: "api"}, nil)
+				m.history.EXPECT().Get("test", "api", 3).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get deployment #3 of service api in environment test: some error"),
+		},
+		"errors if the template of the deployment can't be retrieved": {
+			inApp:              "phonetool",
+			inSkipConfirmation: true,
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "test", Name: "api"}, nil)
+				m.history.EXPECT().Get("test", "api", 3).Return(deployment, nil)
+				m.history.EXPECT().Template(deployment).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("get template of deployment #3: some error"),
+		},
+		"errors if the rollback is cancelled": {
+			inApp: "phonetool",
+			inSvc: "api",
+			inEnv: "test",
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{}, nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "test", Name: "api"}, nil)
+				m.history.EXPECT().Get("test", "api", 3).Return(deployment, nil)
+				m.history.EXPECT().Template(deployment).Return("Resources: {}", nil)
+				m.prompt.EXPECT().Confirm(
+					fmt.Sprintf(fmtSvcRollbackConfirmPrompt, "api", "test", 3, deployment.DeployedAt.Local().Format(time.RFC1123)),
+					svcRollbackConfirmHelp, gomock.Any()).Return(false, nil)
+			},
+			wantedError: errSvcRollbackCancelled,
+		},
+		"prompts for app and service and confirms the rollback": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.sel.EXPECT().Application(svcAppNamePrompt, wkldAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "test", Name: "api"}, nil)
+				m.history.EXPECT().Get("test", "api", 3).Return(deployment, nil)
+				m.history.EXPECT().Template(deployment).Return("Resources: {}", nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+			wantedSvc:      "api",
+			wantedEnv:      "test",
+			wantedTemplate: "Resources: {}",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcRollbackMocks{
+				store:   mocks.NewMockstore(ctrl),
+				sel:     mocks.NewMockdeploySelector(ctrl),
+				prompt:  mocks.NewMockprompter(ctrl),
+				history: mocks.NewMockdeploymentHistory(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					appName:          tc.inApp,
+					svcName:          tc.inSvc,
+					envName:          tc.inEnv,
+					to:               3,
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				store:  m.store,
+				sel:    m.sel,
+				prompt: m.prompt,
+			}
+			opts.initClient = func() error {
+				opts.history = m.history
+				return nil
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, deployment, opts.deployment)
+			require.Equal(t, tc.wantedTemplate, opts.template)
+		})
+	}
+}
+
+func TestSvcRollback_Execute(t *testing.T) {
+	const signedEnvManifest = `name: test
+type: Environment
+image_verification:
+  tool: cosign
+  key: awskms:///alias/signing
+`
+	testCases := map[string]struct {
+		setupMocks func(m svcRollbackMocks)
+
+		wantedError error
+	}{
+		"errors if an image can't be described": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.images.EXPECT().ImageExists("phonetool/api", "sha256:api").Return(false, errors.New("some error"))
+			},
+			wantedError: errors.New("check if image sha256:api of container api exists: some error"),
+		},
+		"errors if an image is no longer in the repository": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.images.EXPECT().ImageExists("phonetool/api", "sha256:api").Return(true, nil)
+				m.images.EXPECT().ImageExists("phonetool/api", "sha256:nginx").Return(false, nil)
+			},
+			wantedError: errors.New(`image sha256:nginx of container nginx is no longer in repository phonetool/api: deploy the service with "copilot svc deploy" instead`),
+		},
+		"errors if the environment manifest can't be read": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.images.EXPECT().ImageExists(gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+				m.envDescriber.EXPECT().Manifest().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("read the manifest used to deploy environment test: some error"),
+		},
+		"errors if an image isn't signed while the environment requires signed images": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.images.EXPECT().ImageExists(gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+				m.envDescriber.EXPECT().Manifest().Return([]byte(signedEnvManifest), nil)
+				m.signer.EXPECT().Verify(gomock.Any(), signature.ToolCosign, "awskms:///alias/signing", "uri@sha256:api").Return(errors.New("no signatures found"))
+			},
+			wantedError: errors.New("environment test only accepts signed images: image of container api: no signatures found"),
+		},
+		"errors if the stack can't be deployed": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.images.EXPECT().ImageExists(gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+				m.envDescriber.EXPECT().Manifest().Return([]byte(signedEnvManifest), nil)
+				m.signer.EXPECT().Verify(gomock.Any(), signature.ToolCosign, "awskms:///alias/signing", "uri@sha256:api").Return(nil)
+				m.signer.EXPECT().Verify(gomock.Any(), signature.ToolCosign, "awskms:///alias/signing", "uri@sha256:nginx").Return(nil)
+				m.deployer.EXPECT().DeployService(gomock.Any(), "bucket", false, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("roll back service api in environment test to deployment #3: some error"),
+		},
+		"returns errNoInfrastructureChanges if the deployment is already deployed": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.images.EXPECT().ImageExists(gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+				m.envDescriber.EXPECT().Manifest().Return([]byte("name: test\ntype: Environment\n"), nil)
+				m.deployer.EXPECT().DeployService(gomock.Any(), "bucket", false, gomock.Any()).
+					Return(awscfn.NewMockErrChangeSetEmpty())
+			},
+			wantedError: &errNoInfrastructureChanges{parentErr: awscfn.NewMockErrChangeSetEmpty()},
+		},
+		"succeeds even if the rollback can't be recorded": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.images.EXPECT().ImageExists(gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+				m.envDescriber.EXPECT().Manifest().Return([]byte("name: test\ntype: Environment\n"), nil)
+				m.deployer.EXPECT().DeployService(gomock.Any(), "bucket", false, gomock.Any()).Return(nil)
+				m.caller.EXPECT().Get().Return(identity.Caller{}, errors.New("some error"))
+			},
+		},
+		"records the rollback as a new deployment": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.images.EXPECT().ImageExists(gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+				m.envDescriber.EXPECT().Manifest().Return([]byte("name: test\ntype: Environment\n"), nil)
+				m.deployer.EXPECT().DeployService(gomock.Any(), "bucket", false, gomock.Any()).Return(nil)
+				m.caller.EXPECT().Get().Return(identity.Caller{ARN: "arn:aws:iam::123456789012:user/alice"}, nil)
+				m.history.EXPECT().Record(gomock.Any()).DoAndReturn(func(d *deploy.WorkloadDeployment) error {
+					require.Equal(t, 3, d.RollbackOf)
+					require.Equal(t, "arn:aws:iam::123456789012:user/alice", d.DeployedBy)
+					require.Equal(t, "a1b2c3d", d.GitCommit)
+					return nil
+				})
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcRollbackMocks{
+				history:      mocks.NewMockdeploymentHistory(ctrl),
+				caller:       mocks.NewMockidentityService(ctrl),
+				deployer:     mocks.NewMockworkloadStackDeployer(ctrl),
+				images:       mocks.NewMockimageExistenceChecker(ctrl),
+				signer:       mocks.NewMockimageVerifier(ctrl),
+				envDescriber: mocks.NewMockenvDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					appName: "phonetool",
+					svcName: "api",
+					envName: "test",
+					to:      3,
+				},
+				history:      m.history,
+				caller:       m.caller,
+				deployer:     m.deployer,
+				images:       m.images,
+				signer:       m.signer,
+				envDescriber: m.envDescriber,
+				targetEnv:    &config.Environment{Name: "test"},
+				bucketName:   "bucket",
+				deployment: &deploy.WorkloadDeployment{
+					Number:    3,
+					App:       "phonetool",
+					Env:       "test",
+					Name:      "api",
+					GitCommit: "a1b2c3d",
+					Images: map[string]deploy.DeployedImage{
+						"api": {
+							Repository: "uri",
+							URI:        "uri:v1",
+							Digest:     "sha256:api",
+						},
+						"nginx": {
+							Repository: "uri",
+							URI:        "uri:v1-nginx",
+							Digest:     "sha256:nginx",
+						},
+					},
+				},
+				template: "Resources: {}",
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
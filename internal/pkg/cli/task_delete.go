@@ -11,9 +11,6 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/spf13/afero"
 
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
@@ -76,7 +73,7 @@ type deleteTaskOpts struct {
 }
 
 func newDeleteTaskOpts(vars deleteTaskVars) (*deleteTaskOpts, error) {
-	ws, err := workspace.Use(afero.NewOsFs())
+	ws, err := workspace.UseApp(vars.app, afero.NewOsFs())
 	if err != nil {
 		return nil, err
 	}
@@ -87,11 +84,11 @@ func newDeleteTaskOpts(vars deleteTaskVars) (*deleteTaskOpts, error) {
 		return nil, fmt.Errorf("default session: %v", err)
 	}
 
-	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	store := config.NewStore(defaultSess)
 	prompter := prompt.New()
 	return &deleteTaskOpts{
 		deleteTaskVars: vars,
-		wsAppName:      tryReadingAppName(),
+		wsAppName:      tryReadingWorkspaceAppName(vars.app),
 
 		store:    store,
 		spinner:  termprogress.NewSpinner(log.DiagnosticWriter),
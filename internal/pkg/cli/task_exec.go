@@ -6,9 +6,6 @@ package cli
 import (
 	"fmt"
 
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/cmd/copilot/template"
@@ -64,7 +61,7 @@ func newTaskExecOpts(vars taskExecVars) (*taskExecOpts, error) {
 		return nil, fmt.Errorf("default session: %v", err)
 	}
 
-	ssmStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	ssmStore := config.NewStore(defaultSess)
 	prompter := prompt.New()
 	return &taskExecOpts{
 		taskExecVars:     vars,
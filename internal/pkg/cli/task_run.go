@@ -19,8 +19,6 @@ import (
 	"golang.org/x/mod/semver"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 
 	"github.com/spf13/pflag"
 
@@ -196,7 +194,7 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 	}
 
 	prompter := prompt.New()
-	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	store := config.NewStore(defaultSess)
 	opts := runTaskOpts{
 		runTaskVars: vars,
 
@@ -964,11 +962,12 @@ func (o *runTaskOpts) buildAndPushImage(uri string) error {
 		Context:    ctx,
 		Tags:       append([]string{imageTagLatest}, additionalTags...),
 	}
-	buildArgsList, err := buildArgs.GenerateDockerBuildArgs(dockerengine.New(exec.NewCmd()))
+	engine := dockerengine.New(exec.NewCmd())
+	buildArgsList, err := buildArgs.GenerateDockerBuildArgs(engine)
 	if err != nil {
 		return fmt.Errorf("generate docker build args: %w", err)
 	}
-	log.Infof("Building your container image: docker %s\n", strings.Join(buildArgsList, " "))
+	log.Infof("Building your container image: %s %s\n", engine.Name(), strings.Join(buildArgsList, " "))
 	if _, err := o.repository.BuildAndPush(context.Background(), buildArgs, log.DiagnosticWriter); err != nil {
 		return fmt.Errorf("build and push image: %w", err)
 	}
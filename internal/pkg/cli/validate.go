@@ -467,6 +467,13 @@ func validateEngine(val interface{}) error {
 	return fmt.Errorf(fmtErrInvalidEngineType, engine, prettify(engineTypes))
 }
 
+func validateRouteName(val interface{}) error {
+	if err := basicNameValidation(val); err != nil {
+		return fmt.Errorf("route name %v is invalid: %w", val, err)
+	}
+	return nil
+}
+
 func validateEnvironmentName(val interface{}) error {
 	if err := basicNameValidation(val); err != nil {
 		return fmt.Errorf("environment name %v is invalid: %w", val, err)
@@ -5,11 +5,8 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 // Application is a named collection of environments and services.
@@ -33,32 +30,28 @@ func (s *Store) CreateApplication(application *Application) error {
 		return fmt.Errorf("serializing application %s: %w", application.Name, err)
 	}
 
-	_, err = s.ssm.PutParameter(&ssm.PutParameterInput{
-		Name:        aws.String(applicationPath),
-		Description: aws.String("Copilot Application"),
-		Type:        aws.String(ssm.ParameterTypeString),
-		Value:       aws.String(data),
-		Tags: []*ssm.Tag{
+	err = s.backend.Put(&PutParameterInput{
+		Name:        applicationPath,
+		Description: "Copilot Application",
+		Value:       data,
+		Tags: []ParameterTag{
 			{
-				Key:   aws.String("copilot-application"),
-				Value: aws.String(application.Name),
+				Key:   "copilot-application",
+				Value: application.Name,
 			},
 		},
 	})
 
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterAlreadyExists:
-				return nil
-			}
+		if errors.Is(err, errParameterAlreadyExists) {
+			return nil
 		}
 		return fmt.Errorf("create application %s: %w", application.Name, err)
 	}
 	return nil
 }
 
-// UpdateApplication updates the data in SSM about an application.
+// UpdateApplication updates the data in the store about an application.
 func (s *Store) UpdateApplication(application *Application) error {
 	applicationPath := fmt.Sprintf(fmtApplicationPath, application.Name)
 	application.Version = schemaVersion
@@ -68,12 +61,11 @@ func (s *Store) UpdateApplication(application *Application) error {
 		return fmt.Errorf("serializing application %s: %w", application.Name, err)
 	}
 
-	if _, err = s.ssm.PutParameter(&ssm.PutParameterInput{
-		Name:        aws.String(applicationPath),
-		Description: aws.String("Copilot Application"),
-		Type:        aws.String(ssm.ParameterTypeString),
-		Value:       aws.String(data),
-		Overwrite:   aws.Bool(true),
+	if err = s.backend.Put(&PutParameterInput{
+		Name:        applicationPath,
+		Description: "Copilot Application",
+		Value:       data,
+		Overwrite:   true,
 	}); err != nil {
 		return fmt.Errorf("update application %s: %w", application.Name, err)
 	}
@@ -83,27 +75,22 @@ func (s *Store) UpdateApplication(application *Application) error {
 // GetApplication fetches an application by name. If it can't be found, return a ErrNoSuchApplication
 func (s *Store) GetApplication(applicationName string) (*Application, error) {
 	applicationPath := fmt.Sprintf(fmtApplicationPath, applicationName)
-	applicationParam, err := s.ssm.GetParameter(&ssm.GetParameterInput{
-		Name: aws.String(applicationPath),
-	})
+	applicationParam, err := s.backend.Get(applicationPath)
 
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterNotFound:
-				account, region := s.getCallerAccountAndRegion()
-				return nil, &ErrNoSuchApplication{
-					ApplicationName: applicationName,
-					AccountID:       account,
-					Region:          region,
-				}
+		if errors.Is(err, errParameterNotFound) {
+			account, region := s.getCallerAccountAndRegion()
+			return nil, &ErrNoSuchApplication{
+				ApplicationName: applicationName,
+				AccountID:       account,
+				Region:          region,
 			}
 		}
 		return nil, fmt.Errorf("get application %s: %w", applicationName, err)
 	}
 
 	var application Application
-	if err := json.Unmarshal([]byte(*applicationParam.Parameter.Value), &application); err != nil {
+	if err := json.Unmarshal([]byte(applicationParam), &application); err != nil {
 		return nil, fmt.Errorf("read configuration for application %s: %w", applicationName, err)
 	}
 	return &application, nil
@@ -118,7 +105,7 @@ func (s *Store) ListApplications() ([]*Application, error) {
 	}
 	for _, serializedApplication := range serializedApplications {
 		var application Application
-		if err := json.Unmarshal([]byte(*serializedApplication), &application); err != nil {
+		if err := json.Unmarshal([]byte(serializedApplication), &application); err != nil {
 			return nil, fmt.Errorf("read application configuration: %w", err)
 		}
 
@@ -127,26 +114,8 @@ func (s *Store) ListApplications() ([]*Application, error) {
 	return applications, nil
 }
 
-// DeleteApplication deletes the SSM parameter related to the application.
+// DeleteApplication deletes the parameter related to the application.
+// If the application does not exist in the store or is successfully deleted then returns nil. Otherwise, returns an error.
 func (s *Store) DeleteApplication(name string) error {
-	paramName := fmt.Sprintf(fmtApplicationPath, name)
-
-	_, err := s.ssm.DeleteParameter(&ssm.DeleteParameterInput{
-		Name: aws.String(paramName),
-	})
-
-	if err != nil {
-		awserr, ok := err.(awserr.Error)
-		if !ok {
-			return err
-		}
-
-		if awserr.Code() == ssm.ErrCodeParameterNotFound {
-			return nil
-		}
-
-		return fmt.Errorf("delete SSM param %s: %w", paramName, awserr)
-	}
-
-	return nil
+	return s.backend.Delete(fmt.Sprintf(fmtApplicationPath, name))
 }
@@ -113,10 +113,10 @@ func TestStore_ListApplications(t *testing.T) {
 			// GIVEN
 			lastPageInPaginatedResp = false
 			store := &Store{
-				ssm: &mockSSM{
+				backend: NewSSMBackend(&mockSSM{
 					t:                       t,
 					mockGetParametersByPath: tc.mockGetParametersByPath,
-				},
+				}),
 			}
 
 			// WHEN
@@ -219,10 +219,10 @@ func TestStore_GetApplication(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
 			store := &Store{
-				ssm: &mockSSM{
+				backend: NewSSMBackend(&mockSSM{
 					t:                t,
 					mockGetParameter: tc.mockGetParameter,
-				},
+				}),
 				sts: mockIdentityService{
 					mockIdentityServiceGet: tc.mockIdentityServiceGet,
 				},
@@ -288,10 +288,10 @@ func TestStore_CreateApplication(t *testing.T) {
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			store := &Store{
-				ssm: &mockSSM{
+				backend: NewSSMBackend(&mockSSM{
 					t:                t,
 					mockPutParameter: tc.mockPutParameter,
-				},
+				}),
 			}
 
 			// WHEN
@@ -337,10 +337,10 @@ func TestStore_UpdateApplication(t *testing.T) {
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			store := &Store{
-				ssm: &mockSSM{
+				backend: NewSSMBackend(&mockSSM{
 					t:                t,
 					mockPutParameter: tc.mockPutParameter,
-				},
+				}),
 			}
 
 			// WHEN
@@ -394,10 +394,10 @@ func TestDeleteApplication(t *testing.T) {
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			store := &Store{
-				ssm: &mockSSM{
+				backend: NewSSMBackend(&mockSSM{
 					t:                   t,
 					mockDeleteParameter: test.mockDeleteParameter,
-				},
+				}),
 			}
 
 			got := store.DeleteApplication(mockApplicationName)
@@ -0,0 +1,245 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/spf13/afero"
+)
+
+// Backend types that can hold the configuration of the applications in an account.
+const (
+	BackendTypeSSM      = "ssm"
+	BackendTypeDynamoDB = "dynamodb"
+	BackendTypeFile     = "file"
+)
+
+// BackendTypes are the types of backends that can hold the configuration of the applications.
+var BackendTypes = []string{BackendTypeSSM, BackendTypeDynamoDB, BackendTypeFile}
+
+const (
+	// backendParamPath is the SSM parameter that points the account to a backend other than SSM.
+	backendParamPath = "/copilot/config-store"
+	// EnvFileBackendDir is the environment variable that makes stores read and write files under a local directory
+	// instead of an AWS account.
+	EnvFileBackendDir = "COPILOT_CONFIG_STORE_DIR"
+)
+
+var (
+	errParameterNotFound      = errors.New("parameter not found")
+	errParameterAlreadyExists = errors.New("parameter already exists")
+)
+
+// Backend is a key-value store for the serialized configuration of applications, environments, workloads and routes.
+// Parameter names are paths, such as "/copilot/applications/my-app/environments/test".
+type Backend interface {
+	// Put stores a parameter. It returns an error wrapping errParameterAlreadyExists if the parameter exists and overwrite is false.
+	Put(in *PutParameterInput) error
+	// Get returns the value of a parameter, or an error wrapping errParameterNotFound.
+	Get(name string) (string, error)
+	// List returns the parameters directly under path, which ends with a "/".
+	List(path string) ([]Parameter, error)
+	// Metadata returns the description and tags of a parameter.
+	Metadata(name string) (*ParameterMetadata, error)
+	// Delete removes a parameter. Deleting a parameter that does not exist is not an error.
+	Delete(name string) error
+}
+
+// Parameter is a serialized configuration value stored in a Backend.
+type Parameter struct {
+	Name  string
+	Value string
+}
+
+// ParameterMetadata is the description and tags of a parameter stored in a Backend.
+type ParameterMetadata struct {
+	Description string
+	Tags        []ParameterTag
+}
+
+// PutParameterInput holds the arguments to store a parameter in a Backend.
+type PutParameterInput struct {
+	Name        string
+	Value       string
+	Description string
+	Tags        []ParameterTag
+	Overwrite   bool
+}
+
+// ParameterTag is a key-value pair attached to a parameter, if the backend supports tagging.
+type ParameterTag struct {
+	Key   string
+	Value string
+}
+
+// BackendConfig identifies the backend that holds the configuration of the applications in an account.
+type BackendConfig struct {
+	Type  string `json:"type"`            // One of BackendTypes.
+	Table string `json:"table,omitempty"` // Name of the DynamoDB table for the dynamodb backend.
+	Dir   string `json:"dir,omitempty"`   // Local directory for the file backend.
+}
+
+// NewStore returns a new store for the account and region of the session.
+// The store reads and writes the backend that the account was migrated to with "copilot app migrate-store",
+// and SSM Parameter Store otherwise. If the COPILOT_CONFIG_STORE_DIR environment variable is set,
+// the store reads and writes files under that directory instead.
+func NewStore(sess *session.Session) *Store {
+	region := aws.StringValue(sess.Config.Region)
+	if dir := os.Getenv(EnvFileBackendDir); dir != "" {
+		return NewStoreWithBackend(identity.New(sess), NewFileBackend(afero.NewOsFs(), dir), region)
+	}
+	ssmClient := ssm.New(sess)
+	return NewStoreWithBackend(identity.New(sess), &accountBackend{
+		ssm: ssmClient,
+		newBackend: func(cfg *BackendConfig) (Backend, error) {
+			return NewBackend(sess, cfg)
+		},
+	}, region)
+}
+
+// NewBackend returns the backend described by the configuration.
+func NewBackend(sess *session.Session, cfg *BackendConfig) (Backend, error) {
+	switch cfg.Type {
+	case BackendTypeSSM:
+		return NewSSMBackend(ssm.New(sess)), nil
+	case BackendTypeDynamoDB:
+		if cfg.Table == "" {
+			return nil, errors.New("a table name is required for the dynamodb backend")
+		}
+		return NewDynamoDBBackend(dynamodb.New(sess), cfg.Table), nil
+	case BackendTypeFile:
+		if cfg.Dir == "" {
+			return nil, errors.New("a directory is required for the file backend")
+		}
+		return NewFileBackend(afero.NewOsFs(), cfg.Dir), nil
+	}
+	return nil, fmt.Errorf("unknown backend type %q", cfg.Type)
+}
+
+// GetBackendConfig returns the backend that holds the configuration of the applications in the account.
+func GetBackendConfig(client SSM) (*BackendConfig, error) {
+	out, err := NewSSMBackend(client).Get(backendParamPath)
+	if errors.Is(err, errParameterNotFound) {
+		return &BackendConfig{
+			Type: BackendTypeSSM,
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get config store backend: %w", err)
+	}
+	var cfg BackendConfig
+	if err := json.Unmarshal([]byte(out), &cfg); err != nil {
+		return nil, fmt.Errorf("read config store backend from %s: %w", backendParamPath, err)
+	}
+	return &cfg, nil
+}
+
+// SetBackendConfig points the account to a backend that holds the configuration of its applications.
+// Local file backends only apply to a single machine, so the account cannot point to them.
+func SetBackendConfig(client SSM, cfg *BackendConfig) error {
+	backend := NewSSMBackend(client)
+	switch cfg.Type {
+	case BackendTypeSSM:
+		if err := backend.Delete(backendParamPath); err != nil {
+			return fmt.Errorf("delete config store backend parameter %s: %w", backendParamPath, err)
+		}
+		return nil
+	case BackendTypeFile:
+		return fmt.Errorf("cannot point the account to the local %s backend", BackendTypeFile)
+	}
+	data, err := marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("serialize config store backend: %w", err)
+	}
+	if err := backend.Put(&PutParameterInput{
+		Name:        backendParamPath,
+		Description: "Copilot configuration store backend",
+		Value:       data,
+		Overwrite:   true,
+	}); err != nil {
+		return fmt.Errorf("put config store backend parameter %s: %w", backendParamPath, err)
+	}
+	return nil
+}
+
+// accountBackend delegates to the backend that the account points to, which is looked up once on first use.
+type accountBackend struct {
+	ssm        SSM
+	newBackend func(cfg *BackendConfig) (Backend, error)
+
+	once       sync.Once
+	backend    Backend
+	backendErr error
+}
+
+func (b *accountBackend) resolve() (Backend, error) {
+	b.once.Do(func() {
+		cfg, err := GetBackendConfig(b.ssm)
+		if err != nil {
+			b.backendErr = err
+			return
+		}
+		if cfg.Type == BackendTypeSSM {
+			b.backend = NewSSMBackend(b.ssm)
+			return
+		}
+		b.backend, b.backendErr = b.newBackend(cfg)
+	})
+	return b.backend, b.backendErr
+}
+
+// Put stores a parameter in the backend of the account.
+func (b *accountBackend) Put(in *PutParameterInput) error {
+	backend, err := b.resolve()
+	if err != nil {
+		return err
+	}
+	return backend.Put(in)
+}
+
+// Get returns the value of a parameter from the backend of the account.
+func (b *accountBackend) Get(name string) (string, error) {
+	backend, err := b.resolve()
+	if err != nil {
+		return "", err
+	}
+	return backend.Get(name)
+}
+
+// List returns the parameters directly under path from the backend of the account.
+func (b *accountBackend) List(path string) ([]Parameter, error) {
+	backend, err := b.resolve()
+	if err != nil {
+		return nil, err
+	}
+	return backend.List(path)
+}
+
+// Metadata returns the description and tags of a parameter from the backend of the account.
+func (b *accountBackend) Metadata(name string) (*ParameterMetadata, error) {
+	backend, err := b.resolve()
+	if err != nil {
+		return nil, err
+	}
+	return backend.Metadata(name)
+}
+
+// Delete removes a parameter from the backend of the account.
+func (b *accountBackend) Delete(name string) error {
+	backend, err := b.resolve()
+	if err != nil {
+		return err
+	}
+	return backend.Delete(name)
+}
@@ -0,0 +1,149 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBackendConfig(t *testing.T) {
+	testCases := map[string]struct {
+		mockGetParameter func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+
+		wanted    *BackendConfig
+		wantedErr string
+	}{
+		"defaults to SSM if the account does not point to a backend": {
+			mockGetParameter: func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				require.Equal(t, "/copilot/config-store", aws.StringValue(in.Name))
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil)
+			},
+			wanted: &BackendConfig{Type: BackendTypeSSM},
+		},
+		"returns the backend the account points to": {
+			mockGetParameter: func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Value: aws.String(`{"type":"dynamodb","table":"copilot-config"}`),
+					},
+				}, nil
+			},
+			wanted: &BackendConfig{Type: BackendTypeDynamoDB, Table: "copilot-config"},
+		},
+		"errors on a malformed parameter": {
+			mockGetParameter: func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Value: aws.String("oops"),
+					},
+				}, nil
+			},
+			wantedErr: "read config store backend from /copilot/config-store: invalid character 'o' looking for beginning of value",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetBackendConfig(&mockSSM{
+				t:                t,
+				mockGetParameter: tc.mockGetParameter,
+			})
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestSetBackendConfig(t *testing.T) {
+	t.Run("points the account to a DynamoDB table", func(t *testing.T) {
+		err := SetBackendConfig(&mockSSM{
+			t: t,
+			mockPutParameter: func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, "/copilot/config-store", aws.StringValue(in.Name))
+				require.Equal(t, `{"type":"dynamodb","table":"copilot-config"}`, aws.StringValue(in.Value))
+				require.True(t, aws.BoolValue(in.Overwrite))
+				return &ssm.PutParameterOutput{}, nil
+			},
+		}, &BackendConfig{Type: BackendTypeDynamoDB, Table: "copilot-config"})
+		require.NoError(t, err)
+	})
+	t.Run("points the account back to SSM", func(t *testing.T) {
+		err := SetBackendConfig(&mockSSM{
+			t: t,
+			mockDeleteParameter: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				require.Equal(t, "/copilot/config-store", aws.StringValue(in.Name))
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil)
+			},
+		}, &BackendConfig{Type: BackendTypeSSM})
+		require.NoError(t, err)
+	})
+	t.Run("cannot point the account to a local directory", func(t *testing.T) {
+		err := SetBackendConfig(&mockSSM{t: t}, &BackendConfig{Type: BackendTypeFile, Dir: "/tmp"})
+		require.EqualError(t, err, "cannot point the account to the local file backend")
+	})
+}
+
+func TestAccountBackend(t *testing.T) {
+	t.Run("resolves the backend once", func(t *testing.T) {
+		var lookups int
+		fileBackend := NewFileBackend(afero.NewMemMapFs(), "/store")
+		require.NoError(t, fileBackend.Put(&PutParameterInput{Name: "/copilot/applications/phonetool", Value: "phonetool"}))
+		backend := &accountBackend{
+			ssm: &mockSSM{
+				t: t,
+				mockGetParameter: func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+					lookups++
+					return &ssm.GetParameterOutput{
+						Parameter: &ssm.Parameter{
+							Value: aws.String(`{"type":"dynamodb","table":"copilot-config"}`),
+						},
+					}, nil
+				},
+			},
+			newBackend: func(cfg *BackendConfig) (Backend, error) {
+				require.Equal(t, "copilot-config", cfg.Table)
+				return fileBackend, nil
+			},
+		}
+
+		got, err := backend.Get("/copilot/applications/phonetool")
+		require.NoError(t, err)
+		require.Equal(t, "phonetool", got)
+		params, err := backend.List("/copilot/applications/")
+		require.NoError(t, err)
+		require.Len(t, params, 1)
+		require.Equal(t, 1, lookups)
+	})
+	t.Run("uses SSM if the account does not point to a backend", func(t *testing.T) {
+		backend := &accountBackend{
+			ssm: &mockSSM{
+				t: t,
+				mockGetParameter: func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+					if aws.StringValue(in.Name) == "/copilot/config-store" {
+						return nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil)
+					}
+					return &ssm.GetParameterOutput{
+						Parameter: &ssm.Parameter{
+							Value: aws.String("phonetool"),
+						},
+					}, nil
+				},
+			},
+		}
+
+		got, err := backend.Get("/copilot/applications/phonetool")
+		require.NoError(t, err)
+		require.Equal(t, "phonetool", got)
+	})
+}
@@ -0,0 +1,188 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// Attributes of the items in the DynamoDB table of a DynamoDBBackend.
+// The table has a partition key "path" and a sort key "name", both strings.
+const (
+	dynamoDBPathAttr        = "path"
+	dynamoDBNameAttr        = "name"
+	dynamoDBValueAttr       = "value"
+	dynamoDBDescriptionAttr = "description"
+	dynamoDBTagsAttr        = "tags"
+)
+
+// DynamoDB is the interface for the AWS DynamoDB client.
+type DynamoDB interface {
+	PutItem(in *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error)
+	GetItem(in *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error)
+	Query(in *dynamodb.QueryInput) (*dynamodb.QueryOutput, error)
+	DeleteItem(in *dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error)
+}
+
+// DynamoDBBackend stores parameters as items of a DynamoDB table.
+// Each parameter is partitioned by its parent path, so that listing the parameters under a path is a single query.
+type DynamoDBBackend struct {
+	client DynamoDB
+	table  string
+}
+
+// NewDynamoDBBackend returns a backend that stores parameters in the DynamoDB table.
+func NewDynamoDBBackend(client DynamoDB, table string) *DynamoDBBackend {
+	return &DynamoDBBackend{
+		client: client,
+		table:  table,
+	}
+}
+
+// Put stores a parameter as an item of the table.
+func (b *DynamoDBBackend) Put(in *PutParameterInput) error {
+	item := dynamoDBKey(in.Name)
+	item[dynamoDBValueAttr] = &dynamodb.AttributeValue{S: aws.String(in.Value)}
+	if in.Description != "" {
+		item[dynamoDBDescriptionAttr] = &dynamodb.AttributeValue{S: aws.String(in.Description)}
+	}
+	if len(in.Tags) > 0 {
+		tags := make(map[string]*dynamodb.AttributeValue, len(in.Tags))
+		for _, tag := range in.Tags {
+			tags[tag.Key] = &dynamodb.AttributeValue{S: aws.String(tag.Value)}
+		}
+		item[dynamoDBTagsAttr] = &dynamodb.AttributeValue{M: tags}
+	}
+	input := &dynamodb.PutItemInput{
+		TableName: aws.String(b.table),
+		Item:      item,
+	}
+	if !in.Overwrite {
+		input.ConditionExpression = aws.String("attribute_not_exists(#name)")
+		input.ExpressionAttributeNames = map[string]*string{
+			"#name": aws.String(dynamoDBNameAttr),
+		}
+	}
+	if _, err := b.client.PutItem(input); err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case dynamodb.ErrCodeConditionalCheckFailedException:
+				return fmt.Errorf("put item %s: %w", in.Name, errParameterAlreadyExists)
+			}
+		}
+		return fmt.Errorf("put item %s in table %s: %w", in.Name, b.table, err)
+	}
+	return nil
+}
+
+// Get returns the value of the item for a parameter.
+func (b *DynamoDBBackend) Get(name string) (string, error) {
+	out, err := b.client.GetItem(&dynamodb.GetItemInput{
+		TableName:      aws.String(b.table),
+		Key:            dynamoDBKey(name),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get item %s from table %s: %w", name, b.table, err)
+	}
+	if len(out.Item) == 0 {
+		return "", fmt.Errorf("get item %s: %w", name, errParameterNotFound)
+	}
+	return aws.StringValue(out.Item[dynamoDBValueAttr].S), nil
+}
+
+// List queries the items partitioned under path, following the pagination of the results.
+func (b *DynamoDBBackend) List(path string) ([]Parameter, error) {
+	var params []Parameter
+
+	var startKey map[string]*dynamodb.AttributeValue
+	for {
+		out, err := b.client.Query(&dynamodb.QueryInput{
+			TableName:              aws.String(b.table),
+			KeyConditionExpression: aws.String("#path = :path"),
+			ExpressionAttributeNames: map[string]*string{
+				"#path": aws.String(dynamoDBPathAttr),
+			},
+			ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+				":path": {S: aws.String(path)},
+			},
+			ConsistentRead:    aws.Bool(true),
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("query items under %s in table %s: %w", path, b.table, err)
+		}
+		for _, item := range out.Items {
+			params = append(params, Parameter{
+				Name:  path + aws.StringValue(item[dynamoDBNameAttr].S),
+				Value: aws.StringValue(item[dynamoDBValueAttr].S),
+			})
+		}
+		startKey = out.LastEvaluatedKey
+		if len(startKey) == 0 {
+			break
+		}
+	}
+	return params, nil
+}
+
+// Metadata returns the description and tags stored in the item of a parameter.
+func (b *DynamoDBBackend) Metadata(name string) (*ParameterMetadata, error) {
+	out, err := b.client.GetItem(&dynamodb.GetItemInput{
+		TableName:      aws.String(b.table),
+		Key:            dynamoDBKey(name),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get item %s from table %s: %w", name, b.table, err)
+	}
+	if len(out.Item) == 0 {
+		return nil, fmt.Errorf("get item %s: %w", name, errParameterNotFound)
+	}
+	metadata := &ParameterMetadata{}
+	if description, ok := out.Item[dynamoDBDescriptionAttr]; ok {
+		metadata.Description = aws.StringValue(description.S)
+	}
+	if tags, ok := out.Item[dynamoDBTagsAttr]; ok {
+		keys := make([]string, 0, len(tags.M))
+		for key := range tags.M {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			metadata.Tags = append(metadata.Tags, ParameterTag{
+				Key:   key,
+				Value: aws.StringValue(tags.M[key].S),
+			})
+		}
+	}
+	return metadata, nil
+}
+
+// Delete removes the item of a parameter.
+func (b *DynamoDBBackend) Delete(name string) error {
+	if _, err := b.client.DeleteItem(&dynamodb.DeleteItemInput{
+		TableName: aws.String(b.table),
+		Key:       dynamoDBKey(name),
+	}); err != nil {
+		return fmt.Errorf("delete item %s from table %s: %w", name, b.table, err)
+	}
+	return nil
+}
+
+// dynamoDBKey splits the name of a parameter into its parent path and base name.
+// For example, "/copilot/applications/my-app" is stored under the path "/copilot/applications/" with the name "my-app".
+func dynamoDBKey(name string) map[string]*dynamodb.AttributeValue {
+	i := strings.LastIndex(name, "/")
+	return map[string]*dynamodb.AttributeValue{
+		dynamoDBPathAttr: {S: aws.String(name[:i+1])},
+		dynamoDBNameAttr: {S: aws.String(name[i+1:])},
+	}
+}
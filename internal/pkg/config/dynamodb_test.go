@@ -0,0 +1,254 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamoDBBackend_Put(t *testing.T) {
+	testCases := map[string]struct {
+		overwrite   bool
+		tags        []ParameterTag
+		mockPutItem func(t *testing.T, in *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error)
+
+		wantedErr error
+	}{
+		"puts the item only if it does not exist": {
+			mockPutItem: func(t *testing.T, in *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+				require.Equal(t, &dynamodb.PutItemInput{
+					TableName: aws.String("copilot-config"),
+					Item: map[string]*dynamodb.AttributeValue{
+						"path":        {S: aws.String("/copilot/applications/")},
+						"name":        {S: aws.String("phonetool")},
+						"value":       {S: aws.String(`{"name":"phonetool"}`)},
+						"description": {S: aws.String("Copilot Application")},
+					},
+					ConditionExpression: aws.String("attribute_not_exists(#name)"),
+					ExpressionAttributeNames: map[string]*string{
+						"#name": aws.String("name"),
+					},
+				}, in)
+				return &dynamodb.PutItemOutput{}, nil
+			},
+		},
+		"overwrites the item": {
+			overwrite: true,
+			mockPutItem: func(t *testing.T, in *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+				require.Nil(t, in.ConditionExpression)
+				return &dynamodb.PutItemOutput{}, nil
+			},
+		},
+		"stores the tags of the parameter": {
+			tags: []ParameterTag{
+				{Key: "copilot-application", Value: "phonetool"},
+			},
+			mockPutItem: func(t *testing.T, in *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+				require.Equal(t, &dynamodb.AttributeValue{M: map[string]*dynamodb.AttributeValue{
+					"copilot-application": {S: aws.String("phonetool")},
+				}}, in.Item["tags"])
+				return &dynamodb.PutItemOutput{}, nil
+			},
+		},
+		"returns errParameterAlreadyExists if the condition fails": {
+			mockPutItem: func(t *testing.T, in *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+				return nil, awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "exists", nil)
+			},
+			wantedErr: errParameterAlreadyExists,
+		},
+		"wraps other errors": {
+			mockPutItem: func(t *testing.T, in *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: fmt.Errorf("put item /copilot/applications/phonetool in table copilot-config: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			backend := NewDynamoDBBackend(&mockDynamoDB{
+				t:           t,
+				mockPutItem: tc.mockPutItem,
+			}, "copilot-config")
+
+			err := backend.Put(&PutParameterInput{
+				Name:        "/copilot/applications/phonetool",
+				Value:       `{"name":"phonetool"}`,
+				Description: "Copilot Application",
+				Tags:        tc.tags,
+				Overwrite:   tc.overwrite,
+			})
+
+			switch {
+			case tc.wantedErr == nil:
+				require.NoError(t, err)
+			case errors.Is(tc.wantedErr, errParameterAlreadyExists):
+				require.True(t, errors.Is(err, errParameterAlreadyExists))
+			default:
+				require.EqualError(t, err, tc.wantedErr.Error())
+			}
+		})
+	}
+}
+
+func TestDynamoDBBackend_Metadata(t *testing.T) {
+	testCases := map[string]struct {
+		mockGetItem func(t *testing.T, in *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error)
+
+		wanted    *ParameterMetadata
+		wantedErr error
+	}{
+		"returns the description and tags of the item": {
+			mockGetItem: func(t *testing.T, in *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+				return &dynamodb.GetItemOutput{
+					Item: map[string]*dynamodb.AttributeValue{
+						"description": {S: aws.String("Copilot Application")},
+						"tags": {M: map[string]*dynamodb.AttributeValue{
+							"copilot-environment": {S: aws.String("test")},
+							"copilot-application": {S: aws.String("phonetool")},
+						}},
+					},
+				}, nil
+			},
+			wanted: &ParameterMetadata{
+				Description: "Copilot Application",
+				Tags: []ParameterTag{
+					{Key: "copilot-application", Value: "phonetool"},
+					{Key: "copilot-environment", Value: "test"},
+				},
+			},
+		},
+		"returns errParameterNotFound if there is no item": {
+			mockGetItem: func(t *testing.T, in *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+				return &dynamodb.GetItemOutput{}, nil
+			},
+			wantedErr: errParameterNotFound,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			backend := NewDynamoDBBackend(&mockDynamoDB{
+				t:           t,
+				mockGetItem: tc.mockGetItem,
+			}, "copilot-config")
+
+			got, err := backend.Metadata("/copilot/applications/phonetool")
+
+			if tc.wantedErr != nil {
+				require.ErrorIs(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestDynamoDBBackend_Get(t *testing.T) {
+	testCases := map[string]struct {
+		mockGetItem func(t *testing.T, in *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error)
+
+		wanted    string
+		wantedErr error
+	}{
+		"returns the value of the item": {
+			mockGetItem: func(t *testing.T, in *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+				require.Equal(t, &dynamodb.GetItemInput{
+					TableName: aws.String("copilot-config"),
+					Key: map[string]*dynamodb.AttributeValue{
+						"path": {S: aws.String("/copilot/applications/phonetool/environments/")},
+						"name": {S: aws.String("test")},
+					},
+					ConsistentRead: aws.Bool(true),
+				}, in)
+				return &dynamodb.GetItemOutput{
+					Item: map[string]*dynamodb.AttributeValue{
+						"value": {S: aws.String(`{"name":"test"}`)},
+					},
+				}, nil
+			},
+			wanted: `{"name":"test"}`,
+		},
+		"returns errParameterNotFound if there is no item": {
+			mockGetItem: func(t *testing.T, in *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+				return &dynamodb.GetItemOutput{}, nil
+			},
+			wantedErr: errParameterNotFound,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			backend := NewDynamoDBBackend(&mockDynamoDB{
+				t:           t,
+				mockGetItem: tc.mockGetItem,
+			}, "copilot-config")
+
+			got, err := backend.Get("/copilot/applications/phonetool/environments/test")
+
+			if tc.wantedErr != nil {
+				require.True(t, errors.Is(err, tc.wantedErr))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestDynamoDBBackend_List(t *testing.T) {
+	var calls int
+	backend := NewDynamoDBBackend(&mockDynamoDB{
+		t: t,
+		mockQuery: func(t *testing.T, in *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+			calls++
+			require.Equal(t, "/copilot/applications/", aws.StringValue(in.ExpressionAttributeValues[":path"].S))
+			if calls == 1 {
+				require.Nil(t, in.ExclusiveStartKey)
+				return &dynamodb.QueryOutput{
+					Items: []map[string]*dynamodb.AttributeValue{
+						{"name": {S: aws.String("chatbot")}, "value": {S: aws.String("chatbot")}},
+					},
+					LastEvaluatedKey: map[string]*dynamodb.AttributeValue{
+						"name": {S: aws.String("chatbot")},
+					},
+				}, nil
+			}
+			require.Equal(t, "chatbot", aws.StringValue(in.ExclusiveStartKey["name"].S))
+			return &dynamodb.QueryOutput{
+				Items: []map[string]*dynamodb.AttributeValue{
+					{"name": {S: aws.String("phonetool")}, "value": {S: aws.String("phonetool")}},
+				},
+			}, nil
+		},
+	}, "copilot-config")
+
+	got, err := backend.List("/copilot/applications/")
+
+	require.NoError(t, err)
+	require.Equal(t, []Parameter{
+		{Name: "/copilot/applications/chatbot", Value: "chatbot"},
+		{Name: "/copilot/applications/phonetool", Value: "phonetool"},
+	}, got)
+}
+
+func TestDynamoDBBackend_Delete(t *testing.T) {
+	backend := NewDynamoDBBackend(&mockDynamoDB{
+		t: t,
+		mockDeleteItem: func(t *testing.T, in *dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error) {
+			require.Equal(t, "/copilot/applications/phonetool/routes/", aws.StringValue(in.Key["path"].S))
+			require.Equal(t, "www", aws.StringValue(in.Key["name"].S))
+			return nil, errors.New("some error")
+		},
+	}, "copilot-config")
+
+	err := backend.Delete("/copilot/applications/phonetool/routes/www")
+
+	require.EqualError(t, err, "delete item /copilot/applications/phonetool/routes/www from table copilot-config: some error")
+}
@@ -5,12 +5,9 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
-
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 // Environment represents a deployment environment in an application.
@@ -78,28 +75,24 @@ func (s *Store) CreateEnvironment(environment *Environment) error {
 		return fmt.Errorf("serializing environment %s: %w", environment.Name, err)
 	}
 
-	_, err = s.ssm.PutParameter(&ssm.PutParameterInput{
-		Name:        aws.String(environmentPath),
-		Description: aws.String(fmt.Sprintf("The %s deployment stage", environment.Name)),
-		Type:        aws.String(ssm.ParameterTypeString),
-		Value:       aws.String(data),
-		Tags: []*ssm.Tag{
+	err = s.backend.Put(&PutParameterInput{
+		Name:        environmentPath,
+		Description: fmt.Sprintf("The %s deployment stage", environment.Name),
+		Value:       data,
+		Tags: []ParameterTag{
 			{
-				Key:   aws.String("copilot-application"),
-				Value: aws.String(environment.App),
+				Key:   "copilot-application",
+				Value: environment.App,
 			},
 			{
-				Key:   aws.String("copilot-environment"),
-				Value: aws.String(environment.Name),
+				Key:   "copilot-environment",
+				Value: environment.Name,
 			},
 		},
 	})
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterAlreadyExists:
-				return nil
-			}
+		if errors.Is(err, errParameterAlreadyExists) {
+			return nil
 		}
 		return fmt.Errorf("create environment %s in application %s: %w", environment.Name, environment.App, err)
 	}
@@ -110,25 +103,20 @@ func (s *Store) CreateEnvironment(environment *Environment) error {
 // it returns ErrNoSuchEnvironment.
 func (s *Store) GetEnvironment(appName string, environmentName string) (*Environment, error) {
 	environmentPath := fmt.Sprintf(fmtEnvParamPath, appName, environmentName)
-	environmentParam, err := s.ssm.GetParameter(&ssm.GetParameterInput{
-		Name: aws.String(environmentPath),
-	})
+	environmentParam, err := s.backend.Get(environmentPath)
 
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterNotFound:
-				return nil, &ErrNoSuchEnvironment{
-					ApplicationName: appName,
-					EnvironmentName: environmentName,
-				}
+		if errors.Is(err, errParameterNotFound) {
+			return nil, &ErrNoSuchEnvironment{
+				ApplicationName: appName,
+				EnvironmentName: environmentName,
 			}
 		}
 		return nil, fmt.Errorf("get environment %s in application %s: %w", environmentName, appName, err)
 	}
 
 	var env Environment
-	err = json.Unmarshal([]byte(*environmentParam.Parameter.Value), &env)
+	err = json.Unmarshal([]byte(environmentParam), &env)
 	if err != nil {
 		return nil, fmt.Errorf("read configuration for environment %s in application %s: %w", environmentName, appName, err)
 	}
@@ -146,7 +134,7 @@ func (s *Store) ListEnvironments(appName string) ([]*Environment, error) {
 	}
 	for _, serializedEnv := range serializedEnvs {
 		var env Environment
-		if err := json.Unmarshal([]byte(*serializedEnv), &env); err != nil {
+		if err := json.Unmarshal([]byte(serializedEnv), &env); err != nil {
 			return nil, fmt.Errorf("read environment configuration for application %s: %w", appName, err)
 		}
 
@@ -156,21 +144,11 @@ func (s *Store) ListEnvironments(appName string) ([]*Environment, error) {
 	return environments, nil
 }
 
-// DeleteEnvironment removes an environment from SSM.
+// DeleteEnvironment removes an environment from the store.
 // If the environment does not exist in the store or is successfully deleted then returns nil. Otherwise, returns an error.
 func (s *Store) DeleteEnvironment(appName, environmentName string) error {
 	paramName := fmt.Sprintf(fmtEnvParamPath, appName, environmentName)
-	_, err := s.ssm.DeleteParameter(&ssm.DeleteParameterInput{
-		Name: aws.String(paramName),
-	})
-
-	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterNotFound:
-				return nil
-			}
-		}
+	if err := s.backend.Delete(paramName); err != nil {
 		return fmt.Errorf("delete environment %s from application %s: %w", environmentName, appName, err)
 	}
 	return nil
@@ -122,10 +122,10 @@ func TestStore_ListEnvironments(t *testing.T) {
 			// GIVEN
 			lastPageInPaginatedResp = false
 			store := &Store{
-				ssm: &mockSSM{
+				backend: NewSSMBackend(&mockSSM{
 					t:                       t,
 					mockGetParametersByPath: tc.mockGetParametersByPath,
-				},
+				}),
 			}
 
 			// WHEN
@@ -202,10 +202,10 @@ func TestStore_GetEnvironment(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
 			store := &Store{
-				ssm: &mockSSM{
+				backend: NewSSMBackend(&mockSSM{
 					t:                t,
 					mockGetParameter: tc.mockGetParameter,
-				},
+				}),
 			}
 
 			// WHEN
@@ -320,11 +320,11 @@ func TestStore_CreateEnvironment(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
 			store := &Store{
-				ssm: &mockSSM{
+				backend: NewSSMBackend(&mockSSM{
 					t:                t,
 					mockPutParameter: tc.mockPutParameter,
 					mockGetParameter: tc.mockGetParameter,
-				},
+				}),
 			}
 
 			// WHEN
@@ -382,10 +382,10 @@ func TestStore_DeleteEnvironment(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
 			store := &Store{
-				ssm: &mockSSM{
+				backend: NewSSMBackend(&mockSSM{
 					t:                   t,
 					mockDeleteParameter: tc.mockDeleteParam,
-				},
+				}),
 			}
 
 			// WHEN
@@ -110,3 +110,24 @@ func (e *errNoSuchWorkload) Is(target error) bool {
 func (e *errNoSuchWorkload) Error() string {
 	return fmt.Sprintf("couldn't find %s in the application %s", e.Name, e.App)
 }
+
+// ErrNoSuchRoute means a specific route couldn't be found in a specific application.
+type ErrNoSuchRoute struct {
+	App  string
+	Name string
+}
+
+// Is returns whether the provided error equals this error.
+func (e *ErrNoSuchRoute) Is(target error) bool {
+	t, ok := target.(*ErrNoSuchRoute)
+	if !ok {
+		return false
+	}
+	return e.App == t.App &&
+		e.Name == t.Name
+}
+
+func (e *ErrNoSuchRoute) Error() string {
+	return fmt.Sprintf("couldn't find route %s in the application %s",
+		e.Name, e.App)
+}
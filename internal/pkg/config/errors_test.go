@@ -132,3 +132,34 @@ func TestErrNoSuchJob_Is(t *testing.T) {
 		})
 	}
 }
+
+func TestErrNoSuchRoute(t *testing.T) {
+	err := &ErrNoSuchRoute{Name: "api", App: "cool"}
+	require.EqualError(t, err, "couldn't find route api in the application cool")
+}
+
+func TestErrNoSuchRoute_Is(t *testing.T) {
+	err := &ErrNoSuchRoute{Name: "api", App: "cool"}
+	testCases := map[string]struct {
+		wantedSame bool
+		otherError error
+	}{
+		"errors are same": {
+			wantedSame: true,
+			otherError: &ErrNoSuchRoute{Name: "api", App: "cool"},
+		},
+		"errors have different values": {
+			wantedSame: false,
+			otherError: &ErrNoSuchRoute{Name: "www", App: "cool"},
+		},
+		"errors are different types": {
+			wantedSame: false,
+			otherError: errors.New("something else broke"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, err.Is(tc.otherError), tc.wantedSame)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+const fileBackendExt = ".json"
+
+// FileBackend stores parameters as files under a local directory, for offline development and integration tests.
+// The parameter "/copilot/applications/my-app" is stored in the file "copilot/applications/my-app.json" under the directory.
+type FileBackend struct {
+	fs  afero.Afero
+	dir string
+}
+
+// NewFileBackend returns a backend that stores parameters as files under dir.
+func NewFileBackend(fs afero.Fs, dir string) *FileBackend {
+	return &FileBackend{
+		fs:  afero.Afero{Fs: fs},
+		dir: dir,
+	}
+}
+
+// Put writes a parameter to its file.
+func (b *FileBackend) Put(in *PutParameterInput) error {
+	path := b.path(in.Name)
+	if !in.Overwrite {
+		exists, err := b.fs.Exists(path)
+		if err != nil {
+			return fmt.Errorf("check if file %s exists: %w", path, err)
+		}
+		if exists {
+			return fmt.Errorf("put file %s: %w", path, errParameterAlreadyExists)
+		}
+	}
+	if err := b.fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("create directory for file %s: %w", path, err)
+	}
+	if err := b.fs.WriteFile(path, []byte(in.Value), 0644); err != nil {
+		return fmt.Errorf("write file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Get reads a parameter from its file.
+func (b *FileBackend) Get(name string) (string, error) {
+	path := b.path(name)
+	exists, err := b.fs.Exists(path)
+	if err != nil {
+		return "", fmt.Errorf("check if file %s exists: %w", path, err)
+	}
+	if !exists {
+		return "", fmt.Errorf("read file %s: %w", path, errParameterNotFound)
+	}
+	data, err := b.fs.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read file %s: %w", path, err)
+	}
+	return string(data), nil
+}
+
+// List reads the parameters whose files are directly under the directory of path.
+func (b *FileBackend) List(path string) ([]Parameter, error) {
+	dir := filepath.Join(b.dir, filepath.FromSlash(path))
+	exists, err := b.fs.DirExists(dir)
+	if err != nil {
+		return nil, fmt.Errorf("check if directory %s exists: %w", dir, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	files, err := b.fs.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read directory %s: %w", dir, err)
+	}
+	var params []Parameter
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), fileBackendExt) {
+			continue
+		}
+		data, err := b.fs.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read file %s: %w", filepath.Join(dir, f.Name()), err)
+		}
+		params = append(params, Parameter{
+			Name:  path + strings.TrimSuffix(f.Name(), fileBackendExt),
+			Value: string(data),
+		})
+	}
+	return params, nil
+}
+
+// Metadata returns no description or tags, as the files only hold the values of the parameters.
+func (b *FileBackend) Metadata(name string) (*ParameterMetadata, error) {
+	path := b.path(name)
+	exists, err := b.fs.Exists(path)
+	if err != nil {
+		return nil, fmt.Errorf("check if file %s exists: %w", path, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("read file %s: %w", path, errParameterNotFound)
+	}
+	return &ParameterMetadata{}, nil
+}
+
+// Delete removes the file of a parameter.
+func (b *FileBackend) Delete(name string) error {
+	path := b.path(name)
+	if err := b.fs.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove file %s: %w", path, err)
+	}
+	return nil
+}
+
+func (b *FileBackend) path(name string) string {
+	return filepath.Join(b.dir, filepath.FromSlash(name)+fileBackendExt)
+}
@@ -0,0 +1,107 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileBackend_Put(t *testing.T) {
+	testCases := map[string]struct {
+		existing  string
+		overwrite bool
+
+		wantedErr   error
+		wantedValue string
+	}{
+		"writes a new parameter": {
+			wantedValue: `{"name":"phonetool"}`,
+		},
+		"errors if the parameter exists and overwrite is false": {
+			existing:    "old",
+			wantedErr:   errParameterAlreadyExists,
+			wantedValue: "old",
+		},
+		"overwrites an existing parameter": {
+			existing:    "old",
+			overwrite:   true,
+			wantedValue: `{"name":"phonetool"}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if tc.existing != "" {
+				require.NoError(t, afero.WriteFile(fs, filepath.FromSlash("/store/copilot/applications/phonetool.json"), []byte(tc.existing), 0644))
+			}
+			backend := NewFileBackend(fs, "/store")
+
+			err := backend.Put(&PutParameterInput{
+				Name:      "/copilot/applications/phonetool",
+				Value:     `{"name":"phonetool"}`,
+				Overwrite: tc.overwrite,
+			})
+
+			if tc.wantedErr != nil {
+				require.True(t, errors.Is(err, tc.wantedErr))
+			} else {
+				require.NoError(t, err)
+			}
+			got, err := afero.ReadFile(fs, filepath.FromSlash("/store/copilot/applications/phonetool.json"))
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedValue, string(got))
+		})
+	}
+}
+
+func TestFileBackend_Get(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, filepath.FromSlash("/store/copilot/applications/phonetool.json"), []byte(`{"name":"phonetool"}`), 0644))
+	backend := NewFileBackend(fs, "/store")
+
+	got, err := backend.Get("/copilot/applications/phonetool")
+	require.NoError(t, err)
+	require.Equal(t, `{"name":"phonetool"}`, got)
+
+	_, err = backend.Get("/copilot/applications/chatbot")
+	require.True(t, errors.Is(err, errParameterNotFound))
+}
+
+func TestFileBackend_List(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, filepath.FromSlash("/store/copilot/applications/phonetool.json"), []byte("phonetool"), 0644))
+	require.NoError(t, afero.WriteFile(fs, filepath.FromSlash("/store/copilot/applications/chatbot.json"), []byte("chatbot"), 0644))
+	require.NoError(t, afero.WriteFile(fs, filepath.FromSlash("/store/copilot/applications/phonetool/environments/test.json"), []byte("test"), 0644))
+	require.NoError(t, afero.WriteFile(fs, filepath.FromSlash("/store/copilot/applications/README.md"), []byte("ignored"), 0644))
+	backend := NewFileBackend(fs, "/store")
+
+	got, err := backend.List("/copilot/applications/")
+	require.NoError(t, err)
+	require.Equal(t, []Parameter{
+		{Name: "/copilot/applications/chatbot", Value: "chatbot"},
+		{Name: "/copilot/applications/phonetool", Value: "phonetool"},
+	}, got)
+
+	got, err = backend.List("/copilot/applications/chatbot/environments/")
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestFileBackend_Delete(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, filepath.FromSlash("/store/copilot/applications/phonetool.json"), []byte("phonetool"), 0644))
+	backend := NewFileBackend(fs, "/store")
+
+	require.NoError(t, backend.Delete("/copilot/applications/phonetool"))
+	exists, err := afero.Exists(fs, filepath.FromSlash("/store/copilot/applications/phonetool.json"))
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, backend.Delete("/copilot/applications/phonetool"))
+}
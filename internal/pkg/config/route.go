@@ -0,0 +1,115 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Routing policies supported by a route.
+const (
+	RoutingPolicyLatency  = "latency"
+	RoutingPolicyWeighted = "weighted"
+	RoutingPolicyFailover = "failover"
+)
+
+// RoutingPolicies are the routing policies supported by a route.
+var RoutingPolicies = []string{RoutingPolicyLatency, RoutingPolicyWeighted, RoutingPolicyFailover}
+
+// Route is a hostname that routes traffic to a service deployed in several environments of an application.
+type Route struct {
+	App           string         `json:"app"`           // Name of the app this route belongs to.
+	Name          string         `json:"name"`          // Name of the route, which must be unique within an app.
+	Hostname      string         `json:"hostname"`      // Fully qualified domain name shared by all the environments.
+	Service       string         `json:"service"`       // Name of the service that receives the traffic.
+	RoutingPolicy string         `json:"routingPolicy"` // One of latency, weighted or failover.
+	Targets       []*RouteTarget `json:"targets"`       // Environments that serve the hostname.
+}
+
+// RouteTarget is an environment that serves the hostname of a route.
+type RouteTarget struct {
+	Env     string `json:"env"`               // Name of the environment.
+	Weight  *int   `json:"weight,omitempty"`  // Relative weight of the environment for weighted routing.
+	Primary bool   `json:"primary,omitempty"` // Whether the environment is the primary one for failover routing.
+}
+
+// Envs returns the names of the environments targeted by the route.
+func (r *Route) Envs() []string {
+	envs := make([]string, len(r.Targets))
+	for i, target := range r.Targets {
+		envs[i] = target.Env
+	}
+	return envs
+}
+
+// CreateRoute stores a route within an existing application. If the route already exists, it is overwritten.
+func (s *Store) CreateRoute(route *Route) error {
+	if _, err := s.GetApplication(route.App); err != nil {
+		return err
+	}
+
+	data, err := marshal(route)
+	if err != nil {
+		return fmt.Errorf("serialize data: %w", err)
+	}
+	err = s.backend.Put(&PutParameterInput{
+		Name:        fmt.Sprintf(fmtRouteParamPath, route.App, route.Name),
+		Description: fmt.Sprintf("Copilot route %s", route.Name),
+		Value:       data,
+		Overwrite:   true,
+	})
+	if err != nil {
+		return fmt.Errorf("create route %s in application %s: %w", route.Name, route.App, err)
+	}
+	return nil
+}
+
+// GetRoute gets a route belonging to a particular application by name. If no route is found
+// it returns ErrNoSuchRoute.
+func (s *Store) GetRoute(appName, routeName string) (*Route, error) {
+	param, err := s.backend.Get(fmt.Sprintf(fmtRouteParamPath, appName, routeName))
+	if err != nil {
+		if errors.Is(err, errParameterNotFound) {
+			return nil, &ErrNoSuchRoute{
+				App:  appName,
+				Name: routeName,
+			}
+		}
+		return nil, fmt.Errorf("get route %s in application %s: %w", routeName, appName, err)
+	}
+
+	var route Route
+	if err := json.Unmarshal([]byte(param), &route); err != nil {
+		return nil, fmt.Errorf("read configuration for route %s in application %s: %w", routeName, appName, err)
+	}
+	return &route, nil
+}
+
+// ListRoutes returns all routes belonging to a particular application.
+func (s *Store) ListRoutes(appName string) ([]*Route, error) {
+	serializedRoutes, err := s.listParams(fmt.Sprintf(rootRouteParamPath, appName))
+	if err != nil {
+		return nil, fmt.Errorf("list routes for application %s: %w", appName, err)
+	}
+	var routes []*Route
+	for _, serializedRoute := range serializedRoutes {
+		var route Route
+		if err := json.Unmarshal([]byte(serializedRoute), &route); err != nil {
+			return nil, fmt.Errorf("read route configuration for application %s: %w", appName, err)
+		}
+		routes = append(routes, &route)
+	}
+	return routes, nil
+}
+
+// DeleteRoute removes a route from the store.
+// If the route does not exist in the store or is successfully deleted then returns nil. Otherwise, returns an error.
+func (s *Store) DeleteRoute(appName, routeName string) error {
+	if err := s.backend.Delete(fmt.Sprintf(fmtRouteParamPath, appName, routeName)); err != nil {
+		return fmt.Errorf("delete route %s from application %s: %w", routeName, appName, err)
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_CreateRoute(t *testing.T) {
+	testApplication := Application{Name: "chicken", Version: "1.0"}
+	testApplicationString, err := marshal(testApplication)
+	testApplicationPath := fmt.Sprintf(fmtApplicationPath, testApplication.Name)
+	require.NoError(t, err, "Marshal app should not fail")
+
+	testRoute := Route{
+		App:           testApplication.Name,
+		Name:          "api",
+		Hostname:      "api.example.com",
+		Service:       "frontend",
+		RoutingPolicy: RoutingPolicyFailover,
+		Targets: []*RouteTarget{
+			{Env: "us", Primary: true},
+			{Env: "eu"},
+		},
+	}
+	testRouteString, err := marshal(testRoute)
+	testRoutePath := fmt.Sprintf(fmtRouteParamPath, testRoute.App, testRoute.Name)
+	require.NoError(t, err, "Marshal route should not fail")
+
+	mockGetApplication := func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+		require.Equal(t, testApplicationPath, *param.Name)
+		return &ssm.GetParameterOutput{
+			Parameter: &ssm.Parameter{
+				Name:  aws.String(testApplicationPath),
+				Value: aws.String(testApplicationString),
+			},
+		}, nil
+	}
+	testCases := map[string]struct {
+		mockGetParameter func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+		mockPutParameter func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		wantedErr        error
+	}{
+		"with no existing app": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("get application chicken: some error"),
+		},
+		"with SSM error": {
+			mockGetParameter: mockGetApplication,
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, errors.New("broken")
+			},
+			wantedErr: errors.New("create route api in application chicken: broken"),
+		},
+		"overwrites the route": {
+			mockGetParameter: mockGetApplication,
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, testRoutePath, *param.Name)
+				require.Equal(t, testRouteString, *param.Value)
+				require.True(t, aws.BoolValue(param.Overwrite))
+				return &ssm.PutParameterOutput{
+					Version: aws.Int64(2),
+				}, nil
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				backend: NewSSMBackend(&mockSSM{
+					t:                t,
+					mockPutParameter: tc.mockPutParameter,
+					mockGetParameter: tc.mockGetParameter,
+				}),
+			}
+
+			// WHEN
+			err := store.CreateRoute(&testRoute)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStore_GetRoute(t *testing.T) {
+	testRoute := Route{
+		App:           "chicken",
+		Name:          "api",
+		Hostname:      "api.example.com",
+		Service:       "frontend",
+		RoutingPolicy: RoutingPolicyWeighted,
+		Targets: []*RouteTarget{
+			{Env: "us", Weight: aws.Int(3)},
+			{Env: "eu", Weight: aws.Int(1)},
+		},
+	}
+	testRouteString, err := marshal(testRoute)
+	testRoutePath := fmt.Sprintf(fmtRouteParamPath, testRoute.App, testRoute.Name)
+	require.NoError(t, err, "Marshal route should not fail")
+
+	testCases := map[string]struct {
+		mockGetParameter func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+		wantedRoute      Route
+		wantedErr        error
+	}{
+		"with existing route": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				require.Equal(t, testRoutePath, *param.Name)
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Name:  aws.String(testRoutePath),
+						Value: aws.String(testRouteString),
+					},
+				}, nil
+			},
+			wantedRoute: testRoute,
+		},
+		"with no existing route": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "bloop", nil)
+			},
+			wantedErr: errors.New("couldn't find route api in the application chicken"),
+		},
+		"with malformed json": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Name:  aws.String(testRoutePath),
+						Value: aws.String("oops"),
+					},
+				}, nil
+			},
+			wantedErr: errors.New("read configuration for route api in application chicken: invalid character 'o' looking for beginning of value"),
+		},
+		"with SSM error": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, errors.New("broken")
+			},
+			wantedErr: errors.New("get route api in application chicken: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				backend: NewSSMBackend(&mockSSM{
+					t:                t,
+					mockGetParameter: tc.mockGetParameter,
+				}),
+			}
+
+			// WHEN
+			route, err := store.GetRoute("chicken", "api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedRoute, *route)
+			}
+		})
+	}
+}
+
+func TestStore_ListRoutes(t *testing.T) {
+	apiRoute := Route{App: "chicken", Name: "api", Hostname: "api.example.com", RoutingPolicy: RoutingPolicyLatency}
+	apiRouteString, err := marshal(apiRoute)
+	require.NoError(t, err, "Marshal route should not fail")
+	routesPath := fmt.Sprintf(rootRouteParamPath, "chicken")
+
+	testCases := map[string]struct {
+		mockGetParametersByPath func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error)
+
+		wantedRoutes []*Route
+		wantedErr    error
+	}{
+		"with existing routes": {
+			mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				require.Equal(t, routesPath, *param.Path)
+				return &ssm.GetParametersByPathOutput{
+					Parameters: []*ssm.Parameter{
+						{
+							Name:  aws.String(fmt.Sprintf(fmtRouteParamPath, "chicken", "api")),
+							Value: aws.String(apiRouteString),
+						},
+					},
+				}, nil
+			},
+			wantedRoutes: []*Route{&apiRoute},
+		},
+		"with malformed json": {
+			mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				return &ssm.GetParametersByPathOutput{
+					Parameters: []*ssm.Parameter{
+						{
+							Name:  aws.String(fmt.Sprintf(fmtRouteParamPath, "chicken", "api")),
+							Value: aws.String("oops"),
+						},
+					},
+				}, nil
+			},
+			wantedErr: errors.New("read route configuration for application chicken: invalid character 'o' looking for beginning of value"),
+		},
+		"with SSM error": {
+			mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				return nil, errors.New("broken")
+			},
+			wantedErr: errors.New("list routes for application chicken: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				backend: NewSSMBackend(&mockSSM{
+					t:                       t,
+					mockGetParametersByPath: tc.mockGetParametersByPath,
+				}),
+			}
+
+			// WHEN
+			routes, err := store.ListRoutes("chicken")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedRoutes, routes)
+			}
+		})
+	}
+}
+
+func TestDeleteRoute(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		mockDeleteParam func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+
+		want error
+	}{
+		"parameter is already deleted": {
+			mockDeleteParam: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "Not found", nil)
+			},
+		},
+		"unexpected error": {
+			mockDeleteParam: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				return nil, mockError
+			},
+			want: fmt.Errorf("delete route api from application chicken: %w", mockError),
+		},
+		"successfully deleted param": {
+			mockDeleteParam: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				require.Equal(t, fmt.Sprintf(fmtRouteParamPath, "chicken", "api"), *in.Name)
+				return nil, nil
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := &Store{
+				backend: NewSSMBackend(&mockSSM{
+					t:                   t,
+					mockDeleteParameter: test.mockDeleteParam,
+				}),
+			}
+
+			got := s.DeleteRoute("chicken", "api")
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
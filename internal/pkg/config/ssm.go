@@ -0,0 +1,173 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+// SSMBackend stores parameters in SSM Parameter Store.
+type SSMBackend struct {
+	ssm SSM
+}
+
+// NewSSMBackend returns a backend that stores parameters in SSM Parameter Store.
+func NewSSMBackend(ssm SSM) *SSMBackend {
+	return &SSMBackend{
+		ssm: ssm,
+	}
+}
+
+// Put stores a parameter as a String SSM parameter.
+func (b *SSMBackend) Put(in *PutParameterInput) error {
+	input := &ssm.PutParameterInput{
+		Name:        aws.String(in.Name),
+		Description: aws.String(in.Description),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(in.Value),
+	}
+	if in.Overwrite {
+		// SSM rejects tags on parameters that are overwritten, so they're added afterwards.
+		input.Overwrite = aws.Bool(true)
+	} else {
+		for _, tag := range in.Tags {
+			input.Tags = append(input.Tags, &ssm.Tag{
+				Key:   aws.String(tag.Key),
+				Value: aws.String(tag.Value),
+			})
+		}
+	}
+	if _, err := b.ssm.PutParameter(input); err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case ssm.ErrCodeParameterAlreadyExists:
+				return fmt.Errorf("put parameter %s: %w", in.Name, errParameterAlreadyExists)
+			}
+		}
+		return err
+	}
+	if !in.Overwrite || len(in.Tags) == 0 {
+		return nil
+	}
+	tags := make([]*ssm.Tag, len(in.Tags))
+	for i, tag := range in.Tags {
+		tags[i] = &ssm.Tag{
+			Key:   aws.String(tag.Key),
+			Value: aws.String(tag.Value),
+		}
+	}
+	if _, err := b.ssm.AddTagsToResource(&ssm.AddTagsToResourceInput{
+		ResourceType: aws.String(ssm.ResourceTypeForTaggingParameter),
+		ResourceId:   aws.String(in.Name),
+		Tags:         tags,
+	}); err != nil {
+		return fmt.Errorf("tag parameter %s: %w", in.Name, err)
+	}
+	return nil
+}
+
+// Get returns the value of an SSM parameter.
+func (b *SSMBackend) Get(name string) (string, error) {
+	out, err := b.ssm.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case ssm.ErrCodeParameterNotFound:
+				return "", fmt.Errorf("get parameter %s: %w", name, errParameterNotFound)
+			}
+		}
+		return "", err
+	}
+	return aws.StringValue(out.Parameter.Value), nil
+}
+
+// List returns the SSM parameters directly under path, following the pagination of the results.
+func (b *SSMBackend) List(path string) ([]Parameter, error) {
+	var params []Parameter
+
+	var nextToken *string
+	for {
+		out, err := b.ssm.GetParametersByPath(&ssm.GetParametersByPathInput{
+			Path:      aws.String(path),
+			Recursive: aws.Bool(false),
+			NextToken: nextToken,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, param := range out.Parameters {
+			params = append(params, Parameter{
+				Name:  aws.StringValue(param.Name),
+				Value: aws.StringValue(param.Value),
+			})
+		}
+
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return params, nil
+}
+
+// Metadata returns the description and tags of an SSM parameter.
+func (b *SSMBackend) Metadata(name string) (*ParameterMetadata, error) {
+	out, err := b.ssm.DescribeParameters(&ssm.DescribeParametersInput{
+		ParameterFilters: []*ssm.ParameterStringFilter{
+			{
+				Key:    aws.String("Name"),
+				Option: aws.String("Equals"),
+				Values: aws.StringSlice([]string{name}),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe parameter %s: %w", name, err)
+	}
+	if len(out.Parameters) == 0 {
+		return nil, fmt.Errorf("describe parameter %s: %w", name, errParameterNotFound)
+	}
+	tags, err := b.ssm.ListTagsForResource(&ssm.ListTagsForResourceInput{
+		ResourceType: aws.String(ssm.ResourceTypeForTaggingParameter),
+		ResourceId:   aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list tags of parameter %s: %w", name, err)
+	}
+	metadata := &ParameterMetadata{
+		Description: aws.StringValue(out.Parameters[0].Description),
+	}
+	for _, tag := range tags.TagList {
+		metadata.Tags = append(metadata.Tags, ParameterTag{
+			Key:   aws.StringValue(tag.Key),
+			Value: aws.StringValue(tag.Value),
+		})
+	}
+	return metadata, nil
+}
+
+// Delete removes an SSM parameter.
+func (b *SSMBackend) Delete(name string) error {
+	_, err := b.ssm.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case ssm.ErrCodeParameterNotFound:
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSMBackend_Put(t *testing.T) {
+	tags := []ParameterTag{
+		{Key: "copilot-application", Value: "phonetool"},
+	}
+	testCases := map[string]struct {
+		overwrite             bool
+		mockPutParameter      func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		mockAddTagsToResource func(t *testing.T, in *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
+
+		wantedErr error
+	}{
+		"creates the parameter with its tags": {
+			mockPutParameter: func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Nil(t, in.Overwrite)
+				require.Equal(t, []*ssm.Tag{{Key: aws.String("copilot-application"), Value: aws.String("phonetool")}}, in.Tags)
+				return &ssm.PutParameterOutput{}, nil
+			},
+		},
+		"tags the parameter after overwriting it": {
+			overwrite: true,
+			mockPutParameter: func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.True(t, aws.BoolValue(in.Overwrite))
+				require.Nil(t, in.Tags)
+				return &ssm.PutParameterOutput{}, nil
+			},
+			mockAddTagsToResource: func(t *testing.T, in *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error) {
+				require.Equal(t, &ssm.AddTagsToResourceInput{
+					ResourceType: aws.String("Parameter"),
+					ResourceId:   aws.String("/copilot/applications/phonetool"),
+					Tags:         []*ssm.Tag{{Key: aws.String("copilot-application"), Value: aws.String("phonetool")}},
+				}, in)
+				return &ssm.AddTagsToResourceOutput{}, nil
+			},
+		},
+		"wraps the error if the overwritten parameter cannot be tagged": {
+			overwrite: true,
+			mockPutParameter: func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return &ssm.PutParameterOutput{}, nil
+			},
+			mockAddTagsToResource: func(t *testing.T, in *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("tag parameter /copilot/applications/phonetool: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			backend := NewSSMBackend(&mockSSM{
+				t:                     t,
+				mockPutParameter:      tc.mockPutParameter,
+				mockAddTagsToResource: tc.mockAddTagsToResource,
+			})
+
+			err := backend.Put(&PutParameterInput{
+				Name:        "/copilot/applications/phonetool",
+				Value:       `{"name":"phonetool"}`,
+				Description: "Copilot Application",
+				Tags:        tags,
+				Overwrite:   tc.overwrite,
+			})
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSSMBackend_Metadata(t *testing.T) {
+	testCases := map[string]struct {
+		mockDescribeParameters  func(t *testing.T, in *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error)
+		mockListTagsForResource func(t *testing.T, in *ssm.ListTagsForResourceInput) (*ssm.ListTagsForResourceOutput, error)
+
+		wanted    *ParameterMetadata
+		wantedErr error
+	}{
+		"returns the description and tags of the parameter": {
+			mockDescribeParameters: func(t *testing.T, in *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error) {
+				require.Equal(t, []string{"/copilot/applications/phonetool"}, aws.StringValueSlice(in.ParameterFilters[0].Values))
+				return &ssm.DescribeParametersOutput{
+					Parameters: []*ssm.ParameterMetadata{
+						{Description: aws.String("Copilot Application")},
+					},
+				}, nil
+			},
+			mockListTagsForResource: func(t *testing.T, in *ssm.ListTagsForResourceInput) (*ssm.ListTagsForResourceOutput, error) {
+				require.Equal(t, "/copilot/applications/phonetool", aws.StringValue(in.ResourceId))
+				return &ssm.ListTagsForResourceOutput{
+					TagList: []*ssm.Tag{
+						{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+					},
+				}, nil
+			},
+			wanted: &ParameterMetadata{
+				Description: "Copilot Application",
+				Tags: []ParameterTag{
+					{Key: "copilot-application", Value: "phonetool"},
+				},
+			},
+		},
+		"returns errParameterNotFound if the parameter does not exist": {
+			mockDescribeParameters: func(t *testing.T, in *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error) {
+				return &ssm.DescribeParametersOutput{}, nil
+			},
+			wantedErr: errParameterNotFound,
+		},
+		"wraps the error if the tags cannot be listed": {
+			mockDescribeParameters: func(t *testing.T, in *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error) {
+				return &ssm.DescribeParametersOutput{
+					Parameters: []*ssm.ParameterMetadata{{}},
+				}, nil
+			},
+			mockListTagsForResource: func(t *testing.T, in *ssm.ListTagsForResourceInput) (*ssm.ListTagsForResourceOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("list tags of parameter /copilot/applications/phonetool: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			backend := NewSSMBackend(&mockSSM{
+				t:                       t,
+				mockDescribeParameters:  tc.mockDescribeParameters,
+				mockListTagsForResource: tc.mockListTagsForResource,
+			})
+
+			got, err := backend.Metadata("/copilot/applications/phonetool")
+
+			switch {
+			case tc.wantedErr == nil:
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			case errors.Is(tc.wantedErr, errParameterNotFound):
+				require.ErrorIs(t, err, errParameterNotFound)
+			default:
+				require.EqualError(t, err, tc.wantedErr.Error())
+			}
+		})
+	}
+}
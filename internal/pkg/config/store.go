@@ -11,17 +11,18 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
+	"path"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 )
 
-// Parameter name formats for resources in an application. Applications are laid out in SSM
+// Parameter name formats for resources in an application. Applications are laid out in the backend
 // based on path - each parameter's key has a certain format, and you can have
 // hierarchies based on that format. Applications are at the root of the hierarchy.
-// Searching SSM for all parameters with the `rootApplicationPath` key will give you
+// Searching the backend for all parameters with the `rootApplicationPath` key will give you
 // all the application keys, for example.
 
 // current schema Version for Apps.
@@ -35,6 +36,8 @@ const (
 	fmtEnvParamPath     = "/copilot/applications/%s/environments/%s" // path for an environment in an application
 	rootWkldParamPath   = "/copilot/applications/%s/components/"
 	fmtWkldParamPath    = "/copilot/applications/%s/components/%s" // path for a workload in an application
+	rootRouteParamPath  = "/copilot/applications/%s/routes/"
+	fmtRouteParamPath   = "/copilot/applications/%s/routes/%s" // path for a route in an application
 )
 
 // IAMIdentityGetter is the interface to get information about the IAM user or role whose credentials are used to make AWS requests.
@@ -48,48 +51,92 @@ type SSM interface {
 	GetParametersByPath(in *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error)
 	GetParameter(in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
 	DeleteParameter(in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+	DescribeParameters(in *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error)
+	ListTagsForResource(in *ssm.ListTagsForResourceInput) (*ssm.ListTagsForResourceOutput, error)
+	AddTagsToResource(in *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
 }
 
-// Store is in charge of fetching and creating applications, environment, services and other workloads, and pipeline configuration in SSM.
+// Store is in charge of fetching and creating applications, environment, services and other workloads, and pipeline configuration in a Backend.
 type Store struct {
 	sts       IAMIdentityGetter
-	ssm       SSM
+	backend   Backend
 	appRegion string
 }
 
-// NewSSMStore returns a new store, allowing you to query or create Applications, Environments, Services, and other workloads.
+// NewSSMStore returns a new store backed by SSM Parameter Store, allowing you to query or create Applications, Environments, Services, and other workloads.
 func NewSSMStore(sts IAMIdentityGetter, ssm SSM, appRegion string) *Store {
+	return NewStoreWithBackend(sts, NewSSMBackend(ssm), appRegion)
+}
+
+// NewStoreWithBackend returns a new store that reads and writes the configuration in the backend.
+func NewStoreWithBackend(sts IAMIdentityGetter, backend Backend, appRegion string) *Store {
 	return &Store{
 		sts:       sts,
-		ssm:       ssm,
+		backend:   backend,
 		appRegion: appRegion,
 	}
 }
 
-func (s *Store) listParams(path string) ([]*string, error) {
-	var serializedParams []*string
-
-	var nextToken *string
-	for {
-		params, err := s.ssm.GetParametersByPath(&ssm.GetParametersByPathInput{
-			Path:      aws.String(path),
-			Recursive: aws.Bool(false),
-			NextToken: nextToken,
-		})
-
-		if err != nil {
-			return nil, err
+// CopyTo copies the configuration of every application, and of its environments, workloads and routes, to the backend,
+// along with the description and tags of each parameter. Existing parameters in the backend are overwritten.
+// It returns the values that were copied by parameter name. If copied holds the values of a previous copy,
+// only the parameters that changed since are copied, and the parameters that were deleted since are deleted from the backend.
+func (s *Store) CopyTo(dst Backend, copied map[string]string) (map[string]string, error) {
+	apps, err := s.backend.List(rootApplicationPath)
+	if err != nil {
+		return nil, fmt.Errorf("list applications: %w", err)
+	}
+	values := make(map[string]string)
+	for _, app := range apps {
+		params := []Parameter{app}
+		appName := path.Base(app.Name)
+		for _, root := range []string{rootEnvParamPath, rootWkldParamPath, rootRouteParamPath} {
+			children, err := s.backend.List(fmt.Sprintf(root, appName))
+			if err != nil {
+				return nil, fmt.Errorf("list configuration under %s: %w", fmt.Sprintf(root, appName), err)
+			}
+			params = append(params, children...)
 		}
-
-		for _, param := range params.Parameters {
-			serializedParams = append(serializedParams, param.Value)
+		for _, param := range params {
+			values[param.Name] = param.Value
+			if prev, ok := copied[param.Name]; ok && prev == param.Value {
+				continue
+			}
+			metadata, err := s.backend.Metadata(param.Name)
+			if err != nil {
+				return nil, fmt.Errorf("get description and tags of %s: %w", param.Name, err)
+			}
+			if err := dst.Put(&PutParameterInput{
+				Name:        param.Name,
+				Value:       param.Value,
+				Description: metadata.Description,
+				Tags:        metadata.Tags,
+				Overwrite:   true,
+			}); err != nil {
+				return nil, fmt.Errorf("copy %s: %w", param.Name, err)
+			}
 		}
-
-		nextToken = params.NextToken
-		if nextToken == nil {
-			break
+	}
+	for name := range copied {
+		if _, ok := values[name]; ok {
+			continue
+		}
+		if err := dst.Delete(name); err != nil {
+			return nil, fmt.Errorf("delete %s: %w", name, err)
 		}
 	}
+	return values, nil
+}
+
+func (s *Store) listParams(path string) ([]string, error) {
+	params, err := s.backend.List(path)
+	if err != nil {
+		return nil, err
+	}
+	serializedParams := make([]string, len(params))
+	for i, param := range params {
+		serializedParams[i] = param.Value
+	}
 	return serializedParams, nil
 }
 
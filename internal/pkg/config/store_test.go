@@ -6,9 +6,12 @@ package config
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
 )
 
 type mockSSM struct {
@@ -18,6 +21,9 @@ type mockSSM struct {
 	mockGetParametersByPath func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error)
 	mockGetParameter        func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
 	mockDeleteParameter     func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+	mockDescribeParameters  func(t *testing.T, param *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error)
+	mockListTagsForResource func(t *testing.T, param *ssm.ListTagsForResourceInput) (*ssm.ListTagsForResourceOutput, error)
+	mockAddTagsToResource   func(t *testing.T, param *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
 }
 
 func (m *mockSSM) PutParameter(in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
@@ -36,6 +42,42 @@ func (m *mockSSM) DeleteParameter(in *ssm.DeleteParameterInput) (*ssm.DeletePara
 	return m.mockDeleteParameter(m.t, in)
 }
 
+func (m *mockSSM) DescribeParameters(in *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error) {
+	return m.mockDescribeParameters(m.t, in)
+}
+
+func (m *mockSSM) ListTagsForResource(in *ssm.ListTagsForResourceInput) (*ssm.ListTagsForResourceOutput, error) {
+	return m.mockListTagsForResource(m.t, in)
+}
+
+func (m *mockSSM) AddTagsToResource(in *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error) {
+	return m.mockAddTagsToResource(m.t, in)
+}
+
+type mockDynamoDB struct {
+	t              *testing.T
+	mockPutItem    func(t *testing.T, in *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error)
+	mockGetItem    func(t *testing.T, in *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error)
+	mockQuery      func(t *testing.T, in *dynamodb.QueryInput) (*dynamodb.QueryOutput, error)
+	mockDeleteItem func(t *testing.T, in *dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error)
+}
+
+func (m *mockDynamoDB) PutItem(in *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+	return m.mockPutItem(m.t, in)
+}
+
+func (m *mockDynamoDB) GetItem(in *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+	return m.mockGetItem(m.t, in)
+}
+
+func (m *mockDynamoDB) Query(in *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+	return m.mockQuery(m.t, in)
+}
+
+func (m *mockDynamoDB) DeleteItem(in *dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error) {
+	return m.mockDeleteItem(m.t, in)
+}
+
 type mockIdentityService struct {
 	mockIdentityServiceGet func() (identity.Caller, error)
 }
@@ -43,3 +85,92 @@ type mockIdentityService struct {
 func (m mockIdentityService) Get() (identity.Caller, error) {
 	return m.mockIdentityServiceGet()
 }
+
+// taggedFileBackend is a FileBackend that also holds the description and tags of its parameters.
+type taggedFileBackend struct {
+	*FileBackend
+	metadata map[string]*ParameterMetadata
+	puts     []string
+}
+
+func newTaggedFileBackend(dir string) *taggedFileBackend {
+	return &taggedFileBackend{
+		FileBackend: NewFileBackend(afero.NewMemMapFs(), dir),
+		metadata:    make(map[string]*ParameterMetadata),
+	}
+}
+
+func (b *taggedFileBackend) Put(in *PutParameterInput) error {
+	b.puts = append(b.puts, in.Name)
+	b.metadata[in.Name] = &ParameterMetadata{
+		Description: in.Description,
+		Tags:        in.Tags,
+	}
+	return b.FileBackend.Put(in)
+}
+
+func (b *taggedFileBackend) Metadata(name string) (*ParameterMetadata, error) {
+	return b.metadata[name], nil
+}
+
+func TestStore_CopyTo(t *testing.T) {
+	appTags := []ParameterTag{
+		{Key: "copilot-application", Value: "phonetool"},
+	}
+	setup := func() (*taggedFileBackend, *taggedFileBackend) {
+		src := newTaggedFileBackend("/src")
+		for _, in := range []*PutParameterInput{
+			{Name: "/copilot/applications/phonetool", Value: `{"name":"phonetool"}`, Description: "Copilot Application", Tags: appTags},
+			{Name: "/copilot/applications/phonetool/environments/test", Value: `{"name":"test"}`, Description: "The test environment for application phonetool", Tags: appTags},
+			{Name: "/copilot/applications/phonetool/components/api", Value: `{"name":"api"}`, Description: "Copilot Service api", Tags: appTags},
+			{Name: "/copilot/applications/phonetool/routes/www", Value: `{"name":"www"}`, Description: "Copilot route www"},
+		} {
+			require.NoError(t, src.Put(in))
+		}
+		dst := newTaggedFileBackend("/dst")
+		require.NoError(t, dst.Put(&PutParameterInput{Name: "/copilot/applications/phonetool", Value: "stale"}))
+		dst.puts = nil
+		return src, dst
+	}
+
+	t.Run("copies the values, descriptions and tags of the parameters", func(t *testing.T) {
+		src, dst := setup()
+		store := NewStoreWithBackend(nil, src, "us-west-2")
+
+		copied, err := store.CopyTo(dst, nil)
+
+		require.NoError(t, err)
+		wanted := map[string]string{
+			"/copilot/applications/phonetool":                   `{"name":"phonetool"}`,
+			"/copilot/applications/phonetool/environments/test": `{"name":"test"}`,
+			"/copilot/applications/phonetool/components/api":    `{"name":"api"}`,
+			"/copilot/applications/phonetool/routes/www":        `{"name":"www"}`,
+		}
+		require.Equal(t, wanted, copied)
+		for name, value := range wanted {
+			got, err := dst.Get(name)
+			require.NoError(t, err)
+			require.Equal(t, value, got)
+			require.Equal(t, src.metadata[name], dst.metadata[name])
+		}
+	})
+	t.Run("only copies the parameters that changed since a previous copy", func(t *testing.T) {
+		src, dst := setup()
+		store := NewStoreWithBackend(nil, src, "us-west-2")
+		copied, err := store.CopyTo(dst, nil)
+		require.NoError(t, err)
+		dst.puts = nil
+		require.NoError(t, src.Put(&PutParameterInput{Name: "/copilot/applications/phonetool/components/api", Value: `{"name":"api","type":"Backend Service"}`, Overwrite: true}))
+		require.NoError(t, src.Delete("/copilot/applications/phonetool/routes/www"))
+
+		_, err = store.CopyTo(dst, copied)
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"/copilot/applications/phonetool/components/api"}, dst.puts)
+		got, err := dst.Get("/copilot/applications/phonetool/components/api")
+		require.NoError(t, err)
+		require.Equal(t, `{"name":"api","type":"Backend Service"}`, got)
+		_, err = dst.Get("/copilot/applications/phonetool/routes/www")
+		require.ErrorIs(t, err, errParameterNotFound)
+	})
+}
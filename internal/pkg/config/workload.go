@@ -8,9 +8,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 )
 
@@ -50,28 +47,24 @@ func (s *Store) createWorkload(wkld *Workload) error {
 		return fmt.Errorf("serialize data: %w", err)
 	}
 
-	_, err = s.ssm.PutParameter(&ssm.PutParameterInput{
-		Name:        aws.String(wkldPath),
-		Description: aws.String(fmt.Sprintf("Copilot %s %s", wkld.Type, wkld.Name)),
-		Type:        aws.String(ssm.ParameterTypeString),
-		Value:       aws.String(data),
-		Tags: []*ssm.Tag{
+	err = s.backend.Put(&PutParameterInput{
+		Name:        wkldPath,
+		Description: fmt.Sprintf("Copilot %s %s", wkld.Type, wkld.Name),
+		Value:       data,
+		Tags: []ParameterTag{
 			{
-				Key:   aws.String("copilot-application"),
-				Value: aws.String(wkld.App),
+				Key:   "copilot-application",
+				Value: wkld.App,
 			},
 			{
-				Key:   aws.String("copilot-service"),
-				Value: aws.String(wkld.Name),
+				Key:   "copilot-service",
+				Value: wkld.Name,
 			},
 		},
 	})
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterAlreadyExists:
-				return nil
-			}
+		if errors.Is(err, errParameterAlreadyExists) {
+			return nil
 		}
 		return err
 	}
@@ -152,22 +145,17 @@ func (s *Store) GetWorkload(appName, name string) (*Workload, error) {
 
 func (s *Store) getWorkloadParam(appName, name string) ([]byte, error) {
 	wlPath := fmt.Sprintf(fmtWkldParamPath, appName, name)
-	wlParam, err := s.ssm.GetParameter(&ssm.GetParameterInput{
-		Name: aws.String(wlPath),
-	})
+	wlParam, err := s.backend.Get(wlPath)
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterNotFound:
-				return nil, &errNoSuchWorkload{
-					App:  appName,
-					Name: name,
-				}
+		if errors.Is(err, errParameterNotFound) {
+			return nil, &errNoSuchWorkload{
+				App:  appName,
+				Name: name,
 			}
 		}
 		return nil, err
 	}
-	return []byte(*wlParam.Parameter.Value), nil
+	return []byte(wlParam), nil
 }
 
 // ListServices returns all services belonging to a particular application.
@@ -224,7 +212,7 @@ func (s *Store) listWorkloads(appName string) ([]*Workload, error) {
 	}
 	for _, serializedWkld := range serializedWklds {
 		var wkld Workload
-		if err := json.Unmarshal([]byte(*serializedWkld), &wkld); err != nil {
+		if err := json.Unmarshal([]byte(serializedWkld), &wkld); err != nil {
 			return nil, err
 		}
 
@@ -233,7 +221,7 @@ func (s *Store) listWorkloads(appName string) ([]*Workload, error) {
 	return workloads, nil
 }
 
-// DeleteService removes a service from SSM.
+// DeleteService removes a service from the store.
 // If the service does not exist in the store or is successfully deleted then returns nil. Otherwise, returns an error.
 func (s *Store) DeleteService(appName, svcName string) error {
 	if err := s.deleteWorkload(appName, svcName); err != nil {
@@ -242,7 +230,7 @@ func (s *Store) DeleteService(appName, svcName string) error {
 	return nil
 }
 
-// DeleteJob removes a job from SSM.
+// DeleteJob removes a job from the store.
 // If the job does not exist in the store or is successfully deleted then returns nil. Otherwise, returns an error.
 func (s *Store) DeleteJob(appName, jobName string) error {
 	if err := s.deleteWorkload(appName, jobName); err != nil {
@@ -252,19 +240,5 @@ func (s *Store) DeleteJob(appName, jobName string) error {
 }
 
 func (s *Store) deleteWorkload(appName, wkldName string) error {
-	paramName := fmt.Sprintf(fmtWkldParamPath, appName, wkldName)
-	_, err := s.ssm.DeleteParameter(&ssm.DeleteParameterInput{
-		Name: aws.String(paramName),
-	})
-
-	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterNotFound:
-				return nil
-			}
-		}
-		return err
-	}
-	return nil
+	return s.backend.Delete(fmt.Sprintf(fmtWkldParamPath, appName, wkldName))
 }
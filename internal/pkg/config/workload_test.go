@@ -113,10 +113,10 @@ func TestStore_ListServices(t *testing.T) {
 			// GIVEN
 			lastPageInPaginatedResp = false
 			store := &Store{
-				ssm: &mockSSM{
+				backend: NewSSMBackend(&mockSSM{
 					t:                       t,
 					mockGetParametersByPath: tc.mockGetParametersByPath,
-				},
+				}),
 			}
 
 			// WHEN
@@ -193,10 +193,10 @@ func TestStore_ListWorkloads(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			//GIVEN
 			store := &Store{
-				ssm: &mockSSM{
+				backend: NewSSMBackend(&mockSSM{
 					t:                       t,
 					mockGetParametersByPath: tc.mockGetParametersByPath,
-				},
+				}),
 			}
 
 			// WHEN
@@ -282,10 +282,10 @@ func TestStore_ListJobs(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			//GIVEN
 			store := &Store{
-				ssm: &mockSSM{
+				backend: NewSSMBackend(&mockSSM{
 					t:                       t,
 					mockGetParametersByPath: tc.mockGetParametersByPath,
-				},
+				}),
 			}
 
 			// WHEN
@@ -359,10 +359,10 @@ func TestStore_GetService(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
 			store := &Store{
-				ssm: &mockSSM{
+				backend: NewSSMBackend(&mockSSM{
 					t:                t,
 					mockGetParameter: tc.mockGetParameter,
-				},
+				}),
 			}
 
 			// WHEN
@@ -434,10 +434,10 @@ func TestStore_GetJob(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
 			store := &Store{
-				ssm: &mockSSM{
+				backend: NewSSMBackend(&mockSSM{
 					t:                t,
 					mockGetParameter: tc.mockGetParameter,
-				},
+				}),
 			}
 
 			// WHEN
@@ -536,11 +536,11 @@ func TestStore_CreateService(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
 			store := &Store{
-				ssm: &mockSSM{
+				backend: NewSSMBackend(&mockSSM{
 					t:                t,
 					mockPutParameter: tc.mockPutParameter,
 					mockGetParameter: tc.mockGetParameter,
-				},
+				}),
 			}
 
 			// WHEN
@@ -592,11 +592,11 @@ func TestDeleteService(t *testing.T) {
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			s := &Store{
-				ssm: &mockSSM{
+				backend: NewSSMBackend(&mockSSM{
 					t: t,
 
 					mockDeleteParameter: test.mockDeleteParam,
-				},
+				}),
 			}
 
 			got := s.DeleteService(mockApplicationName, mockSvcName)
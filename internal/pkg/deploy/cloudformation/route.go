@@ -0,0 +1,40 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+)
+
+// DeployRoute deploys the records of a route, and renders the deployment to out until it is done.
+// If the route stack doesn't exist, then it creates the stack.
+// If the route stack already exists, it updates the stack.
+// If the route stack doesn't have any changes, it returns nil.
+func (cf CloudFormation) DeployRoute(input *deploy.CreateRouteInput, opts ...cloudformation.StackOption) error {
+	conf := stack.NewRouteStackConfig(input)
+	s, err := toStack(conf)
+	if err != nil {
+		return err
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if err := cf.executeAndRenderChangeSet(cf.newUpsertChangeSetInput(cf.console, s)); err != nil {
+		var errChangeSetEmpty *cloudformation.ErrChangeSetEmpty
+		if !errors.As(err, &errChangeSetEmpty) {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteRoute removes the CloudFormation stack of a route.
+func (cf CloudFormation) DeleteRoute(appName, routeName string) error {
+	return cf.cfnClient.DeleteAndWait(stack.NameForRoute(appName, routeName))
+}
@@ -0,0 +1,39 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+)
+
+func TestCloudFormation_DeployRoute(t *testing.T) {
+	mockRoute := &deploy.CreateRouteInput{
+		App:            "phonetool",
+		Name:           "api",
+		Hostname:       "api.example.com",
+		HostedZoneName: "example.com.",
+		RoutingPolicy:  "latency",
+		Targets: []deploy.RouteTargetInput{
+			{Env: "us", Region: "us-east-1", LoadBalancerDNS: "us.elb.amazonaws.com", LoadBalancerZoneID: "Z1"},
+		},
+	}
+	when := func(cf CloudFormation) error {
+		return cf.DeployRoute(mockRoute)
+	}
+
+	t.Run("returns a wrapped error if creating a change set fails", func(t *testing.T) {
+		testDeployTask_OnCreateChangeSetFailure(t, when)
+	})
+	t.Run("calls Update if stack is already created and returns wrapped error if Update fails", func(t *testing.T) {
+		testDeployTask_OnUpdateChangeSetFailure(t, when)
+	})
+	t.Run("returns nil if the change set is empty when calling Update", func(t *testing.T) {
+		testDeployTask_ReturnNilOnEmptyChangeSetWhileUpdatingStack(t, when)
+	})
+	t.Run("returns an error if stack creation fails", func(t *testing.T) {
+		testDeployTask_StreamUntilStackCreationFails(t, "route-phonetool-api", when)
+	})
+}
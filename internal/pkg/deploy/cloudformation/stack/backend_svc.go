@@ -104,6 +104,10 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for service %s: %w`, s.name, err)
 	}
+	appConfig, err := convertAppConfig(s.manifest.Config, s.rc.AppConfigContents)
+	if err != nil {
+		return "", fmt.Errorf(`convert "config" field for service %s: %w`, s.name, err)
+	}
 
 	advancedCount, err := convertAdvancedCount(s.manifest.Count.AdvancedCount)
 	if err != nil {
@@ -173,6 +177,7 @@ func (s *BackendService) Template() (string, error) {
 		NestedStack:             addonsOutputs,
 		Network:                 convertNetworkConfig(s.manifest.Network),
 		Publish:                 publishers,
+		AppConfig:               appConfig,
 		PermissionsBoundary:     s.permBound,
 		Platform:                convertPlatform(s.manifest.Platform),
 		Storage:                 convertStorageOpts(s.manifest.Name, s.manifest.Storage),
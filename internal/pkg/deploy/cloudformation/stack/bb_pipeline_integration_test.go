@@ -20,8 +20,26 @@ import (
 
 // TestBB_Pipeline_Template ensures that the CloudFormation template generated for a pipeline matches our pre-defined template.
 func TestBB_Pipeline_Template(t *testing.T) {
+	var mfBuild manifest.Build
+	require.NoError(t, yaml.Unmarshal([]byte(`
+image: aws/codebuild/amazonlinux2-x86_64-standard:5.0
+compute:
+  size: large
+timeout: 120
+privileged: false
+cache: s3
+vpc:
+  environment: test
+variables:
+  GOFLAGS: -mod=vendor
+secrets:
+  NPM_TOKEN: /copilot/phonetool/npm-token
+  DB_PASSWORD:
+    secretsmanager: phonetool/test/mysql
+`), &mfBuild))
 	var build deploy.Build
-	build.Init(nil, "copilot/pipelines/phonetool-pipeline/")
+	build.Init(&mfBuild, "copilot/pipelines/phonetool-pipeline/")
+	build.CacheLocation = "fancy-bucket/build-cache/phonetool-pipeline"
 
 	var stage deploy.PipelineStage
 	stage.Init(&config.Environment{
@@ -35,6 +53,23 @@ func TestBB_Pipeline_Template(t *testing.T) {
 		Name:         "test",
 		TestCommands: []string{`echo "test"`},
 	}, []string{"api"})
+	// The production stages are deployed in parallel in other regions and accounts.
+	prodStage := func(region, accountID string) deploy.PipelineStage {
+		var stage deploy.PipelineStage
+		name := region + "-prod"
+		stage.Init(&config.Environment{
+			App:              "phonetool",
+			Name:             name,
+			Region:           region,
+			AccountID:        accountID,
+			ExecutionRoleARN: "arn:aws:iam::" + accountID + ":role/phonetool-" + name + "-CFNExecutionRole",
+			ManagerRoleARN:   "arn:aws:iam::" + accountID + ":role/phonetool-" + name + "-EnvManagerRole",
+		}, &manifest.PipelineStage{
+			Name:         name,
+			TestCommands: []string{`echo "smoke test"`},
+		}, []string{"api"})
+		return stage
+	}
 	ps := stack.NewPipelineStackConfig(&deploy.CreatePipelineInput{
 		AppName: "phonetool",
 		Name:    "phonetool-pipeline",
@@ -45,12 +80,21 @@ func TestBB_Pipeline_Template(t *testing.T) {
 			OutputArtifactFormat: "CODEBUILD_CLONE_REF",
 		},
 		Build:  &build,
-		Stages: []deploy.PipelineStage{stage},
+		Stages: []deploy.PipelineStage{stage, prodStage("eu-west-1", "2222"), prodStage("ap-south-1", "3333")},
+		Waves:  [][]string{{"test"}, {"eu-west-1-prod", "ap-south-1-prod"}},
 		ArtifactBuckets: []deploy.ArtifactBucket{
 			{
 				BucketName: "fancy-bucket",
 				KeyArn:     "arn:aws:kms:us-west-2:1111:key/abcd",
 			},
+			{
+				BucketName: "fancy-bucket-eu-west-1",
+				KeyArn:     "arn:aws:kms:eu-west-1:1111:key/efgh",
+			},
+			{
+				BucketName: "fancy-bucket-ap-south-1",
+				KeyArn:     "arn:aws:kms:ap-south-1:1111:key/ijkl",
+			},
 		},
 		AdditionalTags: nil,
 		Version:        "v1.28.0",
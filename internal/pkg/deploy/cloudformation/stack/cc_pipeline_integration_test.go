@@ -23,7 +23,7 @@ import (
 // TestCC_Pipeline_Template ensures that the CloudFormation template generated for a pipeline matches our pre-defined template.
 func TestCC_Pipeline_Template(t *testing.T) {
 	var build deploy.Build
-	build.Init(nil, "copilot/pipelines/phonetool-pipeline/")
+	build.Init(&manifest.Build{OnlyChanged: true}, "copilot/pipelines/phonetool-pipeline/")
 
 	var stage deploy.PipelineStage
 	stage.Init(&config.Environment{
@@ -36,6 +36,7 @@ func TestCC_Pipeline_Template(t *testing.T) {
 	}, &manifest.PipelineStage{
 		Name:         "staging-test",
 		TestCommands: []string{`echo "test"`},
+		OnFailure:    manifest.PipelineStageOnFailureRollback,
 	}, []string{"api"})
 	ps := stack.NewPipelineStackConfig(&deploy.CreatePipelineInput{
 		AppName: "phonetool",
@@ -48,6 +49,20 @@ func TestCC_Pipeline_Template(t *testing.T) {
 		},
 		Build:  &build,
 		Stages: []deploy.PipelineStage{stage},
+		Previews: &deploy.Previews{
+			BinaryURL:   "https://ecs-cli-v2-release.s3.amazonaws.com/copilot-linux-v1.32.0",
+			Environment: "test",
+		},
+		Notifications: deploy.PipelineNotificationsFromManifest([]manifest.PipelineNotification{
+			{
+				Events:  []string{manifest.PipelineEventApprovalNeeded, manifest.PipelineEventStageFailed},
+				Emails:  []string{"oncall@example.com"},
+				Chatbot: []string{"arn:aws:chatbot::1111:chat-configuration/slack-channel/deploys"},
+			},
+			{
+				SNSTopics: []string{"arn:aws:sns:us-west-2:1111:deploys"},
+			},
+		}),
 		ArtifactBuckets: []deploy.ArtifactBucket{
 			{
 				BucketName: "fancy-bucket",
@@ -45,6 +45,10 @@ type pipelineParser interface {
 	ParsePipeline(data interface{}) (*template.Content, error)
 }
 
+type workflowRoleParser interface {
+	ParseWorkflowRole(data interface{}) (*template.Content, error)
+}
+
 // embedFS is the interface to parse any embedded templates.
 type embedFS interface {
 	backendSvcReadParser
@@ -96,6 +96,7 @@ type EnvConfig struct {
 	ArtifactBucketARN    string                // ARN of the regional application bucket.
 	ArtifactBucketKeyARN string                // ARN of the KMS key used to encrypt the contents in the regional application bucket.
 	PermissionsBoundary  string                // Optional. An IAM Managed Policy name used as permissions boundary for IAM roles.
+	ConfigStoreTableARN  string                // Optional. ARN of the DynamoDB table that holds the configuration of the applications, if the account was migrated to DynamoDB.
 
 	// Runtime configurations.
 	Addons              *Addons
@@ -209,6 +210,7 @@ func (e *Env) Template() (string, error) {
 		ArtifactBucketARN:    e.in.ArtifactBucketARN,
 		ArtifactBucketKeyARN: e.in.ArtifactBucketKeyARN,
 		PermissionsBoundary:  e.in.PermissionsBoundary,
+		ConfigStoreTableARN:  e.in.ConfigStoreTableARN,
 		PublicHTTPConfig:     e.publicHTTPConfig(),
 		VPCConfig:            vpcConfig,
 		PrivateHTTPConfig:    e.privateHTTPConfig(),
@@ -67,14 +67,21 @@ func TestGHPipeline_Template(t *testing.T) {
 		},
 		Build:  &build,
 		Stages: []deploy.PipelineStage{stage},
+		Previews: &deploy.Previews{
+			BinaryURL:         "https://ecs-cli-v2-release.s3.amazonaws.com/copilot-linux-v1.32.0",
+			Environment:       "test",
+			AccessTokenSecret: "github-comments-token",
+			TrustedActors:     []string{"1234567", "7654321"},
+		},
 		ArtifactBuckets: []deploy.ArtifactBucket{
 			{
 				BucketName: "fancy-bucket",
 				KeyArn:     "arn:aws:kms:us-west-2:1111:key/abcd",
 			},
 		},
-		AdditionalTags: nil,
-		Version:        "v1.28.0",
+		AdditionalTags:   nil,
+		ConfigStoreTable: "copilot-config",
+		Version:          "v1.28.0",
 	})
 
 	actual, err := ps.Template()
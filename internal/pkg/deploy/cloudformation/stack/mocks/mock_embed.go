@@ -580,6 +580,44 @@ func (mr *MockpipelineParserMockRecorder) ParsePipeline(data interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParsePipeline", reflect.TypeOf((*MockpipelineParser)(nil).ParsePipeline), data)
 }
 
+// MockworkflowRoleParser is a mock of workflowRoleParser interface.
+type MockworkflowRoleParser struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkflowRoleParserMockRecorder
+}
+
+// MockworkflowRoleParserMockRecorder is the mock recorder for MockworkflowRoleParser.
+type MockworkflowRoleParserMockRecorder struct {
+	mock *MockworkflowRoleParser
+}
+
+// NewMockworkflowRoleParser creates a new mock instance.
+func NewMockworkflowRoleParser(ctrl *gomock.Controller) *MockworkflowRoleParser {
+	mock := &MockworkflowRoleParser{ctrl: ctrl}
+	mock.recorder = &MockworkflowRoleParserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkflowRoleParser) EXPECT() *MockworkflowRoleParserMockRecorder {
+	return m.recorder
+}
+
+// ParseWorkflowRole mocks base method.
+func (m *MockworkflowRoleParser) ParseWorkflowRole(data interface{}) (*template.Content, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseWorkflowRole", data)
+	ret0, _ := ret[0].(*template.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseWorkflowRole indicates an expected call of ParseWorkflowRole.
+func (mr *MockworkflowRoleParserMockRecorder) ParseWorkflowRole(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseWorkflowRole", reflect.TypeOf((*MockworkflowRoleParser)(nil).ParseWorkflowRole), data)
+}
+
 // MockembedFS is a mock of embedFS interface.
 type MockembedFS struct {
 	ctrl     *gomock.Controller
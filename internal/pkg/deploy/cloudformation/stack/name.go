@@ -14,6 +14,14 @@ const (
 
 	// After v1.16, pipeline stack names are namespaced with a prefix of "pipeline-${appName}-".
 	fmtPipelineNamespaced = "pipeline-%s-%s"
+
+	// fmtRouteStackName is the stack name of a route of an application.
+	fmtRouteStackName = "route-%s-%s"
+
+	// workflowRoleSuffix is the suffix of the name of the role assumed by a GitHub Actions or GitLab CI workflow.
+	workflowRoleSuffix = "-WorkflowRole"
+	// IAM role names must be at most 64 characters.
+	maxRoleNameLength = 64
 )
 
 // TaskStackName holds the name of a Copilot one-off task stack.
@@ -65,3 +73,17 @@ func NameForPipeline(app string, pipeline string, isLegacy bool) string {
 	}
 	return fmt.Sprintf(fmtPipelineNamespaced, app, pipeline)
 }
+
+// NameForRoute returns the stack name for a route of an application.
+func NameForRoute(app, route string) string {
+	return fmt.Sprintf(fmtRouteStackName, app, route)
+}
+
+// NameForWorkflowRole returns the name of the IAM role assumed by the GitHub Actions or GitLab CI workflow of a pipeline.
+func NameForWorkflowRole(app, pipeline string) string {
+	name := NameForPipeline(app, pipeline, false)
+	if max := maxRoleNameLength - len(workflowRoleSuffix); len(name) > max {
+		name = name[:max]
+	}
+	return name + workflowRoleSuffix
+}
@@ -39,3 +39,14 @@ func TestNameForAppStackSet(t *testing.T) {
 
 	require.Equal(t, name, "foo-infrastructure")
 }
+
+func TestNameForRoute(t *testing.T) {
+	name := NameForRoute("foo", "api")
+
+	require.Equal(t, name, "route-foo-api")
+}
+
+func TestNameForWorkflowRole(t *testing.T) {
+	require.Equal(t, "pipeline-foo-release-WorkflowRole", NameForWorkflowRole("foo", "release"))
+	require.Equal(t, "pipeline-phonetool-my-very-long-pipeline-name-for-t-WorkflowRole", NameForWorkflowRole("phonetool", "my-very-long-pipeline-name-for-the-frontend"))
+}
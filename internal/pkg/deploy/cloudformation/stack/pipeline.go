@@ -4,12 +4,17 @@
 package stack
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 )
 
+// CodeStar Notifications rule names must be at most 64 characters.
+const maxNotificationRuleNameLength = 64
+
 type pipelineStackConfig struct {
 	*deploy.CreatePipelineInput
 	parser pipelineParser
@@ -29,6 +34,16 @@ func (p *pipelineStackConfig) StackName() string {
 	return NameForPipeline(p.AppName, p.Name, p.IsLegacy)
 }
 
+// NotificationRuleName returns the name of the i-th notification rule of the pipeline.
+func (p *pipelineStackConfig) NotificationRuleName(i int) string {
+	suffix := fmt.Sprintf("-notify-%d", i)
+	name := p.StackName()
+	if max := maxNotificationRuleNameLength - len(suffix); len(name) > max {
+		name = name[:max]
+	}
+	return name + suffix
+}
+
 // Template returns the CloudFormation template for the service parametrized for the environment.
 func (p *pipelineStackConfig) Template() (string, error) {
 	content, err := p.parser.ParsePipeline(p)
@@ -112,6 +112,31 @@ func TestPipelineStackName(t *testing.T) {
 	}
 }
 
+func TestPipelineStackConfig_NotificationRuleName(t *testing.T) {
+	testCases := map[string]struct {
+		inPipelineName string
+		wanted         string
+	}{
+		"short pipeline name": {
+			inPipelineName: "release",
+			wanted:         "pipeline-phonetool-release-notify-1",
+		},
+		"truncate long pipeline names": {
+			inPipelineName: "github-my-organization-my-very-long-repository-name-main",
+			wanted:         "pipeline-phonetool-github-my-organization-my-very-long--notify-1",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			pipeline := NewPipelineStackConfig(&deploy.CreatePipelineInput{
+				AppName: "phonetool",
+				Name:    tc.inPipelineName,
+			})
+			require.Equal(t, tc.wanted, pipeline.NotificationRuleName(1))
+		})
+	}
+}
+
 func TestPipelineStackConfig_Template(t *testing.T) {
 	testCases := map[string]struct {
 		in               *deploy.CreatePipelineInput
@@ -0,0 +1,102 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+const (
+	routeTemplatePath = "app/route.yml"
+
+	defaultRouteWeight = 1
+)
+
+type routeStackConfig struct {
+	*deploy.CreateRouteInput
+	parser template.Parser
+}
+
+type routeTarget struct {
+	Env                string
+	Region             string
+	LoadBalancerDNS    string
+	LoadBalancerZoneID string
+	Weight             int
+	Primary            bool
+}
+
+// NewRouteStackConfig sets up a struct that provides stack configurations for CloudFormation
+// to deploy the records of a route.
+func NewRouteStackConfig(in *deploy.CreateRouteInput) *routeStackConfig {
+	return &routeStackConfig{
+		CreateRouteInput: in,
+		parser:           template.New(),
+	}
+}
+
+// StackName returns the name of the CloudFormation stack for the route.
+func (r *routeStackConfig) StackName() string {
+	return NameForRoute(r.App, r.Name)
+}
+
+// Template returns the route CloudFormation template.
+func (r *routeStackConfig) Template() (string, error) {
+	targets := make([]routeTarget, len(r.Targets))
+	for i, target := range r.Targets {
+		weight := defaultRouteWeight
+		if target.Weight != nil {
+			weight = aws.IntValue(target.Weight)
+		}
+		targets[i] = routeTarget{
+			Env:                target.Env,
+			Region:             target.Region,
+			LoadBalancerDNS:    target.LoadBalancerDNS,
+			LoadBalancerZoneID: target.LoadBalancerZoneID,
+			Weight:             weight,
+			Primary:            target.Primary,
+		}
+	}
+	content, err := r.parser.Parse(routeTemplatePath, struct {
+		Hostname       string
+		HostedZoneName string
+		RoutingPolicy  string
+		Targets        []routeTarget
+	}{
+		Hostname:       r.Hostname,
+		HostedZoneName: r.HostedZoneName,
+		RoutingPolicy:  r.RoutingPolicy,
+		Targets:        targets,
+	}, template.WithFuncs(map[string]interface{}{
+		"logicalIDSafe": template.StripNonAlphaNumFunc,
+	}))
+	if err != nil {
+		return "", fmt.Errorf("read template for route stack: %w", err)
+	}
+	return content.String(), nil
+}
+
+// Parameters returns the parameter values to be passed to the route CloudFormation template.
+func (r *routeStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
+	return nil, nil
+}
+
+// SerializedParameters returns the CloudFormation stack's parameters serialized to a JSON document.
+func (r *routeStackConfig) SerializedParameters() (string, error) {
+	// No-op for now.
+	return "", nil
+}
+
+// Tags returns the tags that should be applied to the route CloudFormation stack.
+func (r *routeStackConfig) Tags() []*cloudformation.Tag {
+	return mergeAndFlattenTags(r.AdditionalTags, map[string]string{
+		deploy.AppTagKey:   r.App,
+		deploy.RouteTagKey: r.Name,
+	})
+}
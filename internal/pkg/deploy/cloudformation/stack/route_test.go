@@ -0,0 +1,132 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestRouteStackConfig_Template(t *testing.T) {
+	testCases := map[string]struct {
+		in *deploy.CreateRouteInput
+
+		wantedRecordSets map[string]map[string]interface{}
+	}{
+		"latency routing": {
+			in: &deploy.CreateRouteInput{
+				App:            "phonetool",
+				Name:           "api",
+				Hostname:       "api.example.com",
+				HostedZoneName: "example.com.",
+				RoutingPolicy:  "latency",
+				Targets: []deploy.RouteTargetInput{
+					{Env: "us-prod", Region: "us-east-1", LoadBalancerDNS: "us.elb.amazonaws.com", LoadBalancerZoneID: "Z1"},
+					{Env: "eu-prod", Region: "eu-west-1", LoadBalancerDNS: "eu.elb.amazonaws.com", LoadBalancerZoneID: "Z2"},
+				},
+			},
+			wantedRecordSets: map[string]map[string]interface{}{
+				"RecordSetusprod": {"Region": "us-east-1", "SetIdentifier": "us-prod"},
+				"RecordSeteuprod": {"Region": "eu-west-1", "SetIdentifier": "eu-prod"},
+			},
+		},
+		"weighted routing defaults missing weights to 1": {
+			in: &deploy.CreateRouteInput{
+				App:            "phonetool",
+				Name:           "api",
+				Hostname:       "api.example.com",
+				HostedZoneName: "example.com.",
+				RoutingPolicy:  "weighted",
+				Targets: []deploy.RouteTargetInput{
+					{Env: "us-prod", Region: "us-east-1", LoadBalancerDNS: "us.elb.amazonaws.com", LoadBalancerZoneID: "Z1", Weight: aws.Int(3)},
+					{Env: "eu-prod", Region: "eu-west-1", LoadBalancerDNS: "eu.elb.amazonaws.com", LoadBalancerZoneID: "Z2"},
+				},
+			},
+			wantedRecordSets: map[string]map[string]interface{}{
+				"RecordSetusprod": {"Weight": 3, "SetIdentifier": "us-prod"},
+				"RecordSeteuprod": {"Weight": 1, "SetIdentifier": "eu-prod"},
+			},
+		},
+		"failover routing": {
+			in: &deploy.CreateRouteInput{
+				App:            "phonetool",
+				Name:           "api",
+				Hostname:       "api.example.com",
+				HostedZoneName: "example.com.",
+				RoutingPolicy:  "failover",
+				Targets: []deploy.RouteTargetInput{
+					{Env: "us-prod", Region: "us-east-1", LoadBalancerDNS: "us.elb.amazonaws.com", LoadBalancerZoneID: "Z1", Primary: true},
+					{Env: "eu-prod", Region: "eu-west-1", LoadBalancerDNS: "eu.elb.amazonaws.com", LoadBalancerZoneID: "Z2"},
+				},
+			},
+			wantedRecordSets: map[string]map[string]interface{}{
+				"RecordSetusprod": {"Failover": "PRIMARY", "SetIdentifier": "us-prod"},
+				"RecordSeteuprod": {"Failover": "SECONDARY", "SetIdentifier": "eu-prod"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			conf := NewRouteStackConfig(tc.in)
+
+			// WHEN
+			tpl, err := conf.Template()
+
+			// THEN
+			require.NoError(t, err)
+			var parsed struct {
+				Resources map[string]struct {
+					Type       string                 `yaml:"Type"`
+					Properties map[string]interface{} `yaml:"Properties"`
+				} `yaml:"Resources"`
+			}
+			require.NoError(t, yaml.Unmarshal([]byte(tpl), &parsed))
+			require.Len(t, parsed.Resources, len(tc.in.Targets))
+			for logicalID, wantedProps := range tc.wantedRecordSets {
+				resource, ok := parsed.Resources[logicalID]
+				require.True(t, ok, "resource %s should exist", logicalID)
+				require.Equal(t, "AWS::Route53::RecordSet", resource.Type)
+				require.Equal(t, "api.example.com.", resource.Properties["Name"])
+				require.Equal(t, "example.com.", resource.Properties["HostedZoneName"])
+				require.Equal(t, true, resource.Properties["AliasTarget"].(map[string]interface{})["EvaluateTargetHealth"])
+				for k, v := range wantedProps {
+					require.Equal(t, v, resource.Properties[k])
+				}
+			}
+		})
+	}
+}
+
+func TestRouteStackConfig_Tags(t *testing.T) {
+	conf := NewRouteStackConfig(&deploy.CreateRouteInput{
+		App:  "phonetool",
+		Name: "api",
+		AdditionalTags: map[string]string{
+			"owner": "boss",
+		},
+	})
+
+	require.Equal(t, "route-phonetool-api", conf.StackName())
+	require.Equal(t, []*cloudformation.Tag{
+		{
+			Key:   aws.String(deploy.AppTagKey),
+			Value: aws.String("phonetool"),
+		},
+		{
+			Key:   aws.String(deploy.RouteTagKey),
+			Value: aws.String("api"),
+		},
+		{
+			Key:   aws.String("owner"),
+			Value: aws.String("boss"),
+		},
+	}, conf.Tags())
+}
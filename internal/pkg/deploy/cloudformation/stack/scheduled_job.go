@@ -152,6 +152,10 @@ func (j *ScheduledJob) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for job %s: %w`, j.name, err)
 	}
+	appConfig, err := convertAppConfig(j.manifest.Config, j.rc.AppConfigContents)
+	if err != nil {
+		return "", fmt.Errorf(`convert "config" field for job %s: %w`, j.name, err)
+	}
 	schedule, err := j.awsSchedule()
 	if err != nil {
 		return "", fmt.Errorf("convert schedule for job %s: %w", j.name, err)
@@ -194,6 +198,7 @@ func (j *ScheduledJob) Template() (string, error) {
 		CredentialsParameter:     aws.StringValue(j.manifest.ImageConfig.Image.Credentials),
 		ServiceDiscoveryEndpoint: j.rc.ServiceDiscoveryEndpoint,
 		Publish:                  publishers,
+		AppConfig:                appConfig,
 		Platform:                 convertPlatform(j.manifest.Platform),
 		EnvVersion:               j.rc.EnvVersion,
 		Version:                  j.rc.Version,
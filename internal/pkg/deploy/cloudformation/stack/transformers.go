@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"hash/crc32"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
 
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template/override"
@@ -46,6 +48,14 @@ const (
 	defaultNLBProtocol     = manifest.TCP
 )
 
+// Default values for autoscaling policies on custom metrics.
+const (
+	defaultScalingMetricStatistic    = "Average"
+	defaultStepScalingPeriod         = 60
+	defaultStepScalingEvalPeriods    = 1
+	defaultStepScalingAdjustmentType = "ChangeInCapacity"
+)
+
 // Supported capacityproviders for Fargate services
 const (
 	capacityProviderFargateSpot = "FARGATE_SPOT"
@@ -60,6 +70,16 @@ const (
 	maxPercentDefault         = 200
 )
 
+// AppConfig configuration profile types and deployment strategy settings.
+const (
+	appConfigProfileTypeFreeform     = "AWS.Freeform"
+	appConfigProfileTypeFeatureFlags = "AWS.AppConfig.FeatureFlags"
+
+	appConfigAllAtOnceGrowthFactor = 100
+	appConfigLinearGrowthFactor    = 20 // Deploy to 20% of the targets at each of the five steps.
+	appConfigLinearDefaultDuration = 10 * time.Minute
+)
+
 var (
 	taskDefOverrideRulePrefixes = []string{"Resources", "TaskDefinition", "Properties"}
 	subnetPlacementForTemplate  = map[manifest.PlacementString]string{
@@ -99,6 +119,9 @@ func convertSidecars(s map[string]*manifest.SidecarConfig, exposedPorts map[stri
 		if uri, hasLocation := config.ImageURI(); hasLocation {
 			imageURI = uri
 		}
+		if pinned, ok := rc.PinnedImages[name]; ok {
+			imageURI = pinned
+		}
 		entrypoint, err := convertEntryPoint(config.EntryPoint)
 		if err != nil {
 			return nil, err
@@ -354,9 +377,100 @@ func convertAutoscaling(a manifest.AdvancedCount) (*template.AutoscalingOpts, er
 			AcceptableBacklogPerTask: acceptableBacklog,
 		}
 	}
+	for _, metric := range a.CustomMetrics {
+		autoscalingOpts.CustomMetrics = append(autoscalingOpts.CustomMetrics, template.AutoscalingCustomMetricOpts{
+			AutoscalingMetricOpts: convertScalingMetric(metric.CloudWatchMetric),
+			TargetValue:           aws.Float64Value(metric.Value),
+			Cooldown:              convertScalingCooldown(metric.Cooldown, a.Cooldown),
+		})
+	}
+	for _, policy := range a.StepScaling {
+		autoscalingOpts.StepScaling = append(autoscalingOpts.StepScaling, convertStepScaling(policy))
+	}
 	return &autoscalingOpts, nil
 }
 
+// convertScalingMetric converts a CloudWatch metric into a format parsable by the templates pkg.
+func convertScalingMetric(m manifest.CloudWatchMetric) template.AutoscalingMetricOpts {
+	opts := template.AutoscalingMetricOpts{
+		Namespace:  aws.StringValue(m.Namespace),
+		MetricName: aws.StringValue(m.MetricName),
+		Statistic:  defaultScalingMetricStatistic,
+	}
+	if m.Statistic != nil {
+		opts.Statistic = aws.StringValue(m.Statistic)
+	}
+	for name, value := range m.Dimensions {
+		opts.Dimensions = append(opts.Dimensions, template.AutoscalingMetricDimension{
+			Name:  name,
+			Value: value,
+		})
+	}
+	sort.Slice(opts.Dimensions, func(i, j int) bool {
+		return opts.Dimensions[i].Name < opts.Dimensions[j].Name
+	})
+	return opts
+}
+
+// convertStepScaling converts a step scaling policy into a format parsable by the templates pkg.
+// Scale-out steps are triggered by an alarm on the lowest "above" threshold, and scale-in steps by an alarm on
+// the highest "below" threshold. The bounds of each step are expressed relative to the alarm threshold.
+func convertStepScaling(s manifest.StepScaling) template.AutoscalingStepScalingOpts {
+	opts := template.AutoscalingStepScalingOpts{
+		AutoscalingMetricOpts: convertScalingMetric(s.CloudWatchMetric),
+		Period:                defaultStepScalingPeriod,
+		EvaluationPeriods:     defaultStepScalingEvalPeriods,
+		AdjustmentType:        defaultStepScalingAdjustmentType,
+	}
+	if s.Period != nil {
+		opts.Period = int64(s.Period.Seconds())
+	}
+	if s.EvaluationPeriods != nil {
+		opts.EvaluationPeriods = aws.IntValue(s.EvaluationPeriods)
+	}
+	if s.AdjustmentType != nil {
+		opts.AdjustmentType = aws.StringValue(s.AdjustmentType)
+	}
+	if s.Cooldown != nil {
+		opts.Cooldown = aws.Int64(int64(s.Cooldown.Seconds()))
+	}
+	if steps := s.ScaleOutSteps(); len(steps) > 0 {
+		threshold := aws.Float64Value(steps[0].Above)
+		alarm := &template.AutoscalingStepAlarmOpts{
+			Threshold: threshold,
+		}
+		for i, step := range steps {
+			adjustment := template.AutoscalingStepAdjustment{
+				LowerBound:        aws.Float64(aws.Float64Value(step.Above) - threshold),
+				ScalingAdjustment: aws.IntValue(step.Adjustment),
+			}
+			if i+1 < len(steps) {
+				adjustment.UpperBound = aws.Float64(aws.Float64Value(steps[i+1].Above) - threshold)
+			}
+			alarm.Adjustments = append(alarm.Adjustments, adjustment)
+		}
+		opts.ScaleOut = alarm
+	}
+	if steps := s.ScaleInSteps(); len(steps) > 0 {
+		threshold := aws.Float64Value(steps[0].Below)
+		alarm := &template.AutoscalingStepAlarmOpts{
+			Threshold: threshold,
+		}
+		for i, step := range steps {
+			adjustment := template.AutoscalingStepAdjustment{
+				UpperBound:        aws.Float64(aws.Float64Value(step.Below) - threshold),
+				ScalingAdjustment: aws.IntValue(step.Adjustment),
+			}
+			if i+1 < len(steps) {
+				adjustment.LowerBound = aws.Float64(aws.Float64Value(steps[i+1].Below) - threshold)
+			}
+			alarm.Adjustments = append(alarm.Adjustments, adjustment)
+		}
+		opts.ScaleIn = alarm
+	}
+	return opts
+}
+
 // convertHTTPHealthCheck converts the ALB health check configuration into a format parsable by the templates pkg.
 func convertHTTPHealthCheck(hc *manifest.HealthCheckArgsOrString) template.HTTPHealthCheckOpts {
 	opts := template.HTTPHealthCheckOpts{
@@ -1072,6 +1186,48 @@ func convertWorkerDeploymentConfig(in manifest.WorkerDeploymentConfig) template.
 	return out
 }
 
+// convertAppConfig converts the manifest "config" section into the AppConfig resources of the workload stack.
+// contents holds the content to host for each configuration profile keyed by profile name.
+func convertAppConfig(in manifest.AppConfig, contents map[string]string) (*template.AppConfigOpts, error) {
+	if in.IsEmpty() {
+		return nil, nil
+	}
+	out := &template.AppConfigOpts{
+		Strategy: template.AppConfigStrategy{
+			GrowthFactor: appConfigAllAtOnceGrowthFactor,
+		},
+	}
+	for _, name := range in.ProfileNames() {
+		profile := in.Profiles[name]
+		content, ok := contents[name]
+		if !ok {
+			return nil, fmt.Errorf("content of configuration profile %q is not available", name)
+		}
+		profileType := appConfigProfileTypeFreeform
+		if profile.IsFeatureFlags() {
+			profileType = appConfigProfileTypeFeatureFlags
+		}
+		out.Profiles = append(out.Profiles, template.AppConfigProfile{
+			Name:        name,
+			Type:        profileType,
+			ContentType: profile.ContentType(),
+			Content:     content,
+		})
+	}
+	if aws.StringValue(in.Deployment.Strategy) == manifest.AppConfigStrategyLinear {
+		duration := appConfigLinearDefaultDuration
+		if in.Deployment.Duration != nil {
+			duration = *in.Deployment.Duration
+		}
+		out.Strategy.GrowthFactor = appConfigLinearGrowthFactor
+		out.Strategy.DurationInMinutes = int(duration.Minutes())
+	}
+	if in.Deployment.BakeTime != nil {
+		out.Strategy.BakeTimeInMinutes = int(in.Deployment.BakeTime.Minutes())
+	}
+	return out, nil
+}
+
 func convertCommand(command manifest.CommandOverride) ([]string, error) {
 	out, err := command.ToStringSlice()
 	if err != nil {
@@ -1115,8 +1271,8 @@ func convertPublish(topics []manifest.Topic, accountID, region, app, env, svc st
 	return &publishers, nil
 }
 
-func convertSubscribe(s *manifest.WorkerService) (*template.SubscribeOpts, error) {
-	if s.Subscribe.Topics == nil {
+func convertSubscribe(s *manifest.WorkerService, addons *template.WorkloadNestedStackOpts, storage subscribableStorage) (*template.SubscribeOpts, error) {
+	if s.Subscribe.Topics == nil && s.Subscribe.Tables == nil && s.Subscribe.Buckets == nil {
 		return nil, nil
 	}
 	var subscriptions template.SubscribeOpts
@@ -1127,10 +1283,158 @@ func convertSubscribe(s *manifest.WorkerService) (*template.SubscribeOpts, error
 		}
 		subscriptions.Topics = append(subscriptions.Topics, ts)
 	}
+	for _, table := range s.Subscribe.Tables {
+		ts, err := convertTableSubscription(table, addons, storage)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions.Tables = append(subscriptions.Tables, ts)
+	}
+	for _, bucket := range s.Subscribe.Buckets {
+		bs, err := convertBucketSubscription(bucket, addons, storage)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions.Buckets = append(subscriptions.Buckets, bs)
+	}
 	subscriptions.Queue = convertQueue(s.Subscribe.Queue)
 	return &subscriptions, nil
 }
 
+// subscribableStorage holds the storage defined in the addons of the workload and of its environment.
+type subscribableStorage struct {
+	wkld *addonsStorage
+	env  *addonsStorage
+}
+
+// addonsStorage holds the outputs and the S3 buckets of an addons template.
+type addonsStorage struct {
+	outputs []string
+	// Whether each S3 bucket, by logical ID, sends its notifications to Amazon EventBridge.
+	eventBridgeBuckets map[string]bool
+}
+
+// parseAddonsStorage returns the storage of the addons, or nil if there are no addons.
+func parseAddonsStorage(addons NestedStackConfigurer) (*addonsStorage, error) {
+	if addons == nil {
+		return nil, nil
+	}
+	tmpl, err := addons.Template()
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("generate addons template: %w", err)
+	case tmpl == "":
+		return nil, nil
+	}
+	outputs, err := addon.Outputs(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	buckets, err := addon.EventBridgeBuckets(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	storage := &addonsStorage{
+		eventBridgeBuckets: buckets,
+	}
+	for _, out := range outputs {
+		storage.outputs = append(storage.outputs, out.Name)
+	}
+	return storage, nil
+}
+
+func (s *addonsStorage) hasOutput(name string) bool {
+	return s != nil && slices.Contains(s.outputs, name)
+}
+
+// convertTableSubscription references the stream of the table from the workload addons if the table is defined there,
+// otherwise from the exports of the environment addons.
+// It returns an error if the table was created by an older "storage init" without a stream.
+func convertTableSubscription(t manifest.TableSubscription, addons *template.WorkloadNestedStackOpts, storage subscribableStorage) (*template.TableSubscription, error) {
+	name := aws.StringValue(t.Name)
+	logicalID := template.StripNonAlphaNumFunc(name)
+	isDefined := storage.wkld.hasOutput(template.EnvVarNameFunc(name)) || storage.env.hasOutput(template.EnvVarNameFunc(name))
+	hasStream := storage.wkld.hasOutput(fmt.Sprintf("%sStreamArn", logicalID)) || storage.env.hasOutput(fmt.Sprintf("%sDynamoDBStreamARN", logicalID))
+	if isDefined && !hasStream {
+		return nil, fmt.Errorf(`table %s does not output the ARN of its stream: delete its addon template and run "copilot storage init" to regenerate it`, name)
+	}
+	streamARN, err := json.Marshal(storageReference(addons,
+		fmt.Sprintf("%sStreamArn", logicalID),
+		fmt.Sprintf("${AppName}-${EnvName}-%sTableStreamArn", logicalID)))
+	if err != nil {
+		return nil, fmt.Errorf("reference the stream of table %s: %w", name, err)
+	}
+	var pattern string
+	if len(t.FilterPattern) != 0 {
+		out, err := json.Marshal(t.FilterPattern)
+		if err != nil {
+			return nil, fmt.Errorf(`convert "filter_pattern" of table %s to a JSON string: %w`, name, err)
+		}
+		pattern = string(out)
+	}
+	return &template.TableSubscription{
+		Name:          name,
+		StreamARN:     string(streamARN),
+		FilterPattern: pattern,
+	}, nil
+}
+
+// convertBucketSubscription scopes the filter pattern to the object-level events of the bucket, referenced from
+// the workload addons if the bucket is defined there, otherwise from the exports of the environment addons.
+// It returns an error if the bucket was created by an older "storage init" without EventBridge notifications.
+func convertBucketSubscription(b manifest.BucketSubscription, addons *template.WorkloadNestedStackOpts, storage subscribableStorage) (*template.BucketSubscription, error) {
+	name := aws.StringValue(b.Name)
+	bucketStorage := storage.env
+	if storage.wkld.hasOutput(template.EnvVarNameFunc(name)) {
+		bucketStorage = storage.wkld
+	}
+	if bucketStorage.hasOutput(template.EnvVarNameFunc(name)) {
+		if enabled, ok := bucketStorage.eventBridgeBuckets[fmt.Sprintf("%sBucket", template.StripNonAlphaNumFunc(name))]; ok && !enabled {
+			return nil, fmt.Errorf(`bucket %s does not send its events to Amazon EventBridge: delete its addon template and run "copilot storage init" to regenerate it`, name)
+		}
+	}
+	pattern := make(map[string]interface{}, len(b.FilterPattern)+1)
+	for k, v := range b.FilterPattern {
+		pattern[k] = v
+	}
+	pattern["source"] = []string{"aws.s3"}
+	detail, ok := pattern["detail"].(map[string]interface{})
+	if !ok {
+		detail = make(map[string]interface{})
+	}
+	detail["bucket"] = map[string]interface{}{
+		"name": []interface{}{
+			storageReference(addons,
+				template.EnvVarNameFunc(name),
+				fmt.Sprintf("${AppName}-${EnvName}-%sBucketName", template.StripNonAlphaNumFunc(name))),
+		},
+	}
+	pattern["detail"] = detail
+	out, err := json.Marshal(pattern)
+	if err != nil {
+		return nil, fmt.Errorf(`convert "filter_pattern" of bucket %s to a JSON string: %w`, name, err)
+	}
+	return &template.BucketSubscription{
+		Name:         name,
+		EventPattern: string(out),
+	}, nil
+}
+
+// storageReference returns the intrinsic function that resolves to the output of the workload addons stack
+// if it exists, otherwise to the value exported by the environment addons.
+func storageReference(addons *template.WorkloadNestedStackOpts, output, export string) map[string]interface{} {
+	if addons != nil && (slices.Contains(addons.VariableOutputs, output) || slices.Contains(addons.TableStreamOutputs, output)) {
+		return map[string]interface{}{
+			"Fn::GetAtt": []string{addons.StackName, fmt.Sprintf("Outputs.%s", output)},
+		}
+	}
+	return map[string]interface{}{
+		"Fn::ImportValue": map[string]string{
+			"Fn::Sub": export,
+		},
+	}
+}
+
 func convertTopicSubscription(t manifest.TopicSubscription) (
 	*template.TopicSubscription, error) {
 	filterPolicy, err := convertFilterPolicy(t.FilterPolicy)
@@ -314,6 +314,31 @@ func Test_convertSidecar(t *testing.T) {
 	}
 }
 
+func Test_convertSidecars_pinnedImages(t *testing.T) {
+	sidecars := map[string]*manifest.SidecarConfig{
+		"nginx": {
+			Image: manifest.Union[*string, manifest.ImageLocationOrBuild]{
+				Basic: aws.String("public.ecr.aws/nginx/nginx:1.25"),
+			},
+		},
+		"xray": {
+			Image: manifest.Union[*string, manifest.ImageLocationOrBuild]{
+				Basic: aws.String("public.ecr.aws/xray/aws-xray-daemon"),
+			},
+		},
+	}
+
+	got, err := convertSidecars(sidecars, nil, RuntimeConfig{
+		PinnedImages: map[string]string{
+			"nginx": "public.ecr.aws/nginx/nginx@sha256:abcdef",
+		},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "public.ecr.aws/nginx/nginx@sha256:abcdef", aws.StringValue(got[0].Image))
+	require.Equal(t, "public.ecr.aws/xray/aws-xray-daemon", aws.StringValue(got[1].Image))
+}
+
 func Test_convertAdvancedCount(t *testing.T) {
 	mockRange := manifest.IntRangeBand("1-10")
 	timeMinute := time.Second * 60
@@ -690,6 +715,110 @@ func Test_convertAutoscaling(t *testing.T) {
 				},
 			},
 		},
+		"success with custom metrics and step scaling": {
+			input: manifest.AdvancedCount{
+				Range: manifest.Range{
+					Value: &mockRange,
+				},
+				Cooldown: manifest.Cooldown{
+					ScaleOutCooldown: &timeMinute,
+				},
+				CustomMetrics: []manifest.CustomMetricScaling{
+					{
+						CloudWatchMetric: manifest.CloudWatchMetric{
+							Namespace:  aws.String("Kafka"),
+							MetricName: aws.String("ConsumerLag"),
+							Dimensions: map[string]string{
+								"Topic":         "orders",
+								"ConsumerGroup": "workers",
+							},
+						},
+						Value: aws.Float64(1000),
+					},
+				},
+				StepScaling: []manifest.StepScaling{
+					{
+						CloudWatchMetric: manifest.CloudWatchMetric{
+							Namespace:  aws.String("MyApp"),
+							MetricName: aws.String("ActiveConnections"),
+							Statistic:  aws.String("Maximum"),
+						},
+						Period:            &timeMinute,
+						EvaluationPeriods: aws.Int(3),
+						Cooldown:          &timeMinute,
+						Steps: []manifest.ScalingStep{
+							{Above: aws.Float64(5000), Adjustment: aws.Int(5)},
+							{Above: aws.Float64(1000), Adjustment: aws.Int(1)},
+							{Below: aws.Float64(100), Adjustment: aws.Int(-1)},
+							{Below: aws.Float64(10), Adjustment: aws.Int(-2)},
+						},
+					},
+				},
+			},
+			wanted: &template.AutoscalingOpts{
+				MaxCapacity: aws.Int(100),
+				MinCapacity: aws.Int(1),
+				CPUCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+				MemCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+				ReqCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+				RespTimeCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+				QueueDelayCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+				CustomMetrics: []template.AutoscalingCustomMetricOpts{
+					{
+						AutoscalingMetricOpts: template.AutoscalingMetricOpts{
+							Namespace:  "Kafka",
+							MetricName: "ConsumerLag",
+							Statistic:  "Average",
+							Dimensions: []template.AutoscalingMetricDimension{
+								{Name: "ConsumerGroup", Value: "workers"},
+								{Name: "Topic", Value: "orders"},
+							},
+						},
+						TargetValue: 1000,
+						Cooldown: template.Cooldown{
+							ScaleOutCooldown: aws.Float64(60),
+						},
+					},
+				},
+				StepScaling: []template.AutoscalingStepScalingOpts{
+					{
+						AutoscalingMetricOpts: template.AutoscalingMetricOpts{
+							Namespace:  "MyApp",
+							MetricName: "ActiveConnections",
+							Statistic:  "Maximum",
+						},
+						Period:            60,
+						EvaluationPeriods: 3,
+						AdjustmentType:    "ChangeInCapacity",
+						Cooldown:          aws.Int64(60),
+						ScaleOut: &template.AutoscalingStepAlarmOpts{
+							Threshold: 1000,
+							Adjustments: []template.AutoscalingStepAdjustment{
+								{LowerBound: aws.Float64(0), UpperBound: aws.Float64(4000), ScalingAdjustment: 1},
+								{LowerBound: aws.Float64(4000), ScalingAdjustment: 5},
+							},
+						},
+						ScaleIn: &template.AutoscalingStepAlarmOpts{
+							Threshold: 100,
+							Adjustments: []template.AutoscalingStepAdjustment{
+								{LowerBound: aws.Float64(-90), UpperBound: aws.Float64(0), ScalingAdjustment: -1},
+								{UpperBound: aws.Float64(-90), ScalingAdjustment: -2},
+							},
+						},
+					},
+				},
+			},
+		},
 		"returns nil if spot specified": {
 			input: manifest.AdvancedCount{
 				Spot: aws.Int(5),
@@ -1612,8 +1741,11 @@ func Test_convertSubscribe(t *testing.T) {
 	}
 	testCases := map[string]struct {
 		inSubscribe *manifest.WorkerService
+		inAddons    *template.WorkloadNestedStackOpts
+		inStorage   subscribableStorage
 
-		wanted *template.SubscribeOpts
+		wanted    *template.SubscribeOpts
+		wantedErr error
 	}{
 		"empty subscription": { // 1
 			inSubscribe: &manifest.WorkerService{},
@@ -2162,10 +2294,170 @@ func Test_convertSubscribe(t *testing.T) {
 				},
 			},
 		},
+		"subscribe to tables and buckets from the workload and environment addons": {
+			inSubscribe: &manifest.WorkerService{
+				WorkerServiceConfig: manifest.WorkerServiceConfig{
+					Subscribe: manifest.SubscribeConfig{
+						Tables: []manifest.TableSubscription{
+							{
+								Name: aws.String("orders"),
+								FilterPattern: map[string]interface{}{
+									"eventName": []string{"INSERT"},
+								},
+							},
+							{
+								Name: aws.String("users-table"),
+							},
+						},
+						Buckets: []manifest.BucketSubscription{
+							{
+								Name: aws.String("uploads"),
+								FilterPattern: map[string]interface{}{
+									"detail-type": []string{"Object Created"},
+									"detail": map[string]interface{}{
+										"object": map[string]interface{}{
+											"key": []interface{}{map[string]string{"prefix": "images/"}},
+										},
+									},
+								},
+							},
+							{
+								Name: aws.String("shared-assets"),
+							},
+						},
+					},
+				},
+			},
+			inAddons: &template.WorkloadNestedStackOpts{
+				StackName:          "AddonsStack",
+				VariableOutputs:    []string{"ordersName", "uploadsName"},
+				TableStreamOutputs: []string{"ordersStreamArn"},
+			},
+			inStorage: subscribableStorage{
+				wkld: &addonsStorage{
+					outputs:            []string{"ordersName", "ordersStreamArn", "uploadsName"},
+					eventBridgeBuckets: map[string]bool{"uploadsBucket": true},
+				},
+				env: &addonsStorage{
+					outputs:            []string{"userstableName", "userstableDynamoDBStreamARN", "sharedassetsName"},
+					eventBridgeBuckets: map[string]bool{"sharedassetsBucket": true},
+				},
+			},
+			wanted: &template.SubscribeOpts{
+				Tables: []*template.TableSubscription{
+					{
+						Name:          "orders",
+						StreamARN:     `{"Fn::GetAtt":["AddonsStack","Outputs.ordersStreamArn"]}`,
+						FilterPattern: `{"eventName":["INSERT"]}`,
+					},
+					{
+						Name:      "users-table",
+						StreamARN: `{"Fn::ImportValue":{"Fn::Sub":"${AppName}-${EnvName}-userstableTableStreamArn"}}`,
+					},
+				},
+				Buckets: []*template.BucketSubscription{
+					{
+						Name:         "uploads",
+						EventPattern: `{"detail":{"bucket":{"name":[{"Fn::GetAtt":["AddonsStack","Outputs.uploadsName"]}]},"object":{"key":[{"prefix":"images/"}]}},"detail-type":["Object Created"],"source":["aws.s3"]}`,
+					},
+					{
+						Name:         "shared-assets",
+						EventPattern: `{"detail":{"bucket":{"name":[{"Fn::ImportValue":{"Fn::Sub":"${AppName}-${EnvName}-sharedassetsBucketName"}}]}},"source":["aws.s3"]}`,
+					},
+				},
+			},
+		},
+		"returns an error if a table of the workload addons has no stream": {
+			inSubscribe: &manifest.WorkerService{
+				WorkerServiceConfig: manifest.WorkerServiceConfig{
+					Subscribe: manifest.SubscribeConfig{
+						Tables: []manifest.TableSubscription{
+							{
+								Name: aws.String("orders"),
+							},
+						},
+					},
+				},
+			},
+			inAddons: &template.WorkloadNestedStackOpts{
+				StackName:       "AddonsStack",
+				VariableOutputs: []string{"ordersName"},
+			},
+			inStorage: subscribableStorage{
+				wkld: &addonsStorage{
+					outputs: []string{"ordersName"},
+				},
+			},
+			wantedErr: errors.New(`table orders does not output the ARN of its stream: delete its addon template and run "copilot storage init" to regenerate it`),
+		},
+		"returns an error if a table of the environment addons has no stream": {
+			inSubscribe: &manifest.WorkerService{
+				WorkerServiceConfig: manifest.WorkerServiceConfig{
+					Subscribe: manifest.SubscribeConfig{
+						Tables: []manifest.TableSubscription{
+							{
+								Name: aws.String("users-table"),
+							},
+						},
+					},
+				},
+			},
+			inStorage: subscribableStorage{
+				env: &addonsStorage{
+					outputs: []string{"userstableName", "userstableDynamoDBTableARN"},
+				},
+			},
+			wantedErr: errors.New(`table users-table does not output the ARN of its stream: delete its addon template and run "copilot storage init" to regenerate it`),
+		},
+		"returns an error if a bucket does not send its events to EventBridge": {
+			inSubscribe: &manifest.WorkerService{
+				WorkerServiceConfig: manifest.WorkerServiceConfig{
+					Subscribe: manifest.SubscribeConfig{
+						Buckets: []manifest.BucketSubscription{
+							{
+								Name: aws.String("shared-assets"),
+							},
+						},
+					},
+				},
+			},
+			inStorage: subscribableStorage{
+				env: &addonsStorage{
+					outputs:            []string{"sharedassetsName"},
+					eventBridgeBuckets: map[string]bool{"sharedassetsBucket": false},
+				},
+			},
+			wantedErr: errors.New(`bucket shared-assets does not send its events to Amazon EventBridge: delete its addon template and run "copilot storage init" to regenerate it`),
+		},
+		"references the exports of storage that is not defined by the addons": {
+			inSubscribe: &manifest.WorkerService{
+				WorkerServiceConfig: manifest.WorkerServiceConfig{
+					Subscribe: manifest.SubscribeConfig{
+						Tables: []manifest.TableSubscription{
+							{
+								Name: aws.String("users-table"),
+							},
+						},
+					},
+				},
+			},
+			wanted: &template.SubscribeOpts{
+				Tables: []*template.TableSubscription{
+					{
+						Name:      "users-table",
+						StreamARN: `{"Fn::ImportValue":{"Fn::Sub":"${AppName}-${EnvName}-userstableTableStreamArn"}}`,
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			got, err := convertSubscribe(tc.inSubscribe)
+			got, err := convertSubscribe(tc.inSubscribe, tc.inAddons, tc.inStorage)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
 			require.Equal(t, tc.wanted, got)
 			require.NoError(t, err)
 		})
@@ -2289,6 +2581,101 @@ func Test_convertWorkerDeploymentConfig(t *testing.T) {
 	}
 }
 
+func Test_convertAppConfig(t *testing.T) {
+	linear := 30 * time.Minute
+	bake := 5 * time.Minute
+	testCases := map[string]struct {
+		in         manifest.AppConfig
+		inContents map[string]string
+
+		wanted    *template.AppConfigOpts
+		wantedErr error
+	}{
+		"return nil if there are no configuration profiles": {},
+		"error if the content of a profile is missing": {
+			in: manifest.AppConfig{
+				Profiles: map[string]*manifest.AppConfigProfile{
+					"flags": {Path: aws.String("config/flags.json")},
+				},
+			},
+			wantedErr: errors.New(`content of configuration profile "flags" is not available`),
+		},
+		"deploy all at once by default": {
+			in: manifest.AppConfig{
+				Profiles: map[string]*manifest.AppConfigProfile{
+					"settings": {Path: aws.String("config/settings.yml")},
+					"flags":    {Path: aws.String("config/flags.yml"), Type: aws.String("feature-flags")},
+				},
+			},
+			inContents: map[string]string{
+				"settings": "timeout: 30",
+				"flags":    `{"version":"1"}`,
+			},
+			wanted: &template.AppConfigOpts{
+				Profiles: []template.AppConfigProfile{
+					{
+						Name:        "flags",
+						Type:        "AWS.AppConfig.FeatureFlags",
+						ContentType: "application/json",
+						Content:     `{"version":"1"}`,
+					},
+					{
+						Name:        "settings",
+						Type:        "AWS.Freeform",
+						ContentType: "application/x-yaml",
+						Content:     "timeout: 30",
+					},
+				},
+				Strategy: template.AppConfigStrategy{
+					GrowthFactor: 100,
+				},
+			},
+		},
+		"deploy linearly": {
+			in: manifest.AppConfig{
+				Profiles: map[string]*manifest.AppConfigProfile{
+					"settings": {Path: aws.String("config/settings.json")},
+				},
+				Deployment: manifest.AppConfigDeployment{
+					Strategy: aws.String("linear"),
+					Duration: &linear,
+					BakeTime: &bake,
+				},
+			},
+			inContents: map[string]string{
+				"settings": `{"timeout": 30}`,
+			},
+			wanted: &template.AppConfigOpts{
+				Profiles: []template.AppConfigProfile{
+					{
+						Name:        "settings",
+						Type:        "AWS.Freeform",
+						ContentType: "application/json",
+						Content:     `{"timeout": 30}`,
+					},
+				},
+				Strategy: template.AppConfigStrategy{
+					GrowthFactor:      20,
+					DurationInMinutes: 30,
+					BakeTimeInMinutes: 5,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertAppConfig(tc.in, tc.inContents)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func Test_convertPlatform(t *testing.T) {
 	testCases := map[string]struct {
 		in  manifest.PlatformArgsOrString
@@ -21,7 +21,8 @@ import (
 // WorkerService represents the configuration needed to create a CloudFormation stack from a worker service manifest.
 type WorkerService struct {
 	*ecsWkld
-	manifest *manifest.WorkerService
+	manifest  *manifest.WorkerService
+	envAddons NestedStackConfigurer
 
 	parser workerSvcReadParser
 }
@@ -35,6 +36,7 @@ type WorkerServiceConfig struct {
 	RawManifest        []byte
 	RuntimeConfig      RuntimeConfig
 	Addons             NestedStackConfigurer
+	EnvAddons          NestedStackConfigurer // Addons of the environment, used to validate subscriptions to its storage.
 }
 
 // NewWorkerService creates a new WorkerService stack from a manifest file.
@@ -64,8 +66,9 @@ func NewWorkerService(cfg WorkerServiceConfig) (*WorkerService, error) {
 			tc:                  cfg.Manifest.TaskConfig,
 			taskDefOverrideFunc: override.CloudFormationTemplate,
 		},
-		manifest: cfg.Manifest,
-		parser:   fs,
+		manifest:  cfg.Manifest,
+		envAddons: cfg.EnvAddons,
+		parser:    fs,
 	}, nil
 }
 
@@ -114,7 +117,11 @@ func (s *WorkerService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	subscribe, err := convertSubscribe(s.manifest)
+	storage, err := s.subscribableStorage()
+	if err != nil {
+		return "", err
+	}
+	subscribe, err := convertSubscribe(s.manifest, addonsOutputs, storage)
 	if err != nil {
 		return "", err
 	}
@@ -122,6 +129,10 @@ func (s *WorkerService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for service %s: %w`, s.name, err)
 	}
+	appConfig, err := convertAppConfig(s.manifest.Config, s.rc.AppConfigContents)
+	if err != nil {
+		return "", fmt.Errorf(`convert "config" field for service %s: %w`, s.name, err)
+	}
 	var scConfig *template.ServiceConnect
 	if s.manifest.Network.Connect.Enabled() {
 		scConfig = convertServiceConnect(s.manifest.Network.Connect)
@@ -158,6 +169,7 @@ func (s *WorkerService) Template() (string, error) {
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
 		Subscribe:                subscribe,
 		Publish:                  publishers,
+		AppConfig:                appConfig,
 		Platform:                 convertPlatform(s.manifest.Platform),
 		Observability: template.ObservabilityOpts{
 			Tracing: strings.ToUpper(aws.StringValue(s.manifest.Observability.Tracing)),
@@ -174,6 +186,25 @@ func (s *WorkerService) Template() (string, error) {
 	return string(overriddenTpl), nil
 }
 
+// subscribableStorage parses the storage of the workload and environment addons if the service subscribes to tables or buckets.
+func (s *WorkerService) subscribableStorage() (subscribableStorage, error) {
+	if s.manifest.Subscribe.Tables == nil && s.manifest.Subscribe.Buckets == nil {
+		return subscribableStorage{}, nil
+	}
+	wkld, err := parseAddonsStorage(s.addons)
+	if err != nil {
+		return subscribableStorage{}, fmt.Errorf("parse the storage in the addons of %s: %w", s.name, err)
+	}
+	env, err := parseAddonsStorage(s.envAddons)
+	if err != nil {
+		return subscribableStorage{}, fmt.Errorf("parse the storage in the addons of environment %s: %w", s.env, err)
+	}
+	return subscribableStorage{
+		wkld: wkld,
+		env:  env,
+	}, nil
+}
+
 // Parameters returns the list of CloudFormation parameters used by the template.
 func (s *WorkerService) Parameters() ([]*cloudformation.Parameter, error) {
 	wkldParams, err := s.ecsWkld.Parameters()
@@ -0,0 +1,63 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+type workflowStackConfig struct {
+	*deploy.CreateWorkflowInput
+	parser workflowRoleParser
+}
+
+// NewWorkflowStackConfig sets up a struct which can provide values to CloudFormation for
+// spinning up the OIDC identity provider and role of a GitHub Actions or GitLab CI workflow.
+func NewWorkflowStackConfig(in *deploy.CreateWorkflowInput) *workflowStackConfig {
+	return &workflowStackConfig{
+		CreateWorkflowInput: in,
+		parser:              template.New(),
+	}
+}
+
+// StackName returns the name of the CloudFormation stack.
+// A workflow replaces the CodePipeline of the pipeline, so they share the same stack name.
+func (w *workflowStackConfig) StackName() string {
+	return NameForPipeline(w.AppName, w.Name, false)
+}
+
+// RoleName returns the name of the role assumed by the workflow.
+func (w *workflowStackConfig) RoleName() string {
+	return NameForWorkflowRole(w.AppName, w.Name)
+}
+
+// Template returns the CloudFormation template of the OIDC identity provider and role of the workflow.
+func (w *workflowStackConfig) Template() (string, error) {
+	content, err := w.parser.ParseWorkflowRole(w)
+	if err != nil {
+		return "", err
+	}
+	return content.String(), nil
+}
+
+// SerializedParameters returns the CloudFormation stack's parameters serialized to a JSON document.
+func (w *workflowStackConfig) SerializedParameters() (string, error) {
+	// No-op for now.
+	return "", nil
+}
+
+// Parameters returns the list of CloudFormation parameters used by the template.
+func (w *workflowStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
+	return nil, nil
+}
+
+// Tags returns the tags that should be applied to the workflow CloudFormation stack.
+func (w *workflowStackConfig) Tags() []*cloudformation.Tag {
+	return mergeAndFlattenTags(w.AdditionalTags, map[string]string{
+		deploy.AppTagKey:      w.AppName,
+		deploy.PipelineTagKey: w.Name,
+	})
+}
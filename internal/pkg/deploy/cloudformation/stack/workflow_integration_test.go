@@ -0,0 +1,95 @@
+//go:build integration || localintegration
+
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// TestWorkflow_Template ensures that the CloudFormation templates generated for the roles of workflows match our pre-defined templates.
+func TestWorkflow_Template(t *testing.T) {
+	newStage := func(name string) deploy.PipelineStage {
+		var stage deploy.PipelineStage
+		stage.Init(&config.Environment{
+			App:       "phonetool",
+			Name:      name,
+			Region:    "us-west-2",
+			AccountID: "1111",
+		}, &manifest.PipelineStage{
+			Name: name,
+		}, []string{"api"})
+		return stage
+	}
+	artifactBuckets := []deploy.ArtifactBucket{
+		{
+			BucketName: "fancy-bucket",
+			KeyArn:     "arn:aws:kms:us-west-2:1111:key/abcd",
+		},
+	}
+	testCases := map[string]struct {
+		in         *deploy.CreateWorkflowInput
+		wantedFile string
+	}{
+		"GitHub Actions workflow with a new OIDC provider": {
+			in: &deploy.CreateWorkflowInput{
+				AppName:  "phonetool",
+				Name:     "release",
+				Platform: manifest.PipelinePlatformGitHubActions,
+				Source: &deploy.GitHubSource{
+					ProviderName:  manifest.GithubProviderName,
+					RepositoryURL: "https://github.com/aws/phonetool",
+					Branch:        "main",
+				},
+				Stages:          []deploy.PipelineStage{newStage("test"), newStage("prod")},
+				ArtifactBuckets: artifactBuckets,
+				Version:         "v1.28.0",
+			},
+			wantedFile: "gh_workflow_template.yaml",
+		},
+		"GitLab CI workflow with an existing OIDC provider": {
+			in: &deploy.CreateWorkflowInput{
+				AppName:  "phonetool",
+				Name:     "release",
+				Platform: manifest.PipelinePlatformGitLabCI,
+				Source: &deploy.GitLabSource{
+					ProviderName:  manifest.GitLabProviderName,
+					RepositoryURL: "https://gitlab.com/aws/phonetool",
+					Branch:        "main",
+				},
+				OIDCProviderARN:     "arn:aws:iam::1111:oidc-provider/gitlab.com",
+				Stages:              []deploy.PipelineStage{newStage("test")},
+				ArtifactBuckets:     artifactBuckets,
+				PermissionsBoundary: "boundary",
+				Version:             "v1.28.0",
+			},
+			wantedFile: "gl_workflow_template.yaml",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := stack.NewWorkflowStackConfig(tc.in).Template()
+			require.NoError(t, err, "template should have rendered successfully")
+			m1 := make(map[interface{}]interface{})
+			require.NoError(t, yaml.Unmarshal([]byte(actual), m1))
+
+			wanted, err := os.ReadFile(filepath.Join("testdata", "pipeline", tc.wantedFile))
+			require.NoError(t, err, "should be able to read expected template file")
+			m2 := make(map[interface{}]interface{})
+			require.NoError(t, yaml.Unmarshal(wanted, m2))
+
+			require.Equal(t, m2, m1)
+		})
+	}
+}
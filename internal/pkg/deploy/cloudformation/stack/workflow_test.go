@@ -0,0 +1,97 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowStackConfig(t *testing.T) {
+	workflow := NewWorkflowStackConfig(&deploy.CreateWorkflowInput{
+		AppName: projectName,
+		Name:    pipelineName,
+		AdditionalTags: map[string]string{
+			"owner": "boss",
+		},
+	})
+
+	params, _ := workflow.Parameters()
+	require.Nil(t, params, "workflow cloudformation template should not expose any parameters")
+	require.Equal(t, "pipeline-chickenProject-wingspipeline", workflow.StackName(), "the workflow should replace the pipeline stack")
+	require.Equal(t, "pipeline-chickenProject-wingspipeline-WorkflowRole", workflow.RoleName())
+	require.ElementsMatch(t, []*cloudformation.Tag{
+		{
+			Key:   aws.String(deploy.AppTagKey),
+			Value: aws.String(projectName),
+		},
+		{
+			Key:   aws.String(deploy.PipelineTagKey),
+			Value: aws.String(pipelineName),
+		},
+		{
+			Key:   aws.String("owner"),
+			Value: aws.String("boss"),
+		},
+	}, workflow.Tags())
+}
+
+func TestWorkflowStackConfig_Template(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, c *workflowStackConfig)
+
+		wantedTemplate string
+		wantedError    error
+	}{
+		"error parsing file": {
+			mockDependencies: func(ctrl *gomock.Controller, c *workflowStackConfig) {
+				m := mocks.NewMockworkflowRoleParser(ctrl)
+				m.EXPECT().ParseWorkflowRole(c).Return(nil, errors.New("some error"))
+				c.parser = m
+			},
+			wantedError: errors.New("some error"),
+		},
+		"successfully parses file": {
+			mockDependencies: func(ctrl *gomock.Controller, c *workflowStackConfig) {
+				m := mocks.NewMockworkflowRoleParser(ctrl)
+				m.EXPECT().ParseWorkflowRole(c).Return(&template.Content{
+					Buffer: bytes.NewBufferString("workflow"),
+				}, nil)
+				c.parser = m
+			},
+			wantedTemplate: "workflow",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := &workflowStackConfig{
+				CreateWorkflowInput: &deploy.CreateWorkflowInput{
+					AppName: projectName,
+					Name:    pipelineName,
+				},
+			}
+			tc.mockDependencies(ctrl, c)
+
+			// WHEN
+			template, err := c.Template()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedTemplate, template)
+		})
+	}
+}
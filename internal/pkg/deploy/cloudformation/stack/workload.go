@@ -72,10 +72,12 @@ const (
 // that is needed to create a CloudFormation stack.
 type RuntimeConfig struct {
 	PushedImages       map[string]ECRImage // Optional. Image location in an ECR repository.
+	PinnedImages       map[string]string   // Optional. Image references pinned to their verified digest, keyed by container name. Take precedence over other image locations.
 	AddonsTemplateURL  string              // Optional. S3 object URL for the addons template.
 	EnvFileARNs        map[string]string   // Optional. S3 object ARNs for any env files. Map keys are container names.
 	AdditionalTags     map[string]string   // AdditionalTags are labels applied to resources in the workload stack.
 	CustomResourcesURL map[string]string   // Mapping of Custom Resource Function Name to the S3 URL where the function zip file is stored.
+	AppConfigContents  map[string]string   // Optional. Content to host for each AppConfig configuration profile. Map keys are profile names.
 
 	// The target environment metadata.
 	ServiceDiscoveryEndpoint string // Endpoint for the service discovery namespace in the environment.
@@ -174,6 +176,9 @@ func (w *wkld) Parameters() ([]*cloudformation.Parameter, error) {
 	if w.rc.PushedImages != nil {
 		img = w.rc.PushedImages[w.name].URI()
 	}
+	if pinned, ok := w.rc.PinnedImages[w.name]; ok {
+		img = pinned
+	}
 	return []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(WorkloadAppNameParamKey),
@@ -266,6 +271,7 @@ func (w *wkld) addonsOutputs() (*template.WorkloadNestedStackOpts, error) {
 		SecretOutputs:        secretOutputNames(out),
 		PolicyOutputs:        managedPolicyOutputNames(out),
 		SecurityGroupOutputs: securityGroupOutputNames(out),
+		TableStreamOutputs:   tableStreamOutputNames(out),
 	}, nil
 }
 
@@ -311,10 +317,20 @@ func managedPolicyOutputNames(outputs []addon.Output) []string {
 	return policies
 }
 
+func tableStreamOutputNames(outputs []addon.Output) []string {
+	var streams []string
+	for _, out := range outputs {
+		if out.IsTableStream {
+			streams = append(streams, out.Name)
+		}
+	}
+	return streams
+}
+
 func envVarOutputNames(outputs []addon.Output) []string {
 	var envVars []string
 	for _, out := range outputs {
-		if !out.IsSecret && !out.IsManagedPolicy {
+		if !out.IsSecret && !out.IsManagedPolicy && !out.IsTableStream {
 			envVars = append(envVars, out.Name)
 		}
 	}
@@ -413,6 +429,9 @@ func (w *appRunnerWkld) Parameters() ([]*cloudformation.Parameter, error) {
 	if w.rc.PushedImages != nil {
 		img = w.rc.PushedImages[w.name].URI()
 	}
+	if pinned, ok := w.rc.PinnedImages[w.name]; ok {
+		img = pinned
+	}
 
 	imageRepositoryType, err := apprunner.DetermineImageRepositoryType(img)
 	if err != nil {
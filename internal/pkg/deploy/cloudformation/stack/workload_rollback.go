@@ -0,0 +1,64 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+)
+
+// WorkloadRollback is the configuration of a workload stack that redeploys a previous deployment of the workload,
+// with its rendered template and parameters instead of the current manifest.
+type WorkloadRollback struct {
+	deployment *deploy.WorkloadDeployment
+	template   string
+}
+
+// NewWorkloadRollback returns the configuration of a stack that redeploys the template of a deployment.
+// The images of the deployment are referenced by digest, so that the stack deploys the same images
+// even if their tags were since pushed again.
+func NewWorkloadRollback(deployment *deploy.WorkloadDeployment, template string) *WorkloadRollback {
+	return &WorkloadRollback{
+		deployment: deployment,
+		template:   template,
+	}
+}
+
+// StackName returns the name of the CloudFormation stack of the workload.
+func (w *WorkloadRollback) StackName() string {
+	return NameForWorkload(w.deployment.App, w.deployment.Env, w.deployment.Name)
+}
+
+// Template returns the template of the deployment with the images pinned to their digests.
+func (w *WorkloadRollback) Template() (string, error) {
+	return w.deployment.PinImages(w.template), nil
+}
+
+// Parameters returns the parameters of the deployment with the images pinned to their digests.
+func (w *WorkloadRollback) Parameters() ([]*cloudformation.Parameter, error) {
+	params := make([]*cloudformation.Parameter, 0, len(w.deployment.Parameters))
+	for k, v := range w.deployment.Parameters {
+		params = append(params, &cloudformation.Parameter{
+			ParameterKey:   aws.String(k),
+			ParameterValue: aws.String(w.deployment.PinImages(v)),
+		})
+	}
+	sort.Slice(params, func(i, j int) bool {
+		return aws.StringValue(params[i].ParameterKey) < aws.StringValue(params[j].ParameterKey)
+	})
+	return params, nil
+}
+
+// SerializedParameters returns the CloudFormation stack's parameters serialized to a JSON document.
+func (w *WorkloadRollback) SerializedParameters() (string, error) {
+	return serializeTemplateConfig(nil, w)
+}
+
+// Tags returns the tags of the deployment.
+func (w *WorkloadRollback) Tags() []*cloudformation.Tag {
+	return mergeAndFlattenTags(w.deployment.Tags, nil)
+}
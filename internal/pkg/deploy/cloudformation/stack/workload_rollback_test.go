@@ -0,0 +1,66 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadRollback(t *testing.T) {
+	// GIVEN
+	rollback := NewWorkloadRollback(&deploy.WorkloadDeployment{
+		App:  "phonetool",
+		Env:  "test",
+		Name: "api",
+		Parameters: map[string]string{
+			"WorkloadName":   "api",
+			"ContainerImage": "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:latest",
+		},
+		Tags: map[string]string{
+			"copilot-application": "phonetool",
+			"owner":               "team",
+		},
+		Images: map[string]deploy.DeployedImage{
+			"api": {
+				Repository: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api",
+				URI:        "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:latest",
+				Digest:     "sha256:1234",
+			},
+			"logs": {
+				Repository: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api",
+				URI:        "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:logs-latest",
+				Digest:     "sha256:5678",
+			},
+		},
+	}, "Image: 123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:logs-latest\n")
+
+	// WHEN
+	tmpl, err := rollback.Template()
+	require.NoError(t, err)
+	params, err := rollback.Parameters()
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, "phonetool-test-api", rollback.StackName())
+	require.Equal(t, "Image: 123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:5678\n", tmpl)
+	require.Equal(t, []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String("ContainerImage"),
+			ParameterValue: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:1234"),
+		},
+		{
+			ParameterKey:   aws.String("WorkloadName"),
+			ParameterValue: aws.String("api"),
+		},
+	}, params)
+	require.Equal(t, []*cloudformation.Tag{
+		{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+		{Key: aws.String("owner"), Value: aws.String("team")},
+	}, rollback.Tags())
+}
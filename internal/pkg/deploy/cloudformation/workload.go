@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
 )
 
@@ -30,6 +32,35 @@ func (cf CloudFormation) DeployService(conf StackConfiguration, bucketName strin
 	return cf.executeAndRenderChangeSet(cf.newUpsertChangeSetInput(cf.console, stack, withEnableInterrupt(), withDetach(detach)))
 }
 
+// DeployedWorkload returns the configuration of the deployed stack of a workload, with its current template, parameters and tags,
+// so that a later update of the stack can be undone by deploying it again.
+// If the stack does not exist, returns ErrStackNotFound.
+func (cf CloudFormation) DeployedWorkload(app, env, name string) (StackConfiguration, error) {
+	stackName := stack.NameForWorkload(app, env, name)
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := cf.cfnClient.TemplateBody(stackName)
+	if err != nil {
+		return nil, err
+	}
+	deployed := &deploy.WorkloadDeployment{
+		App:        app,
+		Env:        env,
+		Name:       name,
+		Parameters: make(map[string]string, len(descr.Parameters)),
+		Tags:       make(map[string]string, len(descr.Tags)),
+	}
+	for _, param := range descr.Parameters {
+		deployed.Parameters[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	for _, tag := range descr.Tags {
+		deployed.Tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return stack.NewWorkloadRollback(deployed, tmpl), nil
+}
+
 type uploadableStack interface {
 	StackName() string
 	Template() (string, error)
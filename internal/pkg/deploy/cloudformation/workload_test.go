@@ -211,3 +211,85 @@ func TestCloudFormation_DeleteWorkload(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudFormation_DeployedWorkload(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedTemplate   string
+		wantedParameters []*sdkcloudformation.Parameter
+		wantedTags       []*sdkcloudformation.Tag
+		wantedErr        error
+	}{
+		"returns the error as is if the stack cannot be described": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test-api").Return(nil, &cloudformation.ErrStackNotFound{})
+				return m
+			},
+			wantedErr: &cloudformation.ErrStackNotFound{},
+		},
+		"returns the error as is if the template cannot be retrieved": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test-api").Return(&cloudformation.StackDescription{}, nil)
+				m.EXPECT().TemplateBody("phonetool-test-api").Return("", errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"returns the deployed template, parameters and tags": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test-api").Return(&cloudformation.StackDescription{
+					Parameters: []*sdkcloudformation.Parameter{
+						{ParameterKey: aws.String("TaskCount"), ParameterValue: aws.String("2")},
+						{ParameterKey: aws.String("ContainerImage"), ParameterValue: aws.String("repo:v1")},
+					},
+					Tags: []*sdkcloudformation.Tag{
+						{Key: aws.String(deploy.AppTagKey), Value: aws.String("phonetool")},
+					},
+				}, nil)
+				m.EXPECT().TemplateBody("phonetool-test-api").Return("template", nil)
+				return m
+			},
+			wantedTemplate: "template",
+			wantedParameters: []*sdkcloudformation.Parameter{
+				{ParameterKey: aws.String("ContainerImage"), ParameterValue: aws.String("repo:v1")},
+				{ParameterKey: aws.String("TaskCount"), ParameterValue: aws.String("2")},
+			},
+			wantedTags: []*sdkcloudformation.Tag{
+				{Key: aws.String(deploy.AppTagKey), Value: aws.String("phonetool")},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			conf, err := c.DeployedWorkload("phonetool", "test", "api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "phonetool-test-api", conf.StackName())
+			tmpl, err := conf.Template()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTemplate, tmpl)
+			params, err := conf.Parameters()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedParameters, params)
+			require.Equal(t, tc.wantedTags, conf.Tags())
+		})
+	}
+}
@@ -29,6 +29,8 @@ const (
 	PipelineTagKey = "copilot-pipeline"
 	// TaskTagKey is tag key for Copilot task.
 	TaskTagKey = "copilot-task"
+	// RouteTagKey is tag key for Copilot route.
+	RouteTagKey = "copilot-route"
 )
 
 const (
@@ -0,0 +1,232 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package deploy holds the structures to deploy infrastructure resources.
+// This file defines the history of the deployments of workloads.
+package deploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
+)
+
+// WorkloadDeployment is the record of a successful deployment of a workload to an environment.
+type WorkloadDeployment struct {
+	// Number is the position of the deployment in the history of the workload in the environment, starting at 1.
+	Number     int       `json:"number"`
+	App        string    `json:"app"`
+	Env        string    `json:"env"`
+	Name       string    `json:"name"`
+	DeployedAt time.Time `json:"deployedAt"`
+	// DeployedBy is the ARN of the IAM identity that deployed the workload.
+	DeployedBy string `json:"deployedBy"`
+	GitCommit  string `json:"gitCommit,omitempty"`
+	// ManifestSHA256 is the hash of the manifest file before interpolation.
+	ManifestSHA256 string `json:"manifestSHA256,omitempty"`
+	// TemplateURL is the location of the rendered template in the artifact bucket.
+	TemplateURL string            `json:"templateURL"`
+	Parameters  map[string]string `json:"parameters"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	// Images are the container images pushed for the deployment by container name.
+	Images map[string]DeployedImage `json:"images,omitempty"`
+	// RollbackOf is the number of the deployment that this deployment rolled back to, if any.
+	RollbackOf int `json:"rollbackOf,omitempty"`
+}
+
+// DeployedImage is a container image referenced by the template of a deployment.
+type DeployedImage struct {
+	Repository string `json:"repository"`
+	// URI is the reference to the image in the template, such as "<repository>:<tag>".
+	URI    string `json:"uri"`
+	Digest string `json:"digest"`
+}
+
+// PinnedURI returns the reference to the image by its digest.
+func (img DeployedImage) PinnedURI() string {
+	if img.Digest == "" {
+		return img.URI
+	}
+	return fmt.Sprintf("%s@%s", img.Repository, img.Digest)
+}
+
+// PinImages replaces the references to the images of the deployment in s with references by digest,
+// so that redeploying the deployment doesn't pick up the images later pushed with the same tags.
+func (d *WorkloadDeployment) PinImages(s string) string {
+	images := make([]DeployedImage, 0, len(d.Images))
+	for _, img := range d.Images {
+		images = append(images, img)
+	}
+	// Replace the longest references first, so that "repo:web" doesn't match a prefix of "repo:web-sidecar".
+	sort.Slice(images, func(i, j int) bool {
+		return len(images[i].URI) > len(images[j].URI)
+	})
+	for _, img := range images {
+		if img.URI == "" {
+			continue
+		}
+		s = strings.ReplaceAll(s, img.URI, img.PinnedURI())
+	}
+	return s
+}
+
+// maxRecordAttempts is the number of deployment numbers that Record tries when other deployments of the workload
+// are recorded concurrently.
+const maxRecordAttempts = 10
+
+type historyStorage interface {
+	UploadIfNotExists(bucket, key string, data io.Reader) (string, error)
+	ListKeys(bucket, prefix string) ([]string, error)
+	Download(bucket, key string) ([]byte, error)
+}
+
+// DeploymentHistory stores the records of the deployments of workloads in the artifact bucket of a region.
+type DeploymentHistory struct {
+	bucket  string
+	storage historyStorage
+}
+
+// NewDeploymentHistory returns a DeploymentHistory that stores records in the bucket.
+func NewDeploymentHistory(bucket string, storage historyStorage) *DeploymentHistory {
+	return &DeploymentHistory{
+		bucket:  bucket,
+		storage: storage,
+	}
+}
+
+// ErrDeploymentNotFound is returned when a deployment is not in the history of a workload.
+type ErrDeploymentNotFound struct {
+	Env    string
+	Name   string
+	Number int
+}
+
+// Error implements the error interface.
+func (e *ErrDeploymentNotFound) Error() string {
+	return fmt.Sprintf("deployment #%d of %s in environment %s not found", e.Number, e.Name, e.Env)
+}
+
+// ErrDeploymentTemplateNotFound is returned when the template of a recorded deployment was removed from the artifact bucket.
+type ErrDeploymentTemplateNotFound struct {
+	Number      int
+	TemplateURL string
+}
+
+// Error implements the error interface.
+func (e *ErrDeploymentTemplateNotFound) Error() string {
+	return fmt.Sprintf("template %s of deployment #%d no longer exists", e.TemplateURL, e.Number)
+}
+
+// Record assigns the next number in the history of the workload to the deployment, and stores it.
+// A record is never overwritten: if another deployment of the workload is recorded with the same number concurrently,
+// the deployment is assigned the following number.
+func (h *DeploymentHistory) Record(deployment *WorkloadDeployment) error {
+	numbers, err := h.numbers(deployment.Env, deployment.Name)
+	if err != nil {
+		return err
+	}
+	next := 1
+	if len(numbers) != 0 {
+		next = numbers[len(numbers)-1] + 1
+	}
+	for attempt := 0; attempt < maxRecordAttempts; attempt++ {
+		deployment.Number = next + attempt
+		content, err := json.MarshalIndent(deployment, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal deployment #%d: %w", deployment.Number, err)
+		}
+		_, err = h.storage.UploadIfNotExists(h.bucket, artifactpath.WorkloadDeployment(deployment.Env, deployment.Name, deployment.Number), bytes.NewReader(content))
+		if err == nil {
+			return nil
+		}
+		var errExists *s3.ErrObjectExists
+		if !errors.As(err, &errExists) {
+			return fmt.Errorf("store deployment #%d: %w", deployment.Number, err)
+		}
+	}
+	return fmt.Errorf("store deployment: deployments #%d to #%d of %s in environment %s were recorded concurrently", next, deployment.Number, deployment.Name, deployment.Env)
+}
+
+// List returns the deployments of a workload to an environment, from the oldest to the most recent.
+func (h *DeploymentHistory) List(env, name string) ([]*WorkloadDeployment, error) {
+	numbers, err := h.numbers(env, name)
+	if err != nil {
+		return nil, err
+	}
+	deployments := make([]*WorkloadDeployment, len(numbers))
+	for i, number := range numbers {
+		if deployments[i], err = h.Get(env, name, number); err != nil {
+			return nil, err
+		}
+	}
+	return deployments, nil
+}
+
+// Get returns a deployment of a workload to an environment by its number.
+func (h *DeploymentHistory) Get(env, name string, number int) (*WorkloadDeployment, error) {
+	content, err := h.storage.Download(h.bucket, artifactpath.WorkloadDeployment(env, name, number))
+	if err != nil {
+		var errNotFound *s3.ErrObjectNotFound
+		if errors.As(err, &errNotFound) {
+			return nil, &ErrDeploymentNotFound{
+				Env:    env,
+				Name:   name,
+				Number: number,
+			}
+		}
+		return nil, fmt.Errorf("get deployment #%d: %w", number, err)
+	}
+	var deployment WorkloadDeployment
+	if err := json.Unmarshal(content, &deployment); err != nil {
+		return nil, fmt.Errorf("unmarshal deployment #%d: %w", number, err)
+	}
+	return &deployment, nil
+}
+
+// Template returns the template rendered for a deployment.
+func (h *DeploymentHistory) Template(deployment *WorkloadDeployment) (string, error) {
+	bucket, key, err := s3.ParseURL(deployment.TemplateURL)
+	if err != nil {
+		return "", err
+	}
+	content, err := h.storage.Download(bucket, key)
+	if err != nil {
+		var errNotFound *s3.ErrObjectNotFound
+		if errors.As(err, &errNotFound) {
+			return "", &ErrDeploymentTemplateNotFound{
+				Number:      deployment.Number,
+				TemplateURL: deployment.TemplateURL,
+			}
+		}
+		return "", fmt.Errorf("get template of deployment #%d: %w", deployment.Number, err)
+	}
+	return string(content), nil
+}
+
+// numbers returns the numbers of the recorded deployments of a workload in increasing order.
+func (h *DeploymentHistory) numbers(env, name string) ([]int, error) {
+	keys, err := h.storage.ListKeys(h.bucket, artifactpath.WorkloadDeployments(env, name))
+	if err != nil {
+		return nil, fmt.Errorf("list deployments of %s in environment %s: %w", name, env, err)
+	}
+	var numbers []int
+	for _, key := range keys {
+		number, err := strconv.Atoi(strings.TrimSuffix(path.Base(key), ".json"))
+		if err != nil {
+			continue // Not a deployment record.
+		}
+		numbers = append(numbers, number)
+	}
+	sort.Ints(numbers)
+	return numbers, nil
+}
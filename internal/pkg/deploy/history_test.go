@@ -0,0 +1,270 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadDeployment_PinImages(t *testing.T) {
+	deployment := &WorkloadDeployment{
+		Images: map[string]DeployedImage{
+			"web": {
+				Repository: "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/web",
+				URI:        "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/web:v1",
+				Digest:     "sha256:main",
+			},
+			"logger": {
+				Repository: "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/web",
+				URI:        "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/web:v1-logger",
+				Digest:     "sha256:sidecar",
+			},
+			"nginx": {
+				Repository: "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/web",
+				URI:        "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/web@sha256:nginx",
+			},
+		},
+	}
+
+	got := deployment.PinImages(`Image: 123456789012.dkr.ecr.us-west-2.amazonaws.com/app/web:v1
+Image: 123456789012.dkr.ecr.us-west-2.amazonaws.com/app/web:v1-logger
+Image: 123456789012.dkr.ecr.us-west-2.amazonaws.com/app/web@sha256:nginx
+Image: public.ecr.aws/nginx:v1`)
+
+	require.Equal(t, `Image: 123456789012.dkr.ecr.us-west-2.amazonaws.com/app/web@sha256:main
+Image: 123456789012.dkr.ecr.us-west-2.amazonaws.com/app/web@sha256:sidecar
+Image: 123456789012.dkr.ecr.us-west-2.amazonaws.com/app/web@sha256:nginx
+Image: public.ecr.aws/nginx:v1`, got)
+}
+
+func TestDeploymentHistory_Record(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockhistoryStorage)
+
+		wantedNumber int
+		wantedErr    error
+	}{
+		"should wrap the error if the deployments can't be listed": {
+			setupMocks: func(m *mocks.MockhistoryStorage) {
+				m.EXPECT().ListKeys("bucket", "deployments/test/api/").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list deployments of api in environment test: some error"),
+		},
+		"should store the first deployment": {
+			setupMocks: func(m *mocks.MockhistoryStorage) {
+				m.EXPECT().ListKeys("bucket", "deployments/test/api/").Return(nil, nil)
+				m.EXPECT().UploadIfNotExists("bucket", "deployments/test/api/000001.json", gomock.Any()).Return("url", nil)
+			},
+			wantedNumber: 1,
+		},
+		"should store the deployment after the last one": {
+			setupMocks: func(m *mocks.MockhistoryStorage) {
+				m.EXPECT().ListKeys("bucket", "deployments/test/api/").Return([]string{
+					"deployments/test/api/000009.json",
+					"deployments/test/api/000010.json",
+					"deployments/test/api/notes.txt",
+				}, nil)
+				m.EXPECT().UploadIfNotExists("bucket", "deployments/test/api/000011.json", gomock.Any()).
+					DoAndReturn(func(_, _ string, data io.Reader) (string, error) {
+						content, err := io.ReadAll(data)
+						require.NoError(t, err)
+						require.Contains(t, string(content), `"number": 11`)
+						return "url", nil
+					})
+			},
+			wantedNumber: 11,
+		},
+		"should store the deployment with the next number if another deployment was recorded concurrently": {
+			setupMocks: func(m *mocks.MockhistoryStorage) {
+				m.EXPECT().ListKeys("bucket", "deployments/test/api/").Return([]string{"deployments/test/api/000001.json"}, nil)
+				gomock.InOrder(
+					m.EXPECT().UploadIfNotExists("bucket", "deployments/test/api/000002.json", gomock.Any()).Return("", &s3.ErrObjectExists{}),
+					m.EXPECT().UploadIfNotExists("bucket", "deployments/test/api/000003.json", gomock.Any()).
+						DoAndReturn(func(_, _ string, data io.Reader) (string, error) {
+							content, err := io.ReadAll(data)
+							require.NoError(t, err)
+							require.Contains(t, string(content), `"number": 3`)
+							return "url", nil
+						}),
+				)
+			},
+			wantedNumber: 3,
+		},
+		"should give up if the numbers keep being taken": {
+			setupMocks: func(m *mocks.MockhistoryStorage) {
+				m.EXPECT().ListKeys("bucket", "deployments/test/api/").Return(nil, nil)
+				m.EXPECT().UploadIfNotExists("bucket", gomock.Any(), gomock.Any()).Return("", &s3.ErrObjectExists{}).Times(10)
+			},
+			wantedErr: errors.New("store deployment: deployments #1 to #10 of api in environment test were recorded concurrently"),
+		},
+		"should wrap the error if the deployment can't be stored": {
+			setupMocks: func(m *mocks.MockhistoryStorage) {
+				m.EXPECT().ListKeys("bucket", "deployments/test/api/").Return(nil, nil)
+				m.EXPECT().UploadIfNotExists("bucket", "deployments/test/api/000001.json", gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("store deployment #1: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockhistoryStorage(ctrl)
+			tc.setupMocks(m)
+			history := NewDeploymentHistory("bucket", m)
+			deployment := &WorkloadDeployment{
+				App:  "phonetool",
+				Env:  "test",
+				Name: "api",
+			}
+
+			// WHEN
+			err := history.Record(deployment)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNumber, deployment.Number)
+		})
+	}
+}
+
+func TestDeploymentHistory_List(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockhistoryStorage)
+
+		wantedDeployments []*WorkloadDeployment
+		wantedErr         error
+	}{
+		"should wrap the error if a deployment can't be retrieved": {
+			setupMocks: func(m *mocks.MockhistoryStorage) {
+				m.EXPECT().ListKeys("bucket", "deployments/test/api/").Return([]string{"deployments/test/api/000001.json"}, nil)
+				m.EXPECT().Download("bucket", "deployments/test/api/000001.json").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get deployment #1: some error"),
+		},
+		"should return an error if a deployment is malformed": {
+			setupMocks: func(m *mocks.MockhistoryStorage) {
+				m.EXPECT().ListKeys("bucket", "deployments/test/api/").Return([]string{"deployments/test/api/000001.json"}, nil)
+				m.EXPECT().Download("bucket", "deployments/test/api/000001.json").Return([]byte("{"), nil)
+			},
+			wantedErr: errors.New("unmarshal deployment #1: unexpected end of JSON input"),
+		},
+		"should return the deployments from the oldest to the most recent": {
+			setupMocks: func(m *mocks.MockhistoryStorage) {
+				m.EXPECT().ListKeys("bucket", "deployments/test/api/").Return([]string{
+					"deployments/test/api/000010.json",
+					"deployments/test/api/000002.json",
+				}, nil)
+				m.EXPECT().Download("bucket", "deployments/test/api/000002.json").Return([]byte(`{"number": 2, "gitCommit": "a1b2c3"}`), nil)
+				m.EXPECT().Download("bucket", "deployments/test/api/000010.json").Return([]byte(`{"number": 10, "rollbackOf": 2}`), nil)
+			},
+			wantedDeployments: []*WorkloadDeployment{
+				{Number: 2, GitCommit: "a1b2c3"},
+				{Number: 10, RollbackOf: 2},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockhistoryStorage(ctrl)
+			tc.setupMocks(m)
+			history := NewDeploymentHistory("bucket", m)
+
+			// WHEN
+			deployments, err := history.List("test", "api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDeployments, deployments)
+		})
+	}
+}
+
+func TestDeploymentHistory_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockhistoryStorage(ctrl)
+	m.EXPECT().Download("bucket", "deployments/test/api/000003.json").Return(nil, &s3.ErrObjectNotFound{})
+	history := NewDeploymentHistory("bucket", m)
+
+	_, err := history.Get("test", "api", 3)
+
+	var errNotFound *ErrDeploymentNotFound
+	require.ErrorAs(t, err, &errNotFound)
+	require.EqualError(t, err, "deployment #3 of api in environment test not found")
+}
+
+func TestDeploymentHistory_Template(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplateURL string
+		setupMocks    func(m *mocks.MockhistoryStorage)
+
+		wantedTemplate string
+		wantedErr      error
+	}{
+		"should return ErrDeploymentTemplateNotFound if the template was removed": {
+			inTemplateURL: "https://bucket.s3.us-west-2.amazonaws.com/manual/templates/phonetool-test-api/sha.yml",
+			setupMocks: func(m *mocks.MockhistoryStorage) {
+				m.EXPECT().Download("bucket", "manual/templates/phonetool-test-api/sha.yml").Return(nil, &s3.ErrObjectNotFound{})
+			},
+			wantedErr: &ErrDeploymentTemplateNotFound{
+				Number:      3,
+				TemplateURL: "https://bucket.s3.us-west-2.amazonaws.com/manual/templates/phonetool-test-api/sha.yml",
+			},
+		},
+		"should return the template": {
+			inTemplateURL: "https://bucket.s3.us-west-2.amazonaws.com/manual/templates/phonetool-test-api/sha.yml",
+			setupMocks: func(m *mocks.MockhistoryStorage) {
+				m.EXPECT().Download("bucket", "manual/templates/phonetool-test-api/sha.yml").Return([]byte("Resources: {}"), nil)
+			},
+			wantedTemplate: "Resources: {}",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockhistoryStorage(ctrl)
+			tc.setupMocks(m)
+			history := NewDeploymentHistory("bucket", m)
+
+			// WHEN
+			tmpl, err := history.Template(&WorkloadDeployment{
+				Number:      3,
+				TemplateURL: tc.inTemplateURL,
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTemplate, tmpl)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/deploy/history.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockhistoryStorage is a mock of historyStorage interface.
+type MockhistoryStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockhistoryStorageMockRecorder
+}
+
+// MockhistoryStorageMockRecorder is the mock recorder for MockhistoryStorage.
+type MockhistoryStorageMockRecorder struct {
+	mock *MockhistoryStorage
+}
+
+// NewMockhistoryStorage creates a new mock instance.
+func NewMockhistoryStorage(ctrl *gomock.Controller) *MockhistoryStorage {
+	mock := &MockhistoryStorage{ctrl: ctrl}
+	mock.recorder = &MockhistoryStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockhistoryStorage) EXPECT() *MockhistoryStorageMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MockhistoryStorage) Download(bucket, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", bucket, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockhistoryStorageMockRecorder) Download(bucket, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockhistoryStorage)(nil).Download), bucket, key)
+}
+
+// ListKeys mocks base method.
+func (m *MockhistoryStorage) ListKeys(bucket, prefix string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKeys", bucket, prefix)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKeys indicates an expected call of ListKeys.
+func (mr *MockhistoryStorageMockRecorder) ListKeys(bucket, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeys", reflect.TypeOf((*MockhistoryStorage)(nil).ListKeys), bucket, prefix)
+}
+
+// UploadIfNotExists mocks base method.
+func (m *MockhistoryStorage) UploadIfNotExists(bucket, key string, data io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadIfNotExists", bucket, key, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadIfNotExists indicates an expected call of UploadIfNotExists.
+func (mr *MockhistoryStorageMockRecorder) UploadIfNotExists(bucket, key, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadIfNotExists", reflect.TypeOf((*MockhistoryStorage)(nil).UploadIfNotExists), bucket, key, data)
+}
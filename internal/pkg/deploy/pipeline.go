@@ -15,6 +15,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/graph"
@@ -28,6 +29,8 @@ const (
 
 	defaultPipelineBuildImage      = "aws/codebuild/amazonlinux2-x86_64-standard:4.0"
 	defaultPipelineEnvironmentType = "LINUX_CONTAINER"
+	defaultPipelineComputeType     = "BUILD_GENERAL1_SMALL"
+	defaultPipelineBuildTimeout    = 60 // In minutes.
 
 	// DefaultPipelineArtifactsDir is the default folder to output Copilot-generated templates.
 	DefaultPipelineArtifactsDir = "infrastructure"
@@ -35,6 +38,10 @@ const (
 	DefaultPipelineBranch = "main"
 	// StageFullNamePrefix is prefix to a pipeline stage name. For example, "DeployTo-test" for a test environment stage.
 	StageFullNamePrefix = "DeployTo-"
+
+	// fmtParallelWaveName is the name of a wave that deploys to several environments, numbered from 1 in the order of the waves.
+	// Environment names are lowercase, so it can't collide with the name of an environment.
+	fmtParallelWaveName = "Wave-%d"
 )
 
 // Name of the environment variables injected into the CodeBuild projects that support pre/post-deployment actions.
@@ -74,20 +81,152 @@ type CreatePipelineInput struct {
 	// will be the order we deploy to.
 	Stages []PipelineStage
 
+	// Names of the stages deployed in parallel, in order. If empty, the stages are deployed one after the other.
+	Waves [][]string
+
 	// A list of artifact buckets and corresponding KMS keys that will
 	// be used in this pipeline.
 	ArtifactBuckets []ArtifactBucket
 
+	// The project that deploys preview environments for pull requests, if any.
+	Previews *Previews
+
+	// The rules that notify the events of the pipeline.
+	Notifications []PipelineNotification
+
 	// AdditionalTags are labels applied to resources under the application.
 	AdditionalTags map[string]string
 
 	// PermissionsBoundary is the name of an IAM policy to set a permissions boundary.
 	PermissionsBoundary string
 
+	// ConfigStoreTable is the name of the DynamoDB table that holds the configuration of the applications,
+	// if the account was migrated to DynamoDB. The builds of the pipeline are allowed to read it.
+	ConfigStoreTable string
+
 	// Version is the pipeline template version.
 	Version string
 }
 
+// Types of CodePipeline pipelines.
+const (
+	PipelineTypeV1 = "V1"
+	PipelineTypeV2 = "V2"
+)
+
+// PipelineType returns the type of the pipeline.
+// Stages can only roll back on failure in V2 pipelines.
+func (in *CreatePipelineInput) PipelineType() string {
+	for i := range in.Stages {
+		if in.Stages[i].RollbackOnFailure() {
+			return PipelineTypeV2
+		}
+	}
+	return PipelineTypeV1
+}
+
+// StageWaves returns the waves of stages deployed in order by the pipeline.
+// Without waves in the manifest, each stage is deployed by its own wave.
+func (in *CreatePipelineInput) StageWaves() []PipelineWave {
+	if len(in.Waves) == 0 {
+		waves := make([]PipelineWave, len(in.Stages))
+		for i := range in.Stages {
+			waves[i] = PipelineWave{Stages: in.Stages[i : i+1], index: i}
+		}
+		return waves
+	}
+	stages := make(map[string]PipelineStage, len(in.Stages))
+	for _, stg := range in.Stages {
+		stages[stg.Name()] = stg
+	}
+	waves := make([]PipelineWave, len(in.Waves))
+	for i, names := range in.Waves {
+		waves[i].index = i
+		for _, name := range names {
+			if stg, ok := stages[name]; ok {
+				waves[i].Stages = append(waves[i].Stages, stg)
+			}
+		}
+	}
+	return waves
+}
+
+// PipelineWave is a CodePipeline stage that deploys to the environments of its pipeline stages in parallel.
+type PipelineWave struct {
+	Stages []PipelineStage
+
+	index int // Position of the wave in the pipeline, starting from 0.
+}
+
+// Name returns the name of the environment of the wave, such as "test".
+// The name of a parallel wave is its position in the pipeline instead, such as "Wave-2",
+// and the environments of its actions are in the name of the actions.
+func (w *PipelineWave) Name() string {
+	if !w.IsParallel() && len(w.Stages) == 1 {
+		return w.Stages[0].Name()
+	}
+	return fmt.Sprintf(fmtParallelWaveName, w.index+1)
+}
+
+// FullName returns the name of the CodePipeline stage of the wave.
+func (w *PipelineWave) FullName() string {
+	return StageFullNamePrefix + w.Name()
+}
+
+// IsParallel returns true if the wave deploys to more than one environment.
+// The names of the pre-deployment, post-deployment and test actions of parallel waves end with their environment
+// to be unique in the CodePipeline stage.
+func (w *PipelineWave) IsParallel() bool {
+	return len(w.Stages) > 1
+}
+
+// RollbackOnFailure returns true if the wave redeploys the artifacts of its last successful execution when it fails.
+func (w *PipelineWave) RollbackOnFailure() bool {
+	for i := range w.Stages {
+		if w.Stages[i].RollbackOnFailure() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasDeployments returns true if any stage of the wave deploys a workload or an environment.
+func (w *PipelineWave) HasDeployments() (bool, error) {
+	for i := range w.Stages {
+		deployments, err := w.Stages[i].Deployments()
+		if err != nil {
+			return false, err
+		}
+		if len(deployments) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// StageDeploysToEnvironment returns true if a CodePipeline stage of the pipeline, given its full name and the names
+// of its actions, deploys to the environment.
+func StageDeploysToEnvironment(fullName string, actionNames []string, env string) bool {
+	if fullName == StageFullNamePrefix+env {
+		return true
+	}
+	name, ok := strings.CutPrefix(fullName, StageFullNamePrefix)
+	if !ok {
+		return false
+	}
+	var index int
+	if _, err := fmt.Sscanf(name, fmtParallelWaveName, &index); err != nil {
+		return false
+	}
+	// The names of the actions of a parallel wave end with their environment.
+	for _, name := range actionNames {
+		if strings.HasSuffix(name, "-"+env) {
+			return true
+		}
+	}
+	return false
+}
+
 // Build represents CodeBuild project used in the CodePipeline
 // to build and test Docker image.
 type Build struct {
@@ -97,6 +236,81 @@ type Build struct {
 	BuildspecPath            string
 	AdditionalPolicyDocument string
 	Variables                map[string]string
+	// OnlyChanged is true if the build only packages the workloads changed since the last successful execution.
+	OnlyChanged bool
+
+	// ComputeType is the CodeBuild compute type of the project, such as "BUILD_GENERAL1_LARGE".
+	ComputeType string
+	// TimeoutInMinutes is the timeout of the build.
+	TimeoutInMinutes int
+	// Privileged is false if the build doesn't need to run the Docker daemon.
+	Privileged *bool
+	// CacheType is the "cache" of the manifest, and CacheLocation the S3 location of the cache if it is "s3".
+	CacheType     string
+	CacheLocation string
+	// Secrets are the environment variables read from SSM Parameter Store or Secrets Manager.
+	Secrets map[string]BuildSecret
+	// VPCEnvironment is the name of the environment whose VPC runs the build.
+	VPCEnvironment string
+}
+
+// Types of the CodeBuild environment variables that hold secrets.
+const (
+	BuildSecretTypeParameterStore = "PARAMETER_STORE"
+	BuildSecretTypeSecretsManager = "SECRETS_MANAGER"
+)
+
+// BuildSecret is an environment variable of the build read from SSM Parameter Store or Secrets Manager.
+type BuildSecret struct {
+	Type  string
+	Value string // Name or ARN of the parameter or the secret.
+}
+
+// buildComputeTypes maps the compute sizes of the manifest to CodeBuild compute types.
+var buildComputeTypes = map[string]string{
+	manifest.BuildComputeSizeSmall:   "BUILD_GENERAL1_SMALL",
+	manifest.BuildComputeSizeMedium:  "BUILD_GENERAL1_MEDIUM",
+	manifest.BuildComputeSizeLarge:   "BUILD_GENERAL1_LARGE",
+	manifest.BuildComputeSize2XLarge: "BUILD_GENERAL1_2XLARGE",
+}
+
+// Compute returns the CodeBuild compute type of the build project.
+func (b Build) Compute() string {
+	if b.ComputeType == "" {
+		return defaultPipelineComputeType
+	}
+	return b.ComputeType
+}
+
+// Timeout returns the timeout of the build in minutes.
+func (b Build) Timeout() int {
+	if b.TimeoutInMinutes == 0 {
+		return defaultPipelineBuildTimeout
+	}
+	return b.TimeoutInMinutes
+}
+
+// PrivilegedMode returns true if the build can run the Docker daemon, which is the default.
+func (b Build) PrivilegedMode() bool {
+	return b.Privileged == nil || *b.Privileged
+}
+
+// LocalCache returns true if the build caches the Docker layers on the build host.
+// Unless another cache is configured, Linux and ARM builds cache the Docker layers locally.
+func (b Build) LocalCache() bool {
+	switch b.CacheType {
+	case manifest.BuildCacheLocal:
+		return true
+	case "":
+		return b.EnvironmentType == manifest.BuildComputeTypeLinux || b.EnvironmentType == manifest.BuildComputeTypeARM
+	default:
+		return false
+	}
+}
+
+// S3Cache returns true if the build caches its files in S3 at CacheLocation.
+func (b Build) S3Cache() bool {
+	return b.CacheType == manifest.BuildCacheS3
 }
 
 // Init populates the fields in Build by parsing the manifest file's "build" section.
@@ -111,7 +325,10 @@ func (b *Build) Init(mfBuild *manifest.Build, mfDirPath string) error {
 		path = mfBuild.Buildspec
 	}
 	if strings.Contains(image, "aarch64") {
-		environmentType = "ARM_CONTAINER"
+		environmentType = manifest.BuildComputeTypeARM
+	}
+	if mfBuild != nil && mfBuild.Compute.Type != "" {
+		environmentType = mfBuild.Compute.Type
 	}
 	if mfBuild != nil && !mfBuild.AdditionalPolicy.Document.IsZero() {
 		additionalPolicy, err := yaml.Marshal(&mfBuild.AdditionalPolicy.Document)
@@ -120,6 +337,16 @@ func (b *Build) Init(mfBuild *manifest.Build, mfDirPath string) error {
 		}
 		b.AdditionalPolicyDocument = strings.TrimSpace(string(additionalPolicy))
 	}
+	if mfBuild != nil {
+		b.OnlyChanged = mfBuild.OnlyChanged
+		b.ComputeType = buildComputeTypes[mfBuild.Compute.Size]
+		b.TimeoutInMinutes = aws.IntValue(mfBuild.Timeout)
+		b.Privileged = mfBuild.Privileged
+		b.CacheType = mfBuild.Cache
+		b.VPCEnvironment = mfBuild.VPC.Environment
+		b.Variables = mfBuild.Variables
+		b.Secrets = buildSecrets(mfBuild.Secrets)
+	}
 	b.Image = image
 	b.EnvironmentType = environmentType
 	b.BuildspecPath = filepath.ToSlash(path) // Buildspec path must be with '/' because CloudFormation expects forward-slash separated file path.
@@ -127,6 +354,112 @@ func (b *Build) Init(mfBuild *manifest.Build, mfDirPath string) error {
 	return nil
 }
 
+func buildSecrets(mfSecrets map[string]manifest.Secret) map[string]BuildSecret {
+	if len(mfSecrets) == 0 {
+		return nil
+	}
+	secrets := make(map[string]BuildSecret, len(mfSecrets))
+	for name, mfSecret := range mfSecrets {
+		secretType := BuildSecretTypeParameterStore
+		if mfSecret.IsSecretsManagerName() || isSecretsManagerARN(mfSecret.Value()) {
+			secretType = BuildSecretTypeSecretsManager
+		}
+		secrets[name] = BuildSecret{
+			Type:  secretType,
+			Value: mfSecret.Value(),
+		}
+	}
+	return secrets
+}
+
+func isSecretsManagerARN(value string) bool {
+	parsed, err := arn.Parse(value)
+	if err != nil {
+		return false
+	}
+	return parsed.Service == "secretsmanager"
+}
+
+// Previews represents the CodeBuild project that deploys a preview environment for each pull request.
+type Previews struct {
+	// URL to download the Copilot binary that deploys the preview environments.
+	BinaryURL string
+	// Name of the environment whose manifest and region the previews reuse.
+	Environment string
+	// Name of the secret with the token to comment on GitHub or Bitbucket pull requests, if any.
+	AccessTokenSecret string
+	// IDs of the GitHub or Bitbucket accounts whose pull requests trigger a preview.
+	TrustedActors []string
+}
+
+// TrustedActorsPattern returns the regular expression of the webhook filter that matches the trusted actors.
+func (p *Previews) TrustedActorsPattern() string {
+	ids := make([]string, len(p.TrustedActors))
+	for i, id := range p.TrustedActors {
+		ids[i] = regexp.QuoteMeta(id)
+	}
+	return fmt.Sprintf("^(%s)$", strings.Join(ids, "|"))
+}
+
+// Types of the targets of a CodeStar Notifications rule.
+const (
+	NotificationTargetTypeSNS          = "SNS"
+	NotificationTargetTypeChatbotSlack = "AWSChatbotSlack"
+	NotificationTargetTypeChatbotTeams = "AWSChatbotMicrosoftTeams"
+)
+
+// pipelineEventTypeIDs maps the events of the pipeline manifest to CodeStar Notifications event type IDs.
+var pipelineEventTypeIDs = map[string]string{
+	manifest.PipelineEventStageFailed:    "codepipeline-pipeline-stage-execution-failed",
+	manifest.PipelineEventApprovalNeeded: "codepipeline-pipeline-manual-approval-needed",
+	manifest.PipelineEventSucceeded:      "codepipeline-pipeline-pipeline-execution-succeeded",
+}
+
+// PipelineNotification represents a CodeStar Notifications rule that sends the events of the pipeline to its targets.
+type PipelineNotification struct {
+	EventTypeIDs []string
+	// Existing SNS topics and AWS Chatbot channel configurations.
+	Targets []NotificationTarget
+	// Email addresses subscribed to a topic created for the rule.
+	Emails []string
+}
+
+// NotificationTarget is an existing SNS topic or AWS Chatbot channel configuration that receives notifications.
+type NotificationTarget struct {
+	Type    string
+	Address string
+}
+
+// PipelineNotificationsFromManifest converts the "notifications" section of the pipeline manifest to notification rules.
+func PipelineNotificationsFromManifest(mfNotifications []manifest.PipelineNotification) []PipelineNotification {
+	var notifications []PipelineNotification
+	for _, mf := range mfNotifications {
+		var n PipelineNotification
+		for _, event := range mf.EventsOrDefault() {
+			n.EventTypeIDs = append(n.EventTypeIDs, pipelineEventTypeIDs[event])
+		}
+		for _, topic := range mf.SNSTopics {
+			n.Targets = append(n.Targets, NotificationTarget{
+				Type:    NotificationTargetTypeSNS,
+				Address: topic,
+			})
+		}
+		for _, channel := range mf.Chatbot {
+			targetType := NotificationTargetTypeChatbotSlack
+			if strings.Contains(channel, ":chat-configuration/microsoft-teams-channel/") {
+				targetType = NotificationTargetTypeChatbotTeams
+			}
+			n.Targets = append(n.Targets, NotificationTarget{
+				Type:    targetType,
+				Address: channel,
+			})
+		}
+		n.Emails = mf.Emails
+		notifications = append(notifications, n)
+	}
+	return notifications
+}
+
 // ArtifactBucket represents an S3 bucket used by the CodePipeline to store
 // intermediate artifacts produced by the pipeline.
 type ArtifactBucket struct {
@@ -287,6 +620,12 @@ func PipelineSourceFromManifest(mfSource *manifest.Source) (source interface{},
 		}
 		repo.ConnectionARN = connection.(string)
 		return repo, false, nil
+	case manifest.GitLabProviderName:
+		return &GitLabSource{
+			ProviderName:  manifest.GitLabProviderName,
+			Branch:        branch,
+			RepositoryURL: repository,
+		}, false, nil
 	default:
 		return nil, false, fmt.Errorf("invalid repo source provider: %s", mfSource.ProviderName)
 	}
@@ -499,6 +838,7 @@ type PipelineStage struct {
 	preDeployments    manifest.PrePostDeployments
 	deployments       manifest.Deployments
 	postDeployments   manifest.PrePostDeployments
+	rollbackOnFailure bool
 }
 
 // Init populates the fields in PipelineStage against a target environment,
@@ -525,6 +865,7 @@ func (stg *PipelineStage) Init(env *config.Environment, mftStage *manifest.Pipel
 	stg.testCommands = mftStage.TestCommands
 	stg.execRoleARN = env.ExecutionRoleARN
 	stg.envManagerRoleARN = env.ManagerRoleARN
+	stg.rollbackOnFailure = mftStage.OnFailure == manifest.PipelineStageOnFailureRollback
 }
 
 // Name returns the stage's name.
@@ -548,6 +889,11 @@ func (stg *PipelineStage) Approval() *ManualApprovalAction {
 	}
 }
 
+// RollbackOnFailure returns true if the stage redeploys the artifacts of its last successful execution when it fails.
+func (stg *PipelineStage) RollbackOnFailure() bool {
+	return stg.rollbackOnFailure
+}
+
 // Region returns the AWS region name, such as "us-west-2", where the deployments will occur.
 func (stg *PipelineStage) Region() string {
 	return stg.associatedEnvironment.Region
@@ -173,6 +173,21 @@ func TestPipelineSourceFromManifest(t *testing.T) {
 			expectedShouldPrompt: false,
 			expectedErr:          errors.New("missing `repository` in properties"),
 		},
+		"transforms GitLab source": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.GitLabProviderName,
+				Properties: map[string]interface{}{
+					"branch":     "test",
+					"repository": "https://gitlab.com/group/project",
+				},
+			},
+			expectedDeploySource: &GitLabSource{
+				ProviderName:  manifest.GitLabProviderName,
+				Branch:        "test",
+				RepositoryURL: "https://gitlab.com/group/project",
+			},
+			expectedShouldPrompt: false,
+		},
 		"errors if user changed provider name in manifest to unsupported source": {
 			mfSource: &manifest.Source{
 				ProviderName: "BitCommitHubBucket",
@@ -200,6 +215,37 @@ func TestPipelineSourceFromManifest(t *testing.T) {
 	}
 }
 
+func TestPipelineBuild_LocalCache(t *testing.T) {
+	testCases := map[string]struct {
+		build Build
+
+		wanted bool
+	}{
+		"cache Linux builds locally by default": {
+			build:  Build{EnvironmentType: "LINUX_CONTAINER"},
+			wanted: true,
+		},
+		"don't cache GPU builds by default": {
+			build: Build{EnvironmentType: "LINUX_GPU_CONTAINER"},
+		},
+		"cache locally if configured": {
+			build:  Build{EnvironmentType: "LINUX_GPU_CONTAINER", CacheType: "local"},
+			wanted: true,
+		},
+		"don't cache locally if the cache is in S3": {
+			build: Build{EnvironmentType: "LINUX_CONTAINER", CacheType: "s3"},
+		},
+		"don't cache locally if the cache is disabled": {
+			build: Build{EnvironmentType: "ARM_CONTAINER", CacheType: "none"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.build.LocalCache())
+		})
+	}
+}
+
 func TestPipelineBuild_Init(t *testing.T) {
 	const (
 		defaultImage   = "aws/codebuild/amazonlinux2-x86_64-standard:4.0"
@@ -214,6 +260,12 @@ func TestPipelineBuild_Init(t *testing.T) {
   Version: 2012-10-17`)
 
 	require.NoError(t, yaml.Unmarshal(policyDocument, &yamlNode))
+	var secrets map[string]manifest.Secret
+	require.NoError(t, yaml.Unmarshal([]byte(`
+NPM_TOKEN: /copilot/release/npm-token
+DB_PASSWORD:
+  secretsmanager: demo/test/mysql
+GITHUB_TOKEN: arn:aws:secretsmanager:us-west-2:123456789012:secret:github-token-Nmk1PR`), &secrets))
 
 	testCases := map[string]struct {
 		mfBuild       *manifest.Build
@@ -281,6 +333,49 @@ func TestPipelineBuild_Init(t *testing.T) {
 				AdditionalPolicyDocument: "",
 			},
 		},
+		"only build changed workloads": {
+			mfBuild: &manifest.Build{
+				OnlyChanged: true,
+			},
+			mfDirPath: "copilot/pipelines/my-pipeline/",
+			expectedBuild: Build{
+				Image:           defaultImage,
+				EnvironmentType: defaultEnvType,
+				BuildspecPath:   "copilot/pipelines/my-pipeline/buildspec.yml",
+				OnlyChanged:     true,
+			},
+		},
+		"configure the compute, timeout, cache, VPC, variables and secrets": {
+			mfBuild: &manifest.Build{
+				Compute: manifest.BuildCompute{
+					Type: manifest.BuildComputeTypeLinuxGPU,
+					Size: manifest.BuildComputeSizeLarge,
+				},
+				Timeout:    aws.Int(120),
+				Privileged: aws.Bool(false),
+				Cache:      manifest.BuildCacheS3,
+				VPC:        manifest.BuildVPC{Environment: "test"},
+				Variables:  map[string]string{"GOFLAGS": "-mod=vendor"},
+				Secrets:    secrets,
+			},
+			mfDirPath: "copilot/pipelines/my-pipeline/",
+			expectedBuild: Build{
+				Image:            defaultImage,
+				EnvironmentType:  "LINUX_GPU_CONTAINER",
+				BuildspecPath:    "copilot/pipelines/my-pipeline/buildspec.yml",
+				Variables:        map[string]string{"GOFLAGS": "-mod=vendor"},
+				ComputeType:      "BUILD_GENERAL1_LARGE",
+				TimeoutInMinutes: 120,
+				Privileged:       aws.Bool(false),
+				CacheType:        "s3",
+				VPCEnvironment:   "test",
+				Secrets: map[string]BuildSecret{
+					"NPM_TOKEN":    {Type: "PARAMETER_STORE", Value: "/copilot/release/npm-token"},
+					"DB_PASSWORD":  {Type: "SECRETS_MANAGER", Value: "demo/test/mysql"},
+					"GITHUB_TOKEN": {Type: "SECRETS_MANAGER", Value: "arn:aws:secretsmanager:us-west-2:123456789012:secret:github-token-Nmk1PR"},
+				},
+			},
+		},
 		"by default convert legacy manifest path to buildspec path": {
 			mfDirPath: "copilot/",
 			expectedBuild: Build{
@@ -307,6 +402,58 @@ func TestPipelineBuild_Init(t *testing.T) {
 	}
 }
 
+func TestPipelineNotificationsFromManifest(t *testing.T) {
+	testCases := map[string]struct {
+		in []manifest.PipelineNotification
+
+		wanted []PipelineNotification
+	}{
+		"no notifications": {},
+		"default to all events": {
+			in: []manifest.PipelineNotification{
+				{Emails: []string{"oncall@example.com"}},
+			},
+			wanted: []PipelineNotification{
+				{
+					EventTypeIDs: []string{
+						"codepipeline-pipeline-stage-execution-failed",
+						"codepipeline-pipeline-manual-approval-needed",
+						"codepipeline-pipeline-pipeline-execution-succeeded",
+					},
+					Emails: []string{"oncall@example.com"},
+				},
+			},
+		},
+		"convert topics and chatbot channels to targets": {
+			in: []manifest.PipelineNotification{
+				{
+					Events:    []string{manifest.PipelineEventApprovalNeeded},
+					SNSTopics: []string{"arn:aws:sns:us-west-2:123456789012:deploys"},
+					Chatbot: []string{
+						"arn:aws:chatbot::123456789012:chat-configuration/slack-channel/deploys",
+						"arn:aws:chatbot::123456789012:chat-configuration/microsoft-teams-channel/deploys",
+					},
+				},
+			},
+			wanted: []PipelineNotification{
+				{
+					EventTypeIDs: []string{"codepipeline-pipeline-manual-approval-needed"},
+					Targets: []NotificationTarget{
+						{Type: "SNS", Address: "arn:aws:sns:us-west-2:123456789012:deploys"},
+						{Type: "AWSChatbotSlack", Address: "arn:aws:chatbot::123456789012:chat-configuration/slack-channel/deploys"},
+						{Type: "AWSChatbotMicrosoftTeams", Address: "arn:aws:chatbot::123456789012:chat-configuration/microsoft-teams-channel/deploys"},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, PipelineNotificationsFromManifest(tc.in))
+		})
+	}
+}
+
 func TestParseOwnerAndRepo(t *testing.T) {
 	testCases := map[string]struct {
 		src            *GitHubSource
@@ -366,6 +513,7 @@ func TestPipelineStage_Init(t *testing.T) {
 		Name:             "test",
 		RequiresApproval: true,
 		TestCommands:     []string{"make test", "echo \"made test\""},
+		OnFailure:        manifest.PipelineStageOnFailureRollback,
 	}, []string{"frontend", "backend"})
 
 	t.Run("stage name matches the environment's name", func(t *testing.T) {
@@ -394,6 +542,145 @@ func TestPipelineStage_Init(t *testing.T) {
 		stg := PipelineStage{}
 		require.Nil(t, stg.Approval(), "should return nil by default")
 	})
+	t.Run("rollback on failure", func(t *testing.T) {
+		require.True(t, stg.RollbackOnFailure(), "should roll back the stage when the manifest requires it")
+
+		stg := PipelineStage{}
+		require.False(t, stg.RollbackOnFailure(), "should not roll back by default")
+	})
+}
+
+func TestCreatePipelineInput_PipelineType(t *testing.T) {
+	stage := func(onFailure string) PipelineStage {
+		var stg PipelineStage
+		stg.Init(&config.Environment{Name: "test"}, &manifest.PipelineStage{Name: "test", OnFailure: onFailure}, nil)
+		return stg
+	}
+	testCases := map[string]struct {
+		stages []PipelineStage
+
+		wanted string
+	}{
+		"V1 by default": {
+			stages: []PipelineStage{stage("")},
+			wanted: PipelineTypeV1,
+		},
+		"V2 if a stage rolls back on failure": {
+			stages: []PipelineStage{stage(""), stage(manifest.PipelineStageOnFailureRollback)},
+			wanted: PipelineTypeV2,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			in := &CreatePipelineInput{Stages: tc.stages}
+			require.Equal(t, tc.wanted, in.PipelineType())
+		})
+	}
+}
+
+func TestCreatePipelineInput_StageWaves(t *testing.T) {
+	stage := func(name, onFailure string) PipelineStage {
+		var stg PipelineStage
+		stg.Init(&config.Environment{Name: name}, &manifest.PipelineStage{Name: name, OnFailure: onFailure}, nil)
+		return stg
+	}
+	type wantedWave struct {
+		name              string
+		fullName          string
+		isParallel        bool
+		rollbackOnFailure bool
+	}
+	testCases := map[string]struct {
+		stages []PipelineStage
+		waves  [][]string
+
+		wanted []wantedWave
+	}{
+		"deploys each stage in its own wave without waves": {
+			stages: []PipelineStage{stage("test", ""), stage("prod", "")},
+			wanted: []wantedWave{
+				{name: "test", fullName: "DeployTo-test"},
+				{name: "prod", fullName: "DeployTo-prod"},
+			},
+		},
+		"deploys the stages of a wave in parallel": {
+			stages: []PipelineStage{stage("test", ""), stage("prod-eu", ""), stage("prod-us", manifest.PipelineStageOnFailureRollback)},
+			waves:  [][]string{{"test"}, {"prod-us", "prod-eu"}},
+			wanted: []wantedWave{
+				{name: "test", fullName: "DeployTo-test"},
+				{name: "Wave-2", fullName: "DeployTo-Wave-2", isParallel: true, rollbackOnFailure: true},
+			},
+		},
+		"names parallel waves after their position regardless of the number of environments": {
+			stages: []PipelineStage{stage("prod-us-east-1", ""), stage("prod-us-west-2", ""), stage("prod-eu-west-1", ""),
+				stage("prod-eu-central-1", ""), stage("prod-ap-south-1", ""), stage("prod-ap-northeast-1", ""),
+				stage("prod-sa-east-1", ""), stage("prod-ca-central-1", "")},
+			waves: [][]string{{"prod-us-east-1", "prod-us-west-2", "prod-eu-west-1", "prod-eu-central-1",
+				"prod-ap-south-1", "prod-ap-northeast-1", "prod-sa-east-1", "prod-ca-central-1"}},
+			wanted: []wantedWave{
+				{name: "Wave-1", fullName: "DeployTo-Wave-1", isParallel: true},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			in := &CreatePipelineInput{Stages: tc.stages, Waves: tc.waves}
+
+			waves := in.StageWaves()
+
+			require.Len(t, waves, len(tc.wanted))
+			for i, wanted := range tc.wanted {
+				require.Equal(t, wanted.name, waves[i].Name())
+				require.Equal(t, wanted.fullName, waves[i].FullName())
+				require.Equal(t, wanted.isParallel, waves[i].IsParallel())
+				require.Equal(t, wanted.rollbackOnFailure, waves[i].RollbackOnFailure())
+			}
+		})
+	}
+}
+
+func TestStageDeploysToEnvironment(t *testing.T) {
+	waveActions := []string{"ApprovePromotionTo-prod-us", "CreateOrUpdate-api-prod-us", "CreateOrUpdate-api-prod-eu", "TestCommands-prod-eu"}
+	testCases := map[string]struct {
+		fullName    string
+		actionNames []string
+		env         string
+
+		wanted bool
+	}{
+		"stage of the environment": {
+			fullName:    "DeployTo-test",
+			actionNames: []string{"CreateOrUpdate-api-test"},
+			env:         "test",
+			wanted:      true,
+		},
+		"stage of another environment": {
+			fullName:    "DeployTo-test",
+			actionNames: []string{"CreateOrUpdate-api-test"},
+			env:         "prod",
+		},
+		"parallel wave with an action of the environment": {
+			fullName:    "DeployTo-Wave-2",
+			actionNames: waveActions,
+			env:         "prod-eu",
+			wanted:      true,
+		},
+		"parallel wave without actions of the environment": {
+			fullName:    "DeployTo-Wave-2",
+			actionNames: waveActions,
+			env:         "test",
+		},
+		"source stage": {
+			fullName:    "Source",
+			actionNames: []string{"SourceCodeFor-phonetool"},
+			env:         "phonetool",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, StageDeploysToEnvironment(tc.fullName, tc.actionNames, tc.env))
+		})
+	}
 }
 
 func TestPipelineStage_PreDeployments(t *testing.T) {
@@ -846,6 +1133,14 @@ func TestDeployAction_RunOrder(t *testing.T) {
 	require.Equal(t, 6, in.RunOrder(), "should be past actions + 1 + rank")
 }
 
+func TestPreviews_TrustedActorsPattern(t *testing.T) {
+	previews := &Previews{
+		TrustedActors: []string{"1234567", "{a1b2c3d4-0000-0000-0000-000000000000}"},
+	}
+
+	require.Equal(t, `^(1234567|\{a1b2c3d4-0000-0000-0000-000000000000\})$`, previews.TrustedActorsPattern())
+}
+
 func TestTestCommandsAction_Name(t *testing.T) {
 	require.Equal(t, "TestCommands", (&TestCommandsAction{}).Name())
 }
@@ -0,0 +1,29 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package deploy holds the structures to deploy infrastructure resources.
+// This file defines route deployment resources.
+package deploy
+
+// CreateRouteInput holds the fields required to deploy a route stack.
+type CreateRouteInput struct {
+	App            string // Name of the application the route belongs to.
+	Name           string // Name of the route.
+	Hostname       string // Fully qualified domain name served by the route.
+	HostedZoneName string // Name of the hosted zone that holds the records, ending with a dot.
+	RoutingPolicy  string // One of latency, weighted or failover.
+
+	Targets []RouteTargetInput
+
+	AdditionalTags map[string]string
+}
+
+// RouteTargetInput holds the load balancer of an environment that serves traffic for a route.
+type RouteTargetInput struct {
+	Env                string
+	Region             string
+	LoadBalancerDNS    string // DNS name of the environment's public load balancer.
+	LoadBalancerZoneID string // Canonical hosted zone ID of the environment's public load balancer.
+	Weight             *int
+	Primary            bool
+}
@@ -0,0 +1,321 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// This file defines the resources of pipelines that run as GitHub Actions or GitLab CI workflows.
+
+package deploy
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"gopkg.in/yaml.v3"
+)
+
+// OIDC identity providers trusted by the roles of the workflows.
+const (
+	githubOIDCProviderHost     = "token.actions.githubusercontent.com"
+	githubOIDCProviderAudience = "sts.amazonaws.com"
+	gitlabOIDCProviderHost     = "gitlab.com"
+	gitlabOIDCProviderAudience = "https://gitlab.com"
+)
+
+// Names of the environment variables available to every step of a workflow.
+const (
+	envVarNameAWSRegion = "AWS_REGION"
+)
+
+var (
+	// Ex: https://gitlab.com/group/subgroup/project.git
+	glRepoExp = regexp.MustCompile(`^(https:\/\/gitlab\.com\/|)(?P<path>[^:]+?)(\.git)?$`)
+
+	// The thumbprints of the root CAs of the OIDC identity providers.
+	githubOIDCThumbprints = []string{"6938fd4d98bab03faadb97b34396831e3780aea1", "1c58a3a8518e8759bf075b76b750d4f2df264fcd"}
+	gitlabOIDCThumbprints = []string{"b3dd7606d2b5a8b4a13771dbecc9ee1cecafa38a"}
+)
+
+// GitLabSource defines the GitLab project tracked by a GitLab CI workflow.
+type GitLabSource struct {
+	ProviderName  string
+	Branch        string
+	RepositoryURL string
+}
+
+// ProjectPath returns the full path of the project, including its groups, such as "group/subgroup/project".
+func (s *GitLabSource) ProjectPath() (string, error) {
+	match := glRepoExp.FindStringSubmatch(s.RepositoryURL)
+	if len(match) == 0 {
+		return "", fmt.Errorf(fmtInvalidRepo, s.RepositoryURL)
+	}
+	projectPath := match[glRepoExp.SubexpIndex("path")]
+	if !strings.Contains(projectPath, "/") {
+		return "", fmt.Errorf(fmtInvalidRepo, s.RepositoryURL)
+	}
+	return projectPath, nil
+}
+
+// OIDCProvider represents the OpenID Connect identity provider of a GitHub Actions or GitLab CI workflow.
+type OIDCProvider struct {
+	Host        string   // Host of the issuer of the tokens, such as "gitlab.com".
+	Audience    string   // Audience of the tokens exchanged for AWS credentials.
+	Thumbprints []string // Thumbprints of the certificates of the issuer.
+	Subjects    []string // Subjects of the tokens that can assume the role of the workflow.
+}
+
+// URL returns the URL of the issuer of the tokens.
+func (p *OIDCProvider) URL() string {
+	return "https://" + p.Host
+}
+
+// CreateWorkflowInput represents the fields required to deploy the OIDC identity provider and the
+// role assumed by a GitHub Actions or GitLab CI workflow.
+type CreateWorkflowInput struct {
+	// Name of the application this workflow belongs to.
+	AppName string
+
+	// Name of the pipeline.
+	Name string
+
+	// Platform that runs the workflow, either manifest.PipelinePlatformGitHubActions or manifest.PipelinePlatformGitLabCI.
+	Platform string
+
+	// The source code provider for this workflow.
+	Source interface{}
+
+	// ARN of an existing OIDC identity provider for the platform. If empty, the provider is created with the workflow.
+	OIDCProviderARN string
+
+	// The stages of the workflow. The order of stages in this list will be the order we deploy to.
+	Stages []PipelineStage
+
+	// A list of artifact buckets and corresponding KMS keys that the workflow uploads artifacts to.
+	ArtifactBuckets []ArtifactBucket
+
+	// AdditionalTags are labels applied to resources under the application.
+	AdditionalTags map[string]string
+
+	// PermissionsBoundary is the name of an IAM policy to set a permissions boundary.
+	PermissionsBoundary string
+
+	// Version is the workflow template version.
+	Version string
+}
+
+// OIDCProvider returns the identity provider of the platform and the subjects trusted by the role of the workflow.
+// GitHub tokens are trusted for the environments of the stages, and GitLab tokens for the branch of the source.
+func (in *CreateWorkflowInput) OIDCProvider() (*OIDCProvider, error) {
+	switch src := in.Source.(type) {
+	case *GitHubSource:
+		return githubOIDCProvider(src.RepositoryURL, in.Stages)
+	case *GitHubV1Source:
+		return githubOIDCProvider(src.RepositoryURL, in.Stages)
+	case *GitLabSource:
+		projectPath, err := src.ProjectPath()
+		if err != nil {
+			return nil, err
+		}
+		return &OIDCProvider{
+			Host:        gitlabOIDCProviderHost,
+			Audience:    gitlabOIDCProviderAudience,
+			Thumbprints: gitlabOIDCThumbprints,
+			Subjects:    []string{fmt.Sprintf("project_path:%s:ref_type:branch:ref:%s", projectPath, src.Branch)},
+		}, nil
+	default:
+		return nil, fmt.Errorf("source %T cannot run a workflow", in.Source)
+	}
+}
+
+func githubOIDCProvider(url GitHubURL, stages []PipelineStage) (*OIDCProvider, error) {
+	owner, repo, err := url.parse()
+	if err != nil {
+		return nil, err
+	}
+	var subjects []string
+	for _, stg := range stages {
+		subjects = append(subjects, fmt.Sprintf("repo:%s/%s:environment:%s", owner, repo, stg.Name()))
+	}
+	return &OIDCProvider{
+		Host:        githubOIDCProviderHost,
+		Audience:    githubOIDCProviderAudience,
+		Thumbprints: githubOIDCThumbprints,
+		Subjects:    subjects,
+	}, nil
+}
+
+// WorkflowOIDCProviderURL returns the URL of the OIDC identity provider of a workflow platform.
+func WorkflowOIDCProviderURL(platform string) string {
+	if platform == manifest.PipelinePlatformGitLabCI {
+		return "https://" + gitlabOIDCProviderHost
+	}
+	return "https://" + githubOIDCProviderHost
+}
+
+// Workflow holds the data to render a GitHub Actions or GitLab CI workflow.
+type Workflow struct {
+	AppName   string          // Name of the application.
+	Name      string          // Name of the pipeline.
+	Branch    string          // Branch whose pushes trigger the workflow.
+	RoleARN   string          // ARN of the role assumed by the jobs of the workflow.
+	Region    string          // Region of the application.
+	BinaryURL string          // URL of the Copilot binary installed by the jobs.
+	Stages    []WorkflowStage // Stages deployed in order by the workflow.
+}
+
+// Env returns the environment variables available to every job of the workflow.
+func (w *Workflow) Env() map[string]string {
+	return map[string]string{
+		envVarNameAWSRegion:       w.Region,
+		envVarNameApplicationName: w.AppName,
+	}
+}
+
+// WorkflowStage is a job of a workflow that deploys to an environment.
+type WorkflowStage struct {
+	Name             string         // Name of the environment.
+	RequiresApproval bool           // True if the job waits for a manual approval.
+	Steps            []WorkflowStep // Steps run in order by the job.
+}
+
+// JobName returns the name of the job that deploys the stage.
+func (s *WorkflowStage) JobName() string {
+	return "deploy-" + s.Name
+}
+
+// ApprovalJobName returns the name of the job that approves the stage on platforms where approvals are jobs.
+func (s *WorkflowStage) ApprovalJobName() string {
+	return "approve-" + s.Name
+}
+
+// Env returns the environment variables available to the steps of the stage.
+func (s *WorkflowStage) Env() map[string]string {
+	return map[string]string{
+		envVarNameEnvironmentName: s.Name,
+	}
+}
+
+// WorkflowStep is a named list of shell commands run by a job of a workflow.
+type WorkflowStep struct {
+	Name     string
+	Commands []string
+}
+
+type workflowStepRunner struct {
+	WorkflowStep
+	runOrder int
+}
+
+// NewWorkflowStage converts a pipeline stage into the job of a workflow.
+// The pre- and post-deployments run the commands read from their buildspecs with readBuildspec,
+// and the deployments run "copilot env deploy" for environments and "copilot deploy" for workloads.
+func NewWorkflowStage(stg *PipelineStage, readBuildspec func(path string) ([]byte, error)) (*WorkflowStage, error) {
+	var steps []workflowStepRunner
+	preDeployments, err := stg.PreDeployments()
+	if err != nil {
+		return nil, err
+	}
+	for i := range preDeployments {
+		step, err := buildspecStep(&preDeployments[i], readBuildspec)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+	}
+	deployments, err := stg.Deployments()
+	if err != nil {
+		return nil, err
+	}
+	for i := range deployments {
+		steps = append(steps, workflowStepRunner{
+			WorkflowStep: WorkflowStep{
+				Name:     deployments[i].Name(),
+				Commands: []string{deployments[i].command()},
+			},
+			runOrder: deployments[i].RunOrder(),
+		})
+	}
+	postDeployments, err := stg.PostDeployments()
+	if err != nil {
+		return nil, err
+	}
+	for i := range postDeployments {
+		step, err := buildspecStep(&postDeployments[i], readBuildspec)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+	}
+	test, err := stg.Test()
+	if err != nil {
+		return nil, err
+	}
+	if test != nil {
+		steps = append(steps, workflowStepRunner{
+			WorkflowStep: WorkflowStep{
+				Name:     test.Name(),
+				Commands: test.Commands(),
+			},
+			runOrder: test.RunOrder(),
+		})
+	}
+	// Actions that run in parallel in CodePipeline run one after the other in a job, keeping the order of their names.
+	sort.SliceStable(steps, func(i, j int) bool {
+		return steps[i].runOrder < steps[j].runOrder
+	})
+	wfStage := &WorkflowStage{
+		Name:             stg.Name(),
+		RequiresApproval: stg.Approval() != nil,
+	}
+	for _, step := range steps {
+		wfStage.Steps = append(wfStage.Steps, step.WorkflowStep)
+	}
+	return wfStage, nil
+}
+
+func buildspecStep(action *PrePostDeployAction, readBuildspec func(path string) ([]byte, error)) (workflowStepRunner, error) {
+	content, err := readBuildspec(action.BuildspecPath)
+	if err != nil {
+		return workflowStepRunner{}, fmt.Errorf("read buildspec %s of action %s: %w", action.BuildspecPath, action.Name(), err)
+	}
+	commands, err := BuildspecCommands(content)
+	if err != nil {
+		return workflowStepRunner{}, fmt.Errorf("parse buildspec %s of action %s: %w", action.BuildspecPath, action.Name(), err)
+	}
+	return workflowStepRunner{
+		WorkflowStep: WorkflowStep{
+			Name:     action.Name(),
+			Commands: commands,
+		},
+		runOrder: action.RunOrder(),
+	}, nil
+}
+
+// command returns the Copilot command that deploys the environment or the workload of the action.
+func (a *DeployAction) command() string {
+	if a.StackName() == fmt.Sprintf("%s-%s", a.appName, a.envName) {
+		return fmt.Sprintf("copilot env deploy --name %s", a.envName)
+	}
+	return fmt.Sprintf("copilot deploy --name %s --env %s --deploy-env=false", a.name, a.envName)
+}
+
+// buildspecPhases lists the phases of a CodeBuild buildspec in the order they run.
+var buildspecPhases = []string{"install", "pre_build", "build", "post_build"}
+
+// BuildspecCommands returns the commands of the phases of a CodeBuild buildspec in the order they run.
+func BuildspecCommands(content []byte) ([]string, error) {
+	var buildspec struct {
+		Phases map[string]struct {
+			Commands []string `yaml:"commands"`
+		} `yaml:"phases"`
+	}
+	if err := yaml.Unmarshal(content, &buildspec); err != nil {
+		return nil, err
+	}
+	var commands []string
+	for _, phase := range buildspecPhases {
+		commands = append(commands, buildspec.Phases[phase].Commands...)
+	}
+	return commands, nil
+}
@@ -0,0 +1,257 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitLabSource_ProjectPath(t *testing.T) {
+	testCases := map[string]struct {
+		url string
+
+		wantedPath string
+		wantedErr  error
+	}{
+		"project URL": {
+			url:        "https://gitlab.com/group/project",
+			wantedPath: "group/project",
+		},
+		"clone URL of a project in a subgroup": {
+			url:        "https://gitlab.com/group/subgroup/project.git",
+			wantedPath: "group/subgroup/project",
+		},
+		"project path": {
+			url:        "group/project",
+			wantedPath: "group/project",
+		},
+		"error if the URL is missing the group": {
+			url:       "https://gitlab.com/project",
+			wantedErr: errors.New("unable to parse the repository from the URL https://gitlab.com/project"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			src := &GitLabSource{RepositoryURL: tc.url}
+
+			path, err := src.ProjectPath()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPath, path)
+		})
+	}
+}
+
+func TestCreateWorkflowInput_OIDCProvider(t *testing.T) {
+	stage := func(name string) PipelineStage {
+		var stg PipelineStage
+		stg.Init(&config.Environment{Name: name}, &manifest.PipelineStage{Name: name}, nil)
+		return stg
+	}
+	testCases := map[string]struct {
+		in *CreateWorkflowInput
+
+		wanted    *OIDCProvider
+		wantedErr error
+	}{
+		"trusts the environments of the stages for a GitHub source": {
+			in: &CreateWorkflowInput{
+				Source: &GitHubSource{
+					RepositoryURL: "https://github.com/aws/phonetool",
+					Branch:        "main",
+				},
+				Stages: []PipelineStage{stage("test"), stage("prod")},
+			},
+			wanted: &OIDCProvider{
+				Host:        "token.actions.githubusercontent.com",
+				Audience:    "sts.amazonaws.com",
+				Thumbprints: githubOIDCThumbprints,
+				Subjects:    []string{"repo:aws/phonetool:environment:test", "repo:aws/phonetool:environment:prod"},
+			},
+		},
+		"trusts the branch for a GitLab source": {
+			in: &CreateWorkflowInput{
+				Source: &GitLabSource{
+					RepositoryURL: "https://gitlab.com/aws/phonetool",
+					Branch:        "release",
+				},
+				Stages: []PipelineStage{stage("test")},
+			},
+			wanted: &OIDCProvider{
+				Host:        "gitlab.com",
+				Audience:    "https://gitlab.com",
+				Thumbprints: gitlabOIDCThumbprints,
+				Subjects:    []string{"project_path:aws/phonetool:ref_type:branch:ref:release"},
+			},
+		},
+		"error if the source can't run a workflow": {
+			in: &CreateWorkflowInput{
+				Source: &CodeCommitSource{},
+			},
+			wantedErr: errors.New("source *deploy.CodeCommitSource cannot run a workflow"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			provider, err := tc.in.OIDCProvider()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, provider)
+		})
+	}
+}
+
+func TestNewWorkflowStage(t *testing.T) {
+	testCases := map[string]struct {
+		env       *config.Environment
+		stg       *manifest.PipelineStage
+		workloads []string
+		buildspec map[string]string
+
+		wanted    *WorkflowStage
+		wantedErr error
+	}{
+		"runs the actions of the stage in order": {
+			env: &config.Environment{App: "phonetool", Name: "test"},
+			stg: &manifest.PipelineStage{
+				Name:             "test",
+				RequiresApproval: true,
+				PreDeployments: manifest.PrePostDeployments{
+					"migrate": {BuildspecPath: "copilot/pipelines/release/migrate.yml"},
+				},
+				Deployments: manifest.Deployments{
+					"frontend": {DependsOn: []string{"api"}},
+					"api":      nil,
+				},
+				TestCommands: []string{"make integ-test"},
+			},
+			buildspec: map[string]string{
+				"copilot/pipelines/release/migrate.yml": `
+version: 0.2
+phases:
+  build:
+    commands:
+      - make migrate
+  install:
+    commands:
+      - make install
+`,
+			},
+			wanted: &WorkflowStage{
+				Name:             "test",
+				RequiresApproval: true,
+				Steps: []WorkflowStep{
+					{Name: "migrate", Commands: []string{"make install", "make migrate"}},
+					{Name: "CreateOrUpdate-api-test", Commands: []string{"copilot deploy --name api --env test --deploy-env=false"}},
+					{Name: "CreateOrUpdate-frontend-test", Commands: []string{"copilot deploy --name frontend --env test --deploy-env=false"}},
+					{Name: "TestCommands", Commands: []string{"make integ-test"}},
+				},
+			},
+		},
+		"deploys the environment of an environment pipeline": {
+			env: &config.Environment{App: "phonetool", Name: "prod"},
+			stg: &manifest.PipelineStage{
+				Name: "prod",
+				Deployments: manifest.Deployments{
+					"deploy-env": {StackName: "phonetool-prod"},
+				},
+			},
+			wanted: &WorkflowStage{
+				Name: "prod",
+				Steps: []WorkflowStep{
+					{Name: "CreateOrUpdate-deploy-env-prod", Commands: []string{"copilot env deploy --name prod"}},
+				},
+			},
+		},
+		"error if a buildspec can't be read": {
+			env: &config.Environment{App: "phonetool", Name: "test"},
+			stg: &manifest.PipelineStage{
+				Name: "test",
+				PostDeployments: manifest.PrePostDeployments{
+					"smoke": {BuildspecPath: "smoke.yml"},
+				},
+			},
+			workloads: []string{"api"},
+			wantedErr: errors.New("read buildspec smoke.yml of action smoke: file smoke.yml does not exist"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var stg PipelineStage
+			stg.Init(tc.env, tc.stg, tc.workloads)
+			readBuildspec := func(path string) ([]byte, error) {
+				content, ok := tc.buildspec[path]
+				if !ok {
+					return nil, errors.New("file " + path + " does not exist")
+				}
+				return []byte(content), nil
+			}
+
+			got, err := NewWorkflowStage(&stg, readBuildspec)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestBuildspecCommands(t *testing.T) {
+	testCases := map[string]struct {
+		content string
+
+		wanted    []string
+		wantedErr string
+	}{
+		"returns the commands of the phases in order": {
+			content: `
+version: 0.2
+env:
+  variables:
+    NAME: value
+phases:
+  post_build:
+    commands:
+      - echo "done"
+  pre_build:
+    commands:
+      - make lint
+      - make test
+`,
+			wanted: []string{"make lint", "make test", `echo "done"`},
+		},
+		"error if the buildspec is malformed": {
+			content:   `phases: [`,
+			wantedErr: "yaml: line 1: did not find expected node content",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			commands, err := BuildspecCommands([]byte(tc.content))
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, commands)
+		})
+	}
+}
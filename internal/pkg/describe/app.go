@@ -31,6 +31,7 @@ type App struct {
 	Services            []*config.Workload       `json:"services"`
 	Jobs                []*config.Workload       `json:"jobs"`
 	Pipelines           []*codepipeline.Pipeline `json:"pipelines"`
+	Routes              []*config.Route          `json:"routes,omitempty"`
 	WkldDeployedtoEnvs  map[string][]string      `json:"-"`
 }
 
@@ -96,9 +97,39 @@ func (a *App) HumanString() string {
 		fmt.Fprintf(writer, "  %s\n", pipeline.Name)
 	}
 	writer.Flush()
+	if len(a.Routes) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nRoutes\n\n"))
+		writer.Flush()
+		headers = []string{"Name", "Hostname", "Service", "Routing", "Environments"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, route := range a.Routes {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", route.Name, route.Hostname, route.Service, route.RoutingPolicy, routeTargetsString(route))
+		}
+		writer.Flush()
+	}
 	return b.String()
 }
 
+func routeTargetsString(route *config.Route) string {
+	targets := make([]string, len(route.Targets))
+	for i, target := range route.Targets {
+		switch {
+		case route.RoutingPolicy == config.RoutingPolicyWeighted:
+			weight := 1
+			if target.Weight != nil {
+				weight = *target.Weight
+			}
+			targets[i] = fmt.Sprintf("%s (weight %d)", target.Env, weight)
+		case route.RoutingPolicy == config.RoutingPolicyFailover && target.Primary:
+			targets[i] = fmt.Sprintf("%s (primary)", target.Env)
+		default:
+			targets[i] = target.Env
+		}
+	}
+	return strings.Join(targets, ", ")
+}
+
 // AppDescriber retrieves information about an application.
 type AppDescriber struct {
 	app               string
@@ -0,0 +1,93 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// shortDigestLength is the number of hexadecimal characters of an image digest displayed in a table.
+const shortDigestLength = 12
+
+// DeploymentHistory contains the recorded deployments of a workload to an environment, the most recent first.
+type DeploymentHistory struct {
+	Deployments []*deploy.WorkloadDeployment `json:"deployments"`
+}
+
+// JSONString returns the stringified DeploymentHistory struct with json format.
+func (h *DeploymentHistory) JSONString() (string, error) {
+	b, err := json.Marshal(h)
+	if err != nil {
+		return "", fmt.Errorf("marshal deployment history: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified DeploymentHistory struct in human-readable format.
+func (h *DeploymentHistory) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Deployments\n\n"))
+	writer.Flush()
+	headers := []string{"No.", "Deployed", "Deployed By", "Git Commit", "Images", "Notes"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, d := range h.Deployments {
+		var notes string
+		if d.RollbackOf != 0 {
+			notes = fmt.Sprintf("rollback to #%d", d.RollbackOf)
+		}
+		fmt.Fprintf(writer, "  %d\t%s\t%s\t%s\t%s\t%s\n", d.Number, humanizeTime(d.DeployedAt), deployerName(d.DeployedBy),
+			valueOrDash(d.GitCommit), valueOrDash(imageDigests(d.Images)), notes)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// deployerName returns the resource of an IAM identity ARN, such as "assumed-role/Admin/alice".
+func deployerName(identityARN string) string {
+	parsed, err := arn.Parse(identityARN)
+	if err != nil {
+		return valueOrDash(identityARN)
+	}
+	return parsed.Resource
+}
+
+// imageDigests returns the shortened digests of the images by container name, such as "api@sha256:1a2b3c4d5e6f".
+func imageDigests(images map[string]deploy.DeployedImage) string {
+	containers := make([]string, 0, len(images))
+	for container := range images {
+		containers = append(containers, container)
+	}
+	sort.Strings(containers)
+	digests := make([]string, 0, len(containers))
+	for _, container := range containers {
+		digest := images[container].Digest
+		if digest == "" {
+			continue
+		}
+		algorithm, hex, found := strings.Cut(digest, ":")
+		if found && len(hex) > shortDigestLength {
+			digest = fmt.Sprintf("%s:%s", algorithm, hex[:shortDigestLength])
+		}
+		digests = append(digests, fmt.Sprintf("%s@%s", container, digest))
+	}
+	return strings.Join(digests, ", ")
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
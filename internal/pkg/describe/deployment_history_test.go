@@ -0,0 +1,61 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/dustin/go-humanize"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentHistory_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2023-10-02T12:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	history := &DeploymentHistory{
+		Deployments: []*deploy.WorkloadDeployment{
+			{
+				Number:     3,
+				DeployedAt: time.Date(2023, 10, 2, 10, 0, 0, 0, time.UTC),
+				DeployedBy: "arn:aws:sts::123456789012:assumed-role/Admin/alice",
+				GitCommit:  "a1b2c3d",
+				Images: map[string]deploy.DeployedImage{
+					"api": {Digest: "sha256:1a2b3c4d5e6f7a8b9c0d"},
+				},
+				RollbackOf: 1,
+			},
+			{
+				Number:     2,
+				DeployedAt: time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC),
+				DeployedBy: "arn:aws:iam::123456789012:user/bob",
+				Images: map[string]deploy.DeployedImage{
+					"logs": {Digest: "sha256:ffffffffffffffff"},
+					"api":  {Digest: "sha256:0000000000000000"},
+				},
+			},
+		},
+	}
+
+	human := history.HumanString()
+	json, err := history.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `Deployments
+
+  No.     Deployed     Deployed By               Git Commit  Images                                             Notes
+  ---     --------     -----------               ----------  ------                                             -----
+  3       2 hours ago  assumed-role/Admin/alice  a1b2c3d     api@sha256:1a2b3c4d5e6f                            rollback to #1
+  2       1 day ago    user/bob                  -           api@sha256:000000000000, logs@sha256:ffffffffffff  
+`, human)
+	require.Contains(t, json, `"number":3`)
+	require.Contains(t, json, `"rollbackOf":1`)
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./internal/pkg/describe/pipeline_show.go
+// Source: ./pipeline_show.go
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	codestarnotifications "github.com/aws/copilot-cli/internal/pkg/aws/codestarnotifications"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -48,3 +49,41 @@ func (mr *MockpipelineGetterMockRecorder) GetPipeline(pipelineName interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipeline), pipelineName)
 }
+
+// MocknotificationRulesGetter is a mock of notificationRulesGetter interface.
+type MocknotificationRulesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocknotificationRulesGetterMockRecorder
+}
+
+// MocknotificationRulesGetterMockRecorder is the mock recorder for MocknotificationRulesGetter.
+type MocknotificationRulesGetterMockRecorder struct {
+	mock *MocknotificationRulesGetter
+}
+
+// NewMocknotificationRulesGetter creates a new mock instance.
+func NewMocknotificationRulesGetter(ctrl *gomock.Controller) *MocknotificationRulesGetter {
+	mock := &MocknotificationRulesGetter{ctrl: ctrl}
+	mock.recorder = &MocknotificationRulesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocknotificationRulesGetter) EXPECT() *MocknotificationRulesGetterMockRecorder {
+	return m.recorder
+}
+
+// RulesForResource mocks base method.
+func (m *MocknotificationRulesGetter) RulesForResource(resourceARN string) ([]codestarnotifications.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RulesForResource", resourceARN)
+	ret0, _ := ret[0].([]codestarnotifications.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RulesForResource indicates an expected call of RulesForResource.
+func (mr *MocknotificationRulesGetterMockRecorder) RulesForResource(resourceARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RulesForResource", reflect.TypeOf((*MocknotificationRulesGetter)(nil).RulesForResource), resourceARN)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/stack_drift.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockstackDriftDetector is a mock of stackDriftDetector interface.
+type MockstackDriftDetector struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackDriftDetectorMockRecorder
+}
+
+// MockstackDriftDetectorMockRecorder is the mock recorder for MockstackDriftDetector.
+type MockstackDriftDetectorMockRecorder struct {
+	mock *MockstackDriftDetector
+}
+
+// NewMockstackDriftDetector creates a new mock instance.
+func NewMockstackDriftDetector(ctrl *gomock.Controller) *MockstackDriftDetector {
+	mock := &MockstackDriftDetector{ctrl: ctrl}
+	mock.recorder = &MockstackDriftDetectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackDriftDetector) EXPECT() *MockstackDriftDetectorMockRecorder {
+	return m.recorder
+}
+
+// DetectDrift mocks base method.
+func (m *MockstackDriftDetector) DetectDrift(ctx context.Context, stackName string) (*cloudformation.StackDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDrift", ctx, stackName)
+	ret0, _ := ret[0].(*cloudformation.StackDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectDrift indicates an expected call of DetectDrift.
+func (mr *MockstackDriftDetectorMockRecorder) DetectDrift(ctx, stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDrift", reflect.TypeOf((*MockstackDriftDetector)(nil).DetectDrift), ctx, stackName)
+}
+
+// StackResources mocks base method.
+func (m *MockstackDriftDetector) StackResources(name string) ([]*cloudformation.StackResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackResources", name)
+	ret0, _ := ret[0].([]*cloudformation.StackResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackResources indicates an expected call of StackResources.
+func (mr *MockstackDriftDetectorMockRecorder) StackResources(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*MockstackDriftDetector)(nil).StackResources), name)
+}
@@ -10,6 +10,9 @@ import (
 	"strings"
 	"text/tabwriter"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codestarnotifications"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -24,13 +27,18 @@ type pipelineGetter interface {
 	GetPipeline(pipelineName string) (*codepipeline.Pipeline, error)
 }
 
+type notificationRulesGetter interface {
+	RulesForResource(resourceARN string) ([]codestarnotifications.Rule, error)
+}
+
 // Pipeline contains serialized parameters for a pipeline.
 type Pipeline struct {
 	// Name is the user provided name for a pipeline
 	Name string `json:"name"`
 	codepipeline.Pipeline
 
-	Resources []*describestack.Resource `json:"resources,omitempty"`
+	Notifications []codestarnotifications.Rule `json:"notifications,omitempty"`
+	Resources     []*describestack.Resource    `json:"resources,omitempty"`
 }
 
 // PipelineDescriber retrieves information about a deployed pipeline.
@@ -38,8 +46,9 @@ type PipelineDescriber struct {
 	pipeline      deploy.Pipeline
 	showResources bool
 
-	pipelineSvc pipelineGetter
-	cfn         stackDescriber
+	pipelineSvc   pipelineGetter
+	notifications notificationRulesGetter
+	cfn           stackDescriber
 }
 
 // NewPipelineDescriber instantiates a new pipeline describer
@@ -55,6 +64,7 @@ func NewPipelineDescriber(pipeline deploy.Pipeline, showResources bool) (*Pipeli
 		pipeline: pipeline,
 
 		pipelineSvc:   pipelineSvc,
+		notifications: codestarnotifications.New(sess),
 		showResources: showResources,
 		cfn:           describestack.NewStackDescriber(stack.NameForPipeline(pipeline.AppName, pipeline.Name, pipeline.IsLegacy), sess),
 	}, nil
@@ -66,6 +76,10 @@ func (d *PipelineDescriber) Describe() (HumanJSONStringer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get pipeline: %w", err)
 	}
+	notifications, err := d.notificationRules(cp)
+	if err != nil {
+		return nil, err
+	}
 	var resources []*describestack.Resource
 	if d.showResources {
 		stackResources, err := d.cfn.Resources()
@@ -75,13 +89,33 @@ func (d *PipelineDescriber) Describe() (HumanJSONStringer, error) {
 		resources = stackResources
 	}
 	pipeline := &Pipeline{
-		Name:      d.pipeline.Name,
-		Pipeline:  *cp,
-		Resources: resources,
+		Name:          d.pipeline.Name,
+		Pipeline:      *cp,
+		Notifications: notifications,
+		Resources:     resources,
 	}
 	return pipeline, nil
 }
 
+func (d *PipelineDescriber) notificationRules(cp *codepipeline.Pipeline) ([]codestarnotifications.Rule, error) {
+	partition, err := partitions.Region(cp.Region).Partition()
+	if err != nil {
+		return nil, err
+	}
+	pipelineARN := arn.ARN{
+		Partition: partition.ID(),
+		Service:   "codepipeline",
+		Region:    cp.Region,
+		AccountID: cp.AccountID,
+		Resource:  cp.Name,
+	}
+	rules, err := d.notifications.RulesForResource(pipelineARN.String())
+	if err != nil {
+		return nil, fmt.Errorf("retrieve pipeline notifications: %w", err)
+	}
+	return rules, nil
+}
+
 // JSONString returns the stringified Pipeline struct with JSON format.
 func (p *Pipeline) JSONString() (string, error) {
 	b, err := json.Marshal(p)
@@ -113,6 +147,21 @@ func (p *Pipeline) HumanString() string {
 		fmt.Fprintf(writer, "  %s", stage.HumanString())
 	}
 	writer.Flush()
+	if len(p.Notifications) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nNotifications\n\n"))
+		writer.Flush()
+		headers := []string{"Name", "Events", "Targets"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, rule := range p.Notifications {
+			targets := make([]string, len(rule.Targets))
+			for i, target := range rule.Targets {
+				targets[i] = fmt.Sprintf("%s (%s)", target.Address, target.Type)
+			}
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", rule.Name, strings.Join(rule.Events, ", "), strings.Join(targets, ", "))
+		}
+	}
+	writer.Flush()
 	if len(p.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codestarnotifications"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
@@ -21,6 +22,24 @@ import (
 type pipelineDescriberMocks struct {
 	cfn            *mocks.MockstackDescriber
 	pipelineGetter *mocks.MockpipelineGetter
+	notifications  *mocks.MocknotificationRulesGetter
+}
+
+const mockPipelineARN = "arn:aws:codepipeline:us-west-2:1234567890:pipeline-dinder-badgoose-repo-RANDOMSTRING"
+
+var mockNotifications = []codestarnotifications.Rule{
+	{
+		Name:   "pipeline-dinder-badgoose-repo-notify-0",
+		Status: "ENABLED",
+		Events: []string{"Manual approval needed", "Failed"},
+		Targets: []codestarnotifications.Target{
+			{
+				Type:    "AWSChatbotSlack",
+				Address: "arn:aws:chatbot::1234567890:chat-configuration/slack-channel/deploys",
+				Status:  "ACTIVE",
+			},
+		},
+	},
 }
 
 var pipelineResourceName = "pipeline-dinder-badgoose-repo-RANDOMSTRING"
@@ -122,6 +141,7 @@ func TestPipelineDescriber_Describe(t *testing.T) {
 		"happy path with resources": {
 			callMocks: func(m pipelineDescriberMocks) {
 				m.pipelineGetter.EXPECT().GetPipeline(pipelineResourceName).Return(mockPipeline, nil)
+				m.notifications.EXPECT().RulesForResource(mockPipelineARN).Return(nil, nil)
 				m.cfn.EXPECT().Resources().Return(mockResources, nil)
 			},
 			inShowResource: true,
@@ -132,9 +152,28 @@ func TestPipelineDescriber_Describe(t *testing.T) {
 				Resources: expectedResources,
 			},
 		},
+		"happy path with notifications": {
+			callMocks: func(m pipelineDescriberMocks) {
+				m.pipelineGetter.EXPECT().GetPipeline(pipelineResourceName).Return(mockPipeline, nil)
+				m.notifications.EXPECT().RulesForResource(mockPipelineARN).Return(mockNotifications, nil)
+			},
+			expectedOutput: &Pipeline{
+				Name:          pipelineName,
+				Pipeline:      *mockPipeline,
+				Notifications: mockNotifications,
+			},
+		},
+		"wraps notification rules error": {
+			callMocks: func(m pipelineDescriberMocks) {
+				m.pipelineGetter.EXPECT().GetPipeline(pipelineResourceName).Return(mockPipeline, nil)
+				m.notifications.EXPECT().RulesForResource(mockPipelineARN).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("retrieve pipeline notifications: %w", mockError),
+		},
 		"happy path without resources": {
 			callMocks: func(m pipelineDescriberMocks) {
 				m.pipelineGetter.EXPECT().GetPipeline(pipelineResourceName).Return(mockPipeline, nil)
+				m.notifications.EXPECT().RulesForResource(mockPipelineARN).Return(nil, nil)
 			},
 			inShowResource: false,
 			expectedError:  nil,
@@ -155,6 +194,7 @@ func TestPipelineDescriber_Describe(t *testing.T) {
 		"wraps stack resources error": {
 			callMocks: func(m pipelineDescriberMocks) {
 				m.pipelineGetter.EXPECT().GetPipeline(pipelineResourceName).Return(mockPipeline, nil)
+				m.notifications.EXPECT().RulesForResource(mockPipelineARN).Return(nil, nil)
 				m.cfn.EXPECT().Resources().Return(nil, mockError)
 			},
 			inShowResource: true,
@@ -169,10 +209,12 @@ func TestPipelineDescriber_Describe(t *testing.T) {
 
 			mockCFN := mocks.NewMockstackDescriber(ctrl)
 			mockPipelineGetter := mocks.NewMockpipelineGetter(ctrl)
+			mockNotifications := mocks.NewMocknotificationRulesGetter(ctrl)
 
 			mocks := pipelineDescriberMocks{
 				cfn:            mockCFN,
 				pipelineGetter: mockPipelineGetter,
+				notifications:  mockNotifications,
 			}
 			tc.callMocks(mocks)
 
@@ -187,6 +229,7 @@ func TestPipelineDescriber_Describe(t *testing.T) {
 				pipeline:      mockDeployedPipeline,
 				showResources: tc.inShowResource,
 				pipelineSvc:   mockPipelineGetter,
+				notifications: mockNotifications,
 				cfn:           mockCFN,
 			}
 
@@ -250,6 +293,36 @@ Resources
 `,
 			expectedJSONString: "{\"name\":\"pipeline-dinder-badgoose-repo\",\"pipelineName\":\"pipeline-dinder-badgoose-repo-RANDOMSTRING\",\"region\":\"us-west-2\",\"accountId\":\"1234567890\",\"stages\":[{\"name\":\"Source\",\"category\":\"Source\",\"provider\":\"GitHub\",\"details\":\"Repository: badgoose/repo\"},{\"name\":\"Build\",\"category\":\"Build\",\"provider\":\"CodeBuild\",\"details\":\"BuildProject: pipeline-dinder-badgoose-repo-BuildProject\"},{\"name\":\"DeployTo-test\",\"category\":\"Deploy\",\"provider\":\"CloudFormation\",\"details\":\"StackName: dinder-test-test\"}],\"createdAt\":\"2020-02-02T15:04:05Z\",\"updatedAt\":\"2020-02-02T15:04:05Z\",\"resources\":[{\"type\":\"AWS::CodeBuild::Project\",\"physicalID\":\"pipeline-dinder-badgoose-repo-BuildProject\"},{\"type\":\"AWS::IAM::Policy\",\"physicalID\":\"pipel-Buil-1PEASDDL44ID2\"},{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"pipeline-dinder-badgoose-repo-BuildProjectRole-A4V6VSG1XIIJ\"},{\"type\":\"AWS::CodePipeline::Pipeline\",\"physicalID\":\"pipeline-dinder-badgoose-repo\"},{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"pipeline-dinder-badgoose-repo-PipelineRole-100SEEQN6CU0F\"},{\"type\":\"AWS::IAM::Policy\",\"physicalID\":\"pipel-Pipe-EO4QGE10RJ8F\"}]}\n",
 		},
+		"correct output with notifications": {
+			inPipeline: &Pipeline{
+				Name:          pipelineName,
+				Pipeline:      *mockPipeline,
+				Notifications: mockNotifications,
+			},
+			expectedHumanString: `About
+
+  Name        pipeline-dinder-badgoose-repo
+  Region      us-west-2
+  AccountID   1234567890
+  Created At  4 months ago
+  Updated At  4 months ago
+
+Stages
+
+  Name           Category  Provider        Details
+  ----           --------  --------        -------
+  Source         Source    GitHub          Repository: badgoose/repo
+  Build          Build     CodeBuild       BuildProject: pipeline-dinder-badgoose-repo-BuildProject
+  DeployTo-test  Deploy    CloudFormation  StackName: dinder-test-test
+
+Notifications
+
+  Name                                    Events                          Targets
+  ----                                    ------                          -------
+  pipeline-dinder-badgoose-repo-notify-0  Manual approval needed, Failed  arn:aws:chatbot::1234567890:chat-configuration/slack-channel/deploys (AWSChatbotSlack)
+`,
+			expectedJSONString: "{\"name\":\"pipeline-dinder-badgoose-repo\",\"pipelineName\":\"pipeline-dinder-badgoose-repo-RANDOMSTRING\",\"region\":\"us-west-2\",\"accountId\":\"1234567890\",\"stages\":[{\"name\":\"Source\",\"category\":\"Source\",\"provider\":\"GitHub\",\"details\":\"Repository: badgoose/repo\"},{\"name\":\"Build\",\"category\":\"Build\",\"provider\":\"CodeBuild\",\"details\":\"BuildProject: pipeline-dinder-badgoose-repo-BuildProject\"},{\"name\":\"DeployTo-test\",\"category\":\"Deploy\",\"provider\":\"CloudFormation\",\"details\":\"StackName: dinder-test-test\"}],\"createdAt\":\"2020-02-02T15:04:05Z\",\"updatedAt\":\"2020-02-02T15:04:05Z\",\"notifications\":[{\"name\":\"pipeline-dinder-badgoose-repo-notify-0\",\"status\":\"ENABLED\",\"events\":[\"Manual approval needed\",\"Failed\"],\"targets\":[{\"type\":\"AWSChatbotSlack\",\"address\":\"arn:aws:chatbot::1234567890:chat-configuration/slack-channel/deploys\",\"status\":\"ACTIVE\"}]}]}\n",
+		},
 		"correct output without resources": {
 			inPipeline: &Pipeline{
 				Name:      pipelineName,
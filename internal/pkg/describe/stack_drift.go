@@ -0,0 +1,269 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	sdkcfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/template/diff"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	nestedStackType = "AWS::CloudFormation::Stack"
+
+	// driftDetectionTimeout is how long to wait for the drift detection of all the stacks.
+	driftDetectionTimeout = 30 * time.Minute
+)
+
+type stackDriftDetector interface {
+	DetectDrift(ctx context.Context, stackName string) (*cloudformation.StackDrift, error)
+	StackResources(name string) ([]*cloudformation.StackResource, error)
+}
+
+// StackDriftDescriber detects the resources of a stack, and of its nested stacks such as the addons stack,
+// that were modified or deleted outside of CloudFormation.
+type StackDriftDescriber struct {
+	stackName string
+	cfn       stackDriftDetector
+}
+
+// NewStackDriftDescriber instantiates a describer for the drift of a stack.
+func NewStackDriftDescriber(stackName string, sess *session.Session) *StackDriftDescriber {
+	return &StackDriftDescriber{
+		stackName: stackName,
+		cfn:       cloudformation.New(sess),
+	}
+}
+
+// Describe detects the drift of the stack and of its nested stacks, and returns their drifted resources.
+func (d *StackDriftDescriber) Describe() (*StackDrift, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), driftDetectionTimeout)
+	defer cancel()
+	drift := &StackDrift{}
+	if err := d.describe(ctx, d.stackName, d.stackName, drift); err != nil {
+		return nil, err
+	}
+	return drift, nil
+}
+
+// describe appends the drift of the stack identified by stackID, then the drift of its nested stacks.
+func (d *StackDriftDescriber) describe(ctx context.Context, name, stackID string, drift *StackDrift) error {
+	out, err := d.cfn.DetectDrift(ctx, stackID)
+	if err != nil {
+		return fmt.Errorf("detect drift of stack %s: %w", name, err)
+	}
+	stack := &DriftedStack{
+		Name:             name,
+		Status:           out.Status,
+		DetectionFailure: out.DetectionFailure,
+		Resources:        make([]*DriftedResource, len(out.Resources)),
+	}
+	for i, resource := range out.Resources {
+		stack.Resources[i] = newDriftedResource(resource)
+	}
+	drift.Stacks = append(drift.Stacks, stack)
+
+	resources, err := d.cfn.StackResources(stackID)
+	if err != nil {
+		return fmt.Errorf("retrieve resources of stack %s: %w", name, err)
+	}
+	for _, resource := range resources {
+		// The nested stacks of a deleted or failed stack resource don't have a stack ID.
+		if aws.StringValue(resource.ResourceType) != nestedStackType || aws.StringValue(resource.PhysicalResourceId) == "" {
+			continue
+		}
+		nestedID := aws.StringValue(resource.PhysicalResourceId)
+		if err := d.describe(ctx, nestedStackName(nestedID), nestedID, drift); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nestedStackName returns the name of a nested stack from its ID, such as "phonetool-test-api-AddonsStack-1A2B3C".
+func nestedStackName(stackID string) string {
+	parsed, err := arn.Parse(stackID)
+	if err != nil {
+		return stackID
+	}
+	parts := strings.Split(parsed.Resource, "/") // The resource is "stack/<name>/<id>".
+	if len(parts) < 2 {
+		return stackID
+	}
+	return parts[1]
+}
+
+// StackDrift contains the drift of a stack and of its nested stacks.
+type StackDrift struct {
+	Stacks []*DriftedStack `json:"stacks"`
+}
+
+// DriftedStack is the drift of a stack.
+type DriftedStack struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	// DetectionFailure is the reason why the drift of some resources of the stack couldn't be detected.
+	DetectionFailure string             `json:"detectionFailure,omitempty"`
+	Resources        []*DriftedResource `json:"resources"`
+}
+
+// DriftedResource is a resource modified or deleted outside of CloudFormation.
+type DriftedResource struct {
+	LogicalID   string                `json:"logicalID"`
+	PhysicalID  string                `json:"physicalID"`
+	Type        string                `json:"type"`
+	Status      string                `json:"status"`
+	Differences []*PropertyDifference `json:"propertyDifferences,omitempty"`
+
+	// The properties of the resource in the template and in the account, as JSON documents.
+	expected string
+	actual   string
+}
+
+// PropertyDifference is a property of a modified resource whose actual value differs from the template.
+type PropertyDifference struct {
+	Path     string `json:"path"`
+	Type     string `json:"type"`
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+}
+
+func newDriftedResource(drift *cloudformation.StackResourceDrift) *DriftedResource {
+	resource := &DriftedResource{
+		LogicalID:  aws.StringValue(drift.LogicalResourceId),
+		PhysicalID: aws.StringValue(drift.PhysicalResourceId),
+		Type:       aws.StringValue(drift.ResourceType),
+		Status:     aws.StringValue(drift.StackResourceDriftStatus),
+		expected:   aws.StringValue(drift.ExpectedProperties),
+		actual:     aws.StringValue(drift.ActualProperties),
+	}
+	for _, diff := range drift.PropertyDifferences {
+		resource.Differences = append(resource.Differences, &PropertyDifference{
+			Path:     aws.StringValue(diff.PropertyPath),
+			Type:     aws.StringValue(diff.DifferenceType),
+			Expected: aws.StringValue(diff.ExpectedValue),
+			Actual:   aws.StringValue(diff.ActualValue),
+		})
+	}
+	return resource
+}
+
+// HasDrift returns true if any resource of the stacks was modified or deleted.
+func (s *StackDrift) HasDrift() bool {
+	for _, stack := range s.Stacks {
+		if stack.Status == sdkcfn.StackDriftStatusDrifted || len(stack.Resources) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// JSONString returns the stringified StackDrift struct with json format.
+func (s *StackDrift) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal stack drift: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified StackDrift struct in human-readable format.
+// The properties of modified resources are rendered as a diff from the template to the actual values.
+func (s *StackDrift) HumanString() string {
+	var b bytes.Buffer
+	fmt.Fprint(&b, color.Bold.Sprint("Drift\n\n"))
+	for _, stack := range s.Stacks {
+		fmt.Fprintf(&b, "  %s  %s\n", stack.Name, driftStatusColor(stack.Status))
+		if stack.DetectionFailure != "" {
+			fmt.Fprintf(&b, "    %s\n", color.Yellow.Sprint(stack.DetectionFailure))
+		}
+		for _, resource := range stack.Resources {
+			fmt.Fprintf(&b, "\n    %s (%s) %s\n", color.HighlightResource(resource.LogicalID), resource.Type, driftStatusColor(resource.Status))
+			if resource.PhysicalID != "" {
+				fmt.Fprintf(&b, "    %s\n", color.Grey.Sprint(resource.PhysicalID))
+			}
+			fmt.Fprint(&b, indentLines(resource.propertiesDiff(), 6))
+		}
+		fmt.Fprintln(&b)
+	}
+	return b.String()
+}
+
+// propertiesDiff returns the diff of the properties of a modified resource, or of its property differences
+// if the properties can't be compared.
+func (r *DriftedResource) propertiesDiff() string {
+	if r.Status != sdkcfn.StackResourceDriftStatusModified {
+		return ""
+	}
+	if out, err := yamlDiff(r.expected, r.actual); err == nil && out != "" {
+		return out
+	}
+	var b strings.Builder
+	for _, diff := range r.Differences {
+		fmt.Fprintf(&b, "~ %s: %s -> %s\n", diff.Path, diff.Expected, diff.Actual)
+	}
+	return b.String()
+}
+
+// yamlDiff returns the diff between two JSON documents rendered in YAML.
+func yamlDiff(from, to string) (string, error) {
+	fromYAML, err := jsonToYAML(from)
+	if err != nil {
+		return "", err
+	}
+	toYAML, err := jsonToYAML(to)
+	if err != nil {
+		return "", err
+	}
+	tree, err := diff.From(fromYAML).ParseWithCFNOverriders(toYAML)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := tree.Write(&b); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// jsonToYAML converts a JSON document to block-style YAML, so that its diff reads like a template diff.
+func jsonToYAML(doc string) ([]byte, error) {
+	var v any
+	if err := json.Unmarshal([]byte(doc), &v); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(v)
+}
+
+func driftStatusColor(status string) string {
+	switch status {
+	case sdkcfn.StackDriftStatusInSync:
+		return color.Green.Sprint(status)
+	case sdkcfn.StackDriftStatusDrifted, sdkcfn.StackResourceDriftStatusModified, sdkcfn.StackResourceDriftStatusDeleted:
+		return color.Red.Sprint(status)
+	default:
+		return color.Yellow.Sprint(status)
+	}
+}
+
+func indentLines(s string, spaces int) string {
+	if s == "" {
+		return ""
+	}
+	indent := strings.Repeat(" ", spaces)
+	lines := strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+	return indent + strings.Join(lines, "\n"+indent) + "\n"
+}
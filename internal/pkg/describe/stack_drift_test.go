@@ -0,0 +1,195 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStackDriftDescriber_Describe(t *testing.T) {
+	const (
+		mockStackName = "phonetool-test-api"
+		mockAddonsID  = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api-AddonsStack-1A2B3C/abcd"
+	)
+	testCases := map[string]struct {
+		setupMock func(m *mocks.MockstackDriftDetector)
+
+		wanted    *StackDrift
+		wantedErr error
+	}{
+		"error if the drift of the stack can't be detected": {
+			setupMock: func(m *mocks.MockstackDriftDetector) {
+				m.EXPECT().DetectDrift(gomock.Any(), mockStackName).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("detect drift of stack phonetool-test-api: some error"),
+		},
+		"error if the resources of the stack can't be retrieved": {
+			setupMock: func(m *mocks.MockstackDriftDetector) {
+				m.EXPECT().DetectDrift(gomock.Any(), mockStackName).Return(&cloudformation.StackDrift{}, nil)
+				m.EXPECT().StackResources(mockStackName).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("retrieve resources of stack phonetool-test-api: some error"),
+		},
+		"detects the drift of the nested stacks": {
+			setupMock: func(m *mocks.MockstackDriftDetector) {
+				m.EXPECT().DetectDrift(gomock.Any(), mockStackName).Return(&cloudformation.StackDrift{
+					Status: sdkcfn.StackDriftStatusDrifted,
+					Resources: []*cloudformation.StackResourceDrift{
+						{
+							LogicalResourceId:        aws.String("LogGroup"),
+							PhysicalResourceId:       aws.String("/copilot/phonetool-test-api"),
+							ResourceType:             aws.String("AWS::Logs::LogGroup"),
+							StackResourceDriftStatus: aws.String(sdkcfn.StackResourceDriftStatusModified),
+							PropertyDifferences: []*sdkcfn.PropertyDifference{
+								{
+									PropertyPath:   aws.String("/RetentionInDays"),
+									DifferenceType: aws.String(sdkcfn.DifferenceTypeNotEqual),
+									ExpectedValue:  aws.String("30"),
+									ActualValue:    aws.String("7"),
+								},
+							},
+						},
+					},
+				}, nil)
+				m.EXPECT().StackResources(mockStackName).Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("LogGroup"),
+						PhysicalResourceId: aws.String("/copilot/phonetool-test-api"),
+						ResourceType:       aws.String("AWS::Logs::LogGroup"),
+					},
+					{
+						LogicalResourceId:  aws.String("AddonsStack"),
+						PhysicalResourceId: aws.String(mockAddonsID),
+						ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+					},
+				}, nil)
+				m.EXPECT().DetectDrift(gomock.Any(), mockAddonsID).Return(&cloudformation.StackDrift{
+					Status: sdkcfn.StackDriftStatusInSync,
+				}, nil)
+				m.EXPECT().StackResources(mockAddonsID).Return(nil, nil)
+			},
+			wanted: &StackDrift{
+				Stacks: []*DriftedStack{
+					{
+						Name:   "phonetool-test-api",
+						Status: sdkcfn.StackDriftStatusDrifted,
+						Resources: []*DriftedResource{
+							{
+								LogicalID:  "LogGroup",
+								PhysicalID: "/copilot/phonetool-test-api",
+								Type:       "AWS::Logs::LogGroup",
+								Status:     sdkcfn.StackResourceDriftStatusModified,
+								Differences: []*PropertyDifference{
+									{
+										Path:     "/RetentionInDays",
+										Type:     sdkcfn.DifferenceTypeNotEqual,
+										Expected: "30",
+										Actual:   "7",
+									},
+								},
+							},
+						},
+					},
+					{
+						Name:      "phonetool-test-api-AddonsStack-1A2B3C",
+						Status:    sdkcfn.StackDriftStatusInSync,
+						Resources: []*DriftedResource{},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockstackDriftDetector(ctrl)
+			tc.setupMock(m)
+			d := &StackDriftDescriber{
+				stackName: mockStackName,
+				cfn:       m,
+			}
+
+			drift, err := d.Describe()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, drift)
+		})
+	}
+}
+
+func TestStackDrift_HasDrift(t *testing.T) {
+	require.False(t, (&StackDrift{
+		Stacks: []*DriftedStack{{Name: "phonetool-test", Status: sdkcfn.StackDriftStatusInSync}},
+	}).HasDrift())
+	require.True(t, (&StackDrift{
+		Stacks: []*DriftedStack{
+			{Name: "phonetool-test", Status: sdkcfn.StackDriftStatusInSync},
+			{Name: "phonetool-test-AddonsStack-1A2B3C", Status: sdkcfn.StackDriftStatusDrifted},
+		},
+	}).HasDrift())
+}
+
+func TestStackDrift_HumanString(t *testing.T) {
+	drift := &StackDrift{
+		Stacks: []*DriftedStack{
+			{
+				Name:   "phonetool-test",
+				Status: sdkcfn.StackDriftStatusDrifted,
+				Resources: []*DriftedResource{
+					{
+						LogicalID:  "EnvironmentSecurityGroup",
+						PhysicalID: "sg-1234",
+						Type:       "AWS::EC2::SecurityGroup",
+						Status:     sdkcfn.StackResourceDriftStatusModified,
+						expected:   `{"GroupDescription":"env","SecurityGroupIngress":[{"CidrIp":"10.0.0.0/16","IpProtocol":"-1"}]}`,
+						actual:     `{"GroupDescription":"env","SecurityGroupIngress":[{"CidrIp":"10.0.0.0/16","IpProtocol":"-1"},{"CidrIp":"0.0.0.0/0","FromPort":22,"IpProtocol":"tcp","ToPort":22}]}`,
+					},
+					{
+						LogicalID: "PublicRouteTable",
+						Type:      "AWS::EC2::RouteTable",
+						Status:    sdkcfn.StackResourceDriftStatusDeleted,
+					},
+				},
+			},
+			{
+				Name:             "phonetool-test-AddonsStack-1A2B3C",
+				Status:           sdkcfn.StackDriftStatusInSync,
+				DetectionFailure: "Failed to detect drift on resource [Bucket]",
+			},
+		},
+	}
+	wanted := `Drift
+
+  phonetool-test  DRIFTED
+
+    EnvironmentSecurityGroup (AWS::EC2::SecurityGroup) MODIFIED
+    sg-1234
+      ~ SecurityGroupIngress:
+          (1 unchanged item)
+          + - CidrIp: 0.0.0.0/0
+          +   FromPort: 22
+          +   IpProtocol: tcp
+          +   ToPort: 22
+
+    PublicRouteTable (AWS::EC2::RouteTable) DELETED
+
+  phonetool-test-AddonsStack-1A2B3C  IN_SYNC
+    Failed to detect drift on resource [Bucket]
+
+`
+	require.Equal(t, wanted, drift.HumanString())
+}
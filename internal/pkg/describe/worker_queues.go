@@ -0,0 +1,236 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	sqsQueueType = "AWS::SQS::Queue"
+
+	sqsMetricNamespace        = "AWS/SQS"
+	sqsAgeOfOldestMessage     = "ApproximateAgeOfOldestMessage"
+	sqsQueueNameDimension     = "QueueName"
+	sqsMetricPeriod           = time.Minute
+	sqsMetricLookbackDuration = 15 * time.Minute
+)
+
+type queueAttributesGetter interface {
+	QueueAttributes(url string) (*sqs.QueueAttributes, error)
+}
+
+type metricMaximumGetter interface {
+	LatestMaximum(metric cloudwatch.Metric, period, lookback time.Duration) (float64, bool, error)
+}
+
+type stackResourcesGetter interface {
+	Resources() ([]*stack.Resource, error)
+}
+
+// WorkerQueue is an SQS queue of a deployed worker service.
+type WorkerQueue struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	ARN  string `json:"arn"`
+	FIFO bool   `json:"fifo"`
+
+	VisibleMessages  int `json:"visibleMessages"`
+	InFlightMessages int `json:"inFlightMessages"`
+	DelayedMessages  int `json:"delayedMessages"`
+	// AgeOfOldestMessage is nil if the age is unknown.
+	AgeOfOldestMessage *time.Duration `json:"ageOfOldestMessage,omitempty"`
+
+	// DeadLetterQueue is the name of the dead-letter queue of the queue, empty if it doesn't have one.
+	DeadLetterQueue string `json:"deadLetterQueue,omitempty"`
+	// SourceQueues are the names of the queues that send their failed messages to this queue.
+	SourceQueues []string `json:"sourceQueues,omitempty"`
+}
+
+// IsDeadLetterQueue returns true if the queue is the dead-letter queue of another queue.
+func (q *WorkerQueue) IsDeadLetterQueue() bool {
+	return len(q.SourceQueues) != 0
+}
+
+// WorkerQueuesDescriber retrieves information about the SQS queues of a worker service in an environment.
+type WorkerQueuesDescriber struct {
+	stack   stackResourcesGetter
+	queues  queueAttributesGetter
+	metrics metricMaximumGetter
+}
+
+// NewWorkerQueuesDescriber instantiates a describer for the queues of a worker service in an environment.
+func NewWorkerQueuesDescriber(opt NewServiceConfig) (*WorkerQueuesDescriber, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &WorkerQueuesDescriber{
+		stack:   stack.NewStackDescriber(cfnstack.NameForWorkload(opt.App, opt.Env, opt.Svc), sess),
+		queues:  sqs.New(sess),
+		metrics: cloudwatch.New(sess),
+	}, nil
+}
+
+// Queues returns the queues of the worker service with their depth, ordered so that each queue is followed by its dead-letter queue.
+func (d *WorkerQueuesDescriber) Queues() ([]*WorkerQueue, error) {
+	resources, err := d.stack.Resources()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve stack resources: %w", err)
+	}
+	var queues []*WorkerQueue
+	byARN := make(map[string]*WorkerQueue)
+	deadLetterTargets := make(map[string]string)
+	for _, resource := range resources {
+		if resource.Type != sqsQueueType || resource.PhysicalID == "" {
+			continue
+		}
+		attrs, err := d.queues.QueueAttributes(resource.PhysicalID)
+		if err != nil {
+			return nil, err
+		}
+		queue := &WorkerQueue{
+			Name:             resource.LogicalID,
+			URL:              resource.PhysicalID,
+			ARN:              attrs.ARN,
+			FIFO:             attrs.FIFO,
+			VisibleMessages:  attrs.VisibleMessages,
+			InFlightMessages: attrs.InFlightMessages,
+			DelayedMessages:  attrs.DelayedMessages,
+		}
+		queues = append(queues, queue)
+		byARN[attrs.ARN] = queue
+		if attrs.DeadLetterTargetARN != "" {
+			deadLetterTargets[queue.Name] = attrs.DeadLetterTargetARN
+		}
+	}
+	for _, queue := range queues {
+		dlq, ok := byARN[deadLetterTargets[queue.Name]]
+		if !ok {
+			continue
+		}
+		queue.DeadLetterQueue = dlq.Name
+		dlq.SourceQueues = append(dlq.SourceQueues, queue.Name)
+	}
+	return orderQueues(queues), nil
+}
+
+// Describe returns the depth and age of the oldest message of each queue of the worker service.
+func (d *WorkerQueuesDescriber) Describe() (HumanJSONStringer, error) {
+	queues, err := d.Queues()
+	if err != nil {
+		return nil, err
+	}
+	for _, queue := range queues {
+		age, ok, err := d.metrics.LatestMaximum(cloudwatch.Metric{
+			Namespace: sqsMetricNamespace,
+			Name:      sqsAgeOfOldestMessage,
+			Dimensions: map[string]string{
+				sqsQueueNameDimension: queueName(queue.URL),
+			},
+		}, sqsMetricPeriod, sqsMetricLookbackDuration)
+		if err != nil {
+			return nil, fmt.Errorf("get age of the oldest message in queue %s: %w", queue.Name, err)
+		}
+		if !ok {
+			continue
+		}
+		duration := time.Duration(age) * time.Second
+		queue.AgeOfOldestMessage = &duration
+	}
+	return &workerQueues{Queues: queues}, nil
+}
+
+// orderQueues sorts the queues by name and moves each dead-letter queue after its first source queue.
+func orderQueues(queues []*WorkerQueue) []*WorkerQueue {
+	sort.SliceStable(queues, func(i, j int) bool {
+		return queues[i].Name < queues[j].Name
+	})
+	byName := make(map[string]*WorkerQueue, len(queues))
+	for _, queue := range queues {
+		byName[queue.Name] = queue
+	}
+	ordered := make([]*WorkerQueue, 0, len(queues))
+	added := make(map[string]bool, len(queues))
+	add := func(queue *WorkerQueue) {
+		if added[queue.Name] {
+			return
+		}
+		added[queue.Name] = true
+		ordered = append(ordered, queue)
+	}
+	for _, queue := range queues {
+		if queue.IsDeadLetterQueue() {
+			continue
+		}
+		add(queue)
+		if dlq, ok := byName[queue.DeadLetterQueue]; ok {
+			add(dlq)
+		}
+	}
+	for _, queue := range queues {
+		add(queue)
+	}
+	return ordered
+}
+
+// queueName returns the name of the queue from its URL.
+// For example, https://sqs.us-west-2.amazonaws.com/123456789012/my-queue returns my-queue.
+func queueName(url string) string {
+	return url[strings.LastIndex(url, "/")+1:]
+}
+
+// workerQueues contains the status of the queues of a worker service.
+type workerQueues struct {
+	Queues []*WorkerQueue `json:"queues"`
+}
+
+// JSONString returns the stringified workerQueues struct with json format.
+func (w *workerQueues) JSONString() (string, error) {
+	b, err := json.Marshal(w)
+	if err != nil {
+		return "", fmt.Errorf("marshal queues: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified workerQueues struct in human-readable format.
+func (w *workerQueues) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Queues\n\n"))
+	writer.Flush()
+	headers := []string{"Name", "Visible", "In Flight", "Delayed", "Oldest Message", "Dead-letter Queue"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, queue := range w.Queues {
+		age, dlq := "-", "-"
+		if queue.AgeOfOldestMessage != nil {
+			age = queue.AgeOfOldestMessage.String()
+		}
+		if queue.DeadLetterQueue != "" {
+			dlq = queue.DeadLetterQueue
+		}
+		fmt.Fprintf(writer, "  %s\t%d\t%d\t%d\t%s\t%s\n", queue.Name, queue.VisibleMessages, queue.InFlightMessages, queue.DelayedMessages, age, dlq)
+	}
+	writer.Flush()
+	return b.String()
+}
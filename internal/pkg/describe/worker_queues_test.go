@@ -0,0 +1,179 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type workerQueuesDescriberMocks struct {
+	stack   *mocks.MockstackResourcesGetter
+	queues  *mocks.MockqueueAttributesGetter
+	metrics *mocks.MockmetricMaximumGetter
+}
+
+func TestWorkerQueuesDescriber_Describe(t *testing.T) {
+	const (
+		mockQueueURL      = "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-EventsQueue-abc"
+		mockQueueARN      = "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-EventsQueue-abc"
+		mockDLQURL        = "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-DeadLetterQueue-abc"
+		mockDLQARN        = "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-DeadLetterQueue-abc"
+		mockTopicQueueURL = "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-apiordersEventsQueue-abc"
+		mockTopicQueueARN = "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-apiordersEventsQueue-abc"
+	)
+	mockResources := []*stack.Resource{
+		{Type: "AWS::SQS::Queue", LogicalID: "DeadLetterQueue", PhysicalID: mockDLQURL},
+		{Type: "AWS::ECS::Service", LogicalID: "Service", PhysicalID: "mockService"},
+		{Type: "AWS::SQS::Queue", LogicalID: "apiordersEventsQueue", PhysicalID: mockTopicQueueURL},
+		{Type: "AWS::SQS::Queue", LogicalID: "EventsQueue", PhysicalID: mockQueueURL},
+	}
+	ageMetric := func(url string) cloudwatch.Metric {
+		return cloudwatch.Metric{
+			Namespace:  "AWS/SQS",
+			Name:       "ApproximateAgeOfOldestMessage",
+			Dimensions: map[string]string{"QueueName": queueName(url)},
+		}
+	}
+	fiveMinutes, oneHour := 5*time.Minute, time.Hour
+	testCases := map[string]struct {
+		setupMocks func(m workerQueuesDescriberMocks)
+
+		wanted    []*WorkerQueue
+		wantedErr string
+	}{
+		"error if stack resources cannot be retrieved": {
+			setupMocks: func(m workerQueuesDescriberMocks) {
+				m.stack.EXPECT().Resources().Return(nil, errors.New("some error"))
+			},
+			wantedErr: "retrieve stack resources: some error",
+		},
+		"error if queue attributes cannot be retrieved": {
+			setupMocks: func(m workerQueuesDescriberMocks) {
+				m.stack.EXPECT().Resources().Return(mockResources, nil)
+				m.queues.EXPECT().QueueAttributes(mockDLQURL).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "some error",
+		},
+		"error if the age of the oldest message cannot be retrieved": {
+			setupMocks: func(m workerQueuesDescriberMocks) {
+				m.stack.EXPECT().Resources().Return([]*stack.Resource{mockResources[3]}, nil)
+				m.queues.EXPECT().QueueAttributes(mockQueueURL).Return(&sqs.QueueAttributes{ARN: mockQueueARN}, nil)
+				m.metrics.EXPECT().LatestMaximum(ageMetric(mockQueueURL), time.Minute, 15*time.Minute).Return(0.0, false, errors.New("some error"))
+			},
+			wantedErr: "get age of the oldest message in queue EventsQueue: some error",
+		},
+		"return each queue followed by its dead-letter queue": {
+			setupMocks: func(m workerQueuesDescriberMocks) {
+				m.stack.EXPECT().Resources().Return(mockResources, nil)
+				m.queues.EXPECT().QueueAttributes(mockDLQURL).Return(&sqs.QueueAttributes{
+					ARN:             mockDLQARN,
+					VisibleMessages: 7,
+				}, nil)
+				m.queues.EXPECT().QueueAttributes(mockTopicQueueURL).Return(&sqs.QueueAttributes{
+					ARN: mockTopicQueueARN,
+				}, nil)
+				m.queues.EXPECT().QueueAttributes(mockQueueURL).Return(&sqs.QueueAttributes{
+					ARN:                 mockQueueARN,
+					VisibleMessages:     42,
+					InFlightMessages:    3,
+					DelayedMessages:     1,
+					DeadLetterTargetARN: mockDLQARN,
+					MaxReceiveCount:     5,
+				}, nil)
+				m.metrics.EXPECT().LatestMaximum(ageMetric(mockQueueURL), time.Minute, 15*time.Minute).Return(300.0, true, nil)
+				m.metrics.EXPECT().LatestMaximum(ageMetric(mockDLQURL), time.Minute, 15*time.Minute).Return(3600.0, true, nil)
+				m.metrics.EXPECT().LatestMaximum(ageMetric(mockTopicQueueURL), time.Minute, 15*time.Minute).Return(0.0, false, nil)
+			},
+			wanted: []*WorkerQueue{
+				{
+					Name:               "EventsQueue",
+					URL:                mockQueueURL,
+					ARN:                mockQueueARN,
+					VisibleMessages:    42,
+					InFlightMessages:   3,
+					DelayedMessages:    1,
+					AgeOfOldestMessage: &fiveMinutes,
+					DeadLetterQueue:    "DeadLetterQueue",
+				},
+				{
+					Name:               "DeadLetterQueue",
+					URL:                mockDLQURL,
+					ARN:                mockDLQARN,
+					VisibleMessages:    7,
+					AgeOfOldestMessage: &oneHour,
+					SourceQueues:       []string{"EventsQueue"},
+				},
+				{
+					Name: "apiordersEventsQueue",
+					URL:  mockTopicQueueURL,
+					ARN:  mockTopicQueueARN,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := workerQueuesDescriberMocks{
+				stack:   mocks.NewMockstackResourcesGetter(ctrl),
+				queues:  mocks.NewMockqueueAttributesGetter(ctrl),
+				metrics: mocks.NewMockmetricMaximumGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &WorkerQueuesDescriber{
+				stack:   m.stack,
+				queues:  m.queues,
+				metrics: m.metrics,
+			}
+
+			got, err := d.Describe()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got.(*workerQueues).Queues)
+		})
+	}
+}
+
+func TestWorkerQueues_HumanString(t *testing.T) {
+	fiveMinutes := 5 * time.Minute
+	queues := &workerQueues{
+		Queues: []*WorkerQueue{
+			{
+				Name:               "EventsQueue",
+				VisibleMessages:    42,
+				InFlightMessages:   3,
+				AgeOfOldestMessage: &fiveMinutes,
+				DeadLetterQueue:    "DeadLetterQueue",
+			},
+			{
+				Name:            "DeadLetterQueue",
+				VisibleMessages: 7,
+				SourceQueues:    []string{"EventsQueue"},
+			},
+		},
+	}
+	wanted := `Queues
+
+  Name             Visible   In Flight  Delayed   Oldest Message  Dead-letter Queue
+  ----             -------   ---------  -------   --------------  -----------------
+  EventsQueue      42        3          0         5m0s            DeadLetterQueue
+  DeadLetterQueue  7         0          0         -               -
+`
+
+	require.Equal(t, wanted, queues.HumanString())
+}
@@ -1,7 +1,8 @@
 // Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
 // SPDX-License-Identifier: Apache-2.0
 
-// Package dockerengine provides functionality to interact with the Docker server.
+// Package dockerengine provides functionality to interact with the Docker server,
+// or with container engines that are compatible with the docker CLI.
 package dockerengine
 
 import (
@@ -42,11 +43,16 @@ const (
 
 const (
 	credStoreECRLogin = "ecr-login" // set on `credStore` attribute in docker configuration file
+
+	// cacheBuilder is the buildx builder that exports build caches.
+	// The default "docker" driver of buildx cannot export caches, so the builder uses the "docker-container" driver.
+	cacheBuilder = "copilot-cache"
 )
 
 // DockerCmdClient represents the docker client to interact with the server via external commands.
 type DockerCmdClient struct {
 	runner Cmd
+	engine Engine
 	// Override in unit tests.
 	buf       *bytes.Buffer
 	homePath  string
@@ -54,9 +60,11 @@ type DockerCmdClient struct {
 }
 
 // New returns CmdClient to make requests against the Docker daemon via external commands.
+// The command of the container engine is selected with EnvContainerEngine, or detected among Engines.
 func New(cmd Cmd) DockerCmdClient {
 	return DockerCmdClient{
 		runner:    cmd,
+		engine:    detectEngine(os.LookupEnv, osexec.LookPath),
 		homePath:  userHomeDirectory(),
 		lookupEnv: os.LookupEnv,
 	}
@@ -64,15 +72,64 @@ func New(cmd Cmd) DockerCmdClient {
 
 // BuildArguments holds the arguments that can be passed while building a container.
 type BuildArguments struct {
-	URI        string            // Required. Location of ECR Repo. Used to generate image name in conjunction with tag.
-	Tags       []string          // Required. List of tags to apply to the image.
-	Dockerfile string            // Required. Dockerfile to pass to `docker build` via --file flag.
-	Context    string            // Optional. Build context directory to pass to `docker build`.
-	Target     string            // Optional. The target build stage to pass to `docker build`.
-	CacheFrom  []string          // Optional. Images to consider as cache sources to pass to `docker build`
-	Platform   string            // Optional. OS/Arch to pass to `docker build`.
-	Args       map[string]string // Optional. Build args to pass via `--build-arg` flags. Equivalent to ARG directives in dockerfile.
-	Labels     map[string]string // Required. Set metadata for an image.
+	URI           string            // Required. Location of ECR Repo. Used to generate image name in conjunction with tag.
+	Tags          []string          // Required. List of tags to apply to the image.
+	Dockerfile    string            // Required. Dockerfile to pass to `docker build` via --file flag.
+	Context       string            // Optional. Build context directory to pass to `docker build`.
+	Target        string            // Optional. The target build stage to pass to `docker build`.
+	CacheFrom     []string          // Optional. Images to consider as cache sources to pass to `docker build`
+	CacheTo       []string          // Optional. Cache export destinations to pass to `docker buildx build`.
+	RegistryCache string            // Optional. Tag in the repository to import and export the build cache as a registry cache.
+	Secrets       []BuildSecret     // Optional. Secrets to expose to the build via `--secret` flags.
+	SSH           []string          // Optional. SSH agent sockets or keys to forward to the build via `--ssh` flags.
+	Platform      string            // Optional. OS/Arch to pass to `docker build`.
+	Platforms     []string          // Optional. OS/Arch pairs to build a manifest list for with `docker buildx build`. Takes precedence over Platform.
+	Args          map[string]string // Optional. Build args to pass via `--build-arg` flags. Equivalent to ARG directives in dockerfile.
+	Labels        map[string]string // Required. Set metadata for an image.
+
+	buildx bool // Build with `docker buildx build` and load the image into the local image store.
+}
+
+// BuildSecret is a secret exposed to the RUN instructions of a Dockerfile, read from either an environment variable or a file.
+type BuildSecret struct {
+	ID  string
+	Env string
+	Src string
+}
+
+// flag returns the value of the `--secret` flag for the secret.
+func (s BuildSecret) flag() string {
+	if s.Env != "" {
+		return fmt.Sprintf("id=%s,env=%s", s.ID, s.Env)
+	}
+	return fmt.Sprintf("id=%s,src=%s", s.ID, s.Src)
+}
+
+// IsMultiPlatform returns true if the image is built for more than one platform.
+func (in *BuildArguments) IsMultiPlatform() bool {
+	return len(in.Platforms) > 1
+}
+
+// forPlatform returns the arguments to build the image of a single platform of a manifest list.
+// The tags of the image are suffixed with the platform so that they can be referenced by the manifest list.
+func (in *BuildArguments) forPlatform(platform string) *BuildArguments {
+	out := *in
+	out.Platform = platform
+	out.Platforms = nil
+	out.buildx = true
+	if in.RegistryCache != "" {
+		out.RegistryCache = platformTag(in.RegistryCache, platform)
+	}
+	out.Tags = make([]string, len(in.Tags))
+	for i, tag := range in.Tags {
+		out.Tags[i] = platformTag(tag, platform)
+	}
+	return &out
+}
+
+// exportsCache returns true if the build cache is exported with `--cache-to`.
+func (in *BuildArguments) exportsCache() bool {
+	return len(in.CacheTo) > 0 || in.RegistryCache != ""
 }
 
 // RunOptions holds the options for running a Docker container.
@@ -110,6 +167,13 @@ func (in *BuildArguments) GenerateDockerBuildArgs(c DockerCmdClient) ([]string,
 	}
 
 	args := []string{"build"}
+	// Exporting the build cache requires buildx, and the image must be loaded explicitly in the local image store.
+	if c.supportsBuildx() && (in.buildx || in.exportsCache()) {
+		args = []string{"buildx", "build", "--load"}
+		if in.exportsCache() {
+			args = append(args, "--builder", cacheBuilder)
+		}
+	}
 
 	// Add additional image tags to the docker build call.
 	for _, tag := range in.Tags {
@@ -121,6 +185,25 @@ func (in *BuildArguments) GenerateDockerBuildArgs(c DockerCmdClient) ([]string,
 		args = append(args, "--cache-from", imageFrom)
 	}
 
+	// Add cache to options.
+	for _, cacheTo := range in.CacheTo {
+		args = append(args, "--cache-to", cacheTo)
+	}
+	if in.RegistryCache != "" {
+		ref := imageName(in.URI, in.RegistryCache)
+		args = append(args, "--cache-from", fmt.Sprintf("type=registry,ref=%s", ref))
+		// ECR requires the cache manifest to be stored as an image manifest with OCI media types.
+		args = append(args, "--cache-to", fmt.Sprintf("type=registry,ref=%s,mode=max,image-manifest=true,oci-mediatypes=true", ref))
+	}
+
+	// Add secrets and SSH agent forwarding options.
+	for _, secret := range in.Secrets {
+		args = append(args, "--secret", secret.flag())
+	}
+	for _, ssh := range in.SSH {
+		args = append(args, "--ssh", ssh)
+	}
+
 	// Add target option.
 	if in.Target != "" {
 		args = append(args, "--target", in.Target)
@@ -132,7 +215,7 @@ func (in *BuildArguments) GenerateDockerBuildArgs(c DockerCmdClient) ([]string,
 	}
 
 	// Plain display if we're in a CI environment.
-	if ci, _ := c.lookupEnv("CI"); ci == "true" {
+	if ci, _ := c.lookupEnv("CI"); ci == "true" && c.supportsProgress() {
 		args = append(args, "--progress", "plain")
 	}
 
@@ -168,20 +251,67 @@ type dockerConfig struct {
 }
 
 // Build will run a `docker build` command for the given ecr repo URI and build arguments.
+// If the image is built for multiple platforms, it runs `docker buildx build` once per platform instead,
+// and tags each image with the platform so that PushManifestList can reference them.
 func (c DockerCmdClient) Build(ctx context.Context, in *BuildArguments, w io.Writer) error {
+	if c.supportsBuildx() && in.exportsCache() {
+		if err := c.ensureCacheBuilder(ctx); err != nil {
+			return err
+		}
+	}
+	if in.IsMultiPlatform() {
+		if err := c.supportsManifestList(); err != nil {
+			return err
+		}
+		return c.buildMultiPlatform(ctx, in, w)
+	}
 	args, err := in.GenerateDockerBuildArgs(c)
 	if err != nil {
 		return fmt.Errorf("generate docker build args: %w", err)
 	}
-	if err := c.runner.RunWithContext(ctx, "docker", args, exec.Stdout(w), exec.Stderr(w)); err != nil {
+	if err := c.runner.RunWithContext(ctx, c.Name(), args, exec.Stdout(w), exec.Stderr(w)); err != nil {
 		return fmt.Errorf("building image: %w", err)
 	}
 	return nil
 }
 
+// ensureCacheBuilder creates the buildx builder that exports build caches if it doesn't exist yet.
+func (c DockerCmdClient) ensureCacheBuilder(ctx context.Context) error {
+	inspect := []string{"buildx", "inspect", cacheBuilder}
+	if err := c.runner.RunWithContext(ctx, c.Name(), inspect, exec.Stdout(io.Discard), exec.Stderr(io.Discard)); err == nil {
+		return nil
+	}
+	stderr := new(strings.Builder)
+	err := c.runner.RunWithContext(ctx, c.Name(), []string{"buildx", "create", "--name", cacheBuilder, "--driver", "docker-container"}, exec.Stdout(io.Discard), exec.Stderr(stderr))
+	if err == nil {
+		return nil
+	}
+	// Images of several containers are built concurrently, so another build may have just created the builder.
+	if c.runner.RunWithContext(ctx, c.Name(), inspect, exec.Stdout(io.Discard), exec.Stderr(io.Discard)) == nil {
+		return nil
+	}
+	if msg := strings.TrimSpace(stderr.String()); msg != "" {
+		err = fmt.Errorf("%w: %s", err, msg)
+	}
+	return fmt.Errorf("create buildx builder %q with the docker-container driver to export the build cache: %w", cacheBuilder, err)
+}
+
+func (c DockerCmdClient) buildMultiPlatform(ctx context.Context, in *BuildArguments, w io.Writer) error {
+	for _, platform := range in.Platforms {
+		args, err := in.forPlatform(platform).GenerateDockerBuildArgs(c)
+		if err != nil {
+			return fmt.Errorf("generate docker build args: %w", err)
+		}
+		if err := c.runner.RunWithContext(ctx, c.Name(), args, exec.Stdout(w), exec.Stderr(w)); err != nil {
+			return fmt.Errorf("building image for platform %s: %w", platform, err)
+		}
+	}
+	return nil
+}
+
 // Login will run a `docker login` command against the Service repository URI with the input uri and auth data.
 func (c DockerCmdClient) Login(uri, username, password string) error {
-	err := c.runner.Run("docker",
+	err := c.runner.Run(c.Name(),
 		[]string{"login", "-u", username, "--password-stdin", uri},
 		exec.Stdin(strings.NewReader(password)))
 
@@ -204,8 +334,8 @@ func (c DockerCmdClient) Push(ctx context.Context, uri string, w io.Writer, tags
 	}
 
 	for _, img := range images {
-		if err := c.runner.RunWithContext(ctx, "docker", append([]string{"push", img}, args...), exec.Stdout(w), exec.Stderr(w)); err != nil {
-			return "", fmt.Errorf("docker push %s: %w", img, err)
+		if err := c.runner.RunWithContext(ctx, c.Name(), append([]string{"push", img}, args...), exec.Stdout(w), exec.Stderr(w)); err != nil {
+			return "", fmt.Errorf("%s push %s: %w", c.Name(), img, err)
 		}
 	}
 	buf := new(strings.Builder)
@@ -213,7 +343,7 @@ func (c DockerCmdClient) Push(ctx context.Context, uri string, w io.Writer, tags
 	// Pick the first tag and get the image's digest.
 	// For Main container we call  docker inspect --format '{{json (index .RepoDigests 0)}}' uri:latest
 	// For Sidecar container images we call docker inspect --format '{{json (index .RepoDigests 0)}}' uri:<sidecarname>-latest
-	if err := c.runner.RunWithContext(ctx, "docker", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", imageName(uri, tags[0])}, exec.Stdout(buf)); err != nil {
+	if err := c.runner.RunWithContext(ctx, c.Name(), []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", imageName(uri, tags[0])}, exec.Stdout(buf)); err != nil {
 		return "", fmt.Errorf("inspect image digest for %s: %w", uri, err)
 	}
 	repoDigest := strings.Trim(strings.TrimSpace(buf.String()), `"'`) // remove new lines and quotes from output
@@ -224,6 +354,65 @@ func (c DockerCmdClient) Push(ctx context.Context, uri string, w io.Writer, tags
 	return parts[1], nil
 }
 
+// ImageDigest returns the digest that the image reference resolves to in its registry.
+// The digest of a multi-platform image is the digest of its manifest list.
+// Only docker can inspect images in registries, with `buildx imagetools inspect`.
+func (c DockerCmdClient) ImageDigest(ctx context.Context, ref string) (string, error) {
+	if !c.supportsBuildx() {
+		return "", fmt.Errorf("resolve the digest of image %s: %s cannot inspect images in registries, reference the image by digest instead", ref, c.Name())
+	}
+	buf := new(strings.Builder)
+	if err := c.runner.RunWithContext(ctx, c.Name(), []string{"buildx", "imagetools", "inspect", "--format", "{{json .Manifest.Digest}}", ref}, exec.Stdout(buf)); err != nil {
+		return "", fmt.Errorf("inspect the manifest of image %s: %w", ref, err)
+	}
+	digest := strings.Trim(strings.TrimSpace(buf.String()), `"'`)
+	if !strings.HasPrefix(digest, "sha256:") {
+		return "", fmt.Errorf("parse the digest of image %s from %q", ref, digest)
+	}
+	return digest, nil
+}
+
+// PushManifestList pushes the images built for each platform, then creates and pushes a manifest list
+// for each tag that references them. It returns the digest of the manifest list on success.
+func (c DockerCmdClient) PushManifestList(ctx context.Context, uri string, w io.Writer, platforms []string, tags ...string) (digest string, err error) {
+	if err := c.supportsManifestList(); err != nil {
+		return "", err
+	}
+	var args []string
+	if ci, _ := c.lookupEnv("CI"); ci == "true" {
+		args = append(args, "--quiet")
+	}
+	for _, tag := range tags {
+		var images []string
+		for _, platform := range platforms {
+			img := imageName(uri, platformTag(tag, platform))
+			if err := c.runner.RunWithContext(ctx, c.Name(), append([]string{"push", img}, args...), exec.Stdout(w), exec.Stderr(w)); err != nil {
+				return "", fmt.Errorf("%s push %s: %w", c.Name(), img, err)
+			}
+			images = append(images, img)
+		}
+		list := imageName(uri, tag)
+		if err := c.runner.RunWithContext(ctx, c.Name(), append([]string{"manifest", "create", "--amend", list}, images...), exec.Stdout(w), exec.Stderr(w)); err != nil {
+			return "", fmt.Errorf("%s manifest create %s: %w", c.Name(), list, err)
+		}
+		buf := new(strings.Builder)
+		// "docker manifest push" prints the digest of the manifest list on the last line of its output.
+		if err := c.runner.RunWithContext(ctx, c.Name(), []string{"manifest", "push", "--purge", list}, exec.Stdout(buf), exec.Stderr(w)); err != nil {
+			return "", fmt.Errorf("%s manifest push %s: %w", c.Name(), list, err)
+		}
+		if digest != "" {
+			// The manifest list has the same digest regardless of the associated tag.
+			continue
+		}
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		digest = strings.TrimSpace(lines[len(lines)-1])
+		if !strings.HasPrefix(digest, "sha256:") {
+			return "", fmt.Errorf("parse the digest of the manifest list from '%s'", digest)
+		}
+	}
+	return digest, nil
+}
+
 func (in *RunOptions) generateRunArguments() []string {
 	args := []string{"run"}
 
@@ -293,7 +482,7 @@ func (c DockerCmdClient) Run(ctx context.Context, options *RunOptions) error {
 		stderr := logger()
 		defer stderr.Close()
 
-		if err := c.runner.RunWithContext(ctx, "docker", options.generateRunArguments(), exec.Stdout(stdout), exec.Stderr(stderr)); err != nil {
+		if err := c.runner.RunWithContext(ctx, c.Name(), options.generateRunArguments(), exec.Stdout(stdout), exec.Stderr(stderr)); err != nil {
 			return fmt.Errorf("running container: %w", err)
 		}
 		return nil
@@ -305,8 +494,8 @@ func (c DockerCmdClient) Run(ctx context.Context, options *RunOptions) error {
 // IsContainerRunning checks if a specific Docker container is running.
 func (c DockerCmdClient) IsContainerRunning(containerName string) (bool, error) {
 	buf := &bytes.Buffer{}
-	if err := c.runner.Run("docker", []string{"ps", "-q", "--filter", "name=" + containerName}, exec.Stdout(buf)); err != nil {
-		return false, fmt.Errorf("run docker ps: %w", err)
+	if err := c.runner.Run(c.Name(), []string{"ps", "-q", "--filter", "name=" + containerName}, exec.Stdout(buf)); err != nil {
+		return false, fmt.Errorf("run %s ps: %w", c.Name(), err)
 	}
 
 	output := strings.TrimSpace(buf.String())
@@ -316,7 +505,7 @@ func (c DockerCmdClient) IsContainerRunning(containerName string) (bool, error)
 // Stop calls `docker stop` to stop a running container.
 func (c DockerCmdClient) Stop(containerID string) error {
 	buf := &bytes.Buffer{}
-	if err := c.runner.Run("docker", []string{"stop", containerID}, exec.Stdout(buf), exec.Stderr(buf)); err != nil {
+	if err := c.runner.Run(c.Name(), []string{"stop", containerID}, exec.Stdout(buf), exec.Stderr(buf)); err != nil {
 		return fmt.Errorf("%s: %w", strings.TrimSpace(buf.String()), err)
 	}
 	return nil
@@ -325,7 +514,7 @@ func (c DockerCmdClient) Stop(containerID string) error {
 // Rm calls `docker rm` to remove a stopped container.
 func (c DockerCmdClient) Rm(containerID string) error {
 	buf := &bytes.Buffer{}
-	if err := c.runner.Run("docker", []string{"rm", containerID}, exec.Stdout(buf), exec.Stderr(buf)); err != nil {
+	if err := c.runner.Run(c.Name(), []string{"rm", containerID}, exec.Stdout(buf), exec.Stderr(buf)); err != nil {
 		return fmt.Errorf("%s: %w", strings.TrimSpace(buf.String()), err)
 	}
 	return nil
@@ -333,11 +522,14 @@ func (c DockerCmdClient) Rm(containerID string) error {
 
 // CheckDockerEngineRunning will run `docker info` command to check if the docker engine is running.
 func (c DockerCmdClient) CheckDockerEngineRunning() error {
-	if _, err := osexec.LookPath("docker"); err != nil {
+	if _, err := osexec.LookPath(c.Name()); err != nil {
 		return ErrDockerCommandNotFound
 	}
+	if c.kind() != EngineDocker {
+		return c.checkEngineRunning()
+	}
 	buf := &bytes.Buffer{}
-	err := c.runner.Run("docker", []string{"info", "-f", "'{{json .}}'"}, exec.Stdout(buf))
+	err := c.runner.Run(c.Name(), []string{"info", "-f", "'{{json .}}'"}, exec.Stdout(buf))
 	if err != nil {
 		return fmt.Errorf("get docker info: %w", err)
 	}
@@ -360,12 +552,19 @@ func (c DockerCmdClient) CheckDockerEngineRunning() error {
 }
 
 // GetPlatform will run the `docker version` command to get the OS/Arch.
+// Other engines report their platform with their `info` command.
 func (c DockerCmdClient) GetPlatform() (os, arch string, err error) {
-	if _, err := osexec.LookPath("docker"); err != nil {
+	if _, err := osexec.LookPath(c.Name()); err != nil {
 		return "", "", ErrDockerCommandNotFound
 	}
 	buf := &bytes.Buffer{}
-	err = c.runner.Run("docker", []string{"version", "-f", "'{{json .Server}}'"}, exec.Stdout(buf))
+	if c.kind() != EngineDocker {
+		if err := c.runner.Run(c.Name(), c.platformFormat(), exec.Stdout(buf)); err != nil {
+			return "", "", fmt.Errorf("run %s info: %w", c.Name(), err)
+		}
+		return parsePlatform(buf.String())
+	}
+	err = c.runner.Run(c.Name(), []string{"version", "-f", "'{{json .Server}}'"}, exec.Stdout(buf))
 	if err != nil {
 		return "", "", fmt.Errorf("run docker version: %w", err)
 	}
@@ -395,10 +594,12 @@ func (c DockerCmdClient) IsEcrCredentialHelperEnabled(uri string) bool {
 		return false
 	}
 
+	if c.kind() == EngineFinch && c.isFinchCredentialHelperEnabled() {
+		return true
+	}
 	// Look into the default locations
-	pathsToTry := []string{filepath.Join(".docker", "config.json"), ".dockercfg"}
-	for _, path := range pathsToTry {
-		content, err := os.ReadFile(filepath.Join(c.homePath, path))
+	for _, path := range c.credentialConfigPaths() {
+		content, err := os.ReadFile(path)
 		if err != nil {
 			// if we can't read the file keep going
 			continue
@@ -422,6 +623,12 @@ func PlatformString(os, arch string) string {
 	return fmt.Sprintf("%s/%s", os, arch)
 }
 
+// platformTag returns the tag of the image built for a platform of a manifest list.
+// For example, the image of "linux/arm64" for the tag "latest" is tagged "latest-linux-arm64".
+func platformTag(tag, platform string) string {
+	return fmt.Sprintf("%s-%s", tag, strings.ReplaceAll(platform, "/", "-"))
+}
+
 func parseCredFromDockerConfig(config []byte) (*dockerConfig, error) {
 	/*
 			Sample docker config file
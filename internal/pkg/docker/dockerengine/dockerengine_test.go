@@ -42,6 +42,11 @@ func TestDockerCommand_Build(t *testing.T) {
 		args       map[string]string
 		target     string
 		cacheFrom  []string
+		platforms  []string
+		cacheTo    []string
+		cache      string
+		secrets    []BuildSecret
+		ssh        []string
 		envVars    map[string]string
 		labels     map[string]string
 		setupMocks func(controller *gomock.Controller)
@@ -98,6 +103,102 @@ func TestDockerCommand_Build(t *testing.T) {
 					Return(nil)
 			},
 		},
+		"builds and loads an image per platform with buildx": {
+			path:      mockPath,
+			tags:      []string{mockTag1},
+			platforms: []string{"linux/amd64", "linux/arm64"},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "build", "--load",
+					"-t", "mockURI:tag1-linux-amd64",
+					"--platform", "linux/amd64",
+					filepath.FromSlash("mockPath/to"),
+					"-f", "mockPath/to/mockDockerfile"}, gomock.Any(), gomock.Any()).Return(nil)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "build", "--load",
+					"-t", "mockURI:tag1-linux-arm64",
+					"--platform", "linux/arm64",
+					filepath.FromSlash("mockPath/to"),
+					"-f", "mockPath/to/mockDockerfile"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"builds with secrets, ssh and cache export options": {
+			path:    mockPath,
+			tags:    []string{mockTag1},
+			cacheTo: []string{"type=local,dest=/tmp/cache"},
+			cache:   "cache",
+			secrets: []BuildSecret{
+				{ID: "gh_token", Env: "GITHUB_TOKEN"},
+				{ID: "npmrc", Src: "/root/.npmrc"},
+			},
+			ssh: []string{"default"},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "inspect", "copilot-cache"}, gomock.Any(), gomock.Any()).Return(nil)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "build", "--load", "--builder", "copilot-cache",
+					"-t", "mockURI:tag1",
+					"--cache-to", "type=local,dest=/tmp/cache",
+					"--cache-from", "type=registry,ref=mockURI:cache",
+					"--cache-to", "type=registry,ref=mockURI:cache,mode=max,image-manifest=true,oci-mediatypes=true",
+					"--secret", "id=gh_token,env=GITHUB_TOKEN",
+					"--secret", "id=npmrc,src=/root/.npmrc",
+					"--ssh", "default",
+					filepath.FromSlash("mockPath/to"),
+					"-f", "mockPath/to/mockDockerfile"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"creates the builder that exports the cache if it doesn't exist": {
+			path:  mockPath,
+			tags:  []string{mockTag1},
+			cache: "cache",
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				gomock.InOrder(
+					mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "inspect", "copilot-cache"}, gomock.Any(), gomock.Any()).Return(mockError),
+					mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "create", "--name", "copilot-cache", "--driver", "docker-container"}, gomock.Any(), gomock.Any()).Return(nil),
+					mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "build", "--load", "--builder", "copilot-cache",
+						"-t", "mockURI:tag1",
+						"--cache-from", "type=registry,ref=mockURI:cache",
+						"--cache-to", "type=registry,ref=mockURI:cache,mode=max,image-manifest=true,oci-mediatypes=true",
+						filepath.FromSlash("mockPath/to"),
+						"-f", "mockPath/to/mockDockerfile"}, gomock.Any(), gomock.Any()).Return(nil),
+				)
+			},
+		},
+		"uses the builder created by a concurrent build": {
+			path:  mockPath,
+			tags:  []string{mockTag1},
+			cache: "cache",
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				gomock.InOrder(
+					mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "inspect", "copilot-cache"}, gomock.Any(), gomock.Any()).Return(mockError),
+					mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "create", "--name", "copilot-cache", "--driver", "docker-container"}, gomock.Any(), gomock.Any()).Return(mockError),
+					mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "inspect", "copilot-cache"}, gomock.Any(), gomock.Any()).Return(nil),
+					mockCmd.EXPECT().RunWithContext(ctx, "docker", gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+				)
+			},
+		},
+		"should error if the builder that exports the cache cannot be created": {
+			path:  mockPath,
+			tags:  []string{mockTag1},
+			cache: "cache",
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "inspect", "copilot-cache"}, gomock.Any(), gomock.Any()).Return(mockError).Times(2)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "create", "--name", "copilot-cache", "--driver", "docker-container"}, gomock.Any(), gomock.Any()).Return(mockError)
+			},
+			wantedError: fmt.Errorf(`create buildx builder "copilot-cache" with the docker-container driver to export the build cache: %w`, mockError),
+		},
+		"should error if the build of a platform fails": {
+			path:      mockPath,
+			tags:      []string{mockTag1},
+			platforms: []string{"linux/amd64", "linux/arm64"},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", gomock.Any(), gomock.Any(), gomock.Any()).Return(mockError)
+			},
+			wantedError: fmt.Errorf("building image for platform linux/amd64: %w", mockError),
+		},
 		"context differs from path": {
 			path:    mockPath,
 			tags:    []string{mockTag1},
@@ -209,14 +310,19 @@ func TestDockerCommand_Build(t *testing.T) {
 				},
 			}
 			buildInput := BuildArguments{
-				Context:    tc.context,
-				Dockerfile: tc.path,
-				URI:        mockURI,
-				Args:       tc.args,
-				Target:     tc.target,
-				CacheFrom:  tc.cacheFrom,
-				Tags:       tc.tags,
-				Labels:     tc.labels,
+				Context:       tc.context,
+				Dockerfile:    tc.path,
+				URI:           mockURI,
+				Args:          tc.args,
+				Target:        tc.target,
+				CacheFrom:     tc.cacheFrom,
+				Platforms:     tc.platforms,
+				CacheTo:       tc.cacheTo,
+				RegistryCache: tc.cache,
+				Secrets:       tc.secrets,
+				SSH:           tc.ssh,
+				Tags:          tc.tags,
+				Labels:        tc.labels,
 			}
 			buf := new(strings.Builder)
 			got := s.Build(ctx, &buildInput, buf)
@@ -230,6 +336,75 @@ func TestDockerCommand_Build(t *testing.T) {
 	}
 }
 
+func TestBuildArguments_GenerateDockerBuildArgs(t *testing.T) {
+	testCases := map[string]struct {
+		in BuildArguments
+
+		wanted []string
+	}{
+		"builds with the docker driver without a cache to export": {
+			in: BuildArguments{
+				URI:        "mockURI",
+				Tags:       []string{"latest"},
+				Dockerfile: "Dockerfile",
+				CacheFrom:  []string{"mockURI:previous"},
+			},
+			wanted: []string{"build", "-t", "mockURI:latest", "--cache-from", "mockURI:previous", ".", "-f", "Dockerfile"},
+		},
+		"exports the registry cache with the docker-container builder": {
+			in: BuildArguments{
+				URI:           "mockURI",
+				Tags:          []string{"latest"},
+				Dockerfile:    "Dockerfile",
+				RegistryCache: "cache",
+			},
+			wanted: []string{"buildx", "build", "--load", "--builder", "copilot-cache",
+				"-t", "mockURI:latest",
+				"--cache-from", "type=registry,ref=mockURI:cache",
+				"--cache-to", "type=registry,ref=mockURI:cache,mode=max,image-manifest=true,oci-mediatypes=true",
+				".", "-f", "Dockerfile"},
+		},
+		"exports cache destinations with the docker-container builder": {
+			in: BuildArguments{
+				URI:        "mockURI",
+				Tags:       []string{"latest"},
+				Dockerfile: "Dockerfile",
+				CacheTo:    []string{"type=local,dest=/tmp/cache"},
+			},
+			wanted: []string{"buildx", "build", "--load", "--builder", "copilot-cache",
+				"-t", "mockURI:latest",
+				"--cache-to", "type=local,dest=/tmp/cache",
+				".", "-f", "Dockerfile"},
+		},
+		"builds a platform of a multi-platform image with the default builder": {
+			in: *(&BuildArguments{
+				URI:        "mockURI",
+				Tags:       []string{"latest"},
+				Dockerfile: "Dockerfile",
+				Platforms:  []string{"linux/amd64", "linux/arm64"},
+			}).forPlatform("linux/arm64"),
+			wanted: []string{"buildx", "build", "--load",
+				"-t", "mockURI:latest-linux-arm64",
+				"--platform", "linux/arm64",
+				".", "-f", "Dockerfile"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c := DockerCmdClient{
+				lookupEnv: func(string) (string, bool) {
+					return "", false
+				},
+			}
+
+			got, err := tc.in.GenerateDockerBuildArgs(c)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func TestDockerCommand_Login(t *testing.T) {
 	mockError := errors.New("mockError")
 
@@ -402,6 +577,127 @@ func TestDockerCommand_Push(t *testing.T) {
 	})
 }
 
+func TestDockerCommand_ImageDigest(t *testing.T) {
+	ctx := context.Background()
+	const ref = "public.ecr.aws/nginx/nginx:1.25"
+	testCases := map[string]struct {
+		output  string
+		runErr  error
+		wanted  string
+		wantErr string
+	}{
+		"returns the digest of the manifest": {
+			output: "\"sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807\"\n",
+			wanted: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+		},
+		"wraps errors from docker": {
+			runErr:  errors.New("some error"),
+			wantErr: "inspect the manifest of image public.ecr.aws/nginx/nginx:1.25: some error",
+		},
+		"errors if the output is not a digest": {
+			output:  "null\n",
+			wantErr: `parse the digest of image public.ecr.aws/nginx/nginx:1.25 from "null"`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := NewMockCmd(ctrl)
+			m.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "imagetools", "inspect", "--format", "{{json .Manifest.Digest}}", ref}, gomock.Any()).
+				Do(func(ctx context.Context, _ string, _ []string, opt exec.CmdOption) {
+					cmd := &osexec.Cmd{}
+					opt(cmd)
+					_, _ = cmd.Stdout.Write([]byte(tc.output))
+				}).Return(tc.runErr)
+
+			digest, err := DockerCmdClient{runner: m}.ImageDigest(ctx, ref)
+
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, digest)
+		})
+	}
+}
+
+func TestDockerCommand_PushManifestList(t *testing.T) {
+	emptyLookupEnv := func(key string) (string, bool) {
+		return "", false
+	}
+	ctx := context.Background()
+	platforms := []string{"linux/amd64", "linux/arm64"}
+	t.Run("pushes the image of each platform and a manifest list for each tag", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		for _, tag := range []string{"latest", "g123bfc"} {
+			m.EXPECT().RunWithContext(ctx, "docker", []string{"push", "my-web-app:" + tag + "-linux-amd64"}, gomock.Any(), gomock.Any()).Return(nil)
+			m.EXPECT().RunWithContext(ctx, "docker", []string{"push", "my-web-app:" + tag + "-linux-arm64"}, gomock.Any(), gomock.Any()).Return(nil)
+			m.EXPECT().RunWithContext(ctx, "docker", []string{"manifest", "create", "--amend", "my-web-app:" + tag,
+				"my-web-app:" + tag + "-linux-amd64", "my-web-app:" + tag + "-linux-arm64"}, gomock.Any(), gomock.Any()).Return(nil)
+			m.EXPECT().RunWithContext(ctx, "docker", []string{"manifest", "push", "--purge", "my-web-app:" + tag}, gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, _ string, _ []string, opts ...exec.CmdOption) {
+					cmd := &osexec.Cmd{}
+					for _, opt := range opts {
+						opt(cmd)
+					}
+					_, _ = cmd.Stdout.Write([]byte("sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807\n"))
+				}).Return(nil)
+		}
+
+		// WHEN
+		cmd := DockerCmdClient{
+			runner:    m,
+			lookupEnv: emptyLookupEnv,
+		}
+		digest, err := cmd.PushManifestList(ctx, "my-web-app", new(strings.Builder), platforms, "latest", "g123bfc")
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", digest)
+	})
+	t.Run("returns a wrapped error on failure to create the manifest list", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().RunWithContext(ctx, "docker", []string{"push", "uri:latest-linux-amd64"}, gomock.Any(), gomock.Any()).Return(nil)
+		m.EXPECT().RunWithContext(ctx, "docker", []string{"push", "uri:latest-linux-arm64"}, gomock.Any(), gomock.Any()).Return(nil)
+		m.EXPECT().RunWithContext(ctx, "docker", gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+
+		// WHEN
+		cmd := DockerCmdClient{
+			runner:    m,
+			lookupEnv: emptyLookupEnv,
+		}
+		_, err := cmd.PushManifestList(ctx, "uri", new(strings.Builder), platforms, "latest")
+
+		// THEN
+		require.EqualError(t, err, "docker manifest create uri:latest: some error")
+	})
+	t.Run("returns an error if the digest of the manifest list cannot be parsed", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().RunWithContext(ctx, "docker", gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(4)
+
+		// WHEN
+		cmd := DockerCmdClient{
+			runner:    m,
+			lookupEnv: emptyLookupEnv,
+		}
+		_, err := cmd.PushManifestList(ctx, "uri", new(strings.Builder), platforms, "latest")
+
+		// THEN
+		require.EqualError(t, err, "parse the digest of the manifest list from ''")
+	})
+}
+
 func TestDockerCommand_CheckDockerEngineRunning(t *testing.T) {
 	mockError := errors.New("some error")
 	var mockCmd *MockCmd
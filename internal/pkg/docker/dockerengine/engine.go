@@ -0,0 +1,181 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerengine
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvContainerEngine is the environment variable that selects the command line interface of the container engine.
+// It holds either the name of a supported engine, or the path to its binary.
+const EnvContainerEngine = "COPILOT_CONTAINER_ENGINE"
+
+// Engine is the command line interface of a container engine compatible with the docker CLI.
+type Engine string
+
+// Container engines supported by Copilot.
+const (
+	EngineDocker  Engine = "docker"
+	EngineFinch   Engine = "finch"
+	EnginePodman  Engine = "podman"
+	EngineNerdctl Engine = "nerdctl"
+)
+
+// Engines are the supported container engines, in the order they are detected.
+var Engines = []Engine{EngineDocker, EngineFinch, EnginePodman, EngineNerdctl}
+
+// detectEngine returns the engine selected with EnvContainerEngine if it's set.
+// Otherwise, it returns the first engine of Engines whose command is found, and defaults to docker.
+func detectEngine(lookupEnv func(string) (string, bool), lookPath func(string) (string, error)) Engine {
+	if engine, ok := lookupEnv(EnvContainerEngine); ok && strings.TrimSpace(engine) != "" {
+		return Engine(strings.TrimSpace(engine))
+	}
+	for _, engine := range Engines {
+		if _, err := lookPath(string(engine)); err == nil {
+			return engine
+		}
+	}
+	return EngineDocker
+}
+
+// kind returns the supported engine that the command refers to, for example "podman" for "/usr/local/bin/podman".
+// Unknown commands are treated as docker.
+func (e Engine) kind() Engine {
+	name := string(e)
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.TrimSuffix(name, ".exe")
+	for _, engine := range Engines {
+		if Engine(name) == engine {
+			return engine
+		}
+	}
+	return EngineDocker
+}
+
+// Name returns the command of the container engine used by the client.
+func (c DockerCmdClient) Name() string {
+	if c.engine == "" {
+		return string(EngineDocker)
+	}
+	return string(c.engine)
+}
+
+func (c DockerCmdClient) kind() Engine {
+	return Engine(c.Name()).kind()
+}
+
+// supportsBuildx returns true if the engine builds images with `buildx build`.
+// Other engines build with BuildKit or Buildah through `build`, and store the image locally.
+func (c DockerCmdClient) supportsBuildx() bool {
+	return c.kind() == EngineDocker
+}
+
+// supportsProgress returns true if the build command of the engine accepts the `--progress` flag.
+func (c DockerCmdClient) supportsProgress() bool {
+	return c.kind() != EnginePodman
+}
+
+// supportsManifestList returns an error if the engine cannot push manifest lists built from local images.
+func (c DockerCmdClient) supportsManifestList() error {
+	if c.kind() != EngineDocker {
+		return fmt.Errorf("building images for multiple platforms is not supported with %s", c.Name())
+	}
+	return nil
+}
+
+// checkEngineRunning runs `info` to check if the engine is reachable, for engines that exit with
+// an error if it is not.
+func (c DockerCmdClient) checkEngineRunning() error {
+	buf := &bytes.Buffer{}
+	if err := c.runner.Run(c.Name(), []string{"info"}, exec.Stdout(&bytes.Buffer{}), exec.Stderr(buf)); err != nil {
+		msg := strings.TrimSpace(buf.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return &ErrDockerDaemonNotResponsive{
+			engine: c.Name(),
+			msg:    msg,
+		}
+	}
+	return nil
+}
+
+// platformFormat returns the arguments of the command to print the platform of the engine as <os>/<arch>.
+func (c DockerCmdClient) platformFormat() []string {
+	switch c.kind() {
+	case EnginePodman:
+		return []string{"info", "--format", "{{.Host.OS}}/{{.Host.Arch}}"}
+	default:
+		// nerdctl and finch report the platform of the containerd host like `docker info`.
+		return []string{"info", "--format", "{{.OSType}}/{{.Architecture}}"}
+	}
+}
+
+// parsePlatform returns the OS and architecture from the output of platformFormat.
+func parsePlatform(out string) (os, arch string, err error) {
+	os, arch, ok := strings.Cut(strings.Trim(strings.TrimSpace(out), "'"), "/")
+	if !ok || os == "" || arch == "" {
+		return "", "", fmt.Errorf("parse platform from %q", out)
+	}
+	switch arch {
+	case "x86_64":
+		arch = ArchAMD64
+	case "aarch64":
+		arch = ArchARM64
+	}
+	return os, arch, nil
+}
+
+// credentialConfigPaths returns the paths of the files where the engine reads the credential helpers of registries.
+func (c DockerCmdClient) credentialConfigPaths() []string {
+	dockerPaths := []string{filepath.Join(c.homePath, ".docker", "config.json"), filepath.Join(c.homePath, ".dockercfg")}
+	switch c.kind() {
+	case EngineFinch:
+		return []string{filepath.Join(c.homePath, ".finch", "config.json")}
+	case EnginePodman:
+		var paths []string
+		if c.lookupEnv != nil {
+			if authFile, ok := c.lookupEnv("REGISTRY_AUTH_FILE"); ok && authFile != "" {
+				paths = append(paths, authFile)
+			}
+			if runtimeDir, ok := c.lookupEnv("XDG_RUNTIME_DIR"); ok && runtimeDir != "" {
+				paths = append(paths, filepath.Join(runtimeDir, "containers", "auth.json"))
+			}
+		}
+		paths = append(paths, filepath.Join(c.homePath, ".config", "containers", "auth.json"))
+		// Podman falls back to the docker configuration.
+		return append(paths, dockerPaths...)
+	default:
+		return dockerPaths
+	}
+}
+
+// isFinchCredentialHelperEnabled returns true if the finch VM is configured with the ECR credential helper.
+func (c DockerCmdClient) isFinchCredentialHelperEnabled() bool {
+	content, err := os.ReadFile(filepath.Join(c.homePath, ".finch", "finch.yaml"))
+	if err != nil {
+		return false
+	}
+	var config struct {
+		CredsHelpers []string `yaml:"creds_helpers"`
+	}
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return false
+	}
+	for _, helper := range config.CredsHelpers {
+		if helper == credStoreECRLogin {
+			return true
+		}
+	}
+	return false
+}
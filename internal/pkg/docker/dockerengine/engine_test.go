@@ -0,0 +1,265 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerengine
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectEngine(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv       map[string]string
+		inInstalled []string
+
+		wanted Engine
+	}{
+		"selects the engine from the environment variable": {
+			inEnv:       map[string]string{EnvContainerEngine: "podman"},
+			inInstalled: []string{"docker", "podman"},
+			wanted:      EnginePodman,
+		},
+		"selects a path to an engine from the environment variable": {
+			inEnv:  map[string]string{EnvContainerEngine: "/opt/homebrew/bin/finch"},
+			wanted: Engine("/opt/homebrew/bin/finch"),
+		},
+		"prefers docker if it's installed": {
+			inInstalled: []string{"podman", "docker"},
+			wanted:      EngineDocker,
+		},
+		"detects finch before podman": {
+			inInstalled: []string{"podman", "finch"},
+			wanted:      EngineFinch,
+		},
+		"detects nerdctl": {
+			inInstalled: []string{"nerdctl"},
+			wanted:      EngineNerdctl,
+		},
+		"defaults to docker": {
+			wanted: EngineDocker,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			lookupEnv := func(key string) (string, bool) {
+				val, ok := tc.inEnv[key]
+				return val, ok
+			}
+			lookPath := func(file string) (string, error) {
+				for _, installed := range tc.inInstalled {
+					if installed == file {
+						return "/usr/local/bin/" + file, nil
+					}
+				}
+				return "", errors.New("not found")
+			}
+
+			got := detectEngine(lookupEnv, lookPath)
+
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestEngine_kind(t *testing.T) {
+	require.Equal(t, EnginePodman, Engine("/usr/bin/podman").kind())
+	require.Equal(t, EngineFinch, Engine(`C:\Program Files\Finch\bin\finch.exe`).kind())
+	require.Equal(t, EngineDocker, Engine("/usr/bin/docker-custom").kind())
+}
+
+func TestDockerCmdClient_GenerateDockerBuildArgs_Engines(t *testing.T) {
+	ci := func(key string) (string, bool) {
+		if key == "CI" {
+			return "true", true
+		}
+		return "", false
+	}
+	in := &BuildArguments{
+		URI:        "uri",
+		Tags:       []string{"latest"},
+		Dockerfile: "Dockerfile",
+		Context:    ".",
+		CacheTo:    []string{"type=local,dest=/tmp/cache"},
+	}
+	testCases := map[string]struct {
+		engine Engine
+		wanted []string
+	}{
+		"docker builds with buildx to export the cache": {
+			engine: EngineDocker,
+			wanted: []string{"buildx", "build", "--load", "--builder", "copilot-cache", "-t", "uri:latest", "--cache-to", "type=local,dest=/tmp/cache", "--progress", "plain", ".", "-f", "Dockerfile"},
+		},
+		"podman builds without buildx and progress": {
+			engine: EnginePodman,
+			wanted: []string{"build", "-t", "uri:latest", "--cache-to", "type=local,dest=/tmp/cache", ".", "-f", "Dockerfile"},
+		},
+		"nerdctl builds without buildx": {
+			engine: EngineNerdctl,
+			wanted: []string{"build", "-t", "uri:latest", "--cache-to", "type=local,dest=/tmp/cache", "--progress", "plain", ".", "-f", "Dockerfile"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c := DockerCmdClient{
+				engine:    tc.engine,
+				lookupEnv: ci,
+			}
+
+			got, err := in.GenerateDockerBuildArgs(c)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestDockerCmdClient_Login_Engine(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := NewMockCmd(ctrl)
+	m.EXPECT().Run("finch", []string{"login", "-u", "AWS", "--password-stdin", "uri"}, gomock.Any()).Return(nil)
+	c := DockerCmdClient{
+		runner: m,
+		engine: EngineFinch,
+	}
+
+	err := c.Login("uri", "AWS", "password")
+
+	require.NoError(t, err)
+}
+
+func TestDockerCmdClient_PushManifestList_UnsupportedEngine(t *testing.T) {
+	c := DockerCmdClient{
+		engine: EnginePodman,
+	}
+
+	_, err := c.PushManifestList(context.Background(), "uri", new(strings.Builder), []string{"linux/amd64", "linux/arm64"}, "latest")
+
+	require.EqualError(t, err, "building images for multiple platforms is not supported with podman")
+}
+
+func TestDockerCmdClient_ImageDigest_UnsupportedEngine(t *testing.T) {
+	c := DockerCmdClient{
+		engine: EngineFinch,
+	}
+
+	_, err := c.ImageDigest(context.Background(), "public.ecr.aws/nginx/nginx:1.25")
+
+	require.EqualError(t, err, "resolve the digest of image public.ecr.aws/nginx/nginx:1.25: finch cannot inspect images in registries, reference the image by digest instead")
+}
+
+func TestParsePlatform(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedOS   string
+		wantedArch string
+		wantedErr  error
+	}{
+		"podman output": {
+			in:         "linux/arm64\n",
+			wantedOS:   "linux",
+			wantedArch: "arm64",
+		},
+		"nerdctl output with an uname architecture": {
+			in:         "linux/x86_64\n",
+			wantedOS:   "linux",
+			wantedArch: "amd64",
+		},
+		"aarch64 architecture": {
+			in:         "linux/aarch64",
+			wantedOS:   "linux",
+			wantedArch: "arm64",
+		},
+		"invalid output": {
+			in:        "linux",
+			wantedErr: errors.New(`parse platform from "linux"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			os, arch, err := parsePlatform(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOS, os)
+				require.Equal(t, tc.wantedArch, arch)
+			}
+		})
+	}
+}
+
+func TestDockerCmdClient_IsEcrCredentialHelperEnabled_Engines(t *testing.T) {
+	registry := "dummyaccountid.dkr.ecr.region.amazonaws.com"
+	uri := registry + "/ui/app"
+	testCases := map[string]struct {
+		engine Engine
+		files  map[string]string
+		env    map[string]string
+
+		wanted bool
+	}{
+		"podman reads the auth file of the user": {
+			engine: EnginePodman,
+			files: map[string]string{
+				".config/containers/auth.json": `{"credHelpers":{"` + registry + `":"ecr-login"}}`,
+			},
+			wanted: true,
+		},
+		"podman reads the auth file of the runtime directory": {
+			engine: EnginePodman,
+			files: map[string]string{
+				"run/containers/auth.json": `{"credsStore":"ecr-login"}`,
+			},
+			env:    map[string]string{"XDG_RUNTIME_DIR": "run"},
+			wanted: true,
+		},
+		"finch reads the credential helpers of the VM": {
+			engine: EngineFinch,
+			files: map[string]string{
+				".finch/finch.yaml": "cpus: 4\ncreds_helpers:\n  - ecr-login\n",
+			},
+			wanted: true,
+		},
+		"finch does not read the docker configuration": {
+			engine: EngineFinch,
+			files: map[string]string{
+				".docker/config.json": `{"credsStore":"ecr-login"}`,
+			},
+			wanted: false,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			home := t.TempDir()
+			for path, content := range tc.files {
+				full := filepath.Join(home, path)
+				require.NoError(t, os.MkdirAll(filepath.Dir(full), 0755))
+				require.NoError(t, os.WriteFile(full, []byte(content), 0644))
+			}
+			c := DockerCmdClient{
+				engine:   tc.engine,
+				homePath: home,
+				lookupEnv: func(key string) (string, bool) {
+					val, ok := tc.env[key]
+					if ok && !filepath.IsAbs(val) {
+						val = filepath.Join(home, val)
+					}
+					return val, ok
+				},
+			}
+
+			require.Equal(t, tc.wanted, c.IsEcrCredentialHelperEnabled(uri))
+		})
+	}
+}
@@ -13,9 +13,14 @@ var ErrDockerCommandNotFound = errors.New("docker: command not found")
 
 // ErrDockerDaemonNotResponsive means the docker daemon is not responsive.
 type ErrDockerDaemonNotResponsive struct {
-	msg string
+	engine string
+	msg    string
 }
 
 func (e ErrDockerDaemonNotResponsive) Error() string {
-	return fmt.Sprintf("docker daemon is not responsive: %s", e.msg)
+	engine := e.engine
+	if engine == "" {
+		engine = string(EngineDocker)
+	}
+	return fmt.Sprintf("%s daemon is not responsive: %s", engine, e.msg)
 }
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/docker/signature/signature.go
+
+// Package signature is a generated GoMock package.
+package signature
+
+import (
+	context "context"
+	reflect "reflect"
+
+	exec "github.com/aws/copilot-cli/internal/pkg/exec"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCmd is a mock of Cmd interface.
+type MockCmd struct {
+	ctrl     *gomock.Controller
+	recorder *MockCmdMockRecorder
+}
+
+// MockCmdMockRecorder is the mock recorder for MockCmd.
+type MockCmdMockRecorder struct {
+	mock *MockCmd
+}
+
+// NewMockCmd creates a new mock instance.
+func NewMockCmd(ctrl *gomock.Controller) *MockCmd {
+	mock := &MockCmd{ctrl: ctrl}
+	mock.recorder = &MockCmdMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCmd) EXPECT() *MockCmdMockRecorder {
+	return m.recorder
+}
+
+// RunWithContext mocks base method.
+func (m *MockCmd) RunWithContext(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, name, args}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunWithContext indicates an expected call of RunWithContext.
+func (mr *MockCmdMockRecorder) RunWithContext(ctx, name, args interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, name, args}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunWithContext", reflect.TypeOf((*MockCmd)(nil).RunWithContext), varargs...)
+}
@@ -0,0 +1,113 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package signature provides a client to sign container images and verify their signatures.
+package signature
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+)
+
+// Tool is the command line interface that signs and verifies images.
+type Tool string
+
+// Signing tools supported by Copilot.
+const (
+	// ToolNotation signs images with an AWS Signer signing profile through the AWS Signer plugin of Notation.
+	// Signatures are verified against the trust policy of Notation.
+	ToolNotation Tool = "notation"
+	// ToolCosign signs and verifies images with a key, such as an AWS KMS key referenced with "awskms:///".
+	ToolCosign Tool = "cosign"
+)
+
+// Tools are the supported signing tools.
+var Tools = []Tool{ToolNotation, ToolCosign}
+
+const notationSignerPlugin = "com.amazonaws.signer.notation.plugin"
+
+// Cmd is the interface implemented by external commands.
+type Cmd interface {
+	RunWithContext(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error
+}
+
+// Client signs images and verifies their signatures by running the command of a signing tool.
+type Client struct {
+	runner Cmd
+}
+
+// New returns a Client that runs signing tools with cmd.
+func New(cmd Cmd) *Client {
+	return &Client{
+		runner: cmd,
+	}
+}
+
+// Sign signs the image reference, which should be pinned to a digest, with the key.
+// The key is the ARN of an AWS Signer signing profile for notation, and a key reference for cosign.
+func (c *Client) Sign(ctx context.Context, tool Tool, key, ref string) error {
+	var args []string
+	switch tool {
+	case ToolNotation:
+		args = []string{"sign", "--plugin", notationSignerPlugin, "--id", key, ref}
+	case ToolCosign:
+		args = []string{"sign", "--key", key, "--yes", ref}
+	default:
+		return fmt.Errorf("unsupported signing tool %q", tool)
+	}
+	if err := c.run(ctx, tool, args); err != nil {
+		return fmt.Errorf("sign image %s: %w", ref, err)
+	}
+	return nil
+}
+
+// Verify returns an error if the image reference does not have a valid signature.
+// The key is required by cosign, and ignored by notation which verifies against its trust policy.
+func (c *Client) Verify(ctx context.Context, tool Tool, key, ref string) error {
+	var args []string
+	switch tool {
+	case ToolNotation:
+		args = []string{"verify", ref}
+	case ToolCosign:
+		args = []string{"verify", "--key", key, ref}
+	default:
+		return fmt.Errorf("unsupported signing tool %q", tool)
+	}
+	if err := c.run(ctx, tool, args); err != nil {
+		return &ErrVerification{
+			Ref: ref,
+			err: err,
+		}
+	}
+	return nil
+}
+
+func (c *Client) run(ctx context.Context, tool Tool, args []string) error {
+	stderr := &bytes.Buffer{}
+	if err := c.runner.RunWithContext(ctx, string(tool), args, exec.Stdout(&bytes.Buffer{}), exec.Stderr(stderr)); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%s %s: %s", tool, args[0], msg)
+		}
+		return fmt.Errorf("%s %s: %w", tool, args[0], err)
+	}
+	return nil
+}
+
+// ErrVerification is returned when the signature of an image cannot be verified.
+type ErrVerification struct {
+	Ref string
+	err error
+}
+
+func (e *ErrVerification) Error() string {
+	return fmt.Sprintf("verify signature of image %s: %v", e.Ref, e.err)
+}
+
+// Unwrap returns the error of the signing tool.
+func (e *ErrVerification) Unwrap() error {
+	return e.err
+}
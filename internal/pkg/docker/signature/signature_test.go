@@ -0,0 +1,118 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package signature
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const mockRef = "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc@sha256:abc"
+
+func TestClient_Sign(t *testing.T) {
+	testCases := map[string]struct {
+		tool Tool
+		key  string
+		mock func(m *MockCmd)
+
+		wantedErr error
+	}{
+		"signs with the AWS Signer plugin of notation": {
+			tool: ToolNotation,
+			key:  "arn:aws:signer:us-west-2:123456789012:/signing-profiles/copilot",
+			mock: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "notation", []string{"sign", "--plugin", "com.amazonaws.signer.notation.plugin", "--id", "arn:aws:signer:us-west-2:123456789012:/signing-profiles/copilot", mockRef}, gomock.Any()).Return(nil)
+			},
+		},
+		"signs with a cosign key": {
+			tool: ToolCosign,
+			key:  "awskms:///alias/copilot",
+			mock: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "cosign", []string{"sign", "--key", "awskms:///alias/copilot", "--yes", mockRef}, gomock.Any()).Return(nil)
+			},
+		},
+		"wraps errors of the tool": {
+			tool: ToolCosign,
+			key:  "awskms:///alias/copilot",
+			mock: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "cosign", gomock.Any(), gomock.Any()).Return(errors.New("exit status 1"))
+			},
+			wantedErr: errors.New("sign image " + mockRef + ": cosign sign: exit status 1"),
+		},
+		"errors on unsupported tools": {
+			tool:      Tool("gpg"),
+			mock:      func(m *MockCmd) {},
+			wantedErr: errors.New(`unsupported signing tool "gpg"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := NewMockCmd(ctrl)
+			tc.mock(m)
+
+			err := New(m).Sign(context.Background(), tc.tool, tc.key, mockRef)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestClient_Verify(t *testing.T) {
+	testCases := map[string]struct {
+		tool Tool
+		key  string
+		mock func(m *MockCmd)
+
+		wantedErr error
+	}{
+		"verifies against the trust policy of notation": {
+			tool: ToolNotation,
+			mock: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "notation", []string{"verify", mockRef}, gomock.Any()).Return(nil)
+			},
+		},
+		"verifies with a cosign key": {
+			tool: ToolCosign,
+			key:  "awskms:///alias/copilot",
+			mock: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "cosign", []string{"verify", "--key", "awskms:///alias/copilot", mockRef}, gomock.Any()).Return(nil)
+			},
+		},
+		"returns a verification error if the image is not signed": {
+			tool: ToolNotation,
+			mock: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "notation", gomock.Any(), gomock.Any()).Return(errors.New("exit status 1"))
+			},
+			wantedErr: errors.New("verify signature of image " + mockRef + ": notation verify: exit status 1"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := NewMockCmd(ctrl)
+			tc.mock(m)
+
+			err := New(m).Verify(context.Background(), tc.tool, tc.key, mockRef)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				var verifyErr *ErrVerification
+				require.ErrorAs(t, err, &verifyErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
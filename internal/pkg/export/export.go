@@ -0,0 +1,362 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package export writes the CloudFormation stacks of an application as a standalone project
+// that can be deployed without Copilot.
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+// Formats of an exported project.
+const (
+	FormatCloudFormation = "cloudformation"
+	FormatCDK            = "cdk"
+)
+
+// Formats are the formats that an application can be exported to.
+var Formats = []string{FormatCloudFormation, FormatCDK}
+
+// Directories of an exported project.
+const (
+	templatesDir       = "templates"
+	paramsDir          = "params"
+	addonsDir          = "addons"
+	customResourcesDir = "custom-resources"
+	stacksFileName     = "stacks.json"
+)
+
+const (
+	lambdaFunctionType = "AWS::Lambda::Function"
+	nestedStackType    = "AWS::CloudFormation::Stack"
+	addonsURLParamKey  = "AddonsTemplateURL"
+)
+
+// Stack is a rendered CloudFormation stack of an application.
+type Stack struct {
+	Name       string // Name of the CloudFormation stack.
+	AccountID  string
+	Region     string
+	Template   string
+	Parameters string // Optional. Template configuration with the "Parameters" and "Tags" of the stack.
+	Addons     string // Optional. Template of the addons nested stack.
+}
+
+// TemplateConfiguration returns the template configuration of a stack with the parameters and tags,
+// in the format of the Parameters field of a Stack.
+func TemplateConfiguration(params []*cloudformation.Parameter, tags []*cloudformation.Tag) (string, error) {
+	config := struct {
+		Parameters map[string]*string `json:"Parameters"`
+		Tags       map[string]*string `json:"Tags,omitempty"`
+	}{
+		Parameters: make(map[string]*string, len(params)),
+		Tags:       make(map[string]*string, len(tags)),
+	}
+	for _, param := range params {
+		config.Parameters[aws.StringValue(param.ParameterKey)] = param.ParameterValue
+	}
+	for _, tag := range tags {
+		config.Tags[aws.StringValue(tag.Key)] = tag.Value
+	}
+	out, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal template configuration: %w", err)
+	}
+	return string(out), nil
+}
+
+// Project is a standalone project holding the stacks of an application.
+type Project struct {
+	fs      afero.Fs
+	appName string
+	dir     string
+	format  string
+	tpl     *template.Template
+
+	bundles map[string][]byte // Zipped custom resources keyed by their S3 object key.
+	used    map[string]bool   // S3 object keys of the custom resources referenced by the templates.
+	stacks  []stackEntry
+}
+
+// stackEntry is an entry of the stacks.json file, with paths relative to the project root.
+type stackEntry struct {
+	Name       string          `json:"name"`
+	AccountID  string          `json:"account"`
+	Region     string          `json:"region"`
+	Template   string          `json:"template"`
+	Parameters string          `json:"parameters,omitempty"`
+	Addons     string          `json:"addons,omitempty"`
+	Functions  []functionEntry `json:"functions,omitempty"`
+}
+
+// functionEntry associates a Lambda function in a template with its bundle in the project.
+type functionEntry struct {
+	LogicalID string `json:"logicalId"`
+	Bundle    string `json:"bundle"`
+}
+
+// New returns a project that writes the files of the application under dir in the format.
+// It returns an error if dir is not empty.
+func New(fs afero.Fs, appName, dir, format string) (*Project, error) {
+	exists, _ := afero.Exists(fs, dir)
+	isEmpty, _ := afero.IsEmpty(fs, dir)
+	if exists && !isEmpty {
+		return nil, fmt.Errorf("directory %q is not empty", dir)
+	}
+	bundles, err := customResourceBundles(template.New())
+	if err != nil {
+		return nil, err
+	}
+	return newProject(fs, appName, dir, format, bundles), nil
+}
+
+func newProject(fs afero.Fs, appName, dir, format string, bundles map[string][]byte) *Project {
+	return &Project{
+		fs:      fs,
+		appName: appName,
+		dir:     dir,
+		format:  format,
+		tpl:     template.New(),
+		bundles: bundles,
+		used:    make(map[string]bool),
+	}
+}
+
+// Add writes the files of a stack to the project.
+// Lambda functions of Copilot custom resources and the addons nested stack are pointed to files in the project,
+// so that the stack does not depend on the artifacts that Copilot uploads to the application's S3 buckets.
+func (p *Project) Add(stack *Stack) error {
+	entry := stackEntry{
+		Name:      stack.Name,
+		AccountID: stack.AccountID,
+		Region:    stack.Region,
+		Template:  path.Join(templatesDir, stack.Name+".yml"),
+	}
+	if stack.Addons != "" {
+		entry.Addons = path.Join(addonsDir, stack.Name+".addons.yml")
+		if err := p.write(entry.Addons, []byte(stack.Addons)); err != nil {
+			return err
+		}
+	}
+	tpl, fns, err := p.localize(stack.Template, entry.Addons)
+	if err != nil {
+		return fmt.Errorf("point stack %s to local files: %w", stack.Name, err)
+	}
+	entry.Functions = fns
+	if err := p.write(entry.Template, tpl); err != nil {
+		return err
+	}
+	if stack.Parameters != "" {
+		params, err := localizeParameters(stack.Parameters, entry.Addons)
+		if err != nil {
+			return fmt.Errorf("point parameters of stack %s to local files: %w", stack.Name, err)
+		}
+		entry.Parameters = path.Join(paramsDir, stack.Name+".params.json")
+		if err := p.write(entry.Parameters, params); err != nil {
+			return err
+		}
+	}
+	p.stacks = append(p.stacks, entry)
+	return nil
+}
+
+// Write writes the custom resource bundles referenced by the stacks, the list of stacks,
+// and the files of the format of the project.
+func (p *Project) Write() error {
+	keys := make([]string, 0, len(p.used))
+	for key := range p.used {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := p.write(bundlePath(key), p.bundles[key]); err != nil {
+			return err
+		}
+	}
+	data, err := json.MarshalIndent(p.stacks, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal stacks: %w", err)
+	}
+	if err := p.write(stacksFileName, data); err != nil {
+		return err
+	}
+	opts := template.ExportProjectOpts{
+		AppName: p.appName,
+	}
+	for _, stack := range p.stacks {
+		opts.Stacks = append(opts.Stacks, template.ExportedStack{
+			Name:       stack.Name,
+			Region:     stack.Region,
+			Template:   stack.Template,
+			Parameters: stack.Parameters,
+		})
+	}
+	return p.tpl.WalkExportDir(p.format, opts, func(name string, content *template.Content) error {
+		return p.write(name, content.Bytes())
+	})
+}
+
+// localize rewrites the template so that the Lambda functions of custom resources and the addons nested stack
+// refer to the files of the project.
+// CloudFormation projects refer to the files with local paths, that "aws cloudformation package" uploads.
+// CDK projects keep the template as is, and return the functions whose code is replaced with CDK assets.
+func (p *Project) localize(tpl, addonsPath string) ([]byte, []functionEntry, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(tpl), &doc); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal template: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return []byte(tpl), nil, nil
+	}
+	resources := mappingValue(doc.Content[0], "Resources")
+	if resources == nil {
+		return []byte(tpl), nil, nil
+	}
+	var fns []functionEntry
+	for i := 0; i+1 < len(resources.Content); i += 2 {
+		logicalID, resource := resources.Content[i].Value, resources.Content[i+1]
+		typ := mappingValue(resource, "Type")
+		props := mappingValue(resource, "Properties")
+		if typ == nil || props == nil {
+			continue
+		}
+		switch {
+		case typ.Value == lambdaFunctionType:
+			code := mappingValue(props, "Code")
+			key := mappingValue(code, "S3Key")
+			if key == nil {
+				continue
+			}
+			if _, ok := p.bundles[key.Value]; !ok {
+				continue
+			}
+			p.used[key.Value] = true
+			if p.format == FormatCDK {
+				fns = append(fns, functionEntry{
+					LogicalID: logicalID,
+					Bundle:    bundlePath(key.Value),
+				})
+				continue
+			}
+			*code = *localPath(bundlePath(key.Value))
+		case typ.Value == nestedStackType && logicalID == template.AddonsStackLogicalID && addonsPath != "":
+			if p.format == FormatCDK {
+				continue // The CfnInclude construct loads the addons nested stack from the project.
+			}
+			if url := mappingValue(props, "TemplateURL"); url != nil {
+				*url = *localPath(addonsPath)
+			}
+		}
+	}
+	if p.format == FormatCDK {
+		return []byte(tpl), fns, nil
+	}
+	buf := new(bytes.Buffer)
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, nil, fmt.Errorf("marshal template: %w", err)
+	}
+	return buf.Bytes(), nil, nil
+}
+
+// localizeParameters points the addons template URL parameter of a workload to the addons template of the project,
+// so that the addons nested stack is still created.
+func localizeParameters(params, addonsPath string) ([]byte, error) {
+	var config struct {
+		Parameters map[string]*string `json:"Parameters"`
+		Tags       map[string]*string `json:"Tags,omitempty"`
+	}
+	if err := json.Unmarshal([]byte(params), &config); err != nil {
+		return nil, fmt.Errorf("unmarshal parameters: %w", err)
+	}
+	if _, ok := config.Parameters[addonsURLParamKey]; ok && addonsPath != "" {
+		config.Parameters[addonsURLParamKey] = &addonsPath
+	}
+	return json.MarshalIndent(config, "", "  ")
+}
+
+func (p *Project) write(name string, data []byte) error {
+	target := filepath.Join(p.dir, filepath.FromSlash(name))
+	if err := p.fs.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return fmt.Errorf("make directories along %q: %w", filepath.Dir(target), err)
+	}
+	if err := afero.WriteFile(p.fs, target, data, 0644); err != nil {
+		return fmt.Errorf("write file at %q: %w", target, err)
+	}
+	return nil
+}
+
+// customResourceBundles returns the zipped custom resources of every stack type, keyed by their S3 object key.
+func customResourceBundles(fs template.Reader) (map[string][]byte, error) {
+	var crs []*customresource.CustomResource
+	for _, build := range []func(template.Reader) ([]*customresource.CustomResource, error){
+		customresource.Env,
+		customresource.LBWS,
+		customresource.Backend,
+		customresource.Worker,
+		customresource.RDWS,
+		customresource.StaticSite,
+		customresource.ScheduledJob,
+	} {
+		out, err := build(fs)
+		if err != nil {
+			return nil, err
+		}
+		crs = append(crs, out...)
+	}
+	bundles := make(map[string][]byte)
+	if _, err := customresource.Upload(func(key string, contents io.Reader) (string, error) {
+		data, err := io.ReadAll(contents)
+		if err != nil {
+			return "", err
+		}
+		bundles[key] = data
+		return key, nil
+	}, crs); err != nil {
+		return nil, err
+	}
+	return bundles, nil
+}
+
+// bundlePath returns the path of a custom resource bundle in the project from its S3 object key.
+// For example, "manual/scripts/custom-resources/envcontrollerfunction/<sha>.zip" is written to
+// "custom-resources/envcontrollerfunction-<sha>.zip".
+func bundlePath(key string) string {
+	return path.Join(customResourcesDir, fmt.Sprintf("%s-%s", path.Base(path.Dir(key)), path.Base(key)))
+}
+
+// localPath returns a scalar node holding the path of a project file relative to the templates directory.
+func localPath(name string) *yaml.Node {
+	return &yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Tag:   "!!str",
+		Value: path.Join("..", name),
+	}
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
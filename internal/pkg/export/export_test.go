@@ -0,0 +1,173 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+const testTemplate = `Parameters:
+  AddonsTemplateURL:
+    Type: String
+    Default: ""
+Resources:
+  EnvControllerFunction:
+    Type: AWS::Lambda::Function
+    Properties:
+      Code:
+        S3Bucket: stackset-phonetool-bucket
+        S3Key: %s
+      Handler: index.handler
+  AddonsStack:
+    Type: AWS::CloudFormation::Stack
+    Properties:
+      TemplateURL: !Ref AddonsTemplateURL
+`
+
+func TestTemplateConfiguration(t *testing.T) {
+	out, err := TemplateConfiguration([]*cloudformation.Parameter{
+		{ParameterKey: aws.String("AppDomainName"), ParameterValue: aws.String("example.com")},
+	}, []*cloudformation.Tag{
+		{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+	})
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+  "Parameters": {"AppDomainName": "example.com"},
+  "Tags": {"copilot-application": "phonetool"}
+}`, out)
+}
+
+func TestNew(t *testing.T) {
+	t.Run("returns an error if the directory is not empty", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fs, "out/file", []byte("hello"), 0644))
+
+		_, err := New(fs, "phonetool", "out", FormatCloudFormation)
+
+		require.EqualError(t, err, `directory "out" is not empty`)
+	})
+}
+
+func TestProject_Add(t *testing.T) {
+	testCases := map[string]struct {
+		format string
+
+		wantedTemplate func(bundle string) string
+		wantedStack    func(bundle string) stackEntry
+	}{
+		"points the template to local files for CloudFormation": {
+			format: FormatCloudFormation,
+			wantedTemplate: func(bundle string) string {
+				return fmt.Sprintf(`Parameters:
+  AddonsTemplateURL:
+    Type: String
+    Default: ""
+Resources:
+  EnvControllerFunction:
+    Type: AWS::Lambda::Function
+    Properties:
+      Code: ../%s
+      Handler: index.handler
+  AddonsStack:
+    Type: AWS::CloudFormation::Stack
+    Properties:
+      TemplateURL: ../addons/phonetool-test-api.addons.yml
+`, bundle)
+			},
+			wantedStack: func(bundle string) stackEntry {
+				return stackEntry{
+					Name:       "phonetool-test-api",
+					AccountID:  "123456789012",
+					Region:     "us-west-2",
+					Template:   "templates/phonetool-test-api.yml",
+					Parameters: "params/phonetool-test-api.params.json",
+					Addons:     "addons/phonetool-test-api.addons.yml",
+				}
+			},
+		},
+		"lists the functions to replace for CDK": {
+			format: FormatCDK,
+			wantedStack: func(bundle string) stackEntry {
+				return stackEntry{
+					Name:       "phonetool-test-api",
+					AccountID:  "123456789012",
+					Region:     "us-west-2",
+					Template:   "templates/phonetool-test-api.yml",
+					Parameters: "params/phonetool-test-api.params.json",
+					Addons:     "addons/phonetool-test-api.addons.yml",
+					Functions: []functionEntry{
+						{
+							LogicalID: "EnvControllerFunction",
+							Bundle:    bundle,
+						},
+					},
+				}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			key := "manual/scripts/custom-resources/envcontrollerfunction/abc.zip"
+			project := newProject(fs, "phonetool", "out", tc.format, map[string][]byte{
+				key: []byte("zip"),
+				"manual/scripts/custom-resources/customdomainfunction/def.zip": []byte("unused"),
+			})
+			tpl := fmt.Sprintf(testTemplate, key)
+
+			// WHEN
+			err := project.Add(&Stack{
+				Name:       "phonetool-test-api",
+				AccountID:  "123456789012",
+				Region:     "us-west-2",
+				Template:   tpl,
+				Parameters: `{"Parameters": {"AddonsTemplateURL": "", "EnvName": "test"}, "Tags": {"copilot-application": "phonetool"}}`,
+				Addons:     "Resources: {}",
+			})
+			require.NoError(t, err)
+			err = project.Write()
+
+			// THEN
+			require.NoError(t, err)
+			bundle := bundlePath(key)
+			gotTpl, err := afero.ReadFile(fs, "out/templates/phonetool-test-api.yml")
+			require.NoError(t, err)
+			if tc.wantedTemplate != nil {
+				require.Equal(t, tc.wantedTemplate(bundle), string(gotTpl))
+			} else {
+				require.Equal(t, tpl, string(gotTpl))
+			}
+			gotBundle, err := afero.ReadFile(fs, "out/"+bundle)
+			require.NoError(t, err)
+			require.Equal(t, "zip", string(gotBundle))
+			exists, err := afero.Exists(fs, "out/custom-resources/customdomainfunction-def.zip")
+			require.NoError(t, err)
+			require.False(t, exists, "bundles that are not referenced should not be written")
+
+			gotParams, err := afero.ReadFile(fs, "out/params/phonetool-test-api.params.json")
+			require.NoError(t, err)
+			require.JSONEq(t, `{"Parameters": {"AddonsTemplateURL": "addons/phonetool-test-api.addons.yml", "EnvName": "test"}, "Tags": {"copilot-application": "phonetool"}}`, string(gotParams))
+
+			gotStacks, err := afero.ReadFile(fs, "out/stacks.json")
+			require.NoError(t, err)
+			var stacks []stackEntry
+			require.NoError(t, json.Unmarshal(gotStacks, &stacks))
+			require.Equal(t, []stackEntry{tc.wantedStack(bundle)}, stacks)
+
+			readme, err := afero.ReadFile(fs, "out/README.md")
+			require.NoError(t, err)
+			require.Contains(t, string(readme), "phonetool-test-api")
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/logging/pipeline.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockpipelineExecutionDescriber is a mock of pipelineExecutionDescriber interface.
+type MockpipelineExecutionDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineExecutionDescriberMockRecorder
+}
+
+// MockpipelineExecutionDescriberMockRecorder is the mock recorder for MockpipelineExecutionDescriber.
+type MockpipelineExecutionDescriberMockRecorder struct {
+	mock *MockpipelineExecutionDescriber
+}
+
+// NewMockpipelineExecutionDescriber creates a new mock instance.
+func NewMockpipelineExecutionDescriber(ctrl *gomock.Controller) *MockpipelineExecutionDescriber {
+	mock := &MockpipelineExecutionDescriber{ctrl: ctrl}
+	mock.recorder = &MockpipelineExecutionDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineExecutionDescriber) EXPECT() *MockpipelineExecutionDescriberMockRecorder {
+	return m.recorder
+}
+
+// BuildActions mocks base method.
+func (m *MockpipelineExecutionDescriber) BuildActions(pipelineName, executionID string) ([]codepipeline.BuildAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildActions", pipelineName, executionID)
+	ret0, _ := ret[0].([]codepipeline.BuildAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildActions indicates an expected call of BuildActions.
+func (mr *MockpipelineExecutionDescriberMockRecorder) BuildActions(pipelineName, executionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildActions", reflect.TypeOf((*MockpipelineExecutionDescriber)(nil).BuildActions), pipelineName, executionID)
+}
+
+// Execution mocks base method.
+func (m *MockpipelineExecutionDescriber) Execution(pipelineName, executionID string) (*codepipeline.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execution", pipelineName, executionID)
+	ret0, _ := ret[0].(*codepipeline.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execution indicates an expected call of Execution.
+func (mr *MockpipelineExecutionDescriberMockRecorder) Execution(pipelineName, executionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execution", reflect.TypeOf((*MockpipelineExecutionDescriber)(nil).Execution), pipelineName, executionID)
+}
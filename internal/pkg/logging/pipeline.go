@@ -0,0 +1,105 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const fmtCodeBuildLogGroupName = "/aws/codebuild/%s"
+
+type pipelineExecutionDescriber interface {
+	Execution(pipelineName, executionID string) (*codepipeline.Execution, error)
+	BuildActions(pipelineName, executionID string) ([]codepipeline.BuildAction, error)
+}
+
+// PipelineLogger retrieves the logs of the CodeBuild actions of a pipeline execution.
+type PipelineLogger struct {
+	pipeline string
+
+	executions   pipelineExecutionDescriber
+	eventsGetter logGetter
+	w            io.Writer
+	sleep        func()
+}
+
+// NewPipelineLogger returns a PipelineLogger for the pipeline with the given resource name.
+func NewPipelineLogger(pipeline string, sess *session.Session) *PipelineLogger {
+	return &PipelineLogger{
+		pipeline:     pipeline,
+		executions:   codepipeline.New(sess),
+		eventsGetter: cloudwatchlogs.New(sess),
+		w:            log.OutputWriter,
+		sleep: func() {
+			time.Sleep(cloudwatchlogs.SleepDuration)
+		},
+	}
+}
+
+// WritePipelineLogEventsOpts wraps the parameters to call WriteLogEvents.
+type WritePipelineLogEventsOpts struct {
+	ExecutionID string
+	Follow      bool
+	Limit       *int64
+	// OnEvents is a handler that's invoked when logs are retrieved from a build.
+	OnEvents func(w io.Writer, logs []HumanJSONStringer) error
+}
+
+// WriteLogEvents writes the logs of the build, test commands, pre-deployments and post-deployments actions of the execution.
+// If follow is true, keeps writing the logs of the actions as they start until the execution completes.
+func (l *PipelineLogger) WriteLogEvents(opts WritePipelineLogEventsOpts) error {
+	lastEventTimes := make(map[string]map[string]int64)
+	for {
+		execution, err := l.executions.Execution(l.pipeline, opts.ExecutionID)
+		if err != nil {
+			return err
+		}
+		actions, err := l.executions.BuildActions(l.pipeline, opts.ExecutionID)
+		if err != nil {
+			return err
+		}
+		for _, action := range actions {
+			project, stream, ok := strings.Cut(action.BuildID, ":")
+			if !ok {
+				return fmt.Errorf("parse build ID %s of action %s", action.BuildID, action.ActionName)
+			}
+			lastEventTime, started := lastEventTimes[action.BuildID]
+			if !started {
+				log.Infof("%s %s\n", color.Emphasize(fmt.Sprintf("%s/%s", action.StageName, action.ActionName)), color.Faint.Sprint(action.Status))
+			}
+			out, err := l.eventsGetter.LogEvents(cloudwatchlogs.LogEventsOpts{
+				LogGroup:               fmt.Sprintf(fmtCodeBuildLogGroupName, project),
+				LogStreamPrefixFilters: []string{stream},
+				LogStreamLimit:         1,
+				Limit:                  opts.Limit,
+				StreamLastEventTime:    lastEventTime,
+			})
+			if err != nil {
+				if opts.Follow && action.Status == "InProgress" {
+					// The log stream of a build that just started might not exist yet.
+					lastEventTimes[action.BuildID] = lastEventTime
+					continue
+				}
+				return fmt.Errorf("get logs of action %s: %w", action.ActionName, err)
+			}
+			if err := opts.OnEvents(l.w, cwEventsToHumanJSONStringers(out.Events)); err != nil {
+				return err
+			}
+			lastEventTimes[action.BuildID] = out.StreamLastEventTime
+		}
+		if !opts.Follow || !execution.IsInProgress() {
+			return nil
+		}
+		l.sleep()
+	}
+}
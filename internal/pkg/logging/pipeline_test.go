@@ -0,0 +1,123 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/logging/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineLogger_WriteLogEvents(t *testing.T) {
+	const (
+		mockPipeline    = "pipeline-dinder-badgoose-repo"
+		mockExecutionID = "exec-1"
+	)
+	buildAction := codepipeline.BuildAction{StageName: "Build", ActionName: "Build", Status: "Succeeded", BuildID: "dinder-build:1111"}
+	testCases := map[string]struct {
+		follow     bool
+		setupMocks func(executions *mocks.MockpipelineExecutionDescriber, logs *mocks.MocklogGetter)
+
+		wantedError   error
+		wantedContent string
+	}{
+		"write the logs of the builds of the execution": {
+			setupMocks: func(executions *mocks.MockpipelineExecutionDescriber, logs *mocks.MocklogGetter) {
+				executions.EXPECT().Execution(mockPipeline, mockExecutionID).Return(&codepipeline.Execution{Status: "Succeeded"}, nil)
+				executions.EXPECT().BuildActions(mockPipeline, mockExecutionID).Return([]codepipeline.BuildAction{
+					buildAction,
+					{StageName: "DeployTo-test", ActionName: "PostDeployment-test", Status: "Failed", BuildID: "dinder-post:2222"},
+				}, nil)
+				logs.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+					LogGroup:               "/aws/codebuild/dinder-build",
+					LogStreamPrefixFilters: []string{"1111"},
+					LogStreamLimit:         1,
+				}).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{{LogStreamName: "1111", Message: "docker build"}},
+				}, nil)
+				logs.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+					LogGroup:               "/aws/codebuild/dinder-post",
+					LogStreamPrefixFilters: []string{"2222"},
+					LogStreamLimit:         1,
+				}).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{{LogStreamName: "2222", Message: "make test"}},
+				}, nil)
+			},
+			wantedContent: "1111 docker build\n2222 make test\n",
+		},
+		"follow the logs until the execution completes": {
+			follow: true,
+			setupMocks: func(executions *mocks.MockpipelineExecutionDescriber, logs *mocks.MocklogGetter) {
+				inProgress := buildAction
+				inProgress.Status = "InProgress"
+				gomock.InOrder(
+					executions.EXPECT().Execution(mockPipeline, mockExecutionID).Return(&codepipeline.Execution{Status: "InProgress"}, nil),
+					executions.EXPECT().BuildActions(mockPipeline, mockExecutionID).Return([]codepipeline.BuildAction{inProgress}, nil),
+					logs.EXPECT().LogEvents(gomock.Any()).Return(nil, errors.New("no log stream found in log group /aws/codebuild/dinder-build")),
+					executions.EXPECT().Execution(mockPipeline, mockExecutionID).Return(&codepipeline.Execution{Status: "InProgress"}, nil),
+					executions.EXPECT().BuildActions(mockPipeline, mockExecutionID).Return([]codepipeline.BuildAction{inProgress}, nil),
+					logs.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+						Events:              []*cloudwatchlogs.Event{{LogStreamName: "1111", Message: "docker build"}},
+						StreamLastEventTime: map[string]int64{"1111": 100},
+					}, nil),
+					executions.EXPECT().Execution(mockPipeline, mockExecutionID).Return(&codepipeline.Execution{Status: "Succeeded"}, nil),
+					executions.EXPECT().BuildActions(mockPipeline, mockExecutionID).Return([]codepipeline.BuildAction{buildAction}, nil),
+					logs.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+						LogGroup:               "/aws/codebuild/dinder-build",
+						LogStreamPrefixFilters: []string{"1111"},
+						LogStreamLimit:         1,
+						StreamLastEventTime:    map[string]int64{"1111": 100},
+					}).Return(&cloudwatchlogs.LogEventsOutput{
+						Events: []*cloudwatchlogs.Event{{LogStreamName: "1111", Message: "pushed image"}},
+					}, nil),
+				)
+			},
+			wantedContent: "1111 docker build\n1111 pushed image\n",
+		},
+		"returns wrapped error if the logs of a completed build cannot be retrieved": {
+			setupMocks: func(executions *mocks.MockpipelineExecutionDescriber, logs *mocks.MocklogGetter) {
+				executions.EXPECT().Execution(mockPipeline, mockExecutionID).Return(&codepipeline.Execution{Status: "Succeeded"}, nil)
+				executions.EXPECT().BuildActions(mockPipeline, mockExecutionID).Return([]codepipeline.BuildAction{buildAction}, nil)
+				logs.EXPECT().LogEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get logs of action Build: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			executions := mocks.NewMockpipelineExecutionDescriber(ctrl)
+			logs := mocks.NewMocklogGetter(ctrl)
+			tc.setupMocks(executions, logs)
+			b := &bytes.Buffer{}
+			logger := &PipelineLogger{
+				pipeline:     mockPipeline,
+				executions:   executions,
+				eventsGetter: logs,
+				w:            b,
+				sleep:        func() {},
+			}
+
+			err := logger.WriteLogEvents(WritePipelineLogEventsOpts{
+				ExecutionID: mockExecutionID,
+				Follow:      tc.follow,
+				OnEvents:    WriteHumanLogs,
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -95,6 +95,19 @@ type EnvironmentConfig struct {
 	Observability environmentObservability `yaml:"observability,omitempty,flow"`
 	HTTPConfig    EnvironmentHTTPConfig    `yaml:"http,omitempty,flow"`
 	CDNConfig     EnvironmentCDNConfig     `yaml:"cdn,omitempty,flow"`
+	// ImageVerification requires the images of workloads deployed to the environment to be signed.
+	ImageVerification EnvironmentImageVerification `yaml:"image_verification,omitempty,flow"`
+}
+
+// EnvironmentImageVerification configures how the signatures of images are verified before workloads are deployed.
+type EnvironmentImageVerification struct {
+	Tool *string `yaml:"tool,omitempty"` // "notation" or "cosign".
+	Key  *string `yaml:"key,omitempty"`  // Key reference to verify signatures with cosign.
+}
+
+// IsEmpty returns true if images are not verified.
+func (v EnvironmentImageVerification) IsEmpty() bool {
+	return v.Tool == nil && v.Key == nil
 }
 
 // IsPublicLBIngressRestrictedToCDN returns whether an environment has its
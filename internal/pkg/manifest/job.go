@@ -150,6 +150,26 @@ func (j *ScheduledJob) BuildArgs(contextDir string) (map[string]*DockerBuildArgs
 	return buildArgs(contextDir, buildArgsPerContainer, j.Sidecars)
 }
 
+// ImageRepository returns the configuration of the repository that stores the images built for the job.
+func (j *ScheduledJob) ImageRepository() ImageRepository {
+	return j.ImageConfig.Image.Repository
+}
+
+// ImageSigning returns the configuration to sign the images built for the job.
+func (j *ScheduledJob) ImageSigning() ImageSigning {
+	return j.ImageConfig.Image.Signing
+}
+
+// ImageLocation returns the location of the image of the main container, if the job does not build it.
+func (j *ScheduledJob) ImageLocation() string {
+	return j.ImageConfig.Image.GetLocation()
+}
+
+// SidecarImageLocations returns the image location of each sidecar that the job does not build, keyed by sidecar name.
+func (j *ScheduledJob) SidecarImageLocations() map[string]string {
+	return sidecarImageLocations(j.Sidecars)
+}
+
 // EnvFiles returns the locations of all env files against the ws root directory.
 // This method returns a map[string]string where the keys are container names
 // and the values are either env file paths or empty strings.
@@ -206,6 +206,31 @@ func (s *LoadBalancedWebService) BuildArgs(contextDir string) (map[string]*Docke
 	return buildArgs(contextDir, buildArgsPerContainer, s.Sidecars)
 }
 
+// ImageRepository returns the configuration of the repository that stores the images built for the service.
+func (s *LoadBalancedWebService) ImageRepository() ImageRepository {
+	return s.ImageConfig.Image.Repository
+}
+
+// ImageSigning returns the configuration to sign the images built for the service.
+func (s *LoadBalancedWebService) ImageSigning() ImageSigning {
+	return s.ImageConfig.Image.Signing
+}
+
+// ImageLocation returns the location of the image of the main container, if the service does not build it.
+func (s *LoadBalancedWebService) ImageLocation() string {
+	return s.ImageConfig.Image.GetLocation()
+}
+
+// SidecarImageLocations returns the image location of each sidecar that the service does not build, keyed by sidecar name.
+func (s *LoadBalancedWebService) SidecarImageLocations() map[string]string {
+	return sidecarImageLocations(s.Sidecars)
+}
+
+// PreDeployTask returns the one-off task to run before the service is updated.
+func (s *LoadBalancedWebService) PreDeployTask() PreDeployTask {
+	return s.DeployConfig.PreDeployTask
+}
+
 // EnvFiles returns the locations of all env files against the ws root directory.
 // This method returns a map[string]string where the keys are container names
 // and the values are either env file paths or empty strings.
@@ -6,6 +6,8 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"path"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/fatih/structs"
@@ -18,8 +20,26 @@ const (
 	GithubV1ProviderName   = "GitHubV1"
 	CodeCommitProviderName = "CodeCommit"
 	BitbucketProviderName  = "Bitbucket"
+	GitLabProviderName     = "GitLab"
 )
 
+// SourceOutputArtifactFormatCloneRef is the output artifact format of a source action that clones the repository with its git history.
+const SourceOutputArtifactFormatCloneRef = "CODEBUILD_CLONE_REF"
+
+// Valid platforms that run Copilot Pipelines.
+const (
+	PipelinePlatformCodePipeline  = "CodePipeline"
+	PipelinePlatformGitHubActions = "GitHubActions"
+	PipelinePlatformGitLabCI      = "GitLabCI"
+)
+
+// PipelinePlatforms is the list of all platforms that can run a pipeline.
+var PipelinePlatforms = []string{
+	PipelinePlatformCodePipeline,
+	PipelinePlatformGitHubActions,
+	PipelinePlatformGitLabCI,
+}
+
 const pipelineManifestPath = "cicd/pipeline.yml"
 
 // PipelineProviders is the list of all available source integrations.
@@ -110,6 +130,27 @@ type GitHubProperties struct {
 	Branch        string `structs:"branch" yaml:"branch"`
 }
 
+type gitlabProvider struct {
+	properties *GitLabProperties
+}
+
+func (p *gitlabProvider) Name() string {
+	return GitLabProviderName
+}
+func (p *gitlabProvider) String() string {
+	return GitLabProviderName
+}
+func (p *gitlabProvider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
+// GitLabProperties contains information for configuring a GitLab
+// source provider. Only GitLab CI workflows can track a GitLab source.
+type GitLabProperties struct {
+	RepositoryURL string `structs:"repository" yaml:"repository"`
+	Branch        string `structs:"branch" yaml:"branch"`
+}
+
 // BitbucketProperties contains information for configuring a Bitbucket
 // source provider.
 type BitbucketProperties struct {
@@ -144,6 +185,10 @@ func NewProvider(configs interface{}) (Provider, error) {
 		return &bitbucketProvider{
 			properties: props,
 		}, nil
+	case *GitLabProperties:
+		return &gitlabProvider{
+			properties: props,
+		}, nil
 	default:
 		return nil, &ErrUnknownProvider{unknownProviderProperties: props}
 	}
@@ -162,15 +207,27 @@ const (
 // and deployment ordering of your environments.
 type Pipeline struct {
 	// Name of the pipeline
-	Name    string                     `yaml:"name"`
-	Version PipelineSchemaMajorVersion `yaml:"version"`
-	Source  *Source                    `yaml:"source"`
-	Build   *Build                     `yaml:"build"`
-	Stages  []PipelineStage            `yaml:"stages"`
+	Name     string                     `yaml:"name"`
+	Version  PipelineSchemaMajorVersion `yaml:"version"`
+	Platform string                     `yaml:"platform,omitempty"`
+	Source   *Source                    `yaml:"source"`
+	Build    *Build                     `yaml:"build"`
+	Stages   []PipelineStage            `yaml:"stages"`
+	Previews *Previews                  `yaml:"previews,omitempty"`
+
+	Notifications []PipelineNotification `yaml:"notifications,omitempty"`
+	// Waves are the names of the stages deployed in parallel, in order. By default, the stages are deployed one after the other.
+	Waves [][]string `yaml:"waves,omitempty"`
 
 	parser template.Parser
 }
 
+// IsCodePipeline returns true if the pipeline runs on AWS CodePipeline, the default platform,
+// instead of a GitHub Actions or GitLab CI workflow.
+func (m *Pipeline) IsCodePipeline() bool {
+	return m.Platform == "" || m.Platform == PipelinePlatformCodePipeline
+}
+
 // Source defines the source of the artifacts to be built and deployed.
 type Source struct {
 	ProviderName string                 `yaml:"provider"`
@@ -179,11 +236,153 @@ type Source struct {
 
 // Build defines the build project to build and test image.
 type Build struct {
-	Image            string `yaml:"image"`
-	Buildspec        string `yaml:"buildspec,omitempty"`
+	Image            string            `yaml:"image"`
+	Buildspec        string            `yaml:"buildspec,omitempty"`
+	Compute          BuildCompute      `yaml:"compute,omitempty"`
+	Timeout          *int              `yaml:"timeout,omitempty"` // Timeout of the build in minutes.
+	Privileged       *bool             `yaml:"privileged,omitempty"`
+	Cache            string            `yaml:"cache,omitempty"`
+	VPC              BuildVPC          `yaml:"vpc,omitempty"`
+	Variables        map[string]string `yaml:"variables,omitempty"`
+	Secrets          map[string]Secret `yaml:"secrets,omitempty"`
 	AdditionalPolicy struct {
 		Document yaml.Node `yaml:"PolicyDocument,omitempty"`
 	} `yaml:"additional_policy,omitempty"`
+	OnlyChanged bool                `yaml:"only_changed,omitempty"`
+	Paths       map[string][]string `yaml:"paths,omitempty"`
+}
+
+// Valid environment types of the build project.
+const (
+	BuildComputeTypeLinux    = "LINUX_CONTAINER"
+	BuildComputeTypeARM      = "ARM_CONTAINER"
+	BuildComputeTypeLinuxGPU = "LINUX_GPU_CONTAINER"
+)
+
+// BuildComputeTypes are the environment types of the build project.
+var BuildComputeTypes = []string{BuildComputeTypeLinux, BuildComputeTypeARM, BuildComputeTypeLinuxGPU}
+
+// Valid compute sizes of the build project.
+const (
+	BuildComputeSizeSmall   = "small"
+	BuildComputeSizeMedium  = "medium"
+	BuildComputeSizeLarge   = "large"
+	BuildComputeSize2XLarge = "2xlarge"
+)
+
+// BuildComputeSizes are the compute sizes of the build project, from the smallest to the largest.
+var BuildComputeSizes = []string{BuildComputeSizeSmall, BuildComputeSizeMedium, BuildComputeSizeLarge, BuildComputeSize2XLarge}
+
+// BuildCompute defines the environment type and the size of the build project.
+type BuildCompute struct {
+	Type string `yaml:"type,omitempty"` // Environment type, inferred from the image if empty.
+	Size string `yaml:"size,omitempty"`
+}
+
+// IsEmpty returns true if the compute of the build project is not configured.
+func (c BuildCompute) IsEmpty() bool {
+	return c.Type == "" && c.Size == ""
+}
+
+// Valid caches of the build project.
+const (
+	BuildCacheLocal = "local"
+	BuildCacheS3    = "s3"
+	BuildCacheNone  = "none"
+)
+
+// BuildCaches are the caches of the build project.
+var BuildCaches = []string{BuildCacheLocal, BuildCacheS3, BuildCacheNone}
+
+// BuildVPC defines the VPC the build project runs in.
+type BuildVPC struct {
+	Environment string `yaml:"environment,omitempty"` // Name of the environment whose private subnets run the build.
+}
+
+// IsEmpty returns true if the build project doesn't run in a VPC.
+func (v BuildVPC) IsEmpty() bool {
+	return v.Environment == ""
+}
+
+// WorkloadPaths returns the path globs that trigger a new build of the workload.
+// The directory of the workload's manifest, workloadDir, always triggers a build.
+// If the manifest doesn't map the workload to any path, the defaults are used in addition to workloadDir.
+func (b *Build) WorkloadPaths(name, workloadDir string, defaults []string) []string {
+	paths := []string{workloadDir}
+	if b == nil || len(b.Paths[name]) == 0 {
+		return append(paths, defaults...)
+	}
+	return append(paths, b.Paths[name]...)
+}
+
+// MatchPath returns true if the slash-separated file path matches the glob pattern.
+// In addition to the syntax of path.Match, "**" matches zero or more directories,
+// and a pattern without wildcards also matches any file under the directory it names.
+func MatchPath(pattern, file string) bool {
+	pattern = strings.TrimPrefix(path.Clean(pattern), "./")
+	file = strings.TrimPrefix(path.Clean(file), "./")
+	if pattern == "." {
+		return true
+	}
+	if !strings.ContainsAny(pattern, `*?[\`) {
+		return file == pattern || strings.HasPrefix(file, pattern+"/")
+	}
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(file, "/"))
+}
+
+func matchSegments(patterns, segments []string) bool {
+	if len(patterns) == 0 {
+		return len(segments) == 0
+	}
+	if patterns[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if matchSegments(patterns[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segments) == 0 {
+		return false
+	}
+	if ok, err := path.Match(patterns[0], segments[0]); err != nil || !ok {
+		return false
+	}
+	return matchSegments(patterns[1:], segments[1:])
+}
+
+// Previews defines the ephemeral environments deployed for the pull requests against the source branch.
+type Previews struct {
+	Environment       string   `yaml:"environment"`                   // Environment whose manifest and region the previews reuse.
+	ShareVPC          bool     `yaml:"share_vpc,omitempty"`           // True means the previews import the VPC of the environment.
+	AccessTokenSecret string   `yaml:"access_token_secret,omitempty"` // Secret with the token to comment on GitHub or Bitbucket pull requests.
+	TrustedActors     []string `yaml:"trusted_actors,omitempty"`      // IDs of the GitHub or Bitbucket accounts whose pull requests are previewed.
+}
+
+// Events of a pipeline execution that can be notified.
+const (
+	PipelineEventStageFailed    = "stage_failed"
+	PipelineEventApprovalNeeded = "approval_needed"
+	PipelineEventSucceeded      = "succeeded"
+)
+
+// PipelineEvents are the events of a pipeline execution that can be notified.
+var PipelineEvents = []string{PipelineEventStageFailed, PipelineEventApprovalNeeded, PipelineEventSucceeded}
+
+// PipelineNotification sends the events of the pipeline to SNS topics, email addresses or AWS Chatbot channels.
+type PipelineNotification struct {
+	Events    []string `yaml:"events,omitempty"`     // Events to notify on. Defaults to all of PipelineEvents.
+	SNSTopics []string `yaml:"sns_topics,omitempty"` // ARNs of existing SNS topics.
+	Emails    []string `yaml:"emails,omitempty"`     // Email addresses subscribed to a topic created with the pipeline.
+	Chatbot   []string `yaml:"chatbot,omitempty"`    // ARNs of AWS Chatbot Slack or Microsoft Teams channel configurations.
+}
+
+// EventsOrDefault returns the events of the notification, or all pipeline events if none are specified.
+func (n PipelineNotification) EventsOrDefault() []string {
+	if len(n.Events) == 0 {
+		return PipelineEvents
+	}
+	return n.Events
 }
 
 // PipelineStage represents a stage in the pipeline manifest
@@ -194,8 +393,17 @@ type PipelineStage struct {
 	Deployments      Deployments        `yaml:"deployments,omitempty"`
 	PreDeployments   PrePostDeployments `yaml:"pre_deployments,omitempty"`
 	PostDeployments  PrePostDeployments `yaml:"post_deployments,omitempty"`
+	OnFailure        string             `yaml:"on_failure,omitempty"`
 }
 
+// Valid actions of a stage when its deployments or post-deployment checks fail.
+const (
+	PipelineStageOnFailureRollback = "rollback"
+)
+
+// PipelineStageOnFailures are the actions of a stage when it fails.
+var PipelineStageOnFailures = []string{PipelineStageOnFailureRollback}
+
 // Deployments represent a directed graph of cloudformation deployments.
 type Deployments map[string]*Deployment
 
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/fatih/structs"
@@ -39,6 +40,12 @@ func TestNewProvider(t *testing.T) {
 				Branch:        defaultCCBranch,
 			},
 		},
+		"successfully create GitLab provider": {
+			providerConfig: &GitLabProperties{
+				RepositoryURL: "https://gitlab.com/aws/wings",
+				Branch:        "main",
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -218,6 +225,40 @@ stages:
 			inContent:   `corrupted yaml`,
 			expectedErr: errors.New("yaml: unmarshal errors:\n  line 1: cannot unmarshal !!str `corrupt...` into manifest.Pipeline"),
 		},
+		"valid pipeline.yml with a GitLab CI platform": {
+			inContent: `
+name: pipepiper
+version: 1
+platform: GitLabCI
+
+source:
+  provider: GitLab
+  properties:
+    repository: https://gitlab.com/aws/wings
+    branch: main
+
+stages:
+    -
+      name: test
+`,
+			expectedManifest: &Pipeline{
+				Name:     "pipepiper",
+				Version:  Ver1,
+				Platform: PipelinePlatformGitLabCI,
+				Source: &Source{
+					ProviderName: GitLabProviderName,
+					Properties: map[string]interface{}{
+						"repository": "https://gitlab.com/aws/wings",
+						"branch":     "main",
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+					},
+				},
+			},
+		},
 		"valid pipeline.yml without build": {
 			inContent: `
 name: pipepiper
@@ -303,6 +344,158 @@ stages:
 				},
 			},
 		},
+		"valid pipeline.yml with build configuration": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: CodeCommit
+  properties:
+    repository: https://git-codecommit.us-west-2.amazonaws.com/v1/repos/somethingCool
+
+build:
+  image: aws/codebuild/amazonlinux2-aarch64-standard:3.0
+  compute:
+    size: large
+  timeout: 120
+  privileged: false
+  cache: s3
+  vpc:
+    environment: test
+  variables:
+    GOFLAGS: -mod=vendor
+  secrets:
+    NPM_TOKEN: /copilot/pipepiper/npm-token
+    DB_PASSWORD:
+      secretsmanager: 'demo/test/mysql'
+
+stages:
+    -
+      name: test
+waves: [[test]]
+`,
+			expectedManifest: &Pipeline{
+				Name:    "pipepiper",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "CodeCommit",
+					Properties: map[string]interface{}{
+						"repository": "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/somethingCool",
+					},
+				},
+				Build: &Build{
+					Image:      "aws/codebuild/amazonlinux2-aarch64-standard:3.0",
+					Compute:    BuildCompute{Size: BuildComputeSizeLarge},
+					Timeout:    aws.Int(120),
+					Privileged: aws.Bool(false),
+					Cache:      BuildCacheS3,
+					VPC:        BuildVPC{Environment: "test"},
+					Variables:  map[string]string{"GOFLAGS": "-mod=vendor"},
+					Secrets: map[string]Secret{
+						"NPM_TOKEN":   {from: StringOrFromCFN{Plain: aws.String("/copilot/pipepiper/npm-token")}},
+						"DB_PASSWORD": {fromSecretsManager: secretsManagerSecret{Name: aws.String("demo/test/mysql")}},
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+					},
+				},
+				Waves: [][]string{{"test"}},
+			},
+		},
+		"valid pipeline.yml with previews": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: chicken
+
+previews:
+  environment: chicken
+  share_vpc: true
+  access_token_secret: github-comments-token
+  trusted_actors:
+    - "1234567"
+`,
+			expectedManifest: &Pipeline{
+				Name:    "pipepiper",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "GitHub",
+					Properties: map[string]interface{}{
+						"repository": "aws/somethingCool",
+						"branch":     defaultGHBranch,
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "chicken",
+					},
+				},
+				Previews: &Previews{
+					Environment:       "chicken",
+					ShareVPC:          true,
+					AccessTokenSecret: "github-comments-token",
+					TrustedActors:     []string{"1234567"},
+				},
+			},
+		},
+		"valid pipeline.yml with notifications": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: chicken
+
+notifications:
+  - events: [approval_needed]
+    emails:
+      - oncall@example.com
+    chatbot:
+      - arn:aws:chatbot::123456789012:chat-configuration/slack-channel/deploys
+`,
+			expectedManifest: &Pipeline{
+				Name:    "pipepiper",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "GitHub",
+					Properties: map[string]interface{}{
+						"repository": "aws/somethingCool",
+						"branch":     defaultGHBranch,
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "chicken",
+					},
+				},
+				Notifications: []PipelineNotification{
+					{
+						Events:  []string{"approval_needed"},
+						Emails:  []string{"oncall@example.com"},
+						Chatbot: []string{"arn:aws:chatbot::123456789012:chat-configuration/slack-channel/deploys"},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -317,3 +510,88 @@ stages:
 		})
 	}
 }
+
+func TestBuild_WorkloadPaths(t *testing.T) {
+	defaults := []string{"api", "api/Dockerfile"}
+	testCases := map[string]struct {
+		in     *Build
+		wanted []string
+	}{
+		"use the defaults without a build section": {
+			wanted: []string{"copilot/api", "api", "api/Dockerfile"},
+		},
+		"use the defaults if the workload is not mapped": {
+			in: &Build{
+				Paths: map[string][]string{"web": {"web/**"}},
+			},
+			wanted: []string{"copilot/api", "api", "api/Dockerfile"},
+		},
+		"use the paths of the workload in addition to its manifest directory": {
+			in: &Build{
+				Paths: map[string][]string{"api": {"api/**", "libs/**"}},
+			},
+			wanted: []string{"copilot/api", "api/**", "libs/**"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.WorkloadPaths("api", "copilot/api", defaults))
+		})
+	}
+}
+
+func TestMatchPath(t *testing.T) {
+	testCases := map[string]struct {
+		pattern string
+		file    string
+		wanted  bool
+	}{
+		"directory matches the files under it": {
+			pattern: "api",
+			file:    "api/cmd/main.go",
+			wanted:  true,
+		},
+		"directory does not match a sibling with the same prefix": {
+			pattern: "api",
+			file:    "api-gateway/main.go",
+		},
+		"current directory matches everything": {
+			pattern: ".",
+			file:    "README.md",
+			wanted:  true,
+		},
+		"single star does not cross directories": {
+			pattern: "api/*.go",
+			file:    "api/cmd/main.go",
+		},
+		"double star matches nested files": {
+			pattern: "api/**",
+			file:    "api/cmd/main.go",
+			wanted:  true,
+		},
+		"double star matches zero directories": {
+			pattern: "libs/**/*.go",
+			file:    "libs/util.go",
+			wanted:  true,
+		},
+		"double star in the middle of the pattern": {
+			pattern: "**/Dockerfile",
+			file:    "services/api/Dockerfile",
+			wanted:  true,
+		},
+		"leading dot slash is ignored": {
+			pattern: "./api/**",
+			file:    "api/main.go",
+			wanted:  true,
+		},
+		"no match": {
+			pattern: "web/**",
+			file:    "api/main.go",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, MatchPath(tc.pattern, tc.file))
+		})
+	}
+}
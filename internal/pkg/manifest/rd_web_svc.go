@@ -168,6 +168,21 @@ func (s *RequestDrivenWebService) BuildArgs(contextDir string) (map[string]*Dock
 	return buildArgsPerContainer, nil
 }
 
+// ImageRepository returns the configuration of the repository that stores the images built for the service.
+func (s *RequestDrivenWebService) ImageRepository() ImageRepository {
+	return s.ImageConfig.Image.Repository
+}
+
+// ImageSigning returns the configuration to sign the images built for the service.
+func (s *RequestDrivenWebService) ImageSigning() ImageSigning {
+	return s.ImageConfig.Image.Signing
+}
+
+// ImageLocation returns the location of the image of the main container, if the service does not build it.
+func (s *RequestDrivenWebService) ImageLocation() string {
+	return s.ImageConfig.Image.GetLocation()
+}
+
 func (s RequestDrivenWebService) applyEnv(envName string) (workloadManifest, error) {
 	overrideConfig, ok := s.Environments[envName]
 	if !ok {
@@ -221,14 +221,16 @@ type Cooldown struct {
 // AdvancedCount represents the configurable options for Auto Scaling as well as
 // Capacity configuration (spot).
 type AdvancedCount struct {
-	Spot         *int                            `yaml:"spot"` // mutually exclusive with other fields
-	Range        Range                           `yaml:"range"`
-	Cooldown     Cooldown                        `yaml:"cooldown"`
-	CPU          ScalingConfigOrT[Percentage]    `yaml:"cpu_percentage"`
-	Memory       ScalingConfigOrT[Percentage]    `yaml:"memory_percentage"`
-	Requests     ScalingConfigOrT[int]           `yaml:"requests"`
-	ResponseTime ScalingConfigOrT[time.Duration] `yaml:"response_time"`
-	QueueScaling QueueScaling                    `yaml:"queue_delay"`
+	Spot          *int                            `yaml:"spot"` // mutually exclusive with other fields
+	Range         Range                           `yaml:"range"`
+	Cooldown      Cooldown                        `yaml:"cooldown"`
+	CPU           ScalingConfigOrT[Percentage]    `yaml:"cpu_percentage"`
+	Memory        ScalingConfigOrT[Percentage]    `yaml:"memory_percentage"`
+	Requests      ScalingConfigOrT[int]           `yaml:"requests"`
+	ResponseTime  ScalingConfigOrT[time.Duration] `yaml:"response_time"`
+	QueueScaling  QueueScaling                    `yaml:"queue_delay"`
+	CustomMetrics []CustomMetricScaling           `yaml:"custom_metrics"`
+	StepScaling   []StepScaling                   `yaml:"step_scaling"`
 
 	workloadType string
 }
@@ -251,7 +253,8 @@ func (c *Cooldown) IsEmpty() bool {
 // IsEmpty returns whether AdvancedCount is empty.
 func (a *AdvancedCount) IsEmpty() bool {
 	return a.Range.IsEmpty() && a.CPU.IsEmpty() && a.Memory.IsEmpty() && a.Cooldown.IsEmpty() &&
-		a.Requests.IsEmpty() && a.ResponseTime.IsEmpty() && a.Spot == nil && a.QueueScaling.IsEmpty()
+		a.Requests.IsEmpty() && a.ResponseTime.IsEmpty() && a.Spot == nil && a.QueueScaling.IsEmpty() &&
+		len(a.CustomMetrics) == 0 && len(a.StepScaling) == 0
 }
 
 // IgnoreRange returns whether desiredCount is specified on spot capacity
@@ -266,17 +269,20 @@ func (a *AdvancedCount) hasAutoscaling() bool {
 func (a *AdvancedCount) validScalingFields() []string {
 	switch a.workloadType {
 	case manifestinfo.LoadBalancedWebServiceType:
-		return []string{"cpu_percentage", "memory_percentage", "requests", "response_time"}
+		return []string{"cpu_percentage", "memory_percentage", "requests", "response_time", "custom_metrics", "step_scaling"}
 	case manifestinfo.BackendServiceType:
-		return []string{"cpu_percentage", "memory_percentage", "requests", "response_time"}
+		return []string{"cpu_percentage", "memory_percentage", "requests", "response_time", "custom_metrics", "step_scaling"}
 	case manifestinfo.WorkerServiceType:
-		return []string{"cpu_percentage", "memory_percentage", "queue_delay"}
+		return []string{"cpu_percentage", "memory_percentage", "queue_delay", "custom_metrics", "step_scaling"}
 	default:
 		return nil
 	}
 }
 
 func (a *AdvancedCount) hasScalingFieldsSet() bool {
+	if len(a.CustomMetrics) > 0 || len(a.StepScaling) > 0 {
+		return true
+	}
 	switch a.workloadType {
 	case manifestinfo.LoadBalancedWebServiceType:
 		return !a.CPU.IsEmpty() || !a.Memory.IsEmpty() || !a.Requests.IsEmpty() || !a.ResponseTime.IsEmpty()
@@ -320,6 +326,8 @@ func (a *AdvancedCount) unsetAutoscaling() {
 	a.Requests = ScalingConfigOrT[int]{}
 	a.ResponseTime = ScalingConfigOrT[time.Duration]{}
 	a.QueueScaling = QueueScaling{}
+	a.CustomMetrics = nil
+	a.StepScaling = nil
 }
 
 // QueueScaling represents the configuration to scale a service based on a SQS queue.
@@ -344,6 +352,76 @@ func (qs *QueueScaling) AcceptableBacklogPerTask() (int, error) {
 	return int(v), nil
 }
 
+// Adjustment types for step scaling policies.
+const (
+	scalingAdjustmentChangeInCapacity        = "ChangeInCapacity"
+	scalingAdjustmentPercentChangeInCapacity = "PercentChangeInCapacity"
+	scalingAdjustmentExactCapacity           = "ExactCapacity"
+)
+
+// CloudWatchMetric identifies an arbitrary CloudWatch metric used to scale a service.
+type CloudWatchMetric struct {
+	Namespace  *string           `yaml:"namespace"`
+	MetricName *string           `yaml:"metric_name"`
+	Dimensions map[string]string `yaml:"dimensions"`
+	Statistic  *string           `yaml:"statistic"`
+}
+
+// CustomMetricScaling represents the configuration to scale a service by tracking a target value
+// for an arbitrary CloudWatch metric.
+type CustomMetricScaling struct {
+	CloudWatchMetric `yaml:",inline"`
+	Value            *float64 `yaml:"value"`
+	Cooldown         Cooldown `yaml:"cooldown"`
+}
+
+// StepScaling represents the configuration to scale a service in steps when an arbitrary
+// CloudWatch metric crosses explicit thresholds.
+type StepScaling struct {
+	CloudWatchMetric  `yaml:",inline"`
+	Period            *time.Duration `yaml:"period"`
+	EvaluationPeriods *int           `yaml:"evaluation_periods"`
+	AdjustmentType    *string        `yaml:"adjustment_type"`
+	Cooldown          *time.Duration `yaml:"cooldown"`
+	Steps             []ScalingStep  `yaml:"steps"`
+}
+
+// ScalingStep represents a single step of a step scaling policy.
+// The step applies when the metric is at or above "above", or at or below "below".
+type ScalingStep struct {
+	Above      *float64 `yaml:"above"` // mutually exclusive with Below
+	Below      *float64 `yaml:"below"`
+	Adjustment *int     `yaml:"adjustment"`
+}
+
+// ScaleOutSteps returns the steps that add capacity, sorted by ascending threshold.
+func (s *StepScaling) ScaleOutSteps() []ScalingStep {
+	var steps []ScalingStep
+	for _, step := range s.Steps {
+		if step.Above != nil {
+			steps = append(steps, step)
+		}
+	}
+	sort.SliceStable(steps, func(i, j int) bool {
+		return *steps[i].Above < *steps[j].Above
+	})
+	return steps
+}
+
+// ScaleInSteps returns the steps that remove capacity, sorted by descending threshold.
+func (s *StepScaling) ScaleInSteps() []ScalingStep {
+	var steps []ScalingStep
+	for _, step := range s.Steps {
+		if step.Below != nil {
+			steps = append(steps, step)
+		}
+	}
+	sort.SliceStable(steps, func(i, j int) bool {
+		return *steps[i].Below > *steps[j].Below
+	})
+	return steps
+}
+
 // HTTPHealthCheckArgs holds the configuration to determine if the load balanced web service is healthy.
 // These options are specifiable under the "healthcheck" field.
 // See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-elasticloadbalancingv2-targetgroup.html.
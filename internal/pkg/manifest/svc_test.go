@@ -581,6 +581,68 @@ func TestCount_UnmarshalYAML(t *testing.T) {
 				},
 			},
 		},
+		"With custom metrics and step scaling": {
+			inContent: []byte(`count:
+  range: 1-10
+  custom_metrics:
+    - namespace: Kafka
+      metric_name: ConsumerLag
+      dimensions:
+        ConsumerGroup: orders
+      statistic: Maximum
+      value: 1000
+  step_scaling:
+    - namespace: MyApp
+      metric_name: ActiveConnections
+      period: 1m
+      evaluation_periods: 2
+      cooldown: 2m
+      steps:
+        - above: 500
+          adjustment: 2
+        - below: 50
+          adjustment: -1
+`),
+			wantedStruct: Count{
+				AdvancedCount: AdvancedCount{
+					Range: Range{Value: &mockRange},
+					CustomMetrics: []CustomMetricScaling{
+						{
+							CloudWatchMetric: CloudWatchMetric{
+								Namespace:  aws.String("Kafka"),
+								MetricName: aws.String("ConsumerLag"),
+								Dimensions: map[string]string{
+									"ConsumerGroup": "orders",
+								},
+								Statistic: aws.String("Maximum"),
+							},
+							Value: aws.Float64(1000),
+						},
+					},
+					StepScaling: []StepScaling{
+						{
+							CloudWatchMetric: CloudWatchMetric{
+								Namespace:  aws.String("MyApp"),
+								MetricName: aws.String("ActiveConnections"),
+							},
+							Period:            &timeMinute,
+							EvaluationPeriods: aws.Int(2),
+							Cooldown:          durationp(2 * time.Minute),
+							Steps: []ScalingStep{
+								{
+									Above:      aws.Float64(500),
+									Adjustment: aws.Int(2),
+								},
+								{
+									Below:      aws.Float64(50),
+									Adjustment: aws.Int(-1),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"With spot specified as count": {
 			inContent: []byte(`count:
   spot: 42
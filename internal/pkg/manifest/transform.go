@@ -73,6 +73,9 @@ func (t imageTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Va
 
 		if srcStruct.Location != nil {
 			dstStruct.Build = BuildArgsOrString{}
+			// The repository only stores and signs images built by Copilot.
+			dstStruct.Repository = ImageRepository{}
+			dstStruct.Signing = ImageSigning{}
 		}
 
 		if dst.CanSet() { // For extra safety to prevent panicking.
@@ -175,10 +178,17 @@ func (t platformArgsOrStringTransformer) Transformer(typ reflect.Type) func(dst,
 
 		if srcStruct.PlatformString != nil {
 			dstStruct.PlatformArgs = PlatformArgs{}
+			dstStruct.PlatformStrings = nil
+		}
+
+		if len(srcStruct.PlatformStrings) > 0 {
+			dstStruct.PlatformString = nil
+			dstStruct.PlatformArgs = PlatformArgs{}
 		}
 
 		if !srcStruct.PlatformArgs.isEmpty() {
 			dstStruct.PlatformString = nil
+			dstStruct.PlatformStrings = nil
 		}
 
 		if dst.CanSet() { // For extra safety to prevent panicking.
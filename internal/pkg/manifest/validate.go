@@ -7,16 +7,20 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"path"
 	"path/filepath"
 	"regexp"
 	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudfront"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/docker/signature"
 	"github.com/aws/copilot-cli/internal/pkg/graph"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
@@ -62,6 +66,19 @@ const (
 	rootPath             = "/"
 )
 
+const (
+	// CodeStar Notifications rules have a quota of ten targets per rule.
+	maxNotificationTargets = 10
+
+	// Range of the timeout of a CodeBuild project.
+	minBuildTimeoutInMinutes = 5
+	maxBuildTimeoutInMinutes = 2160
+
+	// Resource prefixes of AWS Chatbot channel configuration ARNs.
+	chatbotSlackResourcePrefix = "chat-configuration/slack-channel/"
+	chatbotTeamsResourcePrefix = "chat-configuration/microsoft-teams-channel/"
+)
+
 var (
 	intRangeBandRegexp  = regexp.MustCompile(`^(\d+)-(\d+)$`)
 	volumesPathRegexp   = regexp.MustCompile(`^[a-zA-Z0-9\-\.\_/]+$`)
@@ -69,6 +86,7 @@ var (
 	awsNameRegexp       = regexp.MustCompile(`^[a-z][a-z0-9\-]+$`) // Validates that an expression starts with a letter and only contains letters, numbers, and hyphens.
 	punctuationRegExp   = regexp.MustCompile(`[\.\-]{2,}`)         // Check for consecutive periods or dashes.
 	trailingPunctRegExp = regexp.MustCompile(`[\-\.]$`)            // Check for trailing dash or dot.
+	appConfigNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)   // Validates that an expression contains only letters, numbers, underscores, and hyphens.
 
 	essentialContainerDependsOnValidStatuses = []string{dependsOnStart, dependsOnHealthy}
 	dependsOnValidStatuses                   = []string{dependsOnStart, dependsOnComplete, dependsOnSuccess, dependsOnHealthy}
@@ -76,12 +94,17 @@ var (
 	validContainerProtocols                  = []string{TCP, UDP}
 	tracingValidVendors                      = []string{awsXRAY}
 	ecsRollingUpdateStrategies               = []string{ECSDefaultRollingUpdateStrategy, ECSRecreateRollingUpdateStrategy}
+	appConfigProfileTypes                    = []string{AppConfigProfileTypeFreeform, AppConfigProfileTypeFeatureFlags}
+	appConfigStrategies                      = []string{AppConfigStrategyAllAtOnce, AppConfigStrategyLinear}
+	appConfigFileExts                        = []string{".json", ".yml", ".yaml"}
 
 	httpProtocolVersions = []string{"GRPC", "HTTP1", "HTTP2"}
 
 	invalidTaskDefOverridePathRegexp  = []string{`Family`, `ContainerDefinitions\[\d+\].Name`}
 	validSQSDeduplicationScopeValues  = []string{sqsDeduplicationScopeMessageGroup, sqsDeduplicationScopeQueue}
 	validSQSFIFOThroughputLimitValues = []string{sqsFIFOThroughputLimitPerMessageGroupID, sqsFIFOThroughputLimitPerQueue}
+	validMetricStatistics             = []string{"Average", "Minimum", "Maximum", "SampleCount", "Sum"}
+	validScalingAdjustmentTypes       = []string{scalingAdjustmentChangeInCapacity, scalingAdjustmentPercentChangeInCapacity, scalingAdjustmentExactCapacity}
 )
 
 // Validate returns nil if DynamicLoadBalancedWebService is configured correctly.
@@ -164,6 +187,9 @@ func (d DeploymentConfig) validate() error {
 	if err := d.DeploymentControllerConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "rolling": %w`, err)
 	}
+	if err := d.PreDeployTask.validate(); err != nil {
+		return fmt.Errorf(`validate "pre_deploy_task": %w`, err)
+	}
 	return nil
 }
 
@@ -177,6 +203,26 @@ func (w WorkerDeploymentConfig) validate() error {
 	if err := w.DeploymentControllerConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "deployment controller strategy": %w`, err)
 	}
+	if err := w.PreDeployTask.validate(); err != nil {
+		return fmt.Errorf(`validate "pre_deploy_task": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if PreDeployTask is configured correctly.
+func (t PreDeployTask) validate() error {
+	if t.IsEmpty() {
+		return nil
+	}
+	if (*StringSliceOrString)(&t.Command).isEmpty() {
+		return &errFieldMustBeSpecified{
+			missingField:      "command",
+			conditionalFields: []string{"image", "timeout"},
+		}
+	}
+	if t.Timeout != nil && *t.Timeout <= 0 {
+		return errors.New(`"timeout" must be greater than 0`)
+	}
 	return nil
 }
 
@@ -645,6 +691,24 @@ func (p Pipeline) Validate() error {
 	if len(p.Name) > 100 {
 		return fmt.Errorf(`pipeline name '%s' must be shorter than 100 characters`, p.Name)
 	}
+	if err := p.validatePlatform(); err != nil {
+		return fmt.Errorf(`validate "platform" for pipeline %q: %w`, p.Name, err)
+	}
+	if p.Build != nil {
+		if err := p.Build.validate(p.Source); err != nil {
+			return fmt.Errorf(`validate "build" for pipeline %q: %w`, p.Name, err)
+		}
+	}
+	if p.Previews != nil {
+		if err := p.Previews.validate(p.Source); err != nil {
+			return fmt.Errorf(`validate "previews" for pipeline %q: %w`, p.Name, err)
+		}
+	}
+	for i, n := range p.Notifications {
+		if err := n.validate(); err != nil {
+			return fmt.Errorf(`validate "notifications[%d]" for pipeline %q: %w`, i, p.Name, err)
+		}
+	}
 	for _, stg := range p.Stages {
 		if err := stg.validate(); err != nil {
 			return fmt.Errorf(`validate stage %q for pipeline %q: %w`, stg.Name, p.Name, err)
@@ -653,11 +717,240 @@ func (p Pipeline) Validate() error {
 			return fmt.Errorf(`validate "deployments" for pipeline stage %s: %w`, stg.Name, err)
 		}
 	}
+	if err := p.validateWaves(); err != nil {
+		return fmt.Errorf(`validate "waves" for pipeline %q: %w`, p.Name, err)
+	}
+	return nil
+}
+
+// validateWaves returns nil if every stage of the pipeline is deployed by exactly one wave.
+func (p Pipeline) validateWaves() error {
+	if len(p.Waves) == 0 {
+		return nil
+	}
+	stages := make(map[string]PipelineStage, len(p.Stages))
+	for _, stg := range p.Stages {
+		stages[stg.Name] = stg
+	}
+	waveOf := make(map[string]int)
+	for i, wave := range p.Waves {
+		if len(wave) == 0 {
+			return fmt.Errorf("wave %d must contain at least one stage", i+1)
+		}
+		for _, name := range wave {
+			stg, ok := stages[name]
+			if !ok {
+				return fmt.Errorf("stage %q of wave %d is not in the stages of the pipeline", name, i+1)
+			}
+			if prev, ok := waveOf[name]; ok {
+				return fmt.Errorf("stage %q is in both wave %d and wave %d", name, prev, i+1)
+			}
+			waveOf[name] = i + 1
+			if stg.OnFailure != stages[wave[0]].OnFailure {
+				return fmt.Errorf(`stages %q and %q of wave %d must have the same "on_failure"`, wave[0], name, i+1)
+			}
+		}
+	}
+	for _, stg := range p.Stages {
+		if _, ok := waveOf[stg.Name]; !ok {
+			return fmt.Errorf("stage %q must be in a wave", stg.Name)
+		}
+	}
+	return nil
+}
+
+// validatePlatform returns nil if the source, previews and notifications can run on the platform of the pipeline.
+func (p Pipeline) validatePlatform() error {
+	if p.Platform != "" && !slices.Contains(PipelinePlatforms, p.Platform) {
+		return fmt.Errorf("platform %q must be one of %s", p.Platform, english.WordSeries(quoteStringSlice(PipelinePlatforms), "or"))
+	}
+	var provider string
+	if p.Source != nil {
+		provider = p.Source.ProviderName
+	}
+	switch p.Platform {
+	case PipelinePlatformGitHubActions:
+		if provider != GithubProviderName && provider != GithubV1ProviderName {
+			return fmt.Errorf("platform %s requires a %s source", p.Platform, GithubProviderName)
+		}
+	case PipelinePlatformGitLabCI:
+		if provider != GitLabProviderName {
+			return fmt.Errorf("platform %s requires a %s source", p.Platform, GitLabProviderName)
+		}
+	default:
+		if provider == GitLabProviderName {
+			return fmt.Errorf("a %s source requires platform %s", GitLabProviderName, PipelinePlatformGitLabCI)
+		}
+	}
+	if p.IsCodePipeline() {
+		return nil
+	}
+	if p.Previews != nil {
+		return fmt.Errorf(`"previews" cannot be specified with platform %s`, p.Platform)
+	}
+	if len(p.Notifications) != 0 {
+		return fmt.Errorf(`"notifications" cannot be specified with platform %s`, p.Platform)
+	}
+	for _, stg := range p.Stages {
+		if stg.OnFailure != "" {
+			return fmt.Errorf(`"on_failure" of stage %q cannot be specified with platform %s`, stg.Name, p.Platform)
+		}
+	}
+	if len(p.Waves) != 0 {
+		return fmt.Errorf(`"waves" cannot be specified with platform %s`, p.Platform)
+	}
+	return nil
+}
+
+// validate returns nil if the build project is configured correctly.
+func (b Build) validate(src *Source) error {
+	if err := b.Compute.validate(); err != nil {
+		return fmt.Errorf(`validate "compute": %w`, err)
+	}
+	if b.Timeout != nil && (*b.Timeout < minBuildTimeoutInMinutes || *b.Timeout > maxBuildTimeoutInMinutes) {
+		return fmt.Errorf(`"timeout" must be between %d and %d minutes`, minBuildTimeoutInMinutes, maxBuildTimeoutInMinutes)
+	}
+	if b.Cache != "" && !slices.Contains(BuildCaches, b.Cache) {
+		return fmt.Errorf(`"cache" %q must be one of %s`, b.Cache, english.WordSeries(quoteStringSlice(BuildCaches), "or"))
+	}
+	for name, secret := range b.Secrets {
+		if secret.RequiresImport() {
+			return fmt.Errorf(`validate "secrets": secret %q cannot be imported from a CloudFormation stack in a build`, name)
+		}
+	}
+	if len(b.Paths) != 0 && !b.OnlyChanged {
+		return &errFieldMustBeSpecified{
+			missingField:      "only_changed",
+			conditionalFields: []string{"paths"},
+		}
+	}
+	if b.OnlyChanged {
+		var format interface{}
+		if src != nil {
+			format = src.Properties["output_artifact_format"]
+		}
+		// The changed files are computed with git, which requires the history of the repository.
+		if format != SourceOutputArtifactFormatCloneRef {
+			return fmt.Errorf(`"only_changed" requires the source to be configured with "output_artifact_format: %s"`, SourceOutputArtifactFormatCloneRef)
+		}
+	}
+	for name, patterns := range b.Paths {
+		for _, pattern := range patterns {
+			for _, segment := range strings.Split(pattern, "/") {
+				if _, err := path.Match(segment, ""); err != nil {
+					return fmt.Errorf(`validate "paths" of workload %q: pattern %q is malformed`, name, pattern)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validate returns nil if the environment type and the size of the build project are supported by CodeBuild.
+func (c BuildCompute) validate() error {
+	if c.Type != "" && !slices.Contains(BuildComputeTypes, c.Type) {
+		return fmt.Errorf(`"type" %q must be one of %s`, c.Type, english.WordSeries(quoteStringSlice(BuildComputeTypes), "or"))
+	}
+	if c.Size != "" && !slices.Contains(BuildComputeSizes, c.Size) {
+		return fmt.Errorf(`"size" %q must be one of %s`, c.Size, english.WordSeries(quoteStringSlice(BuildComputeSizes), "or"))
+	}
+	switch {
+	case c.Type == BuildComputeTypeARM && (c.Size == BuildComputeSizeMedium || c.Size == BuildComputeSize2XLarge):
+		return fmt.Errorf(`size %q is not supported by type %q: must be %q or %q`, c.Size, c.Type, BuildComputeSizeSmall, BuildComputeSizeLarge)
+	case c.Type == BuildComputeTypeLinuxGPU && c.Size != BuildComputeSizeLarge:
+		return &errFieldMustBeSpecified{
+			missingField:      "size: large",
+			conditionalFields: []string{"type: LINUX_GPU_CONTAINER"},
+		}
+	}
+	return nil
+}
+
+// validate returns nil if the previews are configured correctly for the source of the pipeline.
+func (p Previews) validate(src *Source) error {
+	if p.Environment == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "environment",
+		}
+	}
+	if src == nil {
+		return nil
+	}
+	switch src.ProviderName {
+	case GithubProviderName:
+		if _, ok := src.Properties["access_token_secret"]; ok {
+			return errors.New("previews require a GitHub source with a connection instead of an access token")
+		}
+		if len(p.TrustedActors) == 0 {
+			return &errFieldMustBeSpecified{
+				missingField: "trusted_actors",
+			}
+		}
+	case BitbucketProviderName:
+		if len(p.TrustedActors) == 0 {
+			return &errFieldMustBeSpecified{
+				missingField: "trusted_actors",
+			}
+		}
+	case CodeCommitProviderName:
+		if p.AccessTokenSecret != "" {
+			return errors.New(`"access_token_secret" cannot be specified with a CodeCommit source`)
+		}
+		if len(p.TrustedActors) != 0 {
+			return errors.New(`"trusted_actors" cannot be specified with a CodeCommit source`)
+		}
+	default:
+		return fmt.Errorf("previews are not supported for source provider %s", src.ProviderName)
+	}
+	return nil
+}
+
+// validate returns nil if the notification is configured correctly.
+func (n PipelineNotification) validate() error {
+	for _, event := range n.Events {
+		if !slices.Contains(PipelineEvents, event) {
+			return fmt.Errorf(`validate "events": event %q must be one of %s`, event, english.WordSeries(quoteStringSlice(PipelineEvents), "or"))
+		}
+	}
+	// The emails are sent through a single topic created with the pipeline.
+	numTargets := len(n.SNSTopics) + len(n.Chatbot)
+	if len(n.Emails) != 0 {
+		numTargets++
+	}
+	if numTargets == 0 {
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields: []string{"sns_topics", "emails", "chatbot"},
+		}
+	}
+	if numTargets > maxNotificationTargets {
+		return fmt.Errorf("a notification can have at most %d targets", maxNotificationTargets)
+	}
+	for _, topic := range n.SNSTopics {
+		parsed, err := arn.Parse(topic)
+		if err != nil || parsed.Service != "sns" {
+			return fmt.Errorf(`validate "sns_topics": %q is not an SNS topic ARN`, topic)
+		}
+	}
+	for _, email := range n.Emails {
+		if !strings.Contains(email, "@") {
+			return fmt.Errorf(`validate "emails": %q is not an email address`, email)
+		}
+	}
+	for _, channel := range n.Chatbot {
+		parsed, err := arn.Parse(channel)
+		if err != nil || parsed.Service != "chatbot" ||
+			!(strings.HasPrefix(parsed.Resource, chatbotSlackResourcePrefix) || strings.HasPrefix(parsed.Resource, chatbotTeamsResourcePrefix)) {
+			return fmt.Errorf(`validate "chatbot": %q is not an AWS Chatbot Slack or Microsoft Teams channel configuration ARN`, channel)
+		}
+	}
 	return nil
 }
 
 // validate returns nil if stages are configured correctly.
 func (s PipelineStage) validate() error {
+	if s.OnFailure != "" && !slices.Contains(PipelineStageOnFailures, s.OnFailure) {
+		return fmt.Errorf(`"on_failure" %q must be one of %s`, s.OnFailure, english.WordSeries(quoteStringSlice(PipelineStageOnFailures), "or"))
+	}
 	if len(s.TestCommands) != 0 && s.PostDeployments != nil {
 		return &errFieldMutualExclusive{
 			firstField:  "post_deployments",
@@ -780,6 +1073,105 @@ func (i Image) validate() error {
 	if err = i.DependsOn.validate(); err != nil {
 		return fmt.Errorf(`validate "depends_on": %w`, err)
 	}
+	if err = i.Repository.validate(); err != nil {
+		return fmt.Errorf(`validate "repository": %w`, err)
+	}
+	if err = i.Signing.validate(); err != nil {
+		return fmt.Errorf(`validate "signing": %w`, err)
+	}
+	if !i.Repository.IsEmpty() && i.Build.isEmpty() {
+		return &errFieldMustBeSpecified{
+			missingField:      "build",
+			conditionalFields: []string{"repository"},
+		}
+	}
+	if !i.Signing.IsEmpty() && i.Build.isEmpty() {
+		return &errFieldMustBeSpecified{
+			missingField:      "build",
+			conditionalFields: []string{"signing"},
+		}
+	}
+	return nil
+}
+
+// validate returns nil if ImageSigning is configured correctly.
+func (s ImageSigning) validate() error {
+	if s.IsEmpty() {
+		return nil
+	}
+	if err := validateSigningTool(s.Tool); err != nil {
+		return err
+	}
+	if s.Key == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "key",
+			conditionalFields: []string{"tool"},
+		}
+	}
+	return nil
+}
+
+func validateSigningTool(tool *string) error {
+	if tool == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "tool",
+		}
+	}
+	if !slices.Contains(signature.Tools, signature.Tool(aws.StringValue(tool))) {
+		return fmt.Errorf(`"tool" must be one of %s`, english.WordSeries(quoteStringSlice(signatureTools()), "or"))
+	}
+	return nil
+}
+
+func signatureTools() []string {
+	tools := make([]string, len(signature.Tools))
+	for i, tool := range signature.Tools {
+		tools[i] = string(tool)
+	}
+	return tools
+}
+
+// validate returns nil if ImageRepository is configured correctly.
+func (r ImageRepository) validate() error {
+	if err := r.Lifecycle.validate(); err != nil {
+		return fmt.Errorf(`validate "lifecycle": %w`, err)
+	}
+	if err := r.Scan.validate(); err != nil {
+		return fmt.Errorf(`validate "scan": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if RepositoryLifecycle is configured correctly.
+func (l RepositoryLifecycle) validate() error {
+	if l.KeepLast != nil && aws.IntValue(l.KeepLast) < 1 {
+		return errors.New(`"keep_last" must be at least 1`)
+	}
+	if l.UntaggedExpiryDays != nil && aws.IntValue(l.UntaggedExpiryDays) < 1 {
+		return errors.New(`"untagged_expiry_days" must be at least 1`)
+	}
+	return nil
+}
+
+// validate returns nil if ImageScan is configured correctly.
+func (s ImageScan) validate() error {
+	if s.FailOn != nil {
+		if !slices.Contains(ecr.FindingSeverities, strings.ToUpper(aws.StringValue(s.FailOn))) {
+			return fmt.Errorf(`"fail_on" must be one of %s`, english.WordSeries(ecr.FindingSeverities, "or"))
+		}
+		if s.OnPush != nil && !aws.BoolValue(s.OnPush) {
+			return errors.New(`"on_push" must be true if "fail_on" is specified`)
+		}
+	}
+	if s.Timeout != nil && *s.Timeout <= 0 {
+		return errors.New(`"timeout" must be greater than 0`)
+	}
+	if s.Timeout != nil && s.FailOn == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "fail_on",
+			conditionalFields: []string{"timeout"},
+		}
+	}
 	return nil
 }
 
@@ -816,7 +1208,24 @@ func (b BuildArgsOrString) validate() error {
 }
 
 // validate returns nil if DockerBuildArgs is configured correctly.
-func (DockerBuildArgs) validate() error {
+func (b DockerBuildArgs) validate() error {
+	for id, secret := range b.Secrets {
+		if err := secret.validate(); err != nil {
+			return fmt.Errorf(`validate "secrets[%s]": %w`, id, err)
+		}
+	}
+	return nil
+}
+
+// validate returns nil if BuildSecret is configured correctly.
+func (s BuildSecret) validate() error {
+	if (s.Env == nil) == (s.File == nil) {
+		return &errFieldMutualExclusive{
+			firstField:  "env",
+			secondField: "file",
+			mustExist:   true,
+		}
+	}
 	return nil
 }
 
@@ -1114,6 +1523,76 @@ func (t TaskConfig) validate() error {
 			return fmt.Errorf("environment file %s must have a %s file extension", envFile, envFileExt)
 		}
 	}
+	if err = t.Config.validate(); err != nil {
+		return fmt.Errorf(`validate "config": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if AppConfig is configured correctly.
+func (c AppConfig) validate() error {
+	if c.IsEmpty() {
+		if !c.Deployment.isEmpty() {
+			return &errFieldMustBeSpecified{
+				missingField:      "profiles",
+				conditionalFields: []string{"deployment"},
+			}
+		}
+		return nil
+	}
+	for _, name := range c.ProfileNames() {
+		if !appConfigNameRegexp.MatchString(name) {
+			return fmt.Errorf("profile name %q can only contain letters, numbers, underscores, and hyphens", name)
+		}
+		profile := c.Profiles[name]
+		if profile == nil {
+			return fmt.Errorf(`validate profile %q: %w`, name, &errFieldMustBeSpecified{
+				missingField: "path",
+			})
+		}
+		if err := profile.validate(); err != nil {
+			return fmt.Errorf(`validate profile %q: %w`, name, err)
+		}
+	}
+	if err := c.Deployment.validate(); err != nil {
+		return fmt.Errorf(`validate "deployment": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if AppConfigProfile is configured correctly.
+func (p AppConfigProfile) validate() error {
+	if p.Path == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "path",
+		}
+	}
+	if ext := strings.ToLower(filepath.Ext(aws.StringValue(p.Path))); !slices.Contains(appConfigFileExts, ext) {
+		return fmt.Errorf("configuration file %s must have one of the file extensions %s", aws.StringValue(p.Path), english.WordSeries(appConfigFileExts, "or"))
+	}
+	if p.Type != nil && !slices.Contains(appConfigProfileTypes, aws.StringValue(p.Type)) {
+		return fmt.Errorf(`invalid "type" %q, must be one of %s`, aws.StringValue(p.Type), english.WordSeries(appConfigProfileTypes, "or"))
+	}
+	return nil
+}
+
+// validate returns nil if AppConfigDeployment is configured correctly.
+func (d AppConfigDeployment) validate() error {
+	if d.isEmpty() {
+		return nil
+	}
+	if d.Strategy != nil && !slices.Contains(appConfigStrategies, aws.StringValue(d.Strategy)) {
+		return fmt.Errorf(`invalid "strategy" %q, must be one of %s`, aws.StringValue(d.Strategy), english.WordSeries(appConfigStrategies, "or"))
+	}
+	if d.Duration != nil && aws.StringValue(d.Strategy) != AppConfigStrategyLinear {
+		return fmt.Errorf(`"duration" can only be specified when "strategy" is %q`, AppConfigStrategyLinear)
+	}
+	if d.Duration != nil && (*d.Duration < time.Minute || *d.Duration > 24*time.Hour) {
+		return errors.New(`"duration" must be between 1m and 24h`)
+	}
+	if d.BakeTime != nil && (*d.BakeTime < 0 || *d.BakeTime > 24*time.Hour) {
+		return errors.New(`"bake_time" must be between 0m and 24h`)
+	}
 	return nil
 }
 
@@ -1125,6 +1604,9 @@ func (p PlatformArgsOrString) validate() error {
 	if !p.PlatformArgs.isEmpty() {
 		return p.PlatformArgs.validate()
 	}
+	if len(p.PlatformStrings) > 0 {
+		return validatePlatformStrings(p.PlatformStrings)
+	}
 	if p.PlatformString != nil {
 		return p.PlatformString.validate()
 	}
@@ -1152,6 +1634,34 @@ func (p PlatformArgs) validate() error {
 	return fmt.Errorf("platform pair %s is invalid: fields ('osfamily', 'architecture') must be one of %s", p.String(), prettyValidPlatforms)
 }
 
+// validatePlatformStrings returns nil if the platforms to build a manifest list for are configured correctly.
+func validatePlatformStrings(platforms []PlatformString) error {
+	for _, platform := range platforms {
+		if err := platform.validate(); err != nil {
+			return err
+		}
+	}
+	if len(platforms) == 1 {
+		return nil
+	}
+	seen := make(map[string]bool, len(platforms))
+	for _, platform := range platforms {
+		args := strings.Split(strings.ToLower(string(platform)), "/")
+		if args[0] != OSLinux {
+			return fmt.Errorf("platform '%s' is invalid: multiple platforms can only be specified for %s", platform, OSLinux)
+		}
+		arch := args[1]
+		if arch == ArchX86 {
+			arch = ArchAMD64
+		}
+		if seen[arch] {
+			return fmt.Errorf("platform '%s' is specified more than once", platform)
+		}
+		seen[arch] = true
+	}
+	return nil
+}
+
 // validate returns nil if PlatformString is configured correctly.
 func (p PlatformString) validate() error {
 	args := strings.Split(string(p), "/")
@@ -1231,6 +1741,16 @@ func (a AdvancedCount) validate() error {
 	if err := a.Memory.validate(); err != nil {
 		return fmt.Errorf(`validate "memory_percentage": %w`, err)
 	}
+	for idx, metric := range a.CustomMetrics {
+		if err := metric.validate(); err != nil {
+			return fmt.Errorf(`validate "custom_metrics[%d]": %w`, idx, err)
+		}
+	}
+	for idx, policy := range a.StepScaling {
+		if err := policy.validate(); err != nil {
+			return fmt.Errorf(`validate "step_scaling[%d]": %w`, idx, err)
+		}
+	}
 
 	return nil
 }
@@ -1308,6 +1828,134 @@ func (qs QueueScaling) validate() error {
 	return qs.Cooldown.validate()
 }
 
+// validate returns nil if CloudWatchMetric is configured correctly.
+func (m CloudWatchMetric) validate() error {
+	if m.Namespace == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "namespace",
+		}
+	}
+	if m.MetricName == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "metric_name",
+		}
+	}
+	if m.Statistic != nil && !slices.Contains(validMetricStatistics, aws.StringValue(m.Statistic)) {
+		return fmt.Errorf(`"statistic" %q must be one of %s`, aws.StringValue(m.Statistic), english.WordSeries(validMetricStatistics, "or"))
+	}
+	return nil
+}
+
+// validate returns nil if CustomMetricScaling is configured correctly.
+func (c CustomMetricScaling) validate() error {
+	if err := c.CloudWatchMetric.validate(); err != nil {
+		return err
+	}
+	if c.Value == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "value",
+		}
+	}
+	return c.Cooldown.validate()
+}
+
+// validate returns nil if StepScaling is configured correctly.
+func (s StepScaling) validate() error {
+	if err := s.CloudWatchMetric.validate(); err != nil {
+		return err
+	}
+	if s.Period != nil && !isValidAlarmPeriod(*s.Period) {
+		return fmt.Errorf(`"period" %s must be 10s, 30s or a multiple of 60s`, s.Period.String())
+	}
+	if s.EvaluationPeriods != nil && aws.IntValue(s.EvaluationPeriods) < 1 {
+		return errors.New(`"evaluation_periods" must be at least 1`)
+	}
+	if s.AdjustmentType != nil && !slices.Contains(validScalingAdjustmentTypes, aws.StringValue(s.AdjustmentType)) {
+		return fmt.Errorf(`"adjustment_type" %q must be one of %s`, aws.StringValue(s.AdjustmentType), english.WordSeries(validScalingAdjustmentTypes, "or"))
+	}
+	if len(s.Steps) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "steps",
+		}
+	}
+	isRelative := aws.StringValue(s.AdjustmentType) != scalingAdjustmentExactCapacity
+	for idx, step := range s.Steps {
+		if err := step.validate(); err != nil {
+			return fmt.Errorf(`validate "steps[%d]": %w`, idx, err)
+		}
+		if err := step.validateAdjustment(isRelative); err != nil {
+			return fmt.Errorf(`validate "steps[%d]": %w`, idx, err)
+		}
+	}
+	if err := validateDistinctThresholds(s.ScaleOutSteps(), func(step ScalingStep) float64 { return *step.Above }); err != nil {
+		return fmt.Errorf(`validate "above" thresholds: %w`, err)
+	}
+	if err := validateDistinctThresholds(s.ScaleInSteps(), func(step ScalingStep) float64 { return *step.Below }); err != nil {
+		return fmt.Errorf(`validate "below" thresholds: %w`, err)
+	}
+	if out, in := s.ScaleOutSteps(), s.ScaleInSteps(); len(out) > 0 && len(in) > 0 && *in[0].Below >= *out[0].Above {
+		return fmt.Errorf(`"below" threshold %v must be lower than "above" threshold %v`, *in[0].Below, *out[0].Above)
+	}
+	return nil
+}
+
+// validate returns nil if ScalingStep is configured correctly.
+func (s ScalingStep) validate() error {
+	if s.Above != nil && s.Below != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "above",
+			secondField: "below",
+		}
+	}
+	if s.Above == nil && s.Below == nil {
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields: []string{"above", "below"},
+		}
+	}
+	if s.Adjustment == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "adjustment",
+		}
+	}
+	return nil
+}
+
+// validateAdjustment returns nil if the adjustment of the step matches its threshold.
+// If isRelative is true, the adjustment of a scale-out step must be positive and the one of a scale-in step must be negative.
+func (s ScalingStep) validateAdjustment(isRelative bool) error {
+	adjustment := aws.IntValue(s.Adjustment)
+	if !isRelative {
+		if adjustment < 0 {
+			return fmt.Errorf(`"adjustment" %d must not be negative for an exact capacity`, adjustment)
+		}
+		return nil
+	}
+	if s.Above != nil && adjustment <= 0 {
+		return fmt.Errorf(`"adjustment" %d must be positive when "above" is specified`, adjustment)
+	}
+	if s.Below != nil && adjustment >= 0 {
+		return fmt.Errorf(`"adjustment" %d must be negative when "below" is specified`, adjustment)
+	}
+	return nil
+}
+
+// isValidAlarmPeriod returns true if the period can be used by a CloudWatch alarm.
+func isValidAlarmPeriod(period time.Duration) bool {
+	if period == 10*time.Second || period == 30*time.Second {
+		return true
+	}
+	return period >= time.Minute && period%time.Minute == 0
+}
+
+func validateDistinctThresholds(steps []ScalingStep, threshold func(ScalingStep) float64) error {
+	for i := 1; i < len(steps); i++ {
+		if threshold(steps[i]) == threshold(steps[i-1]) {
+			return fmt.Errorf("threshold %v is specified more than once", threshold(steps[i]))
+		}
+	}
+	return nil
+}
+
 // validate returns nil if Range is configured correctly.
 func (r Range) validate() error {
 	if r.IsEmpty() {
@@ -1709,6 +2357,9 @@ func (r AppRunnerInstanceConfig) validate() error {
 	if isWindowsPlatform(r.Platform) {
 		return ErrAppRunnerInvalidPlatformWindows
 	}
+	if len(r.Platform.PlatformStrings) > 1 {
+		return errors.New("App Runner services cannot build for multiple platforms")
+	}
 	// This extra check is because ARM architectures won't work for App Runner services.
 	if !r.Platform.IsEmpty() {
 		if r.Platform.Arch() != ArchAMD64 || r.Platform.Arch() != ArchX86 {
@@ -1802,9 +2453,42 @@ func (s SubscribeConfig) validate() error {
 			return fmt.Errorf(`validate "topics[%d]": %w`, ind, err)
 		}
 	}
+	for ind, table := range s.Tables {
+		if err := table.validate(); err != nil {
+			return fmt.Errorf(`validate "tables[%d]": %w`, ind, err)
+		}
+	}
+	for ind, bucket := range s.Buckets {
+		if err := bucket.validate(); err != nil {
+			return fmt.Errorf(`validate "buckets[%d]": %w`, ind, err)
+		}
+	}
 	if err := s.Queue.validate(); err != nil {
 		return fmt.Errorf(`validate "queue": %w`, err)
 	}
+	if s.Queue.FIFO.IsEnabled() && (len(s.Tables) != 0 || len(s.Buckets) != 0) {
+		return errors.New(`"tables" and "buckets" cannot be specified with a FIFO "queue"`)
+	}
+	return nil
+}
+
+// validate returns nil if TableSubscription is configured correctly.
+func (t TableSubscription) validate() error {
+	if aws.StringValue(t.Name) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "name",
+		}
+	}
+	return nil
+}
+
+// validate returns nil if BucketSubscription is configured correctly.
+func (b BucketSubscription) validate() error {
+	if aws.StringValue(b.Name) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "name",
+		}
+	}
 	return nil
 }
 
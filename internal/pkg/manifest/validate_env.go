@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudfront"
+	"github.com/aws/copilot-cli/internal/pkg/docker/signature"
 )
 
 var (
@@ -43,6 +44,9 @@ func (e EnvironmentConfig) validate() error {
 	if err := e.CDNConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "cdn": %w`, err)
 	}
+	if err := e.ImageVerification.validate(); err != nil {
+		return fmt.Errorf(`validate "image_verification": %w`, err)
+	}
 	if e.IsPublicLBIngressRestrictedToCDN() && !e.CDNEnabled() {
 		return errors.New("CDN must be enabled to limit security group ingress to CloudFront")
 	}
@@ -455,3 +459,20 @@ func areSetsEqual[T comparable](a map[T]struct{}, b map[T]struct{}) bool {
 	}
 	return true
 }
+
+// validate returns nil if EnvironmentImageVerification is configured correctly.
+func (v EnvironmentImageVerification) validate() error {
+	if v.IsEmpty() {
+		return nil
+	}
+	if err := validateSigningTool(v.Tool); err != nil {
+		return err
+	}
+	if signature.Tool(aws.StringValue(v.Tool)) == signature.ToolCosign && v.Key == nil {
+		return errors.New(`"key" must be specified if "tool" is "cosign"`)
+	}
+	if signature.Tool(aws.StringValue(v.Tool)) == signature.ToolNotation && v.Key != nil {
+		return errors.New(`"key" cannot be specified if "tool" is "notation", signatures are verified against the trust policy of notation`)
+	}
+	return nil
+}
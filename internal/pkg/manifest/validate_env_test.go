@@ -55,6 +55,22 @@ func TestEnvironmentConfig_validate(t *testing.T) {
 		in          EnvironmentConfig
 		wantedError string
 	}{
+		"error if image verification uses an unsupported tool": {
+			in: EnvironmentConfig{
+				ImageVerification: EnvironmentImageVerification{
+					Tool: aws.String("gpg"),
+				},
+			},
+			wantedError: `validate "image_verification": "tool" must be one of "notation" or "cosign"`,
+		},
+		"error if cosign verification does not specify a key": {
+			in: EnvironmentConfig{
+				ImageVerification: EnvironmentImageVerification{
+					Tool: aws.String("cosign"),
+				},
+			},
+			wantedError: `validate "image_verification": "key" must be specified if "tool" is "cosign"`,
+		},
 		"error if internal ALB subnet placement specified with adjusted vpc": {
 			in: EnvironmentConfig{
 				Network: environmentNetworkConfig{
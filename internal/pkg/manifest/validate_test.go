@@ -1380,6 +1380,310 @@ func TestPipelineManifest_validate(t *testing.T) {
 			},
 			wantedError: errors.New("pipeline name '12345678902234567890323456789042345678905234567890623456789072345678908234567890923456789010234567890' must be shorter than 100 characters"),
 		},
+		"error if paths are specified without only_changed": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Build: &Build{
+					Paths: map[string][]string{"api": {"api/**"}},
+				},
+			},
+			wantedError: errors.New(`validate "build" for pipeline "release": "only_changed" must be specified if "paths" is specified`),
+		},
+		"error if a path pattern is malformed": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Source: &Source{
+					ProviderName: GithubProviderName,
+					Properties:   map[string]interface{}{"output_artifact_format": "CODEBUILD_CLONE_REF"},
+				},
+				Build: &Build{
+					OnlyChanged: true,
+					Paths:       map[string][]string{"api": {"api/[**"}},
+				},
+			},
+			wantedError: errors.New(`validate "build" for pipeline "release": validate "paths" of workload "api": pattern "api/[**" is malformed`),
+		},
+		"error if only_changed is specified without a source that clones the repository": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Source: &Source{
+					ProviderName: GithubProviderName,
+					Properties:   map[string]interface{}{"output_artifact_format": "CODE_ZIP"},
+				},
+				Build: &Build{
+					OnlyChanged: true,
+				},
+			},
+			wantedError: errors.New(`validate "build" for pipeline "release": "only_changed" requires the source to be configured with "output_artifact_format: CODEBUILD_CLONE_REF"`),
+		},
+		"valid change-aware build": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Source: &Source{
+					ProviderName: GithubProviderName,
+					Properties:   map[string]interface{}{"output_artifact_format": "CODEBUILD_CLONE_REF"},
+				},
+				Build: &Build{
+					OnlyChanged: true,
+					Paths:       map[string][]string{"api": {"api/**", "libs/*.go"}},
+				},
+			},
+		},
+		"error if the compute size is unknown": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Build: &Build{
+					Compute: BuildCompute{Size: "huge"},
+				},
+			},
+			wantedError: errors.New(`validate "build" for pipeline "release": validate "compute": "size" "huge" must be one of "small", "medium", "large" or "2xlarge"`),
+		},
+		"error if the size is not supported by an ARM build": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Build: &Build{
+					Compute: BuildCompute{Type: BuildComputeTypeARM, Size: BuildComputeSizeMedium},
+				},
+			},
+			wantedError: errors.New(`validate "build" for pipeline "release": validate "compute": size "medium" is not supported by type "ARM_CONTAINER": must be "small" or "large"`),
+		},
+		"error if a GPU build is not large": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Build: &Build{
+					Compute: BuildCompute{Type: BuildComputeTypeLinuxGPU},
+				},
+			},
+			wantedError: errors.New(`validate "build" for pipeline "release": validate "compute": "size: large" must be specified if "type: LINUX_GPU_CONTAINER" is specified`),
+		},
+		"error if the timeout is out of range": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Build: &Build{
+					Timeout: aws.Int(1),
+				},
+			},
+			wantedError: errors.New(`validate "build" for pipeline "release": "timeout" must be between 5 and 2160 minutes`),
+		},
+		"error if the cache is unknown": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Build: &Build{
+					Cache: "efs",
+				},
+			},
+			wantedError: errors.New(`validate "build" for pipeline "release": "cache" "efs" must be one of "local", "s3" or "none"`),
+		},
+		"error if a secret is imported from a stack": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Build: &Build{
+					Secrets: map[string]Secret{
+						"DB_PASSWORD": {from: StringOrFromCFN{FromCFN: fromCFN{Name: aws.String("stack-DBPassword")}}},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "build" for pipeline "release": validate "secrets": secret "DB_PASSWORD" cannot be imported from a CloudFormation stack in a build`),
+		},
+		"valid build configuration": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Build: &Build{
+					Compute:    BuildCompute{Type: BuildComputeTypeARM, Size: BuildComputeSizeLarge},
+					Timeout:    aws.Int(120),
+					Privileged: aws.Bool(false),
+					Cache:      BuildCacheS3,
+					VPC:        BuildVPC{Environment: "test"},
+					Variables:  map[string]string{"GOFLAGS": "-mod=vendor"},
+					Secrets: map[string]Secret{
+						"GITHUB_TOKEN": {from: StringOrFromCFN{Plain: aws.String("/copilot/release/github-token")}},
+					},
+				},
+			},
+		},
+		"error if the platform is unknown": {
+			Pipeline: Pipeline{
+				Name:     "release",
+				Platform: "Jenkins",
+			},
+			wantedError: errors.New(`validate "platform" for pipeline "release": platform "Jenkins" must be one of "CodePipeline", "GitHubActions" or "GitLabCI"`),
+		},
+		"error if GitHub Actions track a CodeCommit source": {
+			Pipeline: Pipeline{
+				Name:     "release",
+				Platform: PipelinePlatformGitHubActions,
+				Source:   &Source{ProviderName: CodeCommitProviderName},
+			},
+			wantedError: errors.New(`validate "platform" for pipeline "release": platform GitHubActions requires a GitHub source`),
+		},
+		"error if GitLab CI tracks a GitHub source": {
+			Pipeline: Pipeline{
+				Name:     "release",
+				Platform: PipelinePlatformGitLabCI,
+				Source:   &Source{ProviderName: GithubProviderName},
+			},
+			wantedError: errors.New(`validate "platform" for pipeline "release": platform GitLabCI requires a GitLab source`),
+		},
+		"error if CodePipeline tracks a GitLab source": {
+			Pipeline: Pipeline{
+				Name:   "release",
+				Source: &Source{ProviderName: GitLabProviderName},
+			},
+			wantedError: errors.New(`validate "platform" for pipeline "release": a GitLab source requires platform GitLabCI`),
+		},
+		"error if a workflow specifies previews": {
+			Pipeline: Pipeline{
+				Name:     "release",
+				Platform: PipelinePlatformGitHubActions,
+				Source:   &Source{ProviderName: GithubProviderName},
+				Previews: &Previews{Environment: "test"},
+			},
+			wantedError: errors.New(`validate "platform" for pipeline "release": "previews" cannot be specified with platform GitHubActions`),
+		},
+		"error if a workflow specifies notifications": {
+			Pipeline: Pipeline{
+				Name:     "release",
+				Platform: PipelinePlatformGitLabCI,
+				Source:   &Source{ProviderName: GitLabProviderName},
+				Notifications: []PipelineNotification{
+					{Emails: []string{"team@example.com"}},
+				},
+			},
+			wantedError: errors.New(`validate "platform" for pipeline "release": "notifications" cannot be specified with platform GitLabCI`),
+		},
+		"error if a workflow rolls back a failed stage": {
+			Pipeline: Pipeline{
+				Name:     "release",
+				Platform: PipelinePlatformGitHubActions,
+				Source:   &Source{ProviderName: GithubProviderName},
+				Stages: []PipelineStage{
+					{Name: "prod", OnFailure: PipelineStageOnFailureRollback},
+				},
+			},
+			wantedError: errors.New(`validate "platform" for pipeline "release": "on_failure" of stage "prod" cannot be specified with platform GitHubActions`),
+		},
+		"error if a workflow deploys waves": {
+			Pipeline: Pipeline{
+				Name:     "release",
+				Platform: PipelinePlatformGitLabCI,
+				Source:   &Source{ProviderName: GitLabProviderName},
+				Stages:   []PipelineStage{{Name: "test"}},
+				Waves:    [][]string{{"test"}},
+			},
+			wantedError: errors.New(`validate "platform" for pipeline "release": "waves" cannot be specified with platform GitLabCI`),
+		},
+		"valid GitLab CI workflow": {
+			Pipeline: Pipeline{
+				Name:     "release",
+				Platform: PipelinePlatformGitLabCI,
+				Source:   &Source{ProviderName: GitLabProviderName},
+			},
+		},
+		"error if previews don't specify the environment": {
+			Pipeline: Pipeline{
+				Name:     "release",
+				Previews: &Previews{ShareVPC: true},
+			},
+			wantedError: errors.New(`validate "previews" for pipeline "release": "environment" must be specified`),
+		},
+		"error if previews use a GitHub access token": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Source: &Source{
+					ProviderName: GithubProviderName,
+					Properties: map[string]interface{}{
+						"repository":          "https://github.com/aws/phonetool",
+						"access_token_secret": "github-token",
+					},
+				},
+				Previews: &Previews{Environment: "test"},
+			},
+			wantedError: errors.New(`validate "previews" for pipeline "release": previews require a GitHub source with a connection instead of an access token`),
+		},
+		"error if previews of a CodeCommit source specify an access token": {
+			Pipeline: Pipeline{
+				Name:     "release",
+				Source:   &Source{ProviderName: CodeCommitProviderName},
+				Previews: &Previews{Environment: "test", AccessTokenSecret: "token"},
+			},
+			wantedError: errors.New(`validate "previews" for pipeline "release": "access_token_secret" cannot be specified with a CodeCommit source`),
+		},
+		"error if previews of a GitHub source don't specify trusted actors": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Source: &Source{
+					ProviderName: GithubProviderName,
+					Properties: map[string]interface{}{
+						"repository": "https://github.com/aws/phonetool",
+					},
+				},
+				Previews: &Previews{Environment: "test"},
+			},
+			wantedError: errors.New(`validate "previews" for pipeline "release": "trusted_actors" must be specified`),
+		},
+		"error if previews of a CodeCommit source specify trusted actors": {
+			Pipeline: Pipeline{
+				Name:     "release",
+				Source:   &Source{ProviderName: CodeCommitProviderName},
+				Previews: &Previews{Environment: "test", TrustedActors: []string{"1234"}},
+			},
+			wantedError: errors.New(`validate "previews" for pipeline "release": "trusted_actors" cannot be specified with a CodeCommit source`),
+		},
+		"valid previews": {
+			Pipeline: Pipeline{
+				Name:   "release",
+				Source: &Source{ProviderName: BitbucketProviderName},
+				Previews: &Previews{
+					Environment:       "test",
+					ShareVPC:          true,
+					AccessTokenSecret: "bitbucket-token",
+					TrustedActors:     []string{"{a1b2c3d4-0000-0000-0000-000000000000}"},
+				},
+			},
+		},
+		"error if a notification has an unknown event": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Notifications: []PipelineNotification{
+					{Events: []string{"started"}, Emails: []string{"oncall@example.com"}},
+				},
+			},
+			wantedError: errors.New(`validate "notifications[0]" for pipeline "release": validate "events": event "started" must be one of "stage_failed", "approval_needed" or "succeeded"`),
+		},
+		"error if a notification has no target": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Notifications: []PipelineNotification{
+					{Events: []string{PipelineEventApprovalNeeded}},
+				},
+			},
+			wantedError: errors.New(`validate "notifications[0]" for pipeline "release": must specify at least one of "sns_topics", "emails" or "chatbot"`),
+		},
+		"error if a notification targets an invalid chatbot channel": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Notifications: []PipelineNotification{
+					{Chatbot: []string{"arn:aws:sns:us-west-2:123456789012:deploys"}},
+				},
+			},
+			wantedError: errors.New(`validate "notifications[0]" for pipeline "release": validate "chatbot": "arn:aws:sns:us-west-2:123456789012:deploys" is not an AWS Chatbot Slack or Microsoft Teams channel configuration ARN`),
+		},
+		"valid notifications": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Notifications: []PipelineNotification{
+					{
+						Events:  []string{PipelineEventApprovalNeeded},
+						Emails:  []string{"oncall@example.com"},
+						Chatbot: []string{"arn:aws:chatbot::123456789012:chat-configuration/slack-channel/deploys"},
+					},
+					{
+						SNSTopics: []string{"arn:aws:sns:us-west-2:123456789012:deploys"},
+						Chatbot:   []string{"arn:aws:chatbot::123456789012:chat-configuration/microsoft-teams-channel/deploys"},
+					},
+				},
+			},
+		},
 		"should validate pipeline stages": {
 			Pipeline: Pipeline{
 				Name: "release",
@@ -1414,6 +1718,98 @@ func TestPipelineManifest_validate(t *testing.T) {
 			},
 			wantedError: errors.New(`validate stage "test" for pipeline "release": "buildspec" must be specified`),
 		},
+		"error if the action of a failed stage is unknown": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name:      "test",
+						OnFailure: "retry",
+					},
+				},
+			},
+			wantedError: errors.New(`validate stage "test" for pipeline "release": "on_failure" "retry" must be one of "rollback"`),
+		},
+		"valid stage that rolls back on failure": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name:      "prod",
+						OnFailure: PipelineStageOnFailureRollback,
+						PostDeployments: PrePostDeployments{
+							"smoke": &PrePostDeployment{
+								BuildspecPath: "copilot/pipelines/release/buildspecs/smoke.yml",
+							},
+						},
+					},
+				},
+			},
+		},
+		"error if a wave is empty": {
+			Pipeline: Pipeline{
+				Name:   "release",
+				Stages: []PipelineStage{{Name: "test"}},
+				Waves:  [][]string{{"test"}, {}},
+			},
+			wantedError: errors.New(`validate "waves" for pipeline "release": wave 2 must contain at least one stage`),
+		},
+		"error if a wave deploys an unknown stage": {
+			Pipeline: Pipeline{
+				Name:   "release",
+				Stages: []PipelineStage{{Name: "test"}},
+				Waves:  [][]string{{"test", "prod"}},
+			},
+			wantedError: errors.New(`validate "waves" for pipeline "release": stage "prod" of wave 1 is not in the stages of the pipeline`),
+		},
+		"error if a stage is in two waves": {
+			Pipeline: Pipeline{
+				Name:   "release",
+				Stages: []PipelineStage{{Name: "test"}, {Name: "prod"}},
+				Waves:  [][]string{{"test"}, {"prod", "test"}},
+			},
+			wantedError: errors.New(`validate "waves" for pipeline "release": stage "test" is in both wave 1 and wave 2`),
+		},
+		"error if a stage is not in a wave": {
+			Pipeline: Pipeline{
+				Name:   "release",
+				Stages: []PipelineStage{{Name: "test"}, {Name: "prod"}},
+				Waves:  [][]string{{"test"}},
+			},
+			wantedError: errors.New(`validate "waves" for pipeline "release": stage "prod" must be in a wave`),
+		},
+		"error if the stages of a wave fail differently": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{Name: "us-east-1-prod", OnFailure: PipelineStageOnFailureRollback},
+					{Name: "eu-west-1-prod"},
+				},
+				Waves: [][]string{{"us-east-1-prod", "eu-west-1-prod"}},
+			},
+			wantedError: errors.New(`validate "waves" for pipeline "release": stages "us-east-1-prod" and "eu-west-1-prod" of wave 1 must have the same "on_failure"`),
+		},
+		"success with a wave of many stages": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{Name: "us-east-1-production"}, {Name: "us-east-2-production"}, {Name: "us-west-1-production"},
+					{Name: "us-west-2-production"}, {Name: "eu-west-1-production"}, {Name: "eu-central-1-production"},
+					{Name: "ap-south-1-production"}, {Name: "ap-northeast-1-production"},
+				},
+				Waves: [][]string{{"us-east-1-production", "us-east-2-production", "us-west-1-production", "us-west-2-production",
+					"eu-west-1-production", "eu-central-1-production", "ap-south-1-production", "ap-northeast-1-production"}},
+			},
+		},
+		"valid waves": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{Name: "us-east-1-prod"}, {Name: "eu-west-1-prod"}, {Name: "ap-south-1-prod"},
+				},
+				Waves: [][]string{{"us-east-1-prod"}, {"eu-west-1-prod", "ap-south-1-prod"}},
+			},
+		},
 		"should validate pipeline deployments": {
 			Pipeline: Pipeline{
 				Name: "release",
@@ -1548,6 +1944,124 @@ func TestImage_validate(t *testing.T) {
 
 			wantedErrorMsgPrefix: `validate "depends_on":`,
 		},
+		"error if repository is specified with location": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Location: aws.String("mockLocation"),
+				},
+				Repository: ImageRepository{
+					Lifecycle: RepositoryLifecycle{
+						KeepLast: aws.Int(10),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`"build" must be specified if "repository" is specified`),
+		},
+		"error if keep_last is not positive": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Repository: ImageRepository{
+					Lifecycle: RepositoryLifecycle{
+						KeepLast: aws.Int(0),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "repository": validate "lifecycle": "keep_last" must be at least 1`),
+		},
+		"error if fail_on is not a severity": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Repository: ImageRepository{
+					Scan: ImageScan{
+						FailOn: aws.String("severe"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "repository": validate "scan": "fail_on" must be one of CRITICAL, HIGH, MEDIUM, LOW, INFORMATIONAL or UNDEFINED`),
+		},
+		"error if fail_on is specified without scanning on push": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Repository: ImageRepository{
+					Scan: ImageScan{
+						OnPush: aws.Bool(false),
+						FailOn: aws.String("high"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "repository": validate "scan": "on_push" must be true if "fail_on" is specified`),
+		},
+		"error if timeout is specified without fail_on": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Repository: ImageRepository{
+					Scan: ImageScan{
+						Timeout: durationp(time.Minute),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "repository": validate "scan": "fail_on" must be specified if "timeout" is specified`),
+		},
+		"error if signing is specified with location": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Location: aws.String("mockLocation"),
+				},
+				Signing: ImageSigning{
+					Tool: aws.String("notation"),
+					Key:  aws.String("arn:aws:signer:us-west-2:123456789012:/signing-profiles/copilot"),
+				},
+			},
+			wantedError: fmt.Errorf(`"build" must be specified if "signing" is specified`),
+		},
+		"error if signing does not specify a key": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Signing: ImageSigning{
+					Tool: aws.String("cosign"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "signing": "key" must be specified if "tool" is specified`),
+		},
+		"valid repository configuration": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Repository: ImageRepository{
+					Lifecycle: RepositoryLifecycle{
+						KeepLast:           aws.Int(100),
+						UntaggedExpiryDays: aws.Int(7),
+					},
+					Scan: ImageScan{
+						FailOn:  aws.String("high"),
+						Timeout: durationp(10 * time.Minute),
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2102,6 +2616,16 @@ func TestTaskConfig_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("environment file foo must have a .env file extension"),
 		},
+		"error if fail to validate config": {
+			TaskConfig: TaskConfig{
+				Config: AppConfig{
+					Profiles: map[string]*AppConfigProfile{
+						"flags": {},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "config": `,
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2121,6 +2645,113 @@ func TestTaskConfig_validate(t *testing.T) {
 	}
 }
 
+func TestAppConfig_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     AppConfig
+		wanted string
+	}{
+		"ok if empty": {},
+		"error if deployment is specified without profiles": {
+			in: AppConfig{
+				Deployment: AppConfigDeployment{
+					Strategy: aws.String("linear"),
+				},
+			},
+			wanted: `"profiles" must be specified if "deployment" is specified`,
+		},
+		"error if profile name is invalid": {
+			in: AppConfig{
+				Profiles: map[string]*AppConfigProfile{
+					"feature flags": {Path: aws.String("flags.json")},
+				},
+			},
+			wanted: `profile name "feature flags" can only contain letters, numbers, underscores, and hyphens`,
+		},
+		"error if profile path is missing": {
+			in: AppConfig{
+				Profiles: map[string]*AppConfigProfile{
+					"flags": {Type: aws.String("feature-flags")},
+				},
+			},
+			wanted: `validate profile "flags": "path" must be specified`,
+		},
+		"error if profile file is not JSON or YAML": {
+			in: AppConfig{
+				Profiles: map[string]*AppConfigProfile{
+					"flags": {Path: aws.String("flags.txt")},
+				},
+			},
+			wanted: `validate profile "flags": configuration file flags.txt must have one of the file extensions .json, .yml or .yaml`,
+		},
+		"error if profile type is invalid": {
+			in: AppConfig{
+				Profiles: map[string]*AppConfigProfile{
+					"flags": {Path: aws.String("flags.json"), Type: aws.String("toggles")},
+				},
+			},
+			wanted: `validate profile "flags": invalid "type" "toggles", must be one of freeform or feature-flags`,
+		},
+		"error if deployment strategy is invalid": {
+			in: AppConfig{
+				Profiles: map[string]*AppConfigProfile{
+					"flags": {Path: aws.String("flags.json")},
+				},
+				Deployment: AppConfigDeployment{
+					Strategy: aws.String("canary"),
+				},
+			},
+			wanted: `validate "deployment": invalid "strategy" "canary", must be one of all-at-once or linear`,
+		},
+		"error if duration is specified without a linear strategy": {
+			in: AppConfig{
+				Profiles: map[string]*AppConfigProfile{
+					"flags": {Path: aws.String("flags.json")},
+				},
+				Deployment: AppConfigDeployment{
+					Duration: durationp(10 * time.Minute),
+				},
+			},
+			wanted: `validate "deployment": "duration" can only be specified when "strategy" is "linear"`,
+		},
+		"error if duration is too long": {
+			in: AppConfig{
+				Profiles: map[string]*AppConfigProfile{
+					"flags": {Path: aws.String("flags.json")},
+				},
+				Deployment: AppConfigDeployment{
+					Strategy: aws.String("linear"),
+					Duration: durationp(25 * time.Hour),
+				},
+			},
+			wanted: `validate "deployment": "duration" must be between 1m and 24h`,
+		},
+		"ok with a linear deployment": {
+			in: AppConfig{
+				Profiles: map[string]*AppConfigProfile{
+					"flags":    {Path: aws.String("config/flags.yml"), Type: aws.String("feature-flags")},
+					"settings": {Path: aws.String("config/settings.json")},
+				},
+				Deployment: AppConfigDeployment{
+					Strategy: aws.String("linear"),
+					Duration: durationp(10 * time.Minute),
+					BakeTime: durationp(5 * time.Minute),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.validate()
+
+			if tc.wanted != "" {
+				require.EqualError(t, gotErr, tc.wanted)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
 func TestPlatformArgsOrString_validate(t *testing.T) {
 	testCases := map[string]struct {
 		in     PlatformArgsOrString
@@ -2171,6 +2802,21 @@ func TestPlatformArgsOrString_validate(t *testing.T) {
 		"return nil if platform string valid": {
 			in: PlatformArgsOrString{PlatformString: (*PlatformString)(aws.String("linux/amd64"))},
 		},
+		"error if a platform of the list is invalid": {
+			in:     PlatformArgsOrString{PlatformStrings: []PlatformString{"linux/amd64", "linux"}},
+			wanted: fmt.Errorf("platform 'linux' must be in the format [OS]/[Arch]"),
+		},
+		"error if a list of platforms includes windows": {
+			in:     PlatformArgsOrString{PlatformStrings: []PlatformString{"linux/amd64", "windows/amd64"}},
+			wanted: fmt.Errorf("platform 'windows/amd64' is invalid: multiple platforms can only be specified for linux"),
+		},
+		"error if a list of platforms has duplicate architectures": {
+			in:     PlatformArgsOrString{PlatformStrings: []PlatformString{"linux/amd64", "linux/x86_64"}},
+			wanted: fmt.Errorf("platform 'linux/x86_64' is specified more than once"),
+		},
+		"return nil if a list of platforms is valid": {
+			in: PlatformArgsOrString{PlatformStrings: []PlatformString{"linux/amd64", "linux/arm64"}},
+		},
 		"return nil if platform args valid": {
 			in: PlatformArgsOrString{
 				PlatformArgs: PlatformArgs{
@@ -2336,7 +2982,7 @@ func TestAdvancedCount_validate(t *testing.T) {
 				CPU:          mockConfig,
 				workloadType: manifestinfo.LoadBalancedWebServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify one, not both, of "spot" and "range/cpu_percentage/memory_percentage/requests/response_time"`),
+			wantedError: fmt.Errorf(`must specify one, not both, of "spot" and "range/cpu_percentage/memory_percentage/requests/response_time/custom_metrics/step_scaling"`),
 		},
 		"error if fail to validate range": {
 			AdvancedCount: AdvancedCount{
@@ -2354,7 +3000,7 @@ func TestAdvancedCount_validate(t *testing.T) {
 				},
 				workloadType: manifestinfo.LoadBalancedWebServiceType,
 			},
-			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage", "requests" or "response_time" are specified`),
+			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage", "requests", "response_time", "custom_metrics" or "step_scaling" are specified`),
 		},
 		"error if range is specified but no autoscaling fields are specified for a Load Balanced Web Service": {
 			AdvancedCount: AdvancedCount{
@@ -2363,7 +3009,7 @@ func TestAdvancedCount_validate(t *testing.T) {
 				},
 				workloadType: manifestinfo.LoadBalancedWebServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests" or "response_time" if "range" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests", "response_time", "custom_metrics" or "step_scaling" if "range" is specified`),
 		},
 		"error if range is specified but no autoscaling fields are specified for a Backend Service": {
 			AdvancedCount: AdvancedCount{
@@ -2372,7 +3018,7 @@ func TestAdvancedCount_validate(t *testing.T) {
 				},
 				workloadType: manifestinfo.BackendServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests" or "response_time" if "range" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests", "response_time", "custom_metrics" or "step_scaling" if "range" is specified`),
 		},
 		"error if range is specified but no autoscaling fields are specified for a Worker Service": {
 			AdvancedCount: AdvancedCount{
@@ -2381,42 +3027,42 @@ func TestAdvancedCount_validate(t *testing.T) {
 				},
 				workloadType: manifestinfo.WorkerServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage" or "queue_delay" if "range" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "queue_delay", "custom_metrics" or "step_scaling" if "range" is specified`),
 		},
 		"error if cooldown is specified but no autoscaling fields are specified for a Load Balanced Web Service": {
 			AdvancedCount: AdvancedCount{
 				Cooldown:     mockCooldown,
 				workloadType: manifestinfo.LoadBalancedWebServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests" or "response_time" if "cooldown" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests", "response_time", "custom_metrics" or "step_scaling" if "cooldown" is specified`),
 		},
 		"error if cooldown is specified but no autoscaling fields are specified for a Backend Service": {
 			AdvancedCount: AdvancedCount{
 				Cooldown:     mockCooldown,
 				workloadType: manifestinfo.BackendServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests" or "response_time" if "cooldown" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests", "response_time", "custom_metrics" or "step_scaling" if "cooldown" is specified`),
 		},
 		"error if cooldown is specified but no autoscaling fields are specified for a Worker Service": {
 			AdvancedCount: AdvancedCount{
 				Cooldown:     mockCooldown,
 				workloadType: manifestinfo.WorkerServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage" or "queue_delay" if "cooldown" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "queue_delay", "custom_metrics" or "step_scaling" if "cooldown" is specified`),
 		},
 		"error if range is missing when autoscaling fields are set for Backend Service": {
 			AdvancedCount: AdvancedCount{
 				CPU:          mockConfig,
 				workloadType: manifestinfo.BackendServiceType,
 			},
-			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage", "requests" or "response_time" are specified`),
+			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage", "requests", "response_time", "custom_metrics" or "step_scaling" are specified`),
 		},
 		"error if range is missing when autoscaling fields are set for Worker Service": {
 			AdvancedCount: AdvancedCount{
 				CPU:          mockConfig,
 				workloadType: manifestinfo.WorkerServiceType,
 			},
-			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage" or "queue_delay" are specified`),
+			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage", "queue_delay", "custom_metrics" or "step_scaling" are specified`),
 		},
 		"wrap error from queue_delay on failure": {
 			AdvancedCount: AdvancedCount{
@@ -2465,6 +3111,76 @@ func TestAdvancedCount_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "memory_percentage": `,
 		},
+		"valid when range and custom metrics are specified": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				CustomMetrics: []CustomMetricScaling{
+					{
+						CloudWatchMetric: CloudWatchMetric{
+							Namespace:  aws.String("Kafka"),
+							MetricName: aws.String("ConsumerLag"),
+						},
+						Value: aws.Float64(1000),
+					},
+				},
+				workloadType: manifestinfo.WorkerServiceType,
+			},
+		},
+		"error if range is missing when step scaling is specified": {
+			AdvancedCount: AdvancedCount{
+				StepScaling: []StepScaling{
+					{
+						CloudWatchMetric: CloudWatchMetric{
+							Namespace:  aws.String("MyApp"),
+							MetricName: aws.String("ActiveConnections"),
+						},
+						Steps: []ScalingStep{
+							{
+								Above:      aws.Float64(500),
+								Adjustment: aws.Int(1),
+							},
+						},
+					},
+				},
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
+			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage", "requests", "response_time", "custom_metrics" or "step_scaling" are specified`),
+		},
+		"error if a custom metric is not valid": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				CustomMetrics: []CustomMetricScaling{
+					{
+						CloudWatchMetric: CloudWatchMetric{
+							Namespace: aws.String("Kafka"),
+						},
+					},
+				},
+				workloadType: manifestinfo.WorkerServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "custom_metrics[0]": "metric_name" must be specified`),
+		},
+		"error if a step scaling policy is not valid": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				StepScaling: []StepScaling{
+					{
+						CloudWatchMetric: CloudWatchMetric{
+							Namespace:  aws.String("MyApp"),
+							MetricName: aws.String("ActiveConnections"),
+						},
+					},
+				},
+				workloadType: manifestinfo.BackendServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "step_scaling[0]": "steps" must be specified`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2552,6 +3268,161 @@ func TestQueueScaling_validate(t *testing.T) {
 	}
 }
 
+func TestCustomMetricScaling_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     CustomMetricScaling
+		wanted error
+	}{
+		"should return an error if namespace is not specified": {
+			in: CustomMetricScaling{
+				CloudWatchMetric: CloudWatchMetric{
+					MetricName: aws.String("ConsumerLag"),
+				},
+				Value: aws.Float64(100),
+			},
+			wanted: errors.New(`"namespace" must be specified`),
+		},
+		"should return an error if the statistic is invalid": {
+			in: CustomMetricScaling{
+				CloudWatchMetric: CloudWatchMetric{
+					Namespace:  aws.String("Kafka"),
+					MetricName: aws.String("ConsumerLag"),
+					Statistic:  aws.String("p99"),
+				},
+				Value: aws.Float64(100),
+			},
+			wanted: errors.New(`"statistic" "p99" must be one of Average, Minimum, Maximum, SampleCount or Sum`),
+		},
+		"should return an error if value is not specified": {
+			in: CustomMetricScaling{
+				CloudWatchMetric: CloudWatchMetric{
+					Namespace:  aws.String("Kafka"),
+					MetricName: aws.String("ConsumerLag"),
+				},
+			},
+			wanted: errors.New(`"value" must be specified`),
+		},
+		"valid custom metric": {
+			in: CustomMetricScaling{
+				CloudWatchMetric: CloudWatchMetric{
+					Namespace:  aws.String("Kafka"),
+					MetricName: aws.String("ConsumerLag"),
+					Dimensions: map[string]string{
+						"ConsumerGroup": "orders",
+					},
+					Statistic: aws.String("Maximum"),
+				},
+				Value: aws.Float64(100),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStepScaling_validate(t *testing.T) {
+	mockMetric := CloudWatchMetric{
+		Namespace:  aws.String("MyApp"),
+		MetricName: aws.String("ActiveConnections"),
+	}
+	testCases := map[string]struct {
+		in     StepScaling
+		wanted error
+	}{
+		"should return an error if the period is invalid": {
+			in: StepScaling{
+				CloudWatchMetric: mockMetric,
+				Period:           durationp(90 * time.Second),
+				Steps: []ScalingStep{
+					{Above: aws.Float64(100), Adjustment: aws.Int(1)},
+				},
+			},
+			wanted: errors.New(`"period" 1m30s must be 10s, 30s or a multiple of 60s`),
+		},
+		"should return an error if the adjustment type is invalid": {
+			in: StepScaling{
+				CloudWatchMetric: mockMetric,
+				AdjustmentType:   aws.String("Double"),
+				Steps: []ScalingStep{
+					{Above: aws.Float64(100), Adjustment: aws.Int(1)},
+				},
+			},
+			wanted: errors.New(`"adjustment_type" "Double" must be one of ChangeInCapacity, PercentChangeInCapacity or ExactCapacity`),
+		},
+		"should return an error if a step sets both above and below": {
+			in: StepScaling{
+				CloudWatchMetric: mockMetric,
+				Steps: []ScalingStep{
+					{Above: aws.Float64(100), Below: aws.Float64(10), Adjustment: aws.Int(1)},
+				},
+			},
+			wanted: errors.New(`validate "steps[0]": must specify one, not both, of "above" and "below"`),
+		},
+		"should return an error if a scale-out step removes capacity": {
+			in: StepScaling{
+				CloudWatchMetric: mockMetric,
+				Steps: []ScalingStep{
+					{Above: aws.Float64(100), Adjustment: aws.Int(1)},
+					{Above: aws.Float64(200), Adjustment: aws.Int(-1)},
+				},
+			},
+			wanted: errors.New(`validate "steps[1]": "adjustment" -1 must be positive when "above" is specified`),
+		},
+		"should return an error if thresholds are duplicated": {
+			in: StepScaling{
+				CloudWatchMetric: mockMetric,
+				Steps: []ScalingStep{
+					{Below: aws.Float64(10), Adjustment: aws.Int(-1)},
+					{Below: aws.Float64(10), Adjustment: aws.Int(-2)},
+				},
+			},
+			wanted: errors.New(`validate "below" thresholds: threshold 10 is specified more than once`),
+		},
+		"should return an error if scale-in and scale-out thresholds overlap": {
+			in: StepScaling{
+				CloudWatchMetric: mockMetric,
+				Steps: []ScalingStep{
+					{Above: aws.Float64(100), Adjustment: aws.Int(1)},
+					{Below: aws.Float64(100), Adjustment: aws.Int(-1)},
+				},
+			},
+			wanted: errors.New(`"below" threshold 100 must be lower than "above" threshold 100`),
+		},
+		"valid exact capacity steps": {
+			in: StepScaling{
+				CloudWatchMetric: mockMetric,
+				AdjustmentType:   aws.String("ExactCapacity"),
+				Period:           durationp(30 * time.Second),
+				Steps: []ScalingStep{
+					{Above: aws.Float64(1000), Adjustment: aws.Int(10)},
+					{Above: aws.Float64(100), Adjustment: aws.Int(4)},
+					{Below: aws.Float64(10), Adjustment: aws.Int(1)},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestIntRangeBand_validate(t *testing.T) {
 	testCases := map[string]struct {
 		IntRangeBand IntRangeBand
@@ -3315,6 +4186,40 @@ func TestSubscribeConfig_validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `validate "topics[0]": `,
 		},
+		"error if a table subscription has no name": {
+			config: SubscribeConfig{
+				Tables: []TableSubscription{{}},
+			},
+			wantedErrorPrefix: `validate "tables[0]": "name" must be specified`,
+		},
+		"error if a bucket subscription has no name": {
+			config: SubscribeConfig{
+				Buckets: []BucketSubscription{{}},
+			},
+			wantedErrorPrefix: `validate "buckets[0]": "name" must be specified`,
+		},
+		"error if tables are consumed into a FIFO queue": {
+			config: SubscribeConfig{
+				Tables: []TableSubscription{{Name: aws.String("orders")}},
+				Queue: SQSQueue{
+					FIFO: FIFOAdvanceConfigOrBool{Enable: aws.Bool(true)},
+				},
+			},
+			wantedErrorPrefix: `"tables" and "buckets" cannot be specified with a FIFO "queue"`,
+		},
+		"ok with table and bucket subscriptions": {
+			config: SubscribeConfig{
+				Tables: []TableSubscription{
+					{
+						Name: aws.String("orders"),
+						FilterPattern: map[string]interface{}{
+							"eventName": []interface{}{"INSERT"},
+						},
+					},
+				},
+				Buckets: []BucketSubscription{{Name: aws.String("uploads")}},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -3924,6 +4829,28 @@ func TestDeploymentConfig_validate(t *testing.T) {
 			deployConfig: DeploymentConfig{
 				RollbackAlarms: BasicToUnion[[]string, AlarmArgs]([]string{"alarmName"})},
 		},
+		"error if pre-deployment task has no command": {
+			deployConfig: DeploymentConfig{
+				PreDeployTask: PreDeployTask{
+					Image: aws.String("nginx"),
+				}},
+			wanted: `validate "pre_deploy_task": "command" must be specified if "image" or "timeout" are specified`,
+		},
+		"error if pre-deployment task timeout is not positive": {
+			deployConfig: DeploymentConfig{
+				PreDeployTask: PreDeployTask{
+					Command: CommandOverride{String: aws.String("bin/migrate")},
+					Timeout: durationp(0),
+				}},
+			wanted: `validate "pre_deploy_task": "timeout" must be greater than 0`,
+		},
+		"ok if pre-deployment task has a command": {
+			deployConfig: DeploymentConfig{
+				PreDeployTask: PreDeployTask{
+					Command: CommandOverride{StringSlice: []string{"bin/migrate", "up"}},
+					Timeout: durationp(5 * time.Minute),
+				}},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -4402,6 +5329,45 @@ func TestImageLocationOrBuild_validate(t *testing.T) {
 				Location: aws.String("mockLocation"),
 			},
 		},
+		"should return error if a build secret has both env and file": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Secrets: map[string]BuildSecret{
+							"gh_token": {
+								Env:  aws.String("GITHUB_TOKEN"),
+								File: aws.String("./token"),
+							},
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "build": validate "secrets[gh_token]": must specify one of "env" and "file"`),
+		},
+		"should return error if a build secret has neither env nor file": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Secrets: map[string]BuildSecret{
+							"gh_token": {},
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "build": validate "secrets[gh_token]": must specify one of "env" and "file"`),
+		},
+		"return nil if build secrets are valid": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Secrets: map[string]BuildSecret{
+							"gh_token": {Env: aws.String("GITHUB_TOKEN")},
+							"npmrc":    {File: aws.String("./.npmrc")},
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
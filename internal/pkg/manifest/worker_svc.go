@@ -59,13 +59,29 @@ type WorkerServiceConfig struct {
 
 // SubscribeConfig represents the configurable options for setting up subscriptions.
 type SubscribeConfig struct {
-	Topics []TopicSubscription `yaml:"topics"`
-	Queue  SQSQueue            `yaml:"queue"`
+	Topics  []TopicSubscription  `yaml:"topics"`
+	Tables  []TableSubscription  `yaml:"tables"`
+	Buckets []BucketSubscription `yaml:"buckets"`
+	Queue   SQSQueue             `yaml:"queue"`
 }
 
 // IsEmpty returns empty if the struct has all zero members.
 func (s *SubscribeConfig) IsEmpty() bool {
-	return s.Topics == nil && s.Queue.IsEmpty()
+	return s.Topics == nil && s.Tables == nil && s.Buckets == nil && s.Queue.IsEmpty()
+}
+
+// TableSubscription represents the configurable options for consuming the stream of a DynamoDB table
+// created with "storage init".
+type TableSubscription struct {
+	Name          *string                `yaml:"name"`
+	FilterPattern map[string]interface{} `yaml:"filter_pattern"`
+}
+
+// BucketSubscription represents the configurable options for consuming the event notifications of an S3 bucket
+// created with "storage init".
+type BucketSubscription struct {
+	Name          *string                `yaml:"name"`
+	FilterPattern map[string]interface{} `yaml:"filter_pattern"`
 }
 
 // TopicSubscription represents the configurable options for setting up a SNS Topic Subscription.
@@ -285,6 +301,31 @@ func (s *WorkerService) BuildArgs(contextDir string) (map[string]*DockerBuildArg
 	return buildArgs(contextDir, buildArgsPerContainer, s.Sidecars)
 }
 
+// ImageRepository returns the configuration of the repository that stores the images built for the service.
+func (s *WorkerService) ImageRepository() ImageRepository {
+	return s.ImageConfig.Image.Repository
+}
+
+// ImageSigning returns the configuration to sign the images built for the service.
+func (s *WorkerService) ImageSigning() ImageSigning {
+	return s.ImageConfig.Image.Signing
+}
+
+// ImageLocation returns the location of the image of the main container, if the service does not build it.
+func (s *WorkerService) ImageLocation() string {
+	return s.ImageConfig.Image.GetLocation()
+}
+
+// SidecarImageLocations returns the image location of each sidecar that the service does not build, keyed by sidecar name.
+func (s *WorkerService) SidecarImageLocations() map[string]string {
+	return sidecarImageLocations(s.Sidecars)
+}
+
+// PreDeployTask returns the one-off task to run before the service is updated.
+func (s *WorkerService) PreDeployTask() PreDeployTask {
+	return s.DeployConfig.PreDeployTask
+}
+
 // EnvFiles returns the locations of all env files against the ws root directory.
 // This method returns a map[string]string where the keys are container names
 // and the values are either env file paths or empty strings.
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
@@ -131,6 +132,61 @@ type Image struct {
 	Credentials          *string           `yaml:"credentials"`     // ARN of the secret containing the private repository credentials.
 	DockerLabels         map[string]string `yaml:"labels,flow"`     // Apply Docker labels to the container at runtime.
 	DependsOn            DependsOn         `yaml:"depends_on,flow"` // Add any sidecar dependencies.
+	Repository           ImageRepository   `yaml:"repository"`      // Configure the repository of images built from a Dockerfile.
+	Signing              ImageSigning      `yaml:"signing"`         // Sign the images built from a Dockerfile.
+}
+
+// ImageSigning configures the tool and key that sign the images pushed by Copilot.
+type ImageSigning struct {
+	Tool *string `yaml:"tool"` // "notation" or "cosign".
+	Key  *string `yaml:"key"`  // ARN of an AWS Signer signing profile for notation, or a key reference for cosign.
+}
+
+// IsEmpty returns true if signing is not configured.
+func (s ImageSigning) IsEmpty() bool {
+	return s.Tool == nil && s.Key == nil
+}
+
+// ImageRepository configures the Copilot-managed ECR repository that stores the images built for the workload.
+type ImageRepository struct {
+	Lifecycle RepositoryLifecycle `yaml:"lifecycle"`
+	Scan      ImageScan           `yaml:"scan"`
+}
+
+// IsEmpty returns true if the repository is not configured.
+func (r ImageRepository) IsEmpty() bool {
+	return r.Lifecycle.IsEmpty() && r.Scan.IsEmpty()
+}
+
+// RepositoryLifecycle holds the rules that expire images from the repository.
+type RepositoryLifecycle struct {
+	KeepLast           *int `yaml:"keep_last"`            // Expire the oldest images beyond this number of images.
+	UntaggedExpiryDays *int `yaml:"untagged_expiry_days"` // Expire untagged images pushed more than this number of days ago.
+}
+
+// IsEmpty returns true if no lifecycle rule is configured.
+func (l RepositoryLifecycle) IsEmpty() bool {
+	return l.KeepLast == nil && l.UntaggedExpiryDays == nil
+}
+
+// ImageScan configures the vulnerability scan of the images pushed to the repository.
+type ImageScan struct {
+	OnPush  *bool          `yaml:"on_push"`
+	FailOn  *string        `yaml:"fail_on"` // Minimum severity of the findings that fail a deployment.
+	Timeout *time.Duration `yaml:"timeout"` // Maximum time to wait for the scan results.
+}
+
+// IsEmpty returns true if scanning is not configured.
+func (s ImageScan) IsEmpty() bool {
+	return s.OnPush == nil && s.FailOn == nil && s.Timeout == nil
+}
+
+// ScanOnPush returns true if images are scanned on push, which is required to check the findings on deployments.
+func (s ImageScan) ScanOnPush() *bool {
+	if s.OnPush == nil && s.FailOn != nil {
+		return aws.Bool(true)
+	}
+	return s.OnPush
 }
 
 // ImageLocationOrBuild represents the docker build arguments and location of the existing image.
@@ -195,6 +251,9 @@ func (i *ImageLocationOrBuild) BuildConfig(rootDirectory string) *DockerBuildArg
 		Args:       i.args(),
 		Target:     i.target(),
 		CacheFrom:  i.cacheFrom(),
+		CacheTo:    i.Build.BuildArgs.CacheTo,
+		Secrets:    i.secrets(rootDirectory),
+		SSH:        i.Build.BuildArgs.SSH,
 	}
 }
 
@@ -237,6 +296,22 @@ func (i *ImageLocationOrBuild) cacheFrom() []string {
 	return i.Build.BuildArgs.CacheFrom
 }
 
+// secrets returns the build secrets, if they exist, with the paths of files relative to the workspace root.
+// Otherwise it returns nil.
+func (i *ImageLocationOrBuild) secrets(rootDirectory string) map[string]BuildSecret {
+	if i.Build.BuildArgs.Secrets == nil {
+		return nil
+	}
+	secrets := make(map[string]BuildSecret, len(i.Build.BuildArgs.Secrets))
+	for id, secret := range i.Build.BuildArgs.Secrets {
+		if file := aws.StringValue(secret.File); file != "" && !filepath.IsAbs(file) {
+			secret.File = aws.String(filepath.Join(rootDirectory, file))
+		}
+		secrets[id] = secret
+	}
+	return secrets
+}
+
 // ImageOverride holds fields that override Dockerfile image defaults.
 type ImageOverride struct {
 	EntryPoint EntryPointOverride `yaml:"entrypoint"`
@@ -397,15 +472,28 @@ type DockerBuildArgs struct {
 	Args       map[string]string `yaml:"args,omitempty"`
 	Target     *string           `yaml:"target,omitempty"`
 	CacheFrom  []string          `yaml:"cache_from,omitempty"`
+	// CacheTo is either true to export the build cache to the workload's repository,
+	// or a list of cache export destinations.
+	CacheTo Union[*bool, []string] `yaml:"cache_to,omitempty"`
+	Secrets map[string]BuildSecret `yaml:"secrets,omitempty"`
+	SSH     StringSliceOrString    `yaml:"ssh,omitempty"`
 }
 
 func (b *DockerBuildArgs) isEmpty() bool {
-	if b.Context == nil && b.Dockerfile == nil && b.Args == nil && b.Target == nil && b.CacheFrom == nil {
+	if b.Context == nil && b.Dockerfile == nil && b.Args == nil && b.Target == nil && b.CacheFrom == nil &&
+		b.CacheTo.IsZero() && b.Secrets == nil && b.SSH.isEmpty() {
 		return true
 	}
 	return false
 }
 
+// BuildSecret represents a secret exposed to the RUN instructions of a Dockerfile with "--mount=type=secret".
+// The secret is read either from an environment variable or from a file.
+type BuildSecret struct {
+	Env  *string `yaml:"env,omitempty"`
+	File *string `yaml:"file,omitempty"`
+}
+
 // PublishConfig represents the configurable options for setting up publishers.
 type PublishConfig struct {
 	Topics []Topic `yaml:"topics"`
@@ -711,16 +799,25 @@ func (v *vpcConfig) isEmpty() bool {
 }
 
 // PlatformArgsOrString is a custom type which supports unmarshaling yaml which
-// can either be of type string or type PlatformArgs.
+// can either be of type string, a list of strings, or type PlatformArgs.
 type PlatformArgsOrString struct {
 	*PlatformString
-	PlatformArgs PlatformArgs
+	PlatformStrings []PlatformString // Build a manifest list for multiple platforms.
+	PlatformArgs    PlatformArgs
 }
 
 // UnmarshalYAML overrides the default YAML unmarshaling logic for the PlatformArgsOrString
 // struct, allowing it to perform more complex unmarshaling behavior.
 // This method implements the yaml.Unmarshaler (v3) interface.
 func (p *PlatformArgsOrString) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.SequenceNode {
+		if err := value.Decode(&p.PlatformStrings); err != nil {
+			return errUnmarshalPlatformOpts
+		}
+		p.PlatformString = nil
+		p.PlatformArgs = PlatformArgs{}
+		return nil
+	}
 	if err := value.Decode(&p.PlatformArgs); err != nil {
 		var yamlTypeErr *yaml.TypeError
 		if !errors.As(err, &yamlTypeErr) {
@@ -739,7 +836,11 @@ func (p *PlatformArgsOrString) UnmarshalYAML(value *yaml.Node) error {
 }
 
 // OS returns the operating system family.
+// If multiple platforms are specified, it returns the operating system of the first one.
 func (p *PlatformArgsOrString) OS() string {
+	if len(p.PlatformStrings) > 0 {
+		return strings.ToLower(strings.Split(string(p.PlatformStrings[0]), "/")[0])
+	}
 	if p := aws.StringValue((*string)(p.PlatformString)); p != "" {
 		args := strings.Split(p, "/")
 		return strings.ToLower(args[0])
@@ -748,7 +849,12 @@ func (p *PlatformArgsOrString) OS() string {
 }
 
 // Arch returns the architecture of PlatformArgsOrString.
+// If multiple platforms are specified, it returns the architecture of the first one, which tasks run on.
 func (p *PlatformArgsOrString) Arch() string {
+	if len(p.PlatformStrings) > 0 {
+		args := strings.Split(string(p.PlatformStrings[0]), "/")
+		return strings.ToLower(args[len(args)-1])
+	}
 	if p := aws.StringValue((*string)(p.PlatformString)); p != "" {
 		args := strings.Split(p, "/")
 		return strings.ToLower(args[1])
@@ -756,6 +862,24 @@ func (p *PlatformArgsOrString) Arch() string {
 	return strings.ToLower(aws.StringValue(p.PlatformArgs.Arch))
 }
 
+// Platforms returns the platforms to build a manifest list for, in the format <os>/<arch>.
+// It returns nil unless multiple platforms are specified.
+func (p *PlatformArgsOrString) Platforms() []string {
+	if len(p.PlatformStrings) < 2 {
+		return nil
+	}
+	platforms := make([]string, len(p.PlatformStrings))
+	for i, platform := range p.PlatformStrings {
+		args := strings.Split(strings.ToLower(string(platform)), "/")
+		arch := args[len(args)-1]
+		if arch == ArchX86 {
+			arch = ArchAMD64 // Manifest lists refer to x86_64 images as "amd64".
+		}
+		platforms[i] = platformString(args[0], arch)
+	}
+	return platforms
+}
+
 // PlatformArgs represents the specifics of a target OS.
 type PlatformArgs struct {
 	OSFamily *string `yaml:"osfamily,omitempty"`
@@ -772,7 +896,7 @@ func (p *PlatformArgs) String() string {
 
 // IsEmpty returns if the platform field is empty.
 func (p *PlatformArgsOrString) IsEmpty() bool {
-	return p.PlatformString == nil && p.PlatformArgs.isEmpty()
+	return p.PlatformString == nil && len(p.PlatformStrings) == 0 && p.PlatformArgs.isEmpty()
 }
 
 func (p *PlatformArgs) isEmpty() bool {
@@ -4,9 +4,13 @@
 package manifest
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -43,6 +47,18 @@ const (
 	ECSRecreateRollingUpdateStrategy = "recreate"
 )
 
+// AppConfig related settings.
+const (
+	AppConfigProfileTypeFreeform     = "freeform"
+	AppConfigProfileTypeFeatureFlags = "feature-flags"
+
+	AppConfigStrategyAllAtOnce = "all-at-once"
+	AppConfigStrategyLinear    = "linear"
+
+	appConfigContentTypeJSON = "application/json"
+	appConfigContentTypeYAML = "application/x-yaml"
+)
+
 // Platform related settings.
 var (
 	defaultPlatform     = platformString(OSLinux, ArchAMD64)
@@ -106,16 +122,31 @@ type DeploymentControllerConfig struct {
 type DeploymentConfig struct {
 	DeploymentControllerConfig `yaml:",inline"`
 	RollbackAlarms             Union[[]string, AlarmArgs] `yaml:"rollback_alarms"`
+	PreDeployTask              PreDeployTask              `yaml:"pre_deploy_task"`
 }
 
 // WorkerDeploymentConfig represents the deployment strategies for a worker service.
 type WorkerDeploymentConfig struct {
 	DeploymentControllerConfig `yaml:",inline"`
 	WorkerRollbackAlarms       Union[[]string, WorkerAlarmArgs] `yaml:"rollback_alarms"`
+	PreDeployTask              PreDeployTask                    `yaml:"pre_deploy_task"`
+}
+
+// PreDeployTask represents a one-off task that runs with the new image before the service is updated,
+// such as a database migration.
+type PreDeployTask struct {
+	Command CommandOverride `yaml:"command"`
+	Image   *string         `yaml:"image"`
+	Timeout *time.Duration  `yaml:"timeout"`
+}
+
+// IsEmpty returns true if the pre-deployment task is not configured.
+func (t *PreDeployTask) IsEmpty() bool {
+	return (*StringSliceOrString)(&t.Command).isEmpty() && t.Image == nil && t.Timeout == nil
 }
 
 func (d *DeploymentConfig) isEmpty() bool {
-	return d == nil || (d.DeploymentControllerConfig.isEmpty() && d.RollbackAlarms.IsZero())
+	return d == nil || (d.DeploymentControllerConfig.isEmpty() && d.RollbackAlarms.IsZero() && d.PreDeployTask.IsEmpty())
 }
 
 func (d *DeploymentControllerConfig) isEmpty() bool {
@@ -123,7 +154,7 @@ func (d *DeploymentControllerConfig) isEmpty() bool {
 }
 
 func (w *WorkerDeploymentConfig) isEmpty() bool {
-	return w == nil || (w.DeploymentControllerConfig.Rolling == nil && w.WorkerRollbackAlarms.IsZero())
+	return w == nil || (w.DeploymentControllerConfig.Rolling == nil && w.WorkerRollbackAlarms.IsZero() && w.PreDeployTask.IsEmpty())
 }
 
 // ExposedPort will hold the port mapping configuration.
@@ -157,6 +188,80 @@ type TaskConfig struct {
 	EnvFile        *string              `yaml:"env_file"`
 	Secrets        map[string]Secret    `yaml:"secrets"`
 	Storage        Storage              `yaml:"storage"`
+	Config         AppConfig            `yaml:"config"`
+}
+
+// AppConfig represents the configuration profiles of a workload hosted in AWS AppConfig.
+type AppConfig struct {
+	Profiles   map[string]*AppConfigProfile `yaml:"profiles"` // NOTE: keep the pointers because `mergo` doesn't automatically deep merge map's value unless it's a pointer type.
+	Deployment AppConfigDeployment          `yaml:"deployment"`
+}
+
+// AppConfigProfile represents a hosted configuration profile whose content is read from a local JSON or YAML file.
+type AppConfigProfile struct {
+	Path *string `yaml:"path"`
+	Type *string `yaml:"type"`
+}
+
+// AppConfigDeployment represents how new versions of the configuration profiles are rolled out.
+type AppConfigDeployment struct {
+	Strategy *string        `yaml:"strategy"`
+	Duration *time.Duration `yaml:"duration"`
+	BakeTime *time.Duration `yaml:"bake_time"`
+}
+
+// IsEmpty returns true if no configuration profiles are defined.
+func (c *AppConfig) IsEmpty() bool {
+	return len(c.Profiles) == 0
+}
+
+// ProfileNames returns the names of the configuration profiles in alphabetical order.
+func (c *AppConfig) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ContentType returns the MIME type of the configuration profile content.
+func (p *AppConfigProfile) ContentType() string {
+	if p.isYAML() && !p.IsFeatureFlags() {
+		return appConfigContentTypeYAML
+	}
+	return appConfigContentTypeJSON
+}
+
+// IsFeatureFlags returns true if the configuration profile holds feature flags instead of freeform configuration.
+func (p *AppConfigProfile) IsFeatureFlags() bool {
+	return aws.StringValue(p.Type) == AppConfigProfileTypeFeatureFlags
+}
+
+// Content returns the content to host for the configuration profile given the content of its local file.
+// AppConfig only accepts feature flags in JSON, so feature flags written in YAML are converted to JSON.
+func (p *AppConfigProfile) Content(file []byte) ([]byte, error) {
+	if !p.isYAML() || !p.IsFeatureFlags() {
+		return file, nil
+	}
+	var flags interface{}
+	if err := yaml.Unmarshal(file, &flags); err != nil {
+		return nil, fmt.Errorf("unmarshal feature flags %s: %w", aws.StringValue(p.Path), err)
+	}
+	out, err := json.Marshal(flags)
+	if err != nil {
+		return nil, fmt.Errorf("convert feature flags %s to JSON: %w", aws.StringValue(p.Path), err)
+	}
+	return out, nil
+}
+
+func (p *AppConfigProfile) isYAML() bool {
+	ext := strings.ToLower(filepath.Ext(aws.StringValue(p.Path)))
+	return ext == ".yml" || ext == ".yaml"
+}
+
+func (d AppConfigDeployment) isEmpty() bool {
+	return d.Strategy == nil && d.Duration == nil && d.BakeTime == nil
 }
 
 // Variable represents an identifier for the value of an environment variable.
@@ -196,6 +301,16 @@ func (t *TaskConfig) ContainerPlatform() string {
 	return platformString(t.Platform.OS(), t.Platform.Arch())
 }
 
+// ContainerPlatforms returns the platforms to build a manifest list for, if multiple platforms are specified.
+func (t *TaskConfig) ContainerPlatforms() []string {
+	return t.Platform.Platforms()
+}
+
+// AppConfig returns the configuration profiles of the task hosted in AWS AppConfig.
+func (t *TaskConfig) AppConfig() AppConfig {
+	return t.Config
+}
+
 // IsWindows returns whether or not the service is building with a Windows OS.
 func (t TaskConfig) IsWindows() bool {
 	return isWindowsPlatform(t.Platform)
@@ -325,6 +440,19 @@ func (cfg *SidecarConfig) ImageURI() (string, bool) {
 	return "", false
 }
 
+func sidecarImageLocations(sidecars map[string]*SidecarConfig) map[string]string {
+	locations := make(map[string]string)
+	for name, sidecar := range sidecars {
+		if sidecar == nil {
+			continue
+		}
+		if uri, ok := sidecar.ImageURI(); ok {
+			locations[name] = uri
+		}
+	}
+	return locations
+}
+
 // OverrideRule holds the manifest overriding rule for CloudFormation template.
 type OverrideRule struct {
 	Path  string    `yaml:"path"`
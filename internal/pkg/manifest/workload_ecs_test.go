@@ -423,6 +423,54 @@ func TestLogging_IsEmpty(t *testing.T) {
 	}
 }
 
+func TestAppConfigProfile_Content(t *testing.T) {
+	testCases := map[string]struct {
+		in   AppConfigProfile
+		file string
+
+		wantedContentType string
+		wantedContent     string
+	}{
+		"freeform JSON": {
+			in:                AppConfigProfile{Path: aws.String("settings.json")},
+			file:              `{"timeout": 30}`,
+			wantedContentType: "application/json",
+			wantedContent:     `{"timeout": 30}`,
+		},
+		"freeform YAML": {
+			in:                AppConfigProfile{Path: aws.String("settings.yml")},
+			file:              "timeout: 30\n",
+			wantedContentType: "application/x-yaml",
+			wantedContent:     "timeout: 30\n",
+		},
+		"feature flags in YAML are converted to JSON": {
+			in: AppConfigProfile{
+				Path: aws.String("flags.yaml"),
+				Type: aws.String("feature-flags"),
+			},
+			file: `version: "1"
+flags:
+  checkout:
+    name: checkout
+values:
+  checkout:
+    enabled: true
+`,
+			wantedContentType: "application/json",
+			wantedContent:     `{"flags":{"checkout":{"name":"checkout"}},"values":{"checkout":{"enabled":true}},"version":"1"}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.Content([]byte(tc.file))
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, string(got))
+			require.Equal(t, tc.wantedContentType, tc.in.ContentType())
+		})
+	}
+}
+
 func TestLogging_LogImage(t *testing.T) {
 	testCases := map[string]struct {
 		inputImage  *string
@@ -287,6 +287,38 @@ func TestBuildArgs_UnmarshalYAML(t *testing.T) {
 				BuildString: nil,
 			},
 		},
+		"Dockerfile with secrets, ssh and cache to build opts": {
+			inContent: []byte(`build:
+  dockerfile: path/to/Dockerfile
+  secrets:
+    gh_token:
+      env: GITHUB_TOKEN
+    npmrc:
+      file: ./.npmrc
+  ssh: default
+  cache_to: true`),
+			wantedStruct: BuildArgsOrString{
+				BuildArgs: DockerBuildArgs{
+					Dockerfile: aws.String("path/to/Dockerfile"),
+					Secrets: map[string]BuildSecret{
+						"gh_token": {Env: aws.String("GITHUB_TOKEN")},
+						"npmrc":    {File: aws.String("./.npmrc")},
+					},
+					SSH:     StringSliceOrString{String: aws.String("default")},
+					CacheTo: BasicToUnion[*bool, []string](aws.Bool(true)),
+				},
+			},
+		},
+		"Dockerfile with cache to destinations": {
+			inContent: []byte(`build:
+  cache_to:
+    - type=local,dest=/tmp/cache`),
+			wantedStruct: BuildArgsOrString{
+				BuildArgs: DockerBuildArgs{
+					CacheTo: AdvancedToUnion[*bool]([]string{"type=local,dest=/tmp/cache"}),
+				},
+			},
+		},
 		"Error if unmarshalable": {
 			inContent: []byte(`build:
   badfield: OH NOES
@@ -315,6 +347,9 @@ func TestBuildArgs_UnmarshalYAML(t *testing.T) {
 				require.Equal(t, tc.wantedStruct.BuildArgs.Args, b.Build.BuildArgs.Args)
 				require.Equal(t, tc.wantedStruct.BuildArgs.Target, b.Build.BuildArgs.Target)
 				require.Equal(t, tc.wantedStruct.BuildArgs.CacheFrom, b.Build.BuildArgs.CacheFrom)
+				require.Equal(t, tc.wantedStruct.BuildArgs.CacheTo, b.Build.BuildArgs.CacheTo)
+				require.Equal(t, tc.wantedStruct.BuildArgs.Secrets, b.Build.BuildArgs.Secrets)
+				require.Equal(t, tc.wantedStruct.BuildArgs.SSH, b.Build.BuildArgs.SSH)
 			}
 		})
 	}
@@ -340,6 +375,12 @@ func TestPlatformArgsOrString_UnmarshalYAML(t *testing.T) {
   archie: leg64`),
 			wantedError: errUnmarshalPlatformOpts,
 		},
+		"success with a list of platforms": {
+			inContent: []byte(`platform: [linux/amd64, linux/arm64]`),
+			wantedStruct: PlatformArgsOrString{
+				PlatformStrings: []PlatformString{"linux/amd64", "linux/arm64"},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -350,6 +391,7 @@ func TestPlatformArgsOrString_UnmarshalYAML(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, tc.wantedStruct.PlatformString, p.Platform.PlatformString)
+				require.Equal(t, tc.wantedStruct.PlatformStrings, p.Platform.PlatformStrings)
 				require.Equal(t, tc.wantedStruct.PlatformArgs.OSFamily, p.Platform.PlatformArgs.OSFamily)
 				require.Equal(t, tc.wantedStruct.PlatformArgs.Arch, p.Platform.PlatformArgs.Arch)
 			}
@@ -537,6 +579,36 @@ func TestSubnetListOrArgs_UnmarshalYAML(t *testing.T) {
 	}
 }
 
+func TestPlatformArgsOrString_Platforms(t *testing.T) {
+	linux := PlatformString("linux/amd64")
+	testCases := map[string]struct {
+		in     *PlatformArgsOrString
+		wanted []string
+	}{
+		"should return nil for a single platform": {
+			in: &PlatformArgsOrString{
+				PlatformString: &linux,
+			},
+		},
+		"should return nil for a list with a single platform": {
+			in: &PlatformArgsOrString{
+				PlatformStrings: []PlatformString{"linux/arm64"},
+			},
+		},
+		"should return normalized platforms for a list": {
+			in: &PlatformArgsOrString{
+				PlatformStrings: []PlatformString{"linux/X86_64", "linux/arm64"},
+			},
+			wanted: []string{"linux/amd64", "linux/arm64"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.Platforms())
+		})
+	}
+}
+
 func TestPlatformArgsOrString_OS(t *testing.T) {
 	linux := PlatformString("linux/amd64")
 	testCases := map[string]struct {
@@ -837,6 +909,30 @@ func TestBuildConfig(t *testing.T) {
 				},
 			},
 		},
+		"including secrets, ssh and cache to": {
+			inBuild: BuildArgsOrString{
+				BuildArgs: DockerBuildArgs{
+					Secrets: map[string]BuildSecret{
+						"gh_token": {Env: aws.String("GITHUB_TOKEN")},
+						"npmrc":    {File: aws.String(".npmrc")},
+						"netrc":    {File: aws.String("/home/user/.netrc")},
+					},
+					SSH:     StringSliceOrString{String: aws.String("default")},
+					CacheTo: BasicToUnion[*bool, []string](aws.Bool(true)),
+				},
+			},
+			wantedBuild: DockerBuildArgs{
+				Dockerfile: aws.String(filepath.Join(mockWsRoot, "Dockerfile")),
+				Context:    aws.String(mockWsRoot),
+				Secrets: map[string]BuildSecret{
+					"gh_token": {Env: aws.String("GITHUB_TOKEN")},
+					"npmrc":    {File: aws.String(filepath.Join(mockWsRoot, ".npmrc"))},
+					"netrc":    {File: aws.String("/home/user/.netrc")},
+				},
+				SSH:     StringSliceOrString{String: aws.String("default")},
+				CacheTo: BasicToUnion[*bool, []string](aws.Bool(true)),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
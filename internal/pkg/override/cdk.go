@@ -111,6 +111,19 @@ func (cdk *CDK) Override(body []byte) ([]byte, error) {
 	return cdk.cleanUp(out)
 }
 
+// Synth returns the CloudFormation template synthesized from the CDK application itself, without an input template.
+// Like Override, Copilot first installs any CDK dependencies as well as the toolkit itself.
+func (cdk *CDK) Synth() ([]byte, error) {
+	if err := cdk.install(); err != nil {
+		return nil, err
+	}
+	out, err := cdk.synth()
+	if err != nil {
+		return nil, err
+	}
+	return cdk.cleanUp(out)
+}
+
 func (cdk *CDK) install() error {
 	manager, err := cdk.packageManager()
 	if err != nil {
@@ -136,6 +149,10 @@ func (cdk *CDK) transform(body []byte) ([]byte, error) {
 		return nil, fmt.Errorf("write CloudFormation template body content at %s: %w", inputPath, err)
 	}
 
+	return cdk.synth()
+}
+
+func (cdk *CDK) synth() ([]byte, error) {
 	// We assume that a node_modules/ dir is present with the CDK downloaded after running "npm install".
 	// This way clients don't need to install the CDK toolkit separately.
 	cmd := cdk.exec.Command(filepath.Join("node_modules", ".bin", "cdk"), "synth", "--no-version-reporting")
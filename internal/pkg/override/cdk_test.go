@@ -395,6 +395,67 @@ Outputs:
 	})
 }
 
+func TestCDK_Synth(t *testing.T) {
+	t.Parallel()
+	t.Run("should return a wrapped error if cdk synth fails", func(t *testing.T) {
+		// GIVEN
+		cdk := WithCDK("", CDKOpts{
+			ExecWriter: new(bytes.Buffer),
+			FS:         afero.NewMemMapFs(),
+			LookPathFn: func(file string) (string, error) {
+				return "/bin/npm", nil
+			},
+			CommandFn: func(name string, args ...string) *exec.Cmd {
+				if name == filepath.Join("node_modules", ".bin", "cdk") {
+					return exec.Command("exit", "42")
+				}
+				return exec.Command("echo", "success")
+			},
+		})
+
+		// WHEN
+		_, err := cdk.Synth()
+
+		// THEN
+		require.ErrorContains(t, err, `run "exit 42"`)
+	})
+	t.Run("should synthesize the CDK app without writing an input template", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		buf := new(strings.Builder)
+		cdk := WithCDK("addons", CDKOpts{
+			ExecWriter: buf,
+			FS:         fs,
+			LookPathFn: func(file string) (string, error) {
+				if file == "npm" {
+					return "/bin/npm", nil
+				}
+				return "", &exec.Error{Name: "yarn", Err: exec.ErrNotFound}
+			},
+			CommandFn: func(name string, args ...string) *exec.Cmd {
+				if name == filepath.Join("node_modules", ".bin", "cdk") {
+					return exec.Command("printf", "Description: My addons.\nParameters:\n  App:\n    Type: String\n  BootstrapVersion:\n    Type: String\n")
+				}
+				return exec.Command("echo", strings.Join(append([]string{name}, args...), " "))
+			},
+		})
+
+		// WHEN
+		out, err := cdk.Synth()
+
+		// THEN
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), "npm install")
+		require.Equal(t, `Description: My addons using AWS Copilot and CDK.
+Parameters:
+  App:
+    Type: String
+`, string(out))
+		exists, _ := afero.Exists(fs, filepath.Join("addons", ".build", "in.yml"))
+		require.False(t, exists, "expected no input template to be written")
+	})
+}
+
 func TestScaffoldWithCDK(t *testing.T) {
 	t.Run("scaffolds files in an empty directory", func(t *testing.T) {
 		// GIVEN
@@ -0,0 +1,180 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pullrequest provides clients to inspect and comment on the pull requests of GitHub and Bitbucket repositories.
+package pullrequest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	gitHubAPIURL    = "https://api.github.com"
+	bitbucketAPIURL = "https://api.bitbucket.org/2.0"
+
+	requestTimeout = 30 * time.Second
+)
+
+type httpClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// GitHub comments on the pull requests of a GitHub repository.
+type GitHub struct {
+	repo  string // Full name of the repository, such as "aws/copilot-cli".
+	token string
+
+	baseURL string
+	client  httpClient
+}
+
+// NewGitHub returns a client that authenticates to the repository with the token.
+func NewGitHub(repo, token string) *GitHub {
+	return &GitHub{
+		repo:    repo,
+		token:   token,
+		baseURL: gitHubAPIURL,
+		client:  &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// CommentOnPullRequest posts a comment on the pull request.
+func (g *GitHub) CommentOnPullRequest(id, content string) error {
+	url := fmt.Sprintf("%s/repos/%s/issues/%s/comments", g.baseURL, g.repo, id)
+	return post(g.client, url, g.token, map[string]string{
+		"body": content,
+	}, http.Header{
+		"Accept": []string{"application/vnd.github+json"},
+	})
+}
+
+// IsFromFork returns true if the head branch of the pull request belongs to another repository than the base branch.
+func (g *GitHub) IsFromFork(id string) (bool, error) {
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s", g.baseURL, g.repo, id)
+	var pr struct {
+		Head struct {
+			Repo *struct {
+				FullName string `json:"full_name"`
+			} `json:"repo"`
+		} `json:"head"`
+		Base struct {
+			Repo struct {
+				FullName string `json:"full_name"`
+			} `json:"repo"`
+		} `json:"base"`
+	}
+	if err := get(g.client, url, g.token, &pr, http.Header{
+		"Accept": []string{"application/vnd.github+json"},
+	}); err != nil {
+		return false, err
+	}
+	// The head repository is null if the fork was deleted.
+	return pr.Head.Repo == nil || !strings.EqualFold(pr.Head.Repo.FullName, pr.Base.Repo.FullName), nil
+}
+
+// Bitbucket comments on the pull requests of a Bitbucket repository.
+type Bitbucket struct {
+	repo  string // Full name of the repository, such as "workspace/repo".
+	token string
+
+	baseURL string
+	client  httpClient
+}
+
+// NewBitbucket returns a client that authenticates to the repository with the access token.
+func NewBitbucket(repo, token string) *Bitbucket {
+	return &Bitbucket{
+		repo:    repo,
+		token:   token,
+		baseURL: bitbucketAPIURL,
+		client:  &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// CommentOnPullRequest posts a comment on the pull request.
+func (b *Bitbucket) CommentOnPullRequest(id, content string) error {
+	url := fmt.Sprintf("%s/repositories/%s/pullrequests/%s/comments", b.baseURL, b.repo, id)
+	return post(b.client, url, b.token, map[string]interface{}{
+		"content": map[string]string{
+			"raw": content,
+		},
+	}, nil)
+}
+
+// IsFromFork returns true if the source branch of the pull request belongs to another repository than the destination branch.
+func (b *Bitbucket) IsFromFork(id string) (bool, error) {
+	url := fmt.Sprintf("%s/repositories/%s/pullrequests/%s", b.baseURL, b.repo, id)
+	type repository struct {
+		FullName string `json:"full_name"`
+	}
+	var pr struct {
+		Source struct {
+			Repository *repository `json:"repository"`
+		} `json:"source"`
+		Destination struct {
+			Repository repository `json:"repository"`
+		} `json:"destination"`
+	}
+	if err := get(b.client, url, b.token, &pr, nil); err != nil {
+		return false, err
+	}
+	return pr.Source.Repository == nil || !strings.EqualFold(pr.Source.Repository.FullName, pr.Destination.Repository.FullName), nil
+}
+
+func get(client httpClient, url, token string, out interface{}, header http.Header) error {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("create request to %s: %w", url, err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("get %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("get %s: %s: %s", url, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response from %s: %w", url, err)
+	}
+	return nil
+}
+
+func post(client httpClient, url, token string, body interface{}, header http.Header) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("marshal comment: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("create request to %s: %w", url, err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post comment to %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("post comment to %s: %s: %s", url, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package pullrequest
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitHub_CommentOnPullRequest(t *testing.T) {
+	testCases := map[string]struct {
+		status int
+
+		wantedErr string
+	}{
+		"error if the comment is rejected": {
+			status:    http.StatusNotFound,
+			wantedErr: `post comment to %s/repos/aws/phonetool/issues/12/comments: 404 Not Found: {"message":"Not Found"}`,
+		},
+		"post the comment": {
+			status: http.StatusCreated,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodPost, r.Method)
+				require.Equal(t, "/repos/aws/phonetool/issues/12/comments", r.URL.Path)
+				require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+				require.Equal(t, "application/vnd.github+json", r.Header.Get("Accept"))
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, `{"body": "hello"}`, string(body))
+				w.WriteHeader(tc.status)
+				if tc.status >= http.StatusBadRequest {
+					_, _ = w.Write([]byte(`{"message":"Not Found"}`))
+				}
+			}))
+			defer server.Close()
+			gh := NewGitHub("aws/phonetool", "token")
+			gh.baseURL = server.URL
+
+			err := gh.CommentOnPullRequest("12", "hello")
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, fmt.Sprintf(tc.wantedErr, server.URL))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBitbucket_CommentOnPullRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/repositories/team/phonetool/pullrequests/7/comments", r.URL.Path)
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"content": {"raw": "hello"}}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+	bb := NewBitbucket("team/phonetool", "token")
+	bb.baseURL = server.URL
+
+	err := bb.CommentOnPullRequest("7", "hello")
+
+	require.NoError(t, err)
+}
+
+func TestGitHub_IsFromFork(t *testing.T) {
+	testCases := map[string]struct {
+		status   int
+		response string
+
+		wanted    bool
+		wantedErr string
+	}{
+		"error if the pull request can't be retrieved": {
+			status:    http.StatusNotFound,
+			response:  `{"message":"Not Found"}`,
+			wantedErr: `get %s/repos/aws/phonetool/pulls/12: 404 Not Found: {"message":"Not Found"}`,
+		},
+		"pull request from a branch of the repository": {
+			status:   http.StatusOK,
+			response: `{"head": {"repo": {"full_name": "aws/phonetool"}}, "base": {"repo": {"full_name": "aws/phonetool"}}}`,
+		},
+		"pull request from a fork": {
+			status:   http.StatusOK,
+			response: `{"head": {"repo": {"full_name": "someone/phonetool"}}, "base": {"repo": {"full_name": "aws/phonetool"}}}`,
+			wanted:   true,
+		},
+		"pull request from a deleted fork": {
+			status:   http.StatusOK,
+			response: `{"head": {"repo": null}, "base": {"repo": {"full_name": "aws/phonetool"}}}`,
+			wanted:   true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+				require.Equal(t, "/repos/aws/phonetool/pulls/12", r.URL.Path)
+				require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+			gh := NewGitHub("aws/phonetool", "token")
+			gh.baseURL = server.URL
+
+			got, err := gh.IsFromFork("12")
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, fmt.Sprintf(tc.wantedErr, server.URL))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestBitbucket_IsFromFork(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/repositories/team/phonetool/pullrequests/7", r.URL.Path)
+		require.Empty(t, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"source": {"repository": {"full_name": "someone/phonetool"}}, "destination": {"repository": {"full_name": "team/phonetool"}}}`))
+	}))
+	defer server.Close()
+	bb := NewBitbucket("team/phonetool", "")
+	bb.baseURL = server.URL
+
+	got, err := bb.IsFromFork("7")
+
+	require.NoError(t, err)
+	require.True(t, got)
+}
@@ -50,6 +50,22 @@ func (mr *MockContainerLoginBuildPusherMockRecorder) Build(ctx, args, w interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).Build), ctx, args, w)
 }
 
+// GetPlatform mocks base method.
+func (m *MockContainerLoginBuildPusher) GetPlatform() (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlatform")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPlatform indicates an expected call of GetPlatform.
+func (mr *MockContainerLoginBuildPusherMockRecorder) GetPlatform() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlatform", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).GetPlatform))
+}
+
 // IsEcrCredentialHelperEnabled mocks base method.
 func (m *MockContainerLoginBuildPusher) IsEcrCredentialHelperEnabled(uri string) bool {
 	m.ctrl.T.Helper()
@@ -98,6 +114,26 @@ func (mr *MockContainerLoginBuildPusherMockRecorder) Push(ctx, uri, w interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).Push), varargs...)
 }
 
+// PushManifestList mocks base method.
+func (m *MockContainerLoginBuildPusher) PushManifestList(ctx context.Context, uri string, w io.Writer, platforms []string, tags ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, uri, w, platforms}
+	for _, a := range tags {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PushManifestList", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PushManifestList indicates an expected call of PushManifestList.
+func (mr *MockContainerLoginBuildPusherMockRecorder) PushManifestList(ctx, uri, w, platforms interface{}, tags ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, uri, w, platforms}, tags...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushManifestList", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).PushManifestList), varargs...)
+}
+
 // MockRegistry is a mock of Registry interface.
 type MockRegistry struct {
 	ctrl     *gomock.Controller
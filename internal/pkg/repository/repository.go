@@ -19,6 +19,8 @@ type ContainerLoginBuildPusher interface {
 	Build(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) error
 	Login(uri, username, password string) error
 	Push(ctx context.Context, uri string, w io.Writer, tags ...string) (digest string, err error)
+	PushManifestList(ctx context.Context, uri string, w io.Writer, platforms []string, tags ...string) (digest string, err error)
+	GetPlatform() (os, arch string, err error)
 	IsEcrCredentialHelperEnabled(uri string) bool
 }
 
@@ -55,8 +57,16 @@ func NewWithURI(registry Registry, name, uri string) *Repository {
 	}
 }
 
-// Build build the image from Dockerfile
+// Build build the image from Dockerfile.
+// Images built for multiple platforms are only built for the platform of the docker engine, so that they can run locally.
 func (r *Repository) Build(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) (digest string, err error) {
+	if args.IsMultiPlatform() {
+		platform, err := r.localPlatform(args.Platforms)
+		if err != nil {
+			return "", err
+		}
+		args.Platform, args.Platforms = platform, nil
+	}
 	if err := r.docker.Build(ctx, args, w); err != nil {
 		return "", fmt.Errorf("build from Dockerfile at %s: %w", args.Dockerfile, err)
 	}
@@ -77,13 +87,31 @@ func (r *Repository) BuildAndPush(ctx context.Context, args *dockerengine.BuildA
 		return "", fmt.Errorf("build Dockerfile at %s: %w", args.Dockerfile, err)
 	}
 
-	digest, err = r.docker.Push(ctx, args.URI, w, args.Tags...)
+	if args.IsMultiPlatform() {
+		digest, err = r.docker.PushManifestList(ctx, args.URI, w, args.Platforms, args.Tags...)
+	} else {
+		digest, err = r.docker.Push(ctx, args.URI, w, args.Tags...)
+	}
 	if err != nil {
 		return "", fmt.Errorf("push to repo %s: %w", r.name, err)
 	}
 	return digest, nil
 }
 
+// localPlatform returns the platform among platforms that matches the docker engine, or the first one if none match.
+func (r *Repository) localPlatform(platforms []string) (string, error) {
+	os, arch, err := r.docker.GetPlatform()
+	if err != nil {
+		return "", fmt.Errorf("get docker engine platform: %w", err)
+	}
+	for _, platform := range platforms {
+		if platform == dockerengine.PlatformString(os, arch) {
+			return platform, nil
+		}
+	}
+	return platforms[0], nil
+}
+
 // repositoryURI() returns the uri of the repository.
 func (r *Repository) repositoryURI() (string, error) {
 	if r.uri != "" {
@@ -72,6 +72,67 @@ func TestRepository_Build(t *testing.T) {
 	}
 }
 
+func TestRepository_Build_MultiPlatform(t *testing.T) {
+	ctx := context.Background()
+	testCases := map[string]struct {
+		inMockDocker func(m *mocks.MockContainerLoginBuildPusher)
+
+		wantedPlatform string
+		wantedError    error
+	}{
+		"builds the platform of the docker engine": {
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().GetPlatform().Return("linux", "arm64", nil)
+			},
+			wantedPlatform: "linux/arm64",
+		},
+		"builds the first platform if none match the docker engine": {
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().GetPlatform().Return("linux", "arm", nil)
+			},
+			wantedPlatform: "linux/amd64",
+		},
+		"returns an error if the platform of the docker engine cannot be retrieved": {
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().GetPlatform().Return("", "", errors.New("some error"))
+			},
+			wantedError: errors.New("get docker engine platform: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDocker := mocks.NewMockContainerLoginBuildPusher(ctrl)
+			tc.inMockDocker(mockDocker)
+			args := &dockerengine.BuildArguments{
+				Dockerfile: "path/to/dockerfile",
+				Tags:       []string{"latest"},
+				Platforms:  []string{"linux/amd64", "linux/arm64"},
+			}
+			if tc.wantedError == nil {
+				mockDocker.EXPECT().Build(ctx, &dockerengine.BuildArguments{
+					Dockerfile: "path/to/dockerfile",
+					Tags:       []string{"latest"},
+					Platform:   tc.wantedPlatform,
+				}, gomock.Any()).Return(nil)
+			}
+			repo := &Repository{
+				name:   "my-repo",
+				docker: mockDocker,
+			}
+
+			_, err := repo.Build(ctx, args, new(strings.Builder))
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestRepository_BuildAndPush(t *testing.T) {
 	inRepoName := "my-repo"
 	inDockerfilePath := "path/to/dockerfile"
@@ -89,6 +150,7 @@ func TestRepository_BuildAndPush(t *testing.T) {
 
 	testCases := map[string]struct {
 		inURI        string
+		inPlatforms  []string
 		inMockDocker func(m *mocks.MockContainerLoginBuildPusher)
 
 		mockRegistry func(m *mocks.MockRegistry)
@@ -130,6 +192,15 @@ func TestRepository_BuildAndPush(t *testing.T) {
 			},
 			wantedDigest: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
 		},
+		"pushes a manifest list for multiple platforms": {
+			inURI:       defaultDockerArguments.URI,
+			inPlatforms: []string{"linux/amd64", "linux/arm64"},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().Build(ctx, gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().PushManifestList(ctx, mockRepoURI, gomock.Any(), []string{"linux/amd64", "linux/arm64"}, mockTag1, mockTag2, mockTag3).Return("sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", nil)
+			},
+			wantedDigest: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+		},
 		"success": {
 			mockRegistry: func(m *mocks.MockRegistry) {
 				m.EXPECT().RepositoryURI(inRepoName).Return(defaultDockerArguments.URI, nil)
@@ -167,6 +238,7 @@ func TestRepository_BuildAndPush(t *testing.T) {
 				Dockerfile: inDockerfilePath,
 				Context:    filepath.Dir(inDockerfilePath),
 				Tags:       []string{mockTag1, mockTag2, mockTag3},
+				Platforms:  tc.inPlatforms,
 			}, buf)
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
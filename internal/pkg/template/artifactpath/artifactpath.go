@@ -20,6 +20,7 @@ const (
 	s3ScriptsDirName            = "scripts"
 	s3CustomResourcesDirName    = "custom-resources"
 	s3EnvironmentsAddonsDirName = "environments"
+	s3DeploymentsDirName        = "deployments"
 )
 
 // MkdirSHA256 prefixes the key with the SHA256 hash of the contents of "manual/<hash>/key".
@@ -69,3 +70,16 @@ func EnvFiles(key string, content []byte) string {
 func CustomResource(key string, zipFile []byte) string {
 	return path.Join(s3ArtifactDirName, s3ScriptsDirName, s3CustomResourcesDirName, key, fmt.Sprintf("%x.zip", sha256.Sum256(zipFile)))
 }
+
+// WorkloadDeployments returns the prefix of the records of the deployments of a workload to an environment.
+// Example: deployments/test/frontend/.
+func WorkloadDeployments(env, workload string) string {
+	return path.Join(s3DeploymentsDirName, env, workload) + "/"
+}
+
+// WorkloadDeployment returns the path to store the record of a deployment of a workload to an environment.
+// The number is zero-padded so that the records are listed in the order of deployment.
+// Example: deployments/test/frontend/000012.json.
+func WorkloadDeployment(env, workload string, number int) string {
+	return path.Join(s3DeploymentsDirName, env, workload, fmt.Sprintf("%06d.json", number))
+}
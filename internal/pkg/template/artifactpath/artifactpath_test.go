@@ -20,3 +20,11 @@ func TestEnvironmentAddons(t *testing.T) {
 func TestEnvironmentAddonsAsset(t *testing.T) {
 	require.Equal(t, "manual/addons/environments/assets/hash", EnvironmentAddonAsset("hash"))
 }
+
+func TestWorkloadDeployments(t *testing.T) {
+	require.Equal(t, "deployments/test/frontend/", WorkloadDeployments("test", "frontend"))
+}
+
+func TestWorkloadDeployment(t *testing.T) {
+	require.Equal(t, "deployments/test/frontend/000012.json", WorkloadDeployment("test", "frontend", 12))
+}
@@ -120,6 +120,7 @@ type EnvOpts struct {
 	PermissionsBoundary  string
 	ArtifactBucketARN    string
 	ArtifactBucketKeyARN string
+	ConfigStoreTableARN  string
 
 	VPCConfig         VPCConfig
 	PublicHTTPConfig  PublicHTTPConfig
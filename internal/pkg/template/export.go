@@ -0,0 +1,40 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"path"
+)
+
+const exportTemplatesPath = "export"
+
+// ExportedStack represents a stack of an application exported as a standalone project.
+type ExportedStack struct {
+	Name       string // Name of the CloudFormation stack.
+	Region     string
+	Template   string // Path of the template relative to the project root.
+	Parameters string // Optional. Path of the parameters file relative to the project root.
+}
+
+// ExportProjectOpts holds the data to render the files of an exported project.
+type ExportProjectOpts struct {
+	AppName string
+	Stacks  []ExportedStack // Stacks in the order to deploy them.
+}
+
+// WalkExportDir walks through the export templates of the format, such as "cloudformation" or "cdk",
+// and calls fn for each parsed template file.
+func (t *Template) WalkExportDir(format string, opts ExportProjectOpts, fn WalkDirFunc) error {
+	type metadata struct {
+		ExportProjectOpts
+		CDKVersion        string
+		ConstructsVersion string
+	}
+	dir := path.Join(exportTemplatesPath, format)
+	return t.walkDir(dir, dir, metadata{
+		ExportProjectOpts: opts,
+		CDKVersion:        cdkVersion,
+		ConstructsVersion: cdkConstructsMinVersion,
+	}, fn)
+}
@@ -0,0 +1,60 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplate_WalkExportDir(t *testing.T) {
+	testCases := map[string]struct {
+		format string
+
+		wantedFiles []string
+	}{
+		"cloudformation": {
+			format:      "cloudformation",
+			wantedFiles: []string{"README.md"},
+		},
+		"cdk": {
+			format:      "cdk",
+			wantedFiles: []string{".gitignore", "README.md", "bin/app.ts", "cdk.json", "package.json", "tsconfig.json"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+			files := make(map[string]string)
+
+			// WHEN
+			err := tpl.WalkExportDir(tc.format, ExportProjectOpts{
+				AppName: "phonetool",
+				Stacks: []ExportedStack{
+					{
+						Name:       "phonetool-test",
+						Region:     "us-west-2",
+						Template:   "templates/phonetool-test.yml",
+						Parameters: "params/phonetool-test.params.json",
+					},
+				},
+			}, func(name string, content *Content) error {
+				files[name] = content.String()
+				return nil
+			})
+
+			// THEN
+			require.NoError(t, err)
+			var names []string
+			for name := range files {
+				names = append(names, name)
+			}
+			require.ElementsMatch(t, tc.wantedFiles, names)
+			require.Contains(t, files["README.md"], "phonetool-test")
+		})
+	}
+}
@@ -0,0 +1,57 @@
+#!/usr/bin/env node
+import * as cdk from 'aws-cdk-lib';
+import * as fs from 'fs';
+import * as path from 'path';
+
+// ExportedStack describes a stack listed in stacks.json.
+interface ExportedStack {
+    name: string;
+    account: string;
+    region: string;
+    template: string;
+    parameters?: string;
+    addons?: string;
+    functions?: { logicalId: string; bundle: string }[];
+}
+
+// TemplateConfiguration is the content of a parameters file.
+interface TemplateConfiguration {
+    Parameters?: { [key: string]: string };
+    Tags?: { [key: string]: string };
+}
+
+const root = path.join(__dirname, '..');
+const stacks: ExportedStack[] = JSON.parse(fs.readFileSync(path.join(root, 'stacks.json'), 'utf-8'));
+
+const app = new cdk.App();
+for (const exported of stacks) {
+    const stack = new cdk.Stack(app, exported.name, {
+        stackName: exported.name,
+        env: { account: exported.account, region: exported.region },
+    });
+    let config: TemplateConfiguration = {};
+    if (exported.parameters) {
+        config = JSON.parse(fs.readFileSync(path.join(root, exported.parameters), 'utf-8'));
+    }
+    const template = new cdk.cloudformation_include.CfnInclude(stack, 'Template', {
+        templateFile: path.join(root, exported.template),
+        parameters: config.Parameters,
+        loadNestedStacks: exported.addons ? {
+            AddonsStack: { templateFile: path.join(root, exported.addons) },
+        } : undefined,
+    });
+    // Replace the code of the custom resource functions with the bundles of the project.
+    for (const fn of exported.functions ?? []) {
+        const bundle = new cdk.aws_s3_assets.Asset(stack, `${fn.logicalId}Bundle`, {
+            path: path.join(root, fn.bundle),
+        });
+        const resource = template.getResource(fn.logicalId) as cdk.aws_lambda.CfnFunction;
+        resource.code = {
+            s3Bucket: bundle.s3BucketName,
+            s3Key: bundle.s3ObjectKey,
+        };
+    }
+    for (const [key, value] of Object.entries(config.Tags ?? {})) {
+        cdk.Tags.of(stack).add(key, value);
+    }
+}
@@ -0,0 +1,41 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const workflowCFTemplatePath = "cicd/workflow_cfn.yml"
+
+// Paths of the templates of the workflows that run pipelines outside of CodePipeline.
+const (
+	GitHubActionsWorkflowTemplatePath = "cicd/workflows/github-actions.yml"
+	GitLabCIWorkflowTemplatePath      = "cicd/workflows/gitlab-ci.yml"
+)
+
+// ParseWorkflowRole parses the CloudFormation template of the OIDC identity provider and role of a workflow
+// with the specified data object and returns its content.
+func (t *Template) ParseWorkflowRole(data interface{}) (*Content, error) {
+	return t.Parse(workflowCFTemplatePath, data)
+}
+
+// ParseWorkflow parses the GitHub Actions or GitLab CI workflow template at path with the specified data object
+// and returns its content.
+func (t *Template) ParseWorkflow(path string, data interface{}) (*Content, error) {
+	return t.Parse(path, data, withWorkflowParsingFuncs())
+}
+
+func withWorkflowParsingFuncs() ParseOption {
+	return func(t *template.Template) *template.Template {
+		return t.Funcs(map[string]interface{}{
+			"quote": strconv.Quote,
+			"lines": func(elems []string) string {
+				return strings.Join(elems, "\n")
+			},
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplate_ParseWorkflowRole(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	_ = afero.WriteFile(fs, "templates/cicd/workflow_cfn.yml", []byte(`RoleName: {{.}}`), 0644)
+	tpl := &Template{
+		fs: &mockFS{
+			Fs: fs,
+		},
+	}
+
+	// WHEN
+	c, err := tpl.ParseWorkflowRole("pipeline-phonetool-release-WorkflowRole")
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `RoleName: pipeline-phonetool-release-WorkflowRole`, c.String())
+}
+
+func TestTemplate_ParseWorkflow(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	_ = afero.WriteFile(fs, "templates/cicd/workflows/github-actions.yml", []byte(`
+- name: {{quote .Name}}
+  run: |
+{{indent 4 (lines .Commands)}}
+`), 0644)
+	tpl := &Template{
+		fs: &mockFS{
+			Fs: fs,
+		},
+	}
+
+	// WHEN
+	c, err := tpl.ParseWorkflow(GitHubActionsWorkflowTemplatePath, struct {
+		Name     string
+		Commands []string
+	}{
+		Name:     `say "hello"`,
+		Commands: []string{"make test", "echo done"},
+	})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `
+- name: "say \"hello\""
+  run: |
+    make test
+    echo done
+`, c.String())
+}
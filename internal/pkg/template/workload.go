@@ -111,6 +111,7 @@ var (
 		"vpc-connector",
 		"alb",
 		"rollback-alarms",
+		"appconfig",
 	}
 
 	// Operating systems to determine Fargate platform versions.
@@ -127,6 +128,7 @@ type WorkloadNestedStackOpts struct {
 	SecretOutputs        []string
 	PolicyOutputs        []string
 	SecurityGroupOutputs []string
+	TableStreamOutputs   []string // Outputs referenced by subscriptions to tables, that are not injected as environment variables.
 }
 
 // SidecarOpts holds configuration that's needed if the service has sidecar containers.
@@ -572,6 +574,54 @@ type AutoscalingOpts struct {
 	RespTimeCooldown   Cooldown
 	QueueDelayCooldown Cooldown
 	QueueDelay         *AutoscalingQueueDelayOpts
+	CustomMetrics      []AutoscalingCustomMetricOpts
+	StepScaling        []AutoscalingStepScalingOpts
+}
+
+// AutoscalingMetricOpts holds configuration to identify a CloudWatch metric used for Auto Scaling.
+type AutoscalingMetricOpts struct {
+	Namespace  string
+	MetricName string
+	Statistic  string
+	Dimensions []AutoscalingMetricDimension
+}
+
+// AutoscalingMetricDimension represents a name-value pair that identifies a metric.
+type AutoscalingMetricDimension struct {
+	Name  string
+	Value string
+}
+
+// AutoscalingCustomMetricOpts holds configuration for a target tracking policy on a custom metric.
+type AutoscalingCustomMetricOpts struct {
+	AutoscalingMetricOpts
+	TargetValue float64
+	Cooldown    Cooldown
+}
+
+// AutoscalingStepScalingOpts holds configuration for step scaling policies and the alarms that trigger them.
+type AutoscalingStepScalingOpts struct {
+	AutoscalingMetricOpts
+	Period            int64
+	EvaluationPeriods int
+	AdjustmentType    string
+	Cooldown          *int64
+	ScaleOut          *AutoscalingStepAlarmOpts
+	ScaleIn           *AutoscalingStepAlarmOpts
+}
+
+// AutoscalingStepAlarmOpts holds the alarm threshold and the step adjustments applied when the alarm fires.
+// The bounds of each adjustment are relative to the threshold.
+type AutoscalingStepAlarmOpts struct {
+	Threshold   float64
+	Adjustments []AutoscalingStepAdjustment
+}
+
+// AutoscalingStepAdjustment holds configuration for a single step adjustment.
+type AutoscalingStepAdjustment struct {
+	LowerBound        *float64
+	UpperBound        *float64
+	ScalingAdjustment int
 }
 
 // AliasesForHostedZone maps hosted zone IDs to aliases that belong to it.
@@ -587,6 +637,27 @@ type ObservabilityOpts struct {
 	Tracing string // The name of the vendor used for tracing.
 }
 
+// AppConfigOpts holds configuration for the configuration profiles of a workload hosted in AWS AppConfig.
+type AppConfigOpts struct {
+	Profiles []AppConfigProfile
+	Strategy AppConfigStrategy
+}
+
+// AppConfigProfile holds a hosted configuration profile and the content of its latest version.
+type AppConfigProfile struct {
+	Name        string
+	Type        string // Either "AWS.Freeform" or "AWS.AppConfig.FeatureFlags".
+	ContentType string
+	Content     string
+}
+
+// AppConfigStrategy holds the configuration of the strategy used to deploy new configuration versions.
+type AppConfigStrategy struct {
+	GrowthFactor      int
+	DurationInMinutes int
+	BakeTimeInMinutes int
+}
+
 // DeploymentConfigurationOpts holds configuration for rolling deployments.
 type DeploymentConfigurationOpts struct {
 	// The lower limit on the number of tasks that should be running during a service deployment or when a container instance is draining.
@@ -659,8 +730,10 @@ type FIFOTopicConfig struct {
 
 // SubscribeOpts holds configuration needed if the service has subscriptions.
 type SubscribeOpts struct {
-	Topics []*TopicSubscription
-	Queue  *SQSQueue
+	Topics  []*TopicSubscription
+	Tables  []*TableSubscription
+	Buckets []*BucketSubscription
+	Queue   *SQSQueue
 }
 
 // HasTopicQueues returns true if any individual subscription has a dedicated queue.
@@ -681,6 +754,19 @@ type TopicSubscription struct {
 	Queue        *SQSQueue
 }
 
+// TableSubscription holds information needed to render an EventBridge Pipe from a DynamoDB table stream to the events queue.
+type TableSubscription struct {
+	Name          string
+	StreamARN     string // JSON-encoded value or intrinsic function that resolves to the ARN of the stream.
+	FilterPattern string // JSON-encoded filter pattern of the pipe, empty if all records are consumed.
+}
+
+// BucketSubscription holds information needed to render an EventBridge rule from S3 bucket notifications to the events queue.
+type BucketSubscription struct {
+	Name         string
+	EventPattern string // JSON-encoded event pattern of the rule.
+}
+
 // SQSQueue holds information needed to render a SQS Queue in a container definition.
 type SQSQueue struct {
 	Retention       *int64
@@ -818,6 +904,7 @@ type WorkloadOpts struct {
 	DockerLabels             map[string]string
 	DependsOn                map[string]string
 	Publish                  *PublishOpts
+	AppConfig                *AppConfigOpts
 	ServiceDiscoveryEndpoint string
 	ALBEnabled               bool
 	CredentialsParameter     string
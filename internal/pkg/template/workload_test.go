@@ -66,6 +66,7 @@ func TestTemplate_ParseSvc(t *testing.T) {
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/vpc-connector.yml", []byte("vpc-connector"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/alb.yml", []byte("alb"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/rollback-alarms.yml", []byte("rollback-alarms"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/appconfig.yml", []byte("appconfig"), 0644)
 
 				return fs
 			},
@@ -102,6 +103,7 @@ func TestTemplate_ParseSvc(t *testing.T) {
   vpc-connector
   alb
   rollback-alarms
+  appconfig
 `,
 		},
 	}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
@@ -96,6 +97,42 @@ func (e *errHasExistingApplication) Error() string {
 	return fmt.Sprintf("workspace is already registered with application %s under %s", e.existingAppName, relPath)
 }
 
+// ErrMultipleApplications means the workspace is an index of several applications and none was selected.
+type ErrMultipleApplications struct {
+	IndexPath string
+	AppNames  []string
+}
+
+func (e *ErrMultipleApplications) Error() string {
+	return fmt.Sprintf("workspace index %s registers multiple applications: %s", e.IndexPath, strings.Join(e.AppNames, ", "))
+}
+
+// RecommendActions suggests steps clients can take to select an application from the workspace index.
+func (e *ErrMultipleApplications) RecommendActions() string {
+	return fmt.Sprintf("Specify the application with %s, or run the command from the directory of the application.", color.HighlightCode("--app"))
+}
+
+// empty denotes that this error represents a workspace that is not associated with a single application.
+func (_ *ErrMultipleApplications) empty() {}
+
+// ErrAppNotInIndex means the application is not registered in the workspace index.
+type ErrAppNotInIndex struct {
+	AppName   string
+	IndexPath string
+}
+
+func (e *ErrAppNotInIndex) Error() string {
+	return fmt.Sprintf("application %s is not registered in workspace index %s", e.AppName, e.IndexPath)
+}
+
+// RecommendActions suggests steps clients can take to register the application in the workspace index.
+func (e *ErrAppNotInIndex) RecommendActions() string {
+	return fmt.Sprintf("Run %s from the directory of the application to register it in the index.", color.HighlightCode("copilot app init"))
+}
+
+// empty denotes that this error represents a workspace that is not associated with the application.
+func (_ *ErrAppNotInIndex) empty() {}
+
 // IsEmptyErr returns true if the error is related to an empty workspace.
 func IsEmptyErr(err error) bool {
 	var emptyWs interface {
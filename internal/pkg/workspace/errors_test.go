@@ -28,6 +28,14 @@ func TestIsEmptyErr(t *testing.T) {
 			err:    fmt.Errorf("burrito: %w", &ErrNoAssociatedApplication{}),
 			wanted: true,
 		},
+		"should return true when ErrMultipleApplications": {
+			err:    &ErrMultipleApplications{},
+			wanted: true,
+		},
+		"should return true when ErrAppNotInIndex": {
+			err:    &ErrAppNotInIndex{},
+			wanted: true,
+		},
 		"should return false when a random error": {
 			err: errors.New("unexpected"),
 		},
@@ -20,6 +20,18 @@
 //	│   │   │   ├── buildspec.yml      (buildspec for the pipeline 'pipeline-app-beta')
 //	│   ┴   ┴   └── manifest.yml       (pipeline manifest for the pipeline 'pipeline-app-beta')
 //	└── my-service-src                 (customer service code)
+//
+// A repository can also hold several applications. Each application keeps its own copilot/ directory, and an
+// optional index at the repository root maps application names to their directories:
+//
+//	.
+//	├── copilot
+//	│   └── .workspace                 (workspace index, e.g. "applications: {api: apps/api, web: apps/web}")
+//	└── apps
+//	    ├── api
+//	    │   └── copilot                (application directory for "api")
+//	    └── web
+//	        └── copilot                (application directory for "web")
 package workspace
 
 import (
@@ -89,8 +101,27 @@ func TraverseUp(startDir string, maxLevels int, process TraverseUpProcessFn) (st
 
 // Summary is a description of what's associated with this workspace.
 type Summary struct {
-	Application string `yaml:"application"` // Name of the application.
-	Path        string `yaml:"-"`           // Absolute path to the summary file.
+	Application  string            `yaml:"application,omitempty"`  // Name of the application.
+	Applications map[string]string `yaml:"applications,omitempty"` // Directories of the applications in an index, relative to the workspace root.
+	Path         string            `yaml:"-"`                      // Absolute path to the summary file.
+}
+
+// IsIndex returns true if the summary is an index of several applications rather than a single application.
+func (s *Summary) IsIndex() bool {
+	return s.Application == "" && len(s.Applications) > 0
+}
+
+// ApplicationNames returns the sorted names of the applications registered in the summary.
+func (s *Summary) ApplicationNames() []string {
+	if !s.IsIndex() {
+		return []string{s.Application}
+	}
+	names := make([]string, 0, len(s.Applications))
+	for name := range s.Applications {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 // Workspace typically represents a Git repository where the user has its infrastructure-as-code files as well as source files.
@@ -111,7 +142,15 @@ var getWd = os.Getwd
 
 // Use returns an existing workspace, searching for a copilot/ directory from the current wd,
 // up to 5 levels above. It returns ErrWorkspaceNotFound if no copilot/ directory is found.
+// If the copilot/ directory holds a workspace index, the index must register exactly one application.
 func Use(fs afero.Fs) (*Workspace, error) {
+	return UseApp("", fs)
+}
+
+// UseApp is like Use but, when the workspace is an index of several applications, it returns the workspace of appName.
+// If the closest workspace belongs to a different application, UseApp looks for an index in the parent directories
+// that registers appName, and falls back to the closest workspace otherwise.
+func UseApp(appName string, fs afero.Fs) (*Workspace, error) {
 	workingDirAbs, err := getWd()
 	if err != nil {
 		return nil, fmt.Errorf("get working directory: %w", err)
@@ -126,11 +165,28 @@ func Use(fs afero.Fs) (*Workspace, error) {
 		return nil, err
 	}
 	ws.CopilotDirAbs = copilotDirPath
-	if _, err := ws.Summary(); err != nil {
+	summary, err := ws.Summary()
+	if err != nil {
 		// If there is an issue retrieving the summary, then the workspace is not usable.
 		return nil, err
 	}
-	return ws, nil
+	if summary.IsIndex() {
+		return ws.useIndexedApp(summary, appName)
+	}
+	if appName == "" || summary.Application == appName {
+		return ws, nil
+	}
+	index, err := ws.parentIndex()
+	if err != nil {
+		return nil, err
+	}
+	if index == nil {
+		return ws, nil
+	}
+	if _, ok := index.Applications[appName]; !ok {
+		return ws, nil
+	}
+	return ws.useIndexedApp(index, appName)
 }
 
 // Create creates a new Workspace in the current working directory for appName with summary if it doesn't already exist.
@@ -155,6 +211,12 @@ func Create(appName string, fs afero.Fs) (*Workspace, error) {
 		ws.CopilotDirAbs = copilotDirPath
 		// If so, grab the summary.
 		summary, err := ws.Summary()
+		if err == nil && summary.IsIndex() {
+			if _, ok := summary.Applications[appName]; ok {
+				return ws.useIndexedApp(summary, appName)
+			}
+			return ws.createIndexedApp(summary, appName)
+		}
 		if err == nil {
 			// If a summary exists, but is registered to a different application, throw an error.
 			if summary.Application != appName {
@@ -564,6 +626,24 @@ func (ws *Workspace) Write(content encoding.BinaryMarshaler, path string) (strin
 	return ws.write(data, path)
 }
 
+// WritePipelineWorkflow writes the GitHub Actions or GitLab CI workflow of a pipeline under the path relative to the
+// root of the project, overwriting the file generated by a previous deployment of the pipeline.
+// If successful returns the full path of the file, otherwise an empty string and an error.
+func (ws *Workspace) WritePipelineWorkflow(marshaler encoding.BinaryMarshaler, path string) (string, error) {
+	data, err := marshaler.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("marshal workflow: %w", err)
+	}
+	filename := filepath.Join(ws.ProjectRoot(), path)
+	if err := ws.fs.MkdirAll(filepath.Dir(filename), 0755 /* -rwxr-xr-x */); err != nil {
+		return "", fmt.Errorf("create directories for file %s: %w", filename, err)
+	}
+	if err := ws.fs.WriteFile(filename, data, 0644 /* -rw-r--r-- */); err != nil {
+		return "", fmt.Errorf("write workflow file: %w", err)
+	}
+	return filename, nil
+}
+
 // FileStat wraps the os.Stat function.
 type FileStat interface {
 	Stat(name string) (os.FileInfo, error)
@@ -594,6 +674,123 @@ func (ws *Workspace) writeSummary(appName string) (*Summary, error) {
 	return &summary, ws.fs.WriteFile(summaryPath, serializedWorkspaceSummary, 0644)
 }
 
+// useIndexedApp returns the workspace of the application appName registered in the index.
+// If appName is empty, the index must register a single application.
+func (ws *Workspace) useIndexedApp(index *Summary, appName string) (*Workspace, error) {
+	if appName == "" {
+		if len(index.Applications) != 1 {
+			return nil, &ErrMultipleApplications{
+				IndexPath: index.Path,
+				AppNames:  index.ApplicationNames(),
+			}
+		}
+		appName = index.ApplicationNames()[0]
+	}
+	appDir, ok := index.Applications[appName]
+	if !ok {
+		return nil, &ErrAppNotInIndex{
+			AppName:   appName,
+			IndexPath: index.Path,
+		}
+	}
+	appWs := &Workspace{
+		workingDirAbs: ws.workingDirAbs,
+		CopilotDirAbs: filepath.Join(indexRoot(index), filepath.FromSlash(appDir), CopilotDirName),
+		fs:            ws.fs,
+		logger:        ws.logger,
+	}
+	summary, err := appWs.Summary()
+	if err != nil {
+		return nil, fmt.Errorf("read summary of application %s: %w", appName, err)
+	}
+	if summary.Application != appName {
+		return nil, fmt.Errorf("application %s is registered under %s but its workspace belongs to application %s", appName, appWs.CopilotDirAbs, summary.Application)
+	}
+	return appWs, nil
+}
+
+// createIndexedApp creates a workspace for appName in the working directory and registers it in the index.
+func (ws *Workspace) createIndexedApp(index *Summary, appName string) (*Workspace, error) {
+	root := indexRoot(index)
+	if ws.workingDirAbs == root || ws.workingDirAbs == filepath.Dir(index.Path) {
+		return nil, &ErrAppNotInIndex{
+			AppName:   appName,
+			IndexPath: index.Path,
+		}
+	}
+	appDir, err := filepath.Rel(root, ws.workingDirAbs)
+	if err != nil {
+		return nil, fmt.Errorf("get path of %s relative to %s: %w", ws.workingDirAbs, root, err)
+	}
+	appWs := &Workspace{
+		workingDirAbs: ws.workingDirAbs,
+		CopilotDirAbs: filepath.Join(ws.workingDirAbs, CopilotDirName),
+		fs:            ws.fs,
+		logger:        ws.logger,
+	}
+	if err := ws.fs.MkdirAll(appWs.CopilotDirAbs, 0755); err != nil {
+		return nil, fmt.Errorf("create directory %s: %w", appWs.CopilotDirAbs, err)
+	}
+	appWs.summary, appWs.summaryErr = appWs.writeSummary(appName)
+	if appWs.summaryErr != nil {
+		return nil, appWs.summaryErr
+	}
+	apps := make(map[string]string, len(index.Applications)+1)
+	for name, dir := range index.Applications {
+		apps[name] = dir
+	}
+	apps[appName] = filepath.ToSlash(appDir)
+	out, err := yaml.Marshal(Summary{Applications: apps})
+	if err != nil {
+		return nil, fmt.Errorf("marshal workspace index: %w", err)
+	}
+	if err := ws.fs.WriteFile(index.Path, out, 0644); err != nil {
+		return nil, fmt.Errorf("register application %s in workspace index %s: %w", appName, index.Path, err)
+	}
+	index.Applications = apps
+	return appWs, nil
+}
+
+// parentIndex returns the closest workspace index above the workspace's project root, or nil if there is none.
+func (ws *Workspace) parentIndex() (*Summary, error) {
+	var index *Summary
+	_, err := TraverseUp(filepath.Dir(ws.ProjectRoot()), maximumParentDirsToSearch, func(dir string) (string, error) {
+		summaryPath := filepath.Join(dir, CopilotDirName, SummaryFileName)
+		exists, err := ws.fs.Exists(summaryPath)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return "", nil
+		}
+		f, err := ws.fs.ReadFile(summaryPath)
+		if err != nil {
+			return "", err
+		}
+		summary := &Summary{
+			Path: summaryPath,
+		}
+		if err := yaml.Unmarshal(f, summary); err != nil {
+			return "", fmt.Errorf("unmarshal workspace summary %s: %w", summaryPath, err)
+		}
+		if summary.IsIndex() {
+			index = summary
+			return "", ErrTraverseUpShouldStop
+		}
+		return "", nil
+	})
+	var targetNotFoundErr *ErrTargetNotFound
+	if err != nil && !errors.As(err, &targetNotFoundErr) {
+		return nil, err
+	}
+	return index, nil
+}
+
+// indexRoot returns the directory that the application paths of an index are relative to.
+func indexRoot(index *Summary) string {
+	return filepath.Dir(filepath.Dir(index.Path))
+}
+
 func (ws *Workspace) pipelinesDirPath() string {
 	return filepath.Join(ws.CopilotDirAbs, pipelinesDirName)
 }
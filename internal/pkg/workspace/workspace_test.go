@@ -152,6 +152,7 @@ func TestWorkspace_Create(t *testing.T) {
 
 		expectedError         error
 		expectedCopilotDirAbs string
+		expectedIndex         string
 	}{
 		"successful no-op with existing workspace and summary": {
 			appName: "DavidsApp",
@@ -202,6 +203,36 @@ func TestWorkspace_Create(t *testing.T) {
 			},
 			expectedCopilotDirAbs: fmt.Sprintf("%s/copilot", wd),
 		},
+		"successful no-op with an application registered in the workspace index": {
+			appName: "DavidsApp",
+			mockFileSystem: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				_ = afero.WriteFile(fs, fmt.Sprintf("%s/copilot/.workspace", wd), []byte("applications:\n  DavidsApp: apps/david\n"), 0644)
+				_ = afero.WriteFile(fs, fmt.Sprintf("%s/apps/david/copilot/.workspace", wd), []byte("application: DavidsApp"), 0644)
+				fs = afero.NewReadOnlyFs(fs) // No write.
+				return fs
+			},
+			expectedCopilotDirAbs: fmt.Sprintf("%s/apps/david/copilot", wd),
+		},
+		"successfully create and register a workspace under a workspace index in a parent directory": {
+			appName: "DavidsApp",
+			mockFileSystem: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				_ = afero.WriteFile(fs, fmt.Sprintf("%s/copilot/.workspace", parent), []byte("applications:\n  DavidsOtherApp: other\n"), 0644)
+				return fs
+			},
+			expectedCopilotDirAbs: fmt.Sprintf("%s/copilot", wd),
+			expectedIndex:         fmt.Sprintf("applications:\n    DavidsApp: %s\n    DavidsOtherApp: other\n", filepath.Base(wd)),
+		},
+		"error if the workspace index is in the working directory and does not register the application": {
+			appName: "DavidsApp",
+			mockFileSystem: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				_ = afero.WriteFile(fs, fmt.Sprintf("%s/copilot/.workspace", wd), []byte("applications:\n  DavidsOtherApp: other\n"), 0644)
+				return fs
+			},
+			expectedError: fmt.Errorf("application DavidsApp is not registered in workspace index %s/copilot/.workspace", wd),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -226,6 +257,12 @@ func TestWorkspace_Create(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, tc.appName, gotSummary.Application)
 
+				// Validate that the application is registered in the workspace index.
+				if tc.expectedIndex != "" {
+					index, err := gotWS.fs.ReadFile(fmt.Sprintf("%s/copilot/.workspace", parent))
+					require.NoError(t, err)
+					require.Equal(t, tc.expectedIndex, string(index))
+				}
 			} else {
 				require.Equal(t, tc.expectedError.Error(), err.Error())
 			}
@@ -290,11 +327,78 @@ func TestWorkspace_Use(t *testing.T) {
 				CopilotDirName,
 			},
 		},
+		"returns the only application registered in the workspace index": {
+			mockFileSystem: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				_ = afero.WriteFile(fs, fmt.Sprintf("%s/copilot/.workspace", wd), []byte("applications:\n  api: apps/api\n"), 0644)
+				_ = afero.WriteFile(fs, fmt.Sprintf("%s/apps/api/copilot/.workspace", wd), []byte("application: api"), 0644)
+				fs = afero.NewReadOnlyFs(fs) // No write.
+				return fs
+			},
+			expectedCopilotDirAbs: fmt.Sprintf("%s/apps/api/copilot", wd),
+		},
+		"returns the workspace of the selected application registered in the workspace index": {
+			appName: "web",
+			mockFileSystem: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				_ = afero.WriteFile(fs, fmt.Sprintf("%s/copilot/.workspace", wd), []byte("applications:\n  api: apps/api\n  web: apps/web\n"), 0644)
+				_ = afero.WriteFile(fs, fmt.Sprintf("%s/apps/api/copilot/.workspace", wd), []byte("application: api"), 0644)
+				_ = afero.WriteFile(fs, fmt.Sprintf("%s/apps/web/copilot/.workspace", wd), []byte("application: web"), 0644)
+				fs = afero.NewReadOnlyFs(fs) // No write.
+				return fs
+			},
+			expectedCopilotDirAbs: fmt.Sprintf("%s/apps/web/copilot", wd),
+		},
+		"returns the workspace of an application registered in a workspace index in a parent directory": {
+			appName: "api",
+			mockFileSystem: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				_ = afero.WriteFile(fs, fmt.Sprintf("%s/copilot/.workspace", parent), []byte(fmt.Sprintf("applications:\n  api: api\n  web: %s\n", filepath.Base(wd))), 0644)
+				_ = afero.WriteFile(fs, fmt.Sprintf("%s/api/copilot/.workspace", parent), []byte("application: api"), 0644)
+				_ = afero.WriteFile(fs, fmt.Sprintf("%s/copilot/.workspace", wd), []byte("application: web"), 0644)
+				fs = afero.NewReadOnlyFs(fs) // No write.
+				return fs
+			},
+			expectedCopilotDirAbs: fmt.Sprintf("%s/api/copilot", parent),
+		},
+		"ErrMultipleApplications if no application is selected from the workspace index": {
+			mockFileSystem: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				_ = afero.WriteFile(fs, fmt.Sprintf("%s/copilot/.workspace", wd), []byte("applications:\n  web: apps/web\n  api: apps/api\n"), 0644)
+				return fs
+			},
+			expectedError: &ErrMultipleApplications{
+				IndexPath: fmt.Sprintf("%s/copilot/.workspace", wd),
+				AppNames:  []string{"api", "web"},
+			},
+		},
+		"ErrAppNotInIndex if the selected application is not registered in the workspace index": {
+			appName: "worker",
+			mockFileSystem: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				_ = afero.WriteFile(fs, fmt.Sprintf("%s/copilot/.workspace", wd), []byte("applications:\n  api: apps/api\n"), 0644)
+				return fs
+			},
+			expectedError: &ErrAppNotInIndex{
+				AppName:   "worker",
+				IndexPath: fmt.Sprintf("%s/copilot/.workspace", wd),
+			},
+		},
+		"error if the registered directory belongs to a different application": {
+			appName: "api",
+			mockFileSystem: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				_ = afero.WriteFile(fs, fmt.Sprintf("%s/copilot/.workspace", wd), []byte("applications:\n  api: apps/web\n  web: apps/web\n"), 0644)
+				_ = afero.WriteFile(fs, fmt.Sprintf("%s/apps/web/copilot/.workspace", wd), []byte("application: web"), 0644)
+				return fs
+			},
+			expectedError: fmt.Errorf("application api is registered under %s/apps/web/copilot but its workspace belongs to application web", wd),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			// Set up filesystem.
-			gotWS, err := Use(tc.mockFileSystem())
+			gotWS, err := UseApp(tc.appName, tc.mockFileSystem())
 			if tc.expectedError == nil {
 				// an operation not permitted error means
 				// we tried to write to the filesystem, but
@@ -1292,6 +1396,68 @@ func TestWorkspace_Write(t *testing.T) {
 	}
 }
 
+func TestWorkspace_WritePipelineWorkflow(t *testing.T) {
+	testCases := map[string]struct {
+		marshaler mockBinaryMarshaler
+		existing  []byte
+
+		wantedErr error
+	}{
+		"writes the workflow under the project root": {
+			marshaler: mockBinaryMarshaler{
+				content: []byte("jobs:"),
+			},
+		},
+		"overwrites the workflow of a previous deployment": {
+			marshaler: mockBinaryMarshaler{
+				content: []byte("jobs:"),
+			},
+			existing: []byte("name: old"),
+		},
+		"wraps error if cannot marshal to binary": {
+			marshaler: mockBinaryMarshaler{
+				err: errors.New("some error"),
+			},
+			wantedErr: errors.New("marshal workflow: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			wantedPath := filepath.FromSlash("/project/.github/workflows/copilot-release.yml")
+			fs := afero.NewMemMapFs()
+			utils := &afero.Afero{
+				Fs: fs,
+			}
+			utils.MkdirAll(filepath.FromSlash("/project/copilot"), 0755)
+			if tc.existing != nil {
+				utils.MkdirAll(filepath.Dir(wantedPath), 0755)
+				utils.WriteFile(wantedPath, tc.existing, 0644)
+			}
+			ws := &Workspace{
+				workingDirAbs: "/project",
+				CopilotDirAbs: filepath.FromSlash("/project/copilot"),
+				fs:            utils,
+			}
+
+			// WHEN
+			actualPath, actualErr := ws.WritePipelineWorkflow(tc.marshaler, filepath.FromSlash(".github/workflows/copilot-release.yml"))
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, actualErr)
+			require.Equal(t, wantedPath, actualPath)
+			out, err := utils.ReadFile(wantedPath)
+			require.NoError(t, err)
+			require.Equal(t, tc.marshaler.content, out)
+		})
+	}
+}
+
 func TestWorkspace_ReadWorkloadManifest(t *testing.T) {
 	const (
 		mockCopilotDir   = "/copilot"